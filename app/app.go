@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"sort"
 
+	v2 "github.com/ExocoreNetwork/exocore/app/upgrades/v2"
 	exoslash "github.com/ExocoreNetwork/exocore/x/slash"
 
 	slashKeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
@@ -26,9 +27,19 @@ import (
 	"github.com/ExocoreNetwork/exocore/x/delegation"
 	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
 	delegationTypes "github.com/ExocoreNetwork/exocore/x/delegation/types"
+
+	"github.com/ExocoreNetwork/exocore/x/avs"
+	avsKeeper "github.com/ExocoreNetwork/exocore/x/avs/keeper"
+	avsTypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	"github.com/ExocoreNetwork/exocore/x/deposit"
 	depositKeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	depositTypes "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	nativeToken "github.com/ExocoreNetwork/exocore/x/native_token"
+	nativeTokenKeeper "github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	nativeTokenTypes "github.com/ExocoreNetwork/exocore/x/native_token/types"
+	priceFeed "github.com/ExocoreNetwork/exocore/x/price_feed"
+	priceFeedKeeper "github.com/ExocoreNetwork/exocore/x/price_feed/keeper"
+	priceFeedTypes "github.com/ExocoreNetwork/exocore/x/price_feed/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage"
 	stakingAssetsManageKeeper "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
 	stakingAssetsManageTypes "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
@@ -258,10 +269,13 @@ var (
 		// exoCore staking modules
 		restaking_assets_manage.AppModuleBasic{},
 		deposit.AppModuleBasic{},
+		nativeToken.AppModuleBasic{},
 		delegation.AppModuleBasic{},
 		withdraw.AppModuleBasic{},
 		reward.AppModuleBasic{},
 		exoslash.AppModuleBasic{},
+		avs.AppModuleBasic{},
+		priceFeed.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -278,6 +292,7 @@ var (
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		claimstypes.ModuleName:         nil,
 		incentivestypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
+		delegationTypes.ModuleName:     nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -349,11 +364,15 @@ type ExocoreApp struct {
 	// exocore staking module keepers
 	StakingAssetsManageKeeper stakingAssetsManageKeeper.Keeper
 	DepositKeeper             depositKeeper.Keeper
+	NativeTokenKeeper         nativeTokenKeeper.Keeper
 	DelegationKeeper          delegationKeeper.Keeper
 	WithdrawKeeper            withdrawKeeper.Keeper
 	RewardKeeper              rewardKeeper.Keeper
 
 	ExoSlashKeeper slashKeeper.Keeper
+	AVSKeeper      avsKeeper.Keeper
+
+	PriceFeedKeeper priceFeedKeeper.Keeper
 	// the module manager
 	mm *module.Manager
 
@@ -432,9 +451,12 @@ func NewExocoreApp(
 		stakingAssetsManageTypes.StoreKey,
 		delegationTypes.StoreKey,
 		depositTypes.StoreKey,
+		nativeTokenTypes.StoreKey,
 		withdrawTypes.StoreKey,
 		rewardTypes.StoreKey,
 		exoslashTypes.StoreKey,
+		avsTypes.StoreKey,
+		priceFeedTypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -614,13 +636,16 @@ func NewExocoreApp(
 	)
 
 	// set exoCore staking keepers
-	app.StakingAssetsManageKeeper = stakingAssetsManageKeeper.NewKeeper(keys[stakingAssetsManageTypes.StoreKey], appCodec)
+	app.StakingAssetsManageKeeper = stakingAssetsManageKeeper.NewKeeper(keys[stakingAssetsManageTypes.StoreKey], appCodec, authAddr)
 	app.DepositKeeper = depositKeeper.NewKeeper(keys[depositTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper)
-	// todo: need to replace the virtual keepers with actual keepers after they have been implemented
-	app.DelegationKeeper = delegationKeeper.NewKeeper(keys[depositTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper, app.DepositKeeper, delegationTypes.VirtualISlashKeeper{}, delegationTypes.VirtualOperatorOptedInKeeper{})
-	app.WithdrawKeeper = *withdrawKeeper.NewKeeper(appCodec, keys[withdrawTypes.StoreKey], app.StakingAssetsManageKeeper, app.DepositKeeper)
-	app.RewardKeeper = *rewardKeeper.NewKeeper(appCodec, keys[rewardTypes.StoreKey], app.StakingAssetsManageKeeper)
-	app.ExoSlashKeeper = slashKeeper.NewKeeper(appCodec, keys[exoslashTypes.StoreKey], app.StakingAssetsManageKeeper)
+	app.NativeTokenKeeper = nativeTokenKeeper.NewKeeper(keys[nativeTokenTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper, &app.ExoSlashKeeper, authAddr)
+	// the slash and avs keepers are passed as pointers, because they depend on the delegation keeper and are set below
+	app.DelegationKeeper = delegationKeeper.NewKeeper(keys[delegationTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper, app.DepositKeeper, &app.ExoSlashKeeper, &app.AVSKeeper, &app.RewardKeeper, app.BankKeeper, &app.PriceFeedKeeper, &app.StakingKeeper, authAddr)
+	app.ExoSlashKeeper = slashKeeper.NewKeeper(appCodec, keys[exoslashTypes.StoreKey], app.StakingAssetsManageKeeper, app.DelegationKeeper, &app.AVSKeeper, authAddr)
+	app.AVSKeeper = avsKeeper.NewKeeper(keys[avsTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper, app.DelegationKeeper)
+	app.PriceFeedKeeper = priceFeedKeeper.NewKeeper(keys[priceFeedTypes.StoreKey], appCodec, app.StakingAssetsManageKeeper, app.AVSKeeper, authAddr)
+	app.WithdrawKeeper = *withdrawKeeper.NewKeeper(appCodec, keys[withdrawTypes.StoreKey], app.StakingAssetsManageKeeper, app.DepositKeeper, app.DelegationKeeper, app.ExoSlashKeeper)
+	app.RewardKeeper = *rewardKeeper.NewKeeper(appCodec, keys[rewardTypes.StoreKey], app.StakingAssetsManageKeeper, app.DelegationKeeper, app.AVSKeeper, authAddr)
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithPrecompiles(
 		evmkeeper.AvailablePrecompiles(
@@ -636,6 +661,8 @@ func NewExocoreApp(
 			app.WithdrawKeeper,
 			app.ExoSlashKeeper,
 			app.RewardKeeper,
+			app.NativeTokenKeeper,
+			app.PriceFeedKeeper,
 		),
 	)
 
@@ -645,6 +672,8 @@ func NewExocoreApp(
 			// insert epoch hooks receivers here
 			app.IncentivesKeeper.Hooks(),
 			app.InflationKeeper.Hooks(),
+			app.StakingAssetsManageKeeper.Hooks(),
+			app.DelegationKeeper.Hooks(),
 		),
 	)
 
@@ -756,9 +785,22 @@ func NewExocoreApp(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		gov.NewAppModule(appCodec, govKeeper, accountK, bankK, app.GetSubspace(govtypes.ModuleName)),
-		slashing.NewAppModule(appCodec, app.SlashingKeeper, accountK, bankK, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
-		distr.NewAppModule(appCodec, app.DistrKeeper, accountK, bankK, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
-		staking.NewAppModule(appCodec, &app.StakingKeeper, accountK, bankK, app.GetSubspace(stakingtypes.ModuleName)),
+		// the slashing, distribution and staking modules are wrapped to support the validator set derived from
+		// the restaked assets, see chain_validation.go
+		slashingModule{
+			AppModule:        slashing.NewAppModule(appCodec, app.SlashingKeeper, accountK, bankK, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
+			delegationKeeper: &app.DelegationKeeper,
+			stakingKeeper:    &app.StakingKeeper,
+		},
+		distrModule{
+			AppModule:        distr.NewAppModule(appCodec, app.DistrKeeper, accountK, bankK, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+			delegationKeeper: &app.DelegationKeeper,
+			stakingKeeper:    &app.StakingKeeper,
+		},
+		stakingModule{
+			AppModule:        staking.NewAppModule(appCodec, &app.StakingKeeper, accountK, bankK, app.GetSubspace(stakingtypes.ModuleName)),
+			delegationKeeper: &app.DelegationKeeper,
+		},
 		upgrade.NewAppModule(&app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -791,10 +833,13 @@ func NewExocoreApp(
 		// exoCore app modules
 		restaking_assets_manage.NewAppModule(appCodec, app.StakingAssetsManageKeeper),
 		deposit.NewAppModule(appCodec, app.DepositKeeper),
-		delegation.NewAppModule(appCodec, app.DelegationKeeper),
+		nativeToken.NewAppModule(appCodec, app.NativeTokenKeeper),
+		delegation.NewAppModule(appCodec, app.DelegationKeeper, keys[depositTypes.StoreKey]),
 		withdraw.NewAppModule(appCodec, app.WithdrawKeeper),
 		reward.NewAppModule(appCodec, app.RewardKeeper),
 		exoslash.NewAppModule(appCodec, app.ExoSlashKeeper),
+		avs.NewAppModule(appCodec, app.AVSKeeper),
+		priceFeed.NewAppModule(appCodec, app.PriceFeedKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -837,16 +882,22 @@ func NewExocoreApp(
 		// ExoCore modules
 		stakingAssetsManageTypes.ModuleName,
 		depositTypes.ModuleName,
+		nativeTokenTypes.ModuleName,
 		delegationTypes.ModuleName,
 		withdrawTypes.ModuleName,
 		rewardTypes.ModuleName,
 		exoslashTypes.ModuleName,
+		avsTypes.ModuleName,
+		priceFeedTypes.ModuleName,
 	)
 
 	// NOTE: fee market module must go last in order to retrieve the block gas used.
+	// NOTE: delegation module must go before staking module, the validator updates derived from the operators
+	// are returned by the staking module after the undelegations are completed.
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
+		delegationTypes.ModuleName,
 		stakingtypes.ModuleName,
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
@@ -879,10 +930,12 @@ func NewExocoreApp(
 		// ExoCore modules
 		stakingAssetsManageTypes.ModuleName,
 		depositTypes.ModuleName,
-		delegationTypes.ModuleName,
+		nativeTokenTypes.ModuleName,
 		withdrawTypes.ModuleName,
 		rewardTypes.ModuleName,
 		exoslashTypes.ModuleName,
+		avsTypes.ModuleName,
+		priceFeedTypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -919,10 +972,13 @@ func NewExocoreApp(
 		// ExoCore modules
 		stakingAssetsManageTypes.ModuleName,
 		depositTypes.ModuleName,
+		nativeTokenTypes.ModuleName,
 		delegationTypes.ModuleName,
 		withdrawTypes.ModuleName,
 		rewardTypes.ModuleName,
 		exoslashTypes.ModuleName,
+		avsTypes.ModuleName,
+		priceFeedTypes.ModuleName,
 		// Evmos modules
 		vestingtypes.ModuleName,
 		inflationtypes.ModuleName,
@@ -976,6 +1032,7 @@ func NewExocoreApp(
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
+	app.setupUpgradeHandlers()
 
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
@@ -1273,3 +1330,46 @@ func (app *ExocoreApp) BlockedAddrs() map[string]bool {
 func (app *ExocoreApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
 	return app.ScopedIBCKeeper
 }
+
+// getStoreUpgrades returns the stores added or removed by the upgrade, it's nil if the upgrade doesn't change
+// the mounted stores.
+func getStoreUpgrades(upgradeName string) *storetypes.StoreUpgrades {
+	switch upgradeName {
+	case v2.UpgradeName:
+		// the stores of the modules added after the genesis of the chain
+		return &storetypes.StoreUpgrades{
+			Added: []string{avsTypes.StoreKey, nativeTokenTypes.StoreKey, priceFeedTypes.StoreKey},
+		}
+	default:
+		return nil
+	}
+}
+
+func (app *ExocoreApp) setupUpgradeHandlers() {
+	// v2 upgrade handler, the store of the delegation module has been mounted since genesis,
+	// but the stores of the avs, native_token and price_feed modules are added in this upgrade.
+	// The indexes of the delegation module are re-keyed and the legacy exoCore address bindings
+	// of the restaking_assets_manage module are removed by the module migrations.
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v2.UpgradeName,
+		v2.CreateUpgradeHandler(
+			app.mm, app.configurator, app.EvmKeeper,
+		),
+	)
+
+	// When a planned update height is reached, the old binary will panic
+	// writing on disk the height and name of the update that triggered it
+	// This will read that value, and execute the preparations for the upgrade.
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Errorf("failed to read upgrade info from disk: %w", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	if storeUpgrades := getStoreUpgrades(upgradeInfo.Name); storeUpgrades != nil {
+		// configure store loader that checks if version == upgradeHeight and applies store upgrades
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, storeUpgrades))
+	}
+}
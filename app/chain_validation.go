@@ -0,0 +1,94 @@
+package app
+
+import (
+	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+// The modules below wrap the SDK modules that assume the validator set is managed by the staking module.
+// After the operators opted in to the Exocore chain validation take over the validator set, the validator
+// updates are returned by the EndBlock of the delegation module instead.
+
+// stakingModule returns the validator updates of both the staking module and the operators, because the
+// module manager only accepts the validator updates from one module. The validator updates of the staking
+// module are dropped after the operators take over the validator set, the staking module still processes
+// its unbonding queues.
+type stakingModule struct {
+	staking.AppModule
+	delegationKeeper *delegationKeeper.Keeper
+}
+
+func (am stakingModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if am.delegationKeeper.IsChainValidationActive(ctx) {
+		// the validator set of the staking module is restored after its EndBlock if the chain validation
+		// is disabled, so its latest validators are returned
+		am.AppModule.EndBlock(ctx, req)
+		return am.updateChainValidatorSet(ctx)
+	}
+	// the operators take over the validator set before the EndBlock of the staking module, so the removed
+	// validators are the ones in the consensus
+	updates := am.updateChainValidatorSet(ctx)
+	stakingUpdates := am.AppModule.EndBlock(ctx, req)
+	if am.delegationKeeper.IsChainValidationActive(ctx) {
+		return updates
+	}
+	return stakingUpdates
+}
+
+func (am stakingModule) updateChainValidatorSet(ctx sdk.Context) []abci.ValidatorUpdate {
+	updates, err := am.delegationKeeper.UpdateChainValidatorSet(ctx)
+	if err != nil {
+		// keep the last validator set if the validator set derived from the operators can't be calculated
+		ctx.Logger().Error("failed to update the validator set derived from the restaked assets", "error", err)
+		return []abci.ValidatorUpdate{}
+	}
+	return updates
+}
+
+// slashingModule only handles the votes of the staking validators, the operators don't have the signing info
+// of the slashing module.
+type slashingModule struct {
+	slashing.AppModule
+	delegationKeeper *delegationKeeper.Keeper
+	stakingKeeper    *stakingkeeper.Keeper
+}
+
+func (am slashingModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	am.AppModule.BeginBlock(ctx, filterStakingVotes(ctx, req, am.delegationKeeper, am.stakingKeeper))
+}
+
+// distrModule only allocates the fees to the staking validators, the fees are sent to the community pool
+// if all the votes are from the operators.
+type distrModule struct {
+	distr.AppModule
+	delegationKeeper *delegationKeeper.Keeper
+	stakingKeeper    *stakingkeeper.Keeper
+}
+
+func (am distrModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
+	am.AppModule.BeginBlock(ctx, filterStakingVotes(ctx, req, am.delegationKeeper, am.stakingKeeper))
+}
+
+// filterStakingVotes removes the votes of the validators unknown to the staking module from the request
+// when the operators have taken over the validator set.
+func filterStakingVotes(
+	ctx sdk.Context, req abci.RequestBeginBlock,
+	dk *delegationKeeper.Keeper, sk *stakingkeeper.Keeper,
+) abci.RequestBeginBlock {
+	if !dk.IsChainValidationActive(ctx) {
+		return req
+	}
+	votes := make([]abci.VoteInfo, 0, len(req.LastCommitInfo.Votes))
+	for _, vote := range req.LastCommitInfo.Votes {
+		if sk.ValidatorByConsAddr(ctx, vote.Validator.Address) != nil {
+			votes = append(votes, vote)
+		}
+	}
+	req.LastCommitInfo.Votes = votes
+	return req
+}
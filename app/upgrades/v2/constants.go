@@ -0,0 +1,13 @@
+package v2
+
+const (
+	// UpgradeName is the shared upgrade plan name, the delegation states are moved to the store of the
+	// delegation module and the stores of the avs, native_token and price_feed modules are added in this upgrade.
+	// The indexes of the pending undelegations and the operator bonds are re-keyed by the big-endian encoded
+	// height, the legacy exoCore address bindings are removed and the reverse indexes from the assets to their
+	// stakers and operators are built by the module migrations as well.
+	UpgradeName = "v2"
+
+	// PriceFeedPrecompileAddress is the address of the price feed precompile activated in this upgrade
+	PriceFeedPrecompileAddress = "0x000000000000000000000000000000000000080A"
+)
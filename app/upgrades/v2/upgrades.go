@@ -0,0 +1,37 @@
+package v2
+
+import (
+	evmkeeper "github.com/ExocoreNetwork/exocore/x/evm/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"golang.org/x/exp/slices"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v2. The delegation states are moved and re-indexed
+// by the migration of the delegation module, the legacy exoCore address bindings are removed by the migration
+// of the restaking_assets_manage module, and the price feed precompile is activated after the migrations.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ek *evmkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+		logger.Debug("running module migrations ...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug("activating the price feed precompile ...")
+		params := ek.GetParams(ctx)
+		if !slices.Contains(params.ActivePrecompiles, PriceFeedPrecompileAddress) {
+			params.ActivePrecompiles = append(params.ActivePrecompiles, PriceFeedPrecompileAddress)
+			if err := ek.SetParams(ctx, params); err != nil {
+				return nil, err
+			}
+		}
+		return vm, nil
+	}
+}
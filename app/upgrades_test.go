@@ -0,0 +1,57 @@
+package app
+
+import (
+	"sort"
+	"testing"
+
+	v2 "github.com/ExocoreNetwork/exocore/app/upgrades/v2"
+	"github.com/ExocoreNetwork/exocore/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// genesisStoreKeys are the stores mounted at the genesis of the chain, before any upgrade
+var genesisStoreKeys = []string{
+	"acc", "bank", "staking", "distribution", "slashing", "gov", "params", "upgrade",
+	"evidence", "capability", "consensus", "feegrant", "authz", "crisis",
+	"ibc", "transfer", "icahost",
+	"evm", "feemarket",
+	"inflation", "erc20", "incentives", "epochs", "claims", "vesting", "revenue", "recoveryv1",
+	"restaking_assets_manage", "delegation", "deposit", "withdraw", "reward", "exoslash",
+}
+
+// TestStoreUpgrades checks that the stores mounted by the app are the genesis stores plus the ones
+// added by the upgrades, otherwise the upgraded nodes fail to load the new stores.
+func TestStoreUpgrades(t *testing.T) {
+	app := Setup(false, nil, utils.TestnetChainID+"-1", false)
+
+	expected := make(map[string]struct{})
+	for _, key := range genesisStoreKeys {
+		expected[key] = struct{}{}
+	}
+	for _, upgradeName := range []string{v2.UpgradeName} {
+		storeUpgrades := getStoreUpgrades(upgradeName)
+		if storeUpgrades == nil {
+			continue
+		}
+		for _, key := range storeUpgrades.Added {
+			_, ok := expected[key]
+			require.False(t, ok, "the store %s has been mounted before the upgrade %s", key, upgradeName)
+			expected[key] = struct{}{}
+		}
+		for _, key := range storeUpgrades.Deleted {
+			delete(expected, key)
+		}
+	}
+
+	mounted := make([]string, 0, len(app.keys))
+	for key := range app.keys {
+		mounted = append(mounted, key)
+	}
+	expectedKeys := make([]string, 0, len(expected))
+	for key := range expected {
+		expectedKeys = append(expectedKeys, key)
+	}
+	sort.Strings(mounted)
+	sort.Strings(expectedKeys)
+	require.Equal(t, expectedKeys, mounted)
+}
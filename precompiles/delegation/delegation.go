@@ -91,10 +91,19 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 	switch method.Name {
 	// deposit transactions
-	case MethodDelegateToThroughClientChain:
+	case MethodDelegateToThroughClientChain, MethodDelegateToThroughClientChainWithApproval:
 		bz, err = p.DelegateToThroughClientChain(ctx, evm.Origin, contract, stateDB, method, args)
 	case MethodUndelegateFromThroughClientChain:
 		bz, err = p.UndelegateFromThroughClientChain(ctx, evm.Origin, contract, stateDB, method, args)
+	// delegation queries
+	case MethodGetDelegation:
+		bz, err = p.GetDelegation(ctx, contract, method, args)
+	case MethodGetOperatorAssetInfo:
+		bz, err = p.GetOperatorAssetInfo(ctx, contract, method, args)
+	case MethodGetPendingUndelegations:
+		bz, err = p.GetPendingUndelegations(ctx, contract, method, args)
+	case MethodIsOperator:
+		bz, err = p.IsOperator(ctx, contract, method, args)
 	}
 
 	if err != nil {
@@ -118,6 +127,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
 	case MethodDelegateToThroughClientChain,
+		MethodDelegateToThroughClientChainWithApproval,
 		MethodUndelegateFromThroughClientChain:
 		return true
 	default:
@@ -13,6 +13,41 @@ IDelegation constant DELEGATION_CONTRACT = IDelegation(
 /// @dev The interface through which solidity contracts will interact with delegation
 /// @custom:address 0x0000000000000000000000000000000000000805
 interface IDelegation {
+/// EVENTS
+/// @dev Emitted when the client chain assets are delegated to the operator
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param operator The bech32 address of the operator
+/// @param opAmount The delegation amount
+    event Delegated(
+        uint16 indexed clientChainLzID,
+        uint64 indexed lzNonce,
+        bytes assetsAddress,
+        bytes stakerAddress,
+        string operator,
+        uint256 opAmount
+    );
+
+/// @dev Emitted when an undelegation is queued, it will be completed at the completeHeight
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param operator The bech32 address of the operator
+/// @param opAmount The Undelegation amount
+/// @param completeHeight The block height at which the undelegation will be completed
+    event UndelegationQueued(
+        uint16 indexed clientChainLzID,
+        uint64 indexed lzNonce,
+        bytes assetsAddress,
+        bytes stakerAddress,
+        string operator,
+        uint256 opAmount,
+        uint64 completeHeight
+    );
+
 /// TRANSACTIONS
 /// @dev delegate the client chain assets to the operator through client chain, that will change the states in delegation and restaking_assets_manage module
 /// Note that this address cannot be a module account.
@@ -31,6 +66,26 @@ interface IDelegation {
         uint256 opAmount
     ) external returns (bool success);
 
+/// TRANSACTIONS
+/// @dev delegate the client chain assets to the operator that requires the approval of its approveAddr
+/// Note that this address cannot be a module account.
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param operatorAddr  The operator address that wants to be delegated to
+/// @param opAmount The delegation amount
+/// @param approval The operator approval packed as signature(65) | salt(32) | expiry(32)
+    function delegateToThroughClientChainWithApproval(
+        uint16 clientChainLzID,
+        uint64 lzNonce,
+        bytes memory assetsAddress,
+        bytes memory stakerAddress,
+        bytes memory operatorAddr,
+        uint256 opAmount,
+        bytes memory approval
+    ) external returns (bool success);
+
 /// TRANSACTIONS
 /// @dev undelegate the client chain assets from the operator through client chain, that will change the states in delegation and restaking_assets_manage module
 /// Note that this address cannot be a module account.
@@ -48,5 +103,44 @@ interface IDelegation {
         bytes memory operatorAddr,
         uint256 opAmount
     ) external returns (bool success);
+
+/// QUERIES
+/// @dev get the amounts of the staker asset delegated to the operator, they are zero if there isn't such a delegation
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param operatorAddr The operator address
+    function getDelegation(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress,
+        bytes memory stakerAddress,
+        bytes memory operatorAddr
+    ) external view returns (uint256 canUndelegationAmount, uint256 waitUndelegationAmount);
+
+/// @dev get the asset state of the operator, all the amounts are zero if the asset hasn't been delegated to the operator
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param operatorAddr The operator address
+    function getOperatorAssetInfo(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress,
+        bytes memory operatorAddr
+    ) external view returns (uint256 totalAmount, uint256 operatorOwnAmount, uint256 waitUndelegationAmount);
+
+/// @dev get the pending undelegations of the staker asset, the elements with the same index belong to the same undelegation
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+    function getPendingUndelegations(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress,
+        bytes memory stakerAddress
+    ) external view returns (string[] memory operators, uint256[] memory amounts, uint64[] memory completeHeights);
+
+/// @dev check if the address has been registered as an operator
+/// @param operatorAddr The operator address
+    function isOperator(
+        bytes memory operatorAddr
+    ) external view returns (bool registered);
 }
 
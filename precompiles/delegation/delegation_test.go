@@ -35,11 +35,26 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[delegation.MethodDelegateToThroughClientChain].Name,
 			true,
 		},
+		{
+			delegation.MethodDelegateToThroughClientChainWithApproval,
+			s.precompile.Methods[delegation.MethodDelegateToThroughClientChainWithApproval].Name,
+			true,
+		},
 		{
 			delegation.MethodUndelegateFromThroughClientChain,
 			s.precompile.Methods[delegation.MethodUndelegateFromThroughClientChain].Name,
 			true,
 		},
+		{
+			delegation.MethodGetDelegation,
+			s.precompile.Methods[delegation.MethodGetDelegation].Name,
+			false,
+		},
+		{
+			delegation.MethodIsOperator,
+			s.precompile.Methods[delegation.MethodIsOperator].Name,
+			false,
+		},
 		{
 			"invalid",
 			"invalid",
@@ -70,7 +85,7 @@ func (s *PrecompileTestSuite) TestRunDelegateToThroughClientChain() {
 	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
 	opAccAddr := "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl"
 	clientChainLzID := 101
-	lzNonce := 0
+	lzNonce := 1
 	delegationAmount := big.NewInt(50)
 	depositAmount := big.NewInt(100)
 	smallDepositAmount := big.NewInt(20)
@@ -97,6 +112,8 @@ func (s *PrecompileTestSuite) TestRunDelegateToThroughClientChain() {
 		_, err := s.app.DelegationKeeper.RegisterOperator(s.ctx, registerReq)
 		s.NoError(err)
 	}
+	// the approval is only packed into delegateToThroughClientChainWithApproval when the testcase sets it
+	var approval []byte
 	commonMalleate := func() (common.Address, []byte) {
 		// prepare the call input for delegation test
 		valAddr, err := sdk.ValAddressFromBech32(s.validators[0].OperatorAddress)
@@ -104,15 +121,20 @@ func (s *PrecompileTestSuite) TestRunDelegateToThroughClientChain() {
 		val, _ := s.app.StakingKeeper.GetValidator(s.ctx, valAddr)
 		coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e18)))
 		s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, val, sdk.NewDecCoinsFromCoins(coins...))
-		input, err := s.precompile.Pack(
-			delegation.MethodDelegateToThroughClientChain,
+		method := delegation.MethodDelegateToThroughClientChain
+		args := []interface{}{
 			uint16(clientChainLzID),
 			uint64(lzNonce),
 			assetAddr,
 			paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength),
 			[]byte(opAccAddr),
 			delegationAmount,
-		)
+		}
+		if approval != nil {
+			method = delegation.MethodDelegateToThroughClientChainWithApproval
+			args = append(args, approval)
+		}
+		input, err := s.precompile.Pack(method, args...)
 		s.Require().NoError(err, "failed to pack input")
 		return s.address, input
 	}
@@ -199,6 +221,24 @@ func (s *PrecompileTestSuite) TestRunDelegateToThroughClientChain() {
 			expPass:     false,
 			errContains: delegationtype.ErrDelegationAmountTooBig.Error(),
 		},
+		{
+			name: "fail - delegateToThroughClientChain transaction will fail because the length of the approval is invalid",
+			malleate: func() (common.Address, []byte) {
+				depositModuleParam := &types3.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
+				s.Require().NoError(err)
+				registerOperator()
+				depositAsset(s.address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				approval = make([]byte, delegation.ApprovalLength-1)
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     false,
+			errContains: "mismatched length of the input operator approval",
+		},
 		{
 			name: "pass - delegateToThroughClientChain transaction",
 			malleate: func() (common.Address, []byte) {
@@ -223,6 +263,7 @@ func (s *PrecompileTestSuite) TestRunDelegateToThroughClientChain() {
 		s.Run(tc.name, func() {
 			// setup basic test suite
 			s.SetupTest()
+			approval = nil
 
 			baseFee := s.app.FeeMarketKeeper.GetBaseFee(s.ctx)
 
@@ -452,6 +493,15 @@ func (s *PrecompileTestSuite) TestRunUnDelegateFromThroughClientChain() {
 			if tc.expPass {
 				s.Require().NoError(err, "expected no error when running the precompile")
 				s.Require().Equal(tc.returnBytes, bz, "the return doesn't match the expected result")
+				// check the emitted event and the complete height of the queued undelegation
+				logs := s.stateDB.Logs()
+				s.Require().Equal(1, len(logs))
+				event := s.precompile.ABI.Events[delegation.EventTypeUndelegationQueued]
+				s.Require().Equal(event.ID, logs[0].Topics[0])
+				data, err := event.Inputs.NonIndexed().Unpack(logs[0].Data)
+				s.Require().NoError(err)
+				s.Require().Equal(operatorAddr, data[2])
+				s.Require().Equal(uint64(s.ctx.BlockHeight())+delegationtype.CanUndelegationDelayHeight, data[4])
 			} else {
 				s.Require().Error(err, "expected error to be returned when running the precompile")
 				s.Require().Nil(bz, "expected returned bytes to be nil")
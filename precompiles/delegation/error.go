@@ -6,4 +6,5 @@ const (
 	ErrCtxTxHash               = "ctx TxHash type error or is nil,type is:%v,value:%v"
 
 	ErrInputOperatorAddrLength = "mismatched length of the input operator address,actual is:%d,expect:%v"
+	ErrInputApprovalLength     = "mismatched length of the input operator approval,actual is:%d,expect:0 or %d"
 )
@@ -0,0 +1,90 @@
+package delegation
+
+import (
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// EventTypeDelegated defines the event type for the DelegateToThroughClientChain transaction.
+	EventTypeDelegated = "Delegated"
+	// EventTypeUndelegationQueued defines the event type for the UndelegateFromThroughClientChain transaction.
+	EventTypeUndelegationQueued = "UndelegationQueued"
+)
+
+// EmitDelegatedEvent creates a new event emitted on a DelegateToThroughClientChain transaction.
+func (p Precompile) EmitDelegatedEvent(ctx sdk.Context, stateDB vm.StateDB, params *delegationkeeper.DelegationOrUndelegationParams) error {
+	event := p.ABI.Events[EventTypeDelegated]
+	topics, err := makeDelegationTopics(event, params)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(params.AssetsAddress, params.StakerAddress, params.OperatorAddress.String(), params.OpAmount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitUndelegationQueuedEvent creates a new event emitted on an UndelegateFromThroughClientChain transaction.
+// The completeHeight is the block height at which the queued undelegation will be completed.
+func (p Precompile) EmitUndelegationQueuedEvent(ctx sdk.Context, stateDB vm.StateDB, params *delegationkeeper.DelegationOrUndelegationParams, completeHeight uint64) error {
+	event := p.ABI.Events[EventTypeUndelegationQueued]
+	topics, err := makeDelegationTopics(event, params)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5], event.Inputs[6]}
+	packed, err := arguments.Pack(params.AssetsAddress, params.StakerAddress, params.OperatorAddress.String(), params.OpAmount.BigInt(), completeHeight)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// makeDelegationTopics returns the topics shared by the delegation events, the indexed
+// fields are the clientChainLzID and the lzNonce.
+func makeDelegationTopics(event abi.Event, params *delegationkeeper.DelegationOrUndelegationParams) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(uint16(params.ClientChainLzID))
+	if err != nil {
+		return nil, err
+	}
+
+	topics[2], err = cmn.MakeTopic(params.LzNonce)
+	if err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
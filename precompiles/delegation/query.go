@@ -0,0 +1,138 @@
+package delegation
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// MethodGetDelegation defines the ABI method name for the query of the delegated amounts.
+	MethodGetDelegation = "getDelegation"
+	// MethodGetOperatorAssetInfo defines the ABI method name for the query of the operator asset state.
+	MethodGetOperatorAssetInfo = "getOperatorAssetInfo"
+	// MethodGetPendingUndelegations defines the ABI method name for the query of the pending undelegations.
+	MethodGetPendingUndelegations = "getPendingUndelegations"
+	// MethodIsOperator defines the ABI method name for checking if an address is a registered operator.
+	MethodIsOperator = "isOperator"
+)
+
+// GetDelegation returns the amounts of the staker asset delegated to the operator.
+// The amounts are zero if there isn't such a delegation.
+func (p Precompile) GetDelegation(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+	stakerID, assetID, err := p.GetStakerAndAssetFromInputs(ctx, args, true)
+	if err != nil {
+		return nil, err
+	}
+	opAccAddr, err := GetOperatorAddrFromInput(args[3], 3)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts, err := p.delegationKeeper.GetSingleDelegationInfo(ctx, stakerID, assetID, opAccAddr.String())
+	if err != nil {
+		if errorsmod.IsOf(err, delegationtype.ErrNoKeyInTheStore) {
+			return method.Outputs.Pack(big.NewInt(0), big.NewInt(0))
+		}
+		return nil, err
+	}
+	return method.Outputs.Pack(amounts.CanUndelegationAmount.BigInt(), amounts.WaitUndelegationAmount.BigInt())
+}
+
+// GetOperatorAssetInfo returns the total, operator own and waiting undelegation amounts of the operator asset.
+// The amounts are zero if the asset hasn't been delegated to the operator.
+func (p Precompile) GetOperatorAssetInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	_, assetID, err := p.GetStakerAndAssetFromInputs(ctx, args, false)
+	if err != nil {
+		return nil, err
+	}
+	opAccAddr, err := GetOperatorAddrFromInput(args[2], 2)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := p.stakingStateKeeper.GetOperatorSpecifiedAssetInfo(ctx, opAccAddr, assetID)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrNoOperatorAssetKey) {
+			return method.Outputs.Pack(big.NewInt(0), big.NewInt(0), big.NewInt(0))
+		}
+		return nil, err
+	}
+	return method.Outputs.Pack(
+		info.TotalAmountOrWantChangeValue.BigInt(),
+		info.OperatorOwnAmountOrWantChangeValue.BigInt(),
+		info.WaitUndelegationAmountOrWantChangeValue.BigInt(),
+	)
+}
+
+// GetPendingUndelegations returns the pending undelegations of the staker asset. The outputs are
+// arrays of the same length, the elements with the same index belong to the same undelegation.
+func (p Precompile) GetPendingUndelegations(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	stakerID, assetID, err := p.GetStakerAndAssetFromInputs(ctx, args, true)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := p.delegationKeeper.GetStakerUndelegationRecords(ctx, stakerID, assetID, delegationkeeper.PendingRecords)
+	if err != nil {
+		return nil, err
+	}
+	operators := make([]string, 0, len(records))
+	amounts := make([]*big.Int, 0, len(records))
+	completeHeights := make([]uint64, 0, len(records))
+	for _, record := range records {
+		operators = append(operators, record.OperatorAddr)
+		amounts = append(amounts, record.Amount.BigInt())
+		completeHeights = append(completeHeights, record.CompleteBlockNumber)
+	}
+	return method.Outputs.Pack(operators, amounts, completeHeights)
+}
+
+// IsOperator checks if the input address has been registered as an operator.
+func (p Precompile) IsOperator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	opAccAddr, err := GetOperatorAddrFromInput(args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(p.delegationKeeper.IsOperator(ctx, opAccAddr))
+}
@@ -0,0 +1,115 @@
+package delegation_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestQueries tests the view methods of the delegation precompile.
+func (s *PrecompileTestSuite) TestQueries() {
+	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	operatorAddr := "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl"
+	clientChainLzID := uint16(101)
+	assetAddr := paddingClientChainAddress(usdtAddress, types.GeneralClientChainAddrLength)
+	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	s.Require().NoError(err)
+
+	// the operator hasn't been registered
+	method := s.precompile.Methods[delegation.MethodIsOperator]
+	bz, err := s.precompile.IsOperator(s.ctx, nil, &method, []interface{}{[]byte(operatorAddr)})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+
+	_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: operatorAddr,
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: operatorAddr,
+		},
+	})
+	s.Require().NoError(err)
+	bz, err = s.precompile.IsOperator(s.ctx, nil, &method, []interface{}{[]byte(operatorAddr)})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+
+	// the amounts are zero before the delegation
+	method = s.precompile.Methods[delegation.MethodGetDelegation]
+	delegationArgs := []interface{}{clientChainLzID, assetAddr, stakerAddr, []byte(operatorAddr)}
+	bz, err = s.precompile.GetDelegation(s.ctx, nil, &method, delegationArgs)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{0, 0}, toInt64s(out))
+
+	err = s.app.DepositKeeper.Deposit(s.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: uint64(clientChainLzID),
+		Action:          types.Deposit,
+		StakerAddress:   s.address.Bytes(),
+		AssetsAddress:   usdtAddress,
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	s.Require().NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: uint64(clientChainLzID),
+		Action:          types.DelegateTo,
+		StakerAddress:   s.address.Bytes(),
+		AssetsAddress:   usdtAddress,
+		OperatorAddress: opAccAddr,
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	err = s.app.DelegationKeeper.DelegateTo(s.ctx, delegationParams)
+	s.Require().NoError(err)
+	delegationParams.OpAmount = sdkmath.NewInt(20)
+	delegationParams.LzNonce = 1
+	err = s.app.DelegationKeeper.UndelegateFrom(s.ctx, delegationParams)
+	s.Require().NoError(err)
+
+	bz, err = s.precompile.GetDelegation(s.ctx, nil, &method, delegationArgs)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{30, 20}, toInt64s(out))
+
+	method = s.precompile.Methods[delegation.MethodGetOperatorAssetInfo]
+	bz, err = s.precompile.GetOperatorAssetInfo(s.ctx, nil, &method, []interface{}{clientChainLzID, assetAddr, []byte(operatorAddr)})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{50, 0, 20}, toInt64s(out))
+
+	method = s.precompile.Methods[delegation.MethodGetPendingUndelegations]
+	bz, err = s.precompile.GetPendingUndelegations(s.ctx, nil, &method, []interface{}{clientChainLzID, assetAddr, stakerAddr})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{operatorAddr}, out[0])
+	s.Require().Equal(1, len(out[1].([]*big.Int)))
+	s.Require().Equal(int64(20), out[1].([]*big.Int)[0].Int64())
+	s.Require().Equal([]uint64{uint64(s.ctx.BlockHeight()) + delegationtype.CanUndelegationDelayHeight}, out[2])
+
+	// the operator address should be a bech32 address
+	method = s.precompile.Methods[delegation.MethodIsOperator]
+	_, err = s.precompile.IsOperator(s.ctx, nil, &method, []interface{}{[]byte(common.Bytes2Hex(opAccAddr))})
+	s.Require().Error(err)
+}
+
+// toInt64s converts the unpacked uint256 outputs to int64 values for comparison.
+func toInt64s(out []interface{}) []int64 {
+	ret := make([]int64, 0, len(out))
+	for _, v := range out {
+		ret = append(ret, v.(*big.Int).Int64())
+	}
+	return ret
+}
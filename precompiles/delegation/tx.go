@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"reflect"
 
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
 )
 
 const (
@@ -15,6 +18,10 @@ const (
 	// DelegateToThroughClientChain transaction.
 	MethodDelegateToThroughClientChain = "delegateToThroughClientChain"
 
+	// MethodDelegateToThroughClientChainWithApproval defines the ABI method name for the
+	// DelegateToThroughClientChain transaction carrying the approval of the operator.
+	MethodDelegateToThroughClientChainWithApproval = "delegateToThroughClientChainWithApproval"
+
 	// MethodUndelegateFromThroughClientChain defines the ABI method name for the
 	// UndelegateFromThroughClientChain transaction.
 	MethodUndelegateFromThroughClientChain = "undelegateFromThroughClientChain"
@@ -27,7 +34,7 @@ func (p Precompile) DelegateToThroughClientChain(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
@@ -40,15 +47,35 @@ func (p Precompile) DelegateToThroughClientChain(
 		return nil, fmt.Errorf(ErrContractCaller, contract.CallerAddress, exoCoreLzAppAddr)
 	}
 
-	delegationParams, err := p.GetDelegationParamsFromInputs(ctx, args)
+	// the approval of the operator is the last input of delegateToThroughClientChainWithApproval
+	var delegationParams *delegationkeeper.DelegationOrUndelegationParams
+	if method.Name == MethodDelegateToThroughClientChainWithApproval {
+		if len(args) != 7 {
+			return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+		}
+		delegationParams, err = p.GetDelegationParamsFromInputs(ctx, args[:6])
+		if err != nil {
+			return nil, err
+		}
+		delegationParams.ApprovedInfo, err = GetApprovalFromInput(args[6], 6)
+	} else {
+		delegationParams, err = p.GetDelegationParamsFromInputs(ctx, args)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, delegationParams.ClientChainLzID, delegationParams.LzNonce); err != nil {
+		return nil, err
+	}
 
 	err = p.delegationKeeper.DelegateTo(ctx, delegationParams)
 	if err != nil {
 		return nil, err
 	}
+
+	if err = p.EmitDelegatedEvent(ctx, stateDB, delegationParams); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
 
@@ -57,7 +84,7 @@ func (p Precompile) UndelegateFromThroughClientChain(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
@@ -74,6 +101,9 @@ func (p Precompile) UndelegateFromThroughClientChain(
 	if err != nil {
 		return nil, err
 	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, UndelegationParams.ClientChainLzID, UndelegationParams.LzNonce); err != nil {
+		return nil, err
+	}
 
 	txHash, ok := ctx.Value(CtxKeyTxHash).(common.Hash)
 	if !ok || txHash.Bytes() == nil {
@@ -85,5 +115,15 @@ func (p Precompile) UndelegateFromThroughClientChain(
 	if err != nil {
 		return nil, err
 	}
+
+	// get the complete height of the queued undelegation to emit the event
+	recordKey := delegationtype.GetUndelegationRecordKey(UndelegationParams.LzNonce, UndelegationParams.TxHash.String(), UndelegationParams.OperatorAddress.String())
+	records, err := p.delegationKeeper.GetUndelegationRecords(ctx, []string{string(recordKey)}, delegationkeeper.AllRecords)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.EmitUndelegationQueuedEvent(ctx, stateDB, UndelegationParams, records[0].CompleteBlockNumber); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
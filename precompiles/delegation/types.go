@@ -10,11 +10,23 @@ import (
 
 	"github.com/ExocoreNetwork/exocore/precompiles/deposit"
 	keeper2 "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	cmn "github.com/evmos/evmos/v14/precompiles/common"
 )
 
+const (
+	// ApprovalSaltLength and ApprovalExpiryLength are the lengths of the salt and the uint256 expiry in the approval
+	ApprovalSaltLength   = common.HashLength
+	ApprovalExpiryLength = 32
+	// ApprovalLength is the length of the operator approval packed as signature(65) | salt(32) | expiry(32)
+	ApprovalLength = crypto.SignatureLength + ApprovalSaltLength + ApprovalExpiryLength
+)
+
 func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interface{}) (*keeper2.DelegationOrUndelegationParams, error) {
 	if len(args) != 6 {
 		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
@@ -58,17 +70,9 @@ func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interf
 	delegationParams.StakerAddress = stakerAddr[:clientChainAddrLength]
 
 	// the input operator address is cosmos accAddress type,so we need to check the length and decode it through Bench32
-	operatorAddr, ok := args[4].([]byte)
-	if !ok || operatorAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 4, reflect.TypeOf(args[4]), operatorAddr)
-	}
-	if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
-		return nil, fmt.Errorf(ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
-	}
-
-	opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+	opAccAddr, err := GetOperatorAddrFromInput(args[4], 4)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", string(operatorAddr)))
+		return nil, err
 	}
 	delegationParams.OperatorAddress = opAccAddr
 
@@ -79,3 +83,82 @@ func (p Precompile) GetDelegationParamsFromInputs(ctx sdk.Context, args []interf
 	delegationParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 	return delegationParams, nil
 }
+
+// GetStakerAndAssetFromInputs parses the clientChainLzID, asset address and staker address that are the
+// first inputs of the query methods. The stakerID is empty if the staker address isn't an input.
+func (p Precompile) GetStakerAndAssetFromInputs(ctx sdk.Context, args []interface{}, hasStaker bool) (stakerID, assetID string, err error) {
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return "", "", fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), clientChainLzID)
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return "", "", err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
+	assetAddr, ok := args[1].([]byte)
+	if !ok || assetAddr == nil {
+		return "", "", fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), assetAddr)
+	}
+	if len(assetAddr) != types.GeneralClientChainAddrLength {
+		return "", "", fmt.Errorf(deposit.ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
+	}
+
+	var stakerAddr []byte
+	if hasStaker {
+		stakerAddr, ok = args[2].([]byte)
+		if !ok || stakerAddr == nil {
+			return "", "", fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), stakerAddr)
+		}
+		if len(stakerAddr) != types.GeneralClientChainAddrLength {
+			return "", "", fmt.Errorf(deposit.ErrInputClientChainAddrLength, len(stakerAddr), types.GeneralClientChainAddrLength)
+		}
+		stakerAddr = stakerAddr[:clientChainAddrLength]
+	}
+
+	stakerID, assetID = types.GetStakeIDAndAssetID(uint64(clientChainLzID), stakerAddr, assetAddr[:clientChainAddrLength])
+	return stakerID, assetID, nil
+}
+
+// GetApprovalFromInput parses the operator approval input packed as signature(65) | salt(32) | expiry(32), the
+// approval is nil if the input is empty. The salt is used as its hex string, and the expiry is the unix time in seconds.
+func GetApprovalFromInput(arg interface{}, index int) (*delegationtype.DelegationApproveInfo, error) {
+	approval, ok := arg.([]byte)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, index, reflect.TypeOf(arg), arg)
+	}
+	if len(approval) == 0 {
+		return nil, nil
+	}
+	if len(approval) != ApprovalLength {
+		return nil, fmt.Errorf(ErrInputApprovalLength, len(approval), ApprovalLength)
+	}
+	expiry := new(big.Int).SetBytes(approval[crypto.SignatureLength+ApprovalSaltLength:])
+	if !expiry.IsUint64() {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, index, reflect.TypeOf(arg), arg)
+	}
+	return &delegationtype.DelegationApproveInfo{
+		Signature: hexutil.Encode(approval[:crypto.SignatureLength]),
+		Salt:      hexutil.Encode(approval[crypto.SignatureLength : crypto.SignatureLength+ApprovalSaltLength]),
+		Expiry:    expiry.Uint64(),
+	}, nil
+}
+
+// GetOperatorAddrFromInput parses the operator address input, it's the bytes of the bech32 address.
+func GetOperatorAddrFromInput(arg interface{}, index int) (sdk.AccAddress, error) {
+	operatorAddr, ok := arg.([]byte)
+	if !ok || operatorAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, index, reflect.TypeOf(arg), operatorAddr)
+	}
+	if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
+		return nil, fmt.Errorf(ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
+	}
+
+	opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", string(operatorAddr)))
+	}
+	return opAccAddr, nil
+}
@@ -90,8 +90,15 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
-	if method.Name == MethodDepositTo {
+	switch method.Name {
+	// deposit transactions
+	case MethodDepositTo:
 		bz, err = p.DepositTo(ctx, evm.Origin, contract, stateDB, method, args)
+	// deposit queries
+	case MethodGetStakerAssetInfo:
+		bz, err = p.GetStakerAssetInfo(ctx, contract, method, args)
+	case MethodGetClientChain:
+		bz, err = p.GetClientChain(ctx, contract, method, args)
 	}
 
 	if err != nil {
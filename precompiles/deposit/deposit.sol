@@ -13,18 +13,52 @@ IDeposit constant DEPOSIT_CONTRACT = IDeposit(
 /// @dev The interface through which solidity contracts will interact with Deposit
 /// @custom:address 0x0000000000000000000000000000000000000804
 interface IDeposit {
+/// EVENTS
+/// @dev Emitted when the client chain assets are deposited to the staker
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+/// @param opAmount The deposit amount
+/// @param latestAssetState The total deposit amount of the staker after the deposit
+    event Deposited(
+        uint16 indexed clientChainLzID,
+        bytes assetsAddress,
+        bytes stakerAddress,
+        uint256 opAmount,
+        uint256 latestAssetState
+    );
+
 /// TRANSACTIONS
 /// @dev deposit the client chain assets to the staker, that will change the state in deposit module
 /// Note that this address cannot be a module account.
 /// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
 /// @param assetsAddress The client chain asset Address
 /// @param stakerAddress The staker address
 /// @param opAmount The deposit amount
     function depositTo(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
     ) external returns (bool success,uint256 latestAssetState);
+
+/// QUERIES
+/// @dev get the asset state of the staker, all the amounts are zero if the staker hasn't deposited the asset
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param stakerAddress The staker address
+    function getStakerAssetInfo(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress,
+        bytes memory stakerAddress
+    ) external view returns (uint256 totalDepositAmount, uint256 canWithdrawAmount, uint256 waitUndelegationAmount);
+
+/// @dev get the info of the client chain, `registered` is false if the client chain hasn't been registered
+/// @param clientChainLzID The lzId of client chain
+    function getClientChain(
+        uint16 clientChainLzID
+    ) external view returns (bool registered, string memory name, uint64 chainID, uint64 finalizationBlocks, uint32 addressLength);
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ExocoreNetwork/exocore/precompiles/testutil/contracts"
 	types3 "github.com/ExocoreNetwork/exocore/x/deposit/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -39,6 +40,7 @@ func (s *PrecompileTestSuite) TestCallDepositToFromEOA() {
 	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
 	opAmount := big.NewInt(100)
 	assetAddr := usdtAddress
+	lzNonce := uint64(1)
 	method := "depositTo"
 
 	beforeEach := func() {
@@ -62,6 +64,7 @@ func (s *PrecompileTestSuite) TestCallDepositToFromEOA() {
 		defaultDepositArgs := defaultCallArgs.WithMethodName(method)
 		return defaultDepositArgs.WithArgs(
 			uint16(clientChainLzID),
+			lzNonce,
 			assetAddr,
 			stakerAddr,
 			opAmount)
@@ -96,24 +99,33 @@ func (s *PrecompileTestSuite) TestCallDepositToFromContract() {
 	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
 	opAmount := big.NewInt(100)
 	assetAddr := usdtAddress
+	lzNonce := uint64(1)
+	stakerID, assetID := types.GetStakeIDAndAssetID(uint64(clientChainLzID), s.address.Bytes(), common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7"))
 
 	// contractAddr is the address of the smart contract that will be deployed
 	var contractAddr common.Address
-	var err error
 
-	// deploy the caller contract
-	s.SetupTest()
-	contractAddr, err = s.DeployContract(contracts.DepositCallerContract)
-	s.Require().NoError(err)
-	// NextBlock the smart contract
-	s.NextBlock()
-	// check contract was correctly deployed
-	cAcc := s.app.EvmKeeper.GetAccount(s.ctx, contractAddr)
-	s.Require().NotNil(cAcc)
-	s.Require().True(cAcc.IsContract())
+	// the events of the caller contract and the deposit precompile can be checked in the logs
+	abiEvents := make(map[string]abi.Event)
+	for name, event := range contracts.DepositCallerContract.ABI.Events {
+		abiEvents[name] = event
+	}
+	for name, event := range s.precompile.ABI.Events {
+		abiEvents[name] = event
+	}
 
+	// the caller contract is deployed after the setup, because the setup resets the state
 	beforeEach := func() {
 		s.SetupTest()
+		var err error
+		contractAddr, err = s.DeployContract(contracts.DepositCallerContract)
+		s.Require().NoError(err)
+		s.NextBlock()
+		// check contract was correctly deployed
+		cAcc := s.app.EvmKeeper.GetAccount(s.ctx, contractAddr)
+		s.Require().NotNil(cAcc)
+		s.Require().True(cAcc.IsContract())
+
 		// populate default call args
 		defaultCallArgs = contracts.CallArgs{
 			ContractAddr: contractAddr,
@@ -122,7 +134,7 @@ func (s *PrecompileTestSuite) TestCallDepositToFromContract() {
 		}
 
 		// default log check arguments
-		defaultLogCheck = testutil.LogCheckArgs{ABIEvents: contracts.DepositCallerContract.ABI.Events}
+		defaultLogCheck = testutil.LogCheckArgs{ABIEvents: abiEvents}
 		passCheck = defaultLogCheck.WithExpPass(true)
 	}
 
@@ -132,42 +144,53 @@ func (s *PrecompileTestSuite) TestCallDepositToFromContract() {
 		defaultDepositArgs := defaultCallArgs.WithMethodName(method)
 		return defaultDepositArgs.WithArgs(
 			uint16(clientChainLzID),
+			lzNonce,
 			assetAddr,
 			stakerAddr,
 			opAmount)
 	}
+	checkDeposit := func(expected *big.Int) {
+		info, err := s.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(s.ctx, stakerID, assetID)
+		if expected.Sign() == 0 {
+			s.Require().Error(err)
+			return
+		}
+		s.Require().NoError(err)
+		s.Require().Equal(expected, info.TotalDepositAmountOrWantChangeValue.BigInt())
+		s.Require().Equal(lzNonce, s.app.StakingAssetsManageKeeper.GetLastLzNonce(s.ctx, uint64(clientChainLzID)))
+	}
 
 	// testDepositTo
 	beforeEach()
 	depositParams.ExoCoreLzAppAddress = contractAddr.String()
 	setDepositToArgs := prepareFunc(&depositParams, "testDepositTo")
-	_, _, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, passCheck)
+	_, ethRes, err := contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, passCheck.WithExpEvents(deposit.EventTypeDeposited))
 	s.Require().NoError(err)
-	//todo: need to find why the ethRet is nil when called by contract
-	/*	successRet, err := contracts.DepositCallerContract.ABI.Methods["testDepositTo"].Outputs.Pack(true, opAmount)
-		s.Require().NoError(err)
-		s.Require().Equal(successRet, ethRes.Ret)*/
+	successRet, err := contracts.DepositCallerContract.ABI.Methods["testDepositTo"].Outputs.Pack(true, opAmount)
+	s.Require().NoError(err)
+	s.Require().Equal(successRet, ethRes.Ret)
+	checkDeposit(opAmount)
 
 	// testCallDepositToAndEmitEvent
 	beforeEach()
+	depositParams.ExoCoreLzAppAddress = contractAddr.String()
 	setDepositToArgs = prepareFunc(&depositParams, "testCallDepositToAndEmitEvent")
-	// todo: need to check why can't get the ethereum log
-	// eventCheck := passCheck.WithExpEvents("callDepositToResult")
-	_, _, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, passCheck)
+	eventCheck := passCheck.WithExpEvents(deposit.EventTypeDeposited, "callDepositToResult")
+	_, ethRes, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, eventCheck)
 	s.Require().NoError(err)
-	/*	successRet, err = contracts.DepositCallerContract.ABI.Methods["testCallDepositToAndEmitEvent"].Outputs.Pack(true, opAmount)
-		s.Require().NoError(err)
-		s.Require().Equal(successRet, ethRes.Ret)*/
+	successRet, err = contracts.DepositCallerContract.ABI.Methods["testCallDepositToAndEmitEvent"].Outputs.Pack(true, opAmount)
+	s.Require().NoError(err)
+	s.Require().Equal(successRet, ethRes.Ret)
+	checkDeposit(opAmount)
 
-	// testCallDepositToWithTryCatch
+	// testCallDepositToWithTryCatch, the contract isn't the ExoCoreLzApp, so the error of the precompile is caught
 	beforeEach()
 	depositParams.ExoCoreLzAppAddress = exoCoreLzAppAddress
 	setDepositToArgs = prepareFunc(&depositParams, "testCallDepositToWithTryCatch")
-	// eventCheck = passCheck.WithExpEvents("ErrorOccurred")
-	// todo: need to check the ethereum log
-	_, _, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, passCheck)
+	_, ethRes, err = contracts.CallContractAndCheckLogs(s.ctx, s.app, setDepositToArgs, passCheck.WithExpEvents("ErrorOccurred"))
 	s.Require().NoError(err)
-	/*	successRet, err = contracts.DepositCallerContract.ABI.Methods["testCallDepositToWithTryCatch"].Outputs.Pack(false, big.NewInt(0))
-		s.Require().NoError(err)
-		s.Require().Equal(successRet, ethRes.Ret)*/
+	failureRet, err := contracts.DepositCallerContract.ABI.Methods["testCallDepositToWithTryCatch"].Outputs.Pack(false, big.NewInt(0))
+	s.Require().NoError(err)
+	s.Require().Equal(failureRet, ethRes.Ret)
+	checkDeposit(big.NewInt(0))
 }
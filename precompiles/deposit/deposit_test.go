@@ -27,6 +27,16 @@ func (s *PrecompileTestSuite) TestIsTransaction() {
 			s.precompile.Methods[deposit.MethodDepositTo].Name,
 			true,
 		},
+		{
+			deposit.MethodGetStakerAssetInfo,
+			s.precompile.Methods[deposit.MethodGetStakerAssetInfo].Name,
+			false,
+		},
+		{
+			deposit.MethodGetClientChain,
+			s.precompile.Methods[deposit.MethodGetClientChain].Name,
+			false,
+		},
 		{
 			"invalid",
 			"invalid",
@@ -60,6 +70,7 @@ func (s *PrecompileTestSuite) TestRunDepositTo() {
 	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
 	opAmount := big.NewInt(100)
 	assetAddr := usdtAddress
+	lzNonce := uint64(1)
 	commonMalleate := func() (common.Address, []byte) {
 		valAddr, err := sdk.ValAddressFromBech32(s.validators[0].OperatorAddress)
 		s.Require().NoError(err)
@@ -69,6 +80,7 @@ func (s *PrecompileTestSuite) TestRunDepositTo() {
 		input, err := s.precompile.Pack(
 			deposit.MethodDepositTo,
 			uint16(clientChainLzID),
+			lzNonce,
 			assetAddr,
 			stakerAddr,
 			opAmount,
@@ -127,6 +139,39 @@ func (s *PrecompileTestSuite) TestRunDepositTo() {
 			expPass:     false,
 			errContains: types3.ErrDepositAssetNotExist.Error(),
 		},
+		{
+			name: "fail - depositTo transaction will fail because the lzNonce has been processed",
+			malleate: func() (common.Address, []byte) {
+				depositModuleParam := &types3.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				assetAddr = usdtAddress
+				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
+				s.Require().NoError(err)
+				s.app.StakingAssetsManageKeeper.SetLastLzNonce(s.ctx, uint64(clientChainLzID), lzNonce)
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     false,
+			errContains: types.ErrDuplicateLzNonce.Error(),
+		},
+		{
+			name: "fail - depositTo transaction will fail because the lzNonce isn't the next nonce",
+			malleate: func() (common.Address, []byte) {
+				depositModuleParam := &types3.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
+				s.Require().NoError(err)
+				lzNonce = 2
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     false,
+			errContains: types.ErrUnorderedLzNonce.Error(),
+		},
 		{
 			name: "pass - depositTo transaction",
 			malleate: func() (common.Address, []byte) {
@@ -135,6 +180,7 @@ func (s *PrecompileTestSuite) TestRunDepositTo() {
 					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
 				}
 				assetAddr = usdtAddress
+				lzNonce = 1
 				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
 				s.Require().NoError(err)
 				return commonMalleate()
@@ -205,6 +251,11 @@ func (s *PrecompileTestSuite) TestRunDepositTo() {
 			if tc.expPass {
 				s.Require().NoError(err, "expected no error when running the precompile")
 				s.Require().Equal(tc.returnBytes, bz, "the return doesn't match the expected result")
+				// check the emitted event
+				logs := s.stateDB.Logs()
+				s.Require().Equal(1, len(logs))
+				s.Require().Equal(s.precompile.Address(), logs[0].Address)
+				s.Require().Equal(s.precompile.ABI.Events[deposit.EventTypeDeposited].ID, logs[0].Topics[0])
 			} else {
 				s.Require().Error(err, "expected error to be returned when running the precompile")
 				s.Require().Nil(bz, "expected returned bytes to be nil")
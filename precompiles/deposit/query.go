@@ -0,0 +1,98 @@
+package deposit
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// MethodGetStakerAssetInfo defines the ABI method name for the query of the staker asset state.
+	MethodGetStakerAssetInfo = "getStakerAssetInfo"
+	// MethodGetClientChain defines the ABI method name for the query of the client chain info.
+	MethodGetClientChain = "getClientChain"
+)
+
+// GetStakerAssetInfo returns the total deposited, withdrawable and waiting undelegation amounts of
+// the staker asset. All the amounts are zero if the staker hasn't deposited the asset.
+func (p Precompile) GetStakerAssetInfo(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), clientChainLzID)
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
+	assetAddr, ok := args[1].([]byte)
+	if !ok || assetAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), assetAddr)
+	}
+	if len(assetAddr) != types.GeneralAssetsAddrLength {
+		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralAssetsAddrLength)
+	}
+	stakerAddr, ok := args[2].([]byte)
+	if !ok || stakerAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), stakerAddr)
+	}
+	if len(stakerAddr) != types.GeneralClientChainAddrLength {
+		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(stakerAddr), types.GeneralClientChainAddrLength)
+	}
+
+	stakerID, assetID := types.GetStakeIDAndAssetID(uint64(clientChainLzID), stakerAddr[:clientChainAddrLength], assetAddr[:clientChainAddrLength])
+	assetInfo, err := p.stakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrNoStakerAssetKey) {
+			return method.Outputs.Pack(big.NewInt(0), big.NewInt(0), big.NewInt(0))
+		}
+		return nil, err
+	}
+	return method.Outputs.Pack(
+		assetInfo.TotalDepositAmountOrWantChangeValue.BigInt(),
+		assetInfo.CanWithdrawAmountOrWantChangeValue.BigInt(),
+		assetInfo.WaitUndelegationAmountOrWantChangeValue.BigInt(),
+	)
+}
+
+// GetClientChain returns the info of the client chain registered with the clientChainLzID.
+// The `registered` output is false and the other outputs are empty if it hasn't been registered.
+func (p Precompile) GetClientChain(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), clientChainLzID)
+	}
+	if !p.stakingStateKeeper.IsExistedClientChain(ctx, uint64(clientChainLzID)) {
+		return method.Outputs.Pack(false, "", uint64(0), uint64(0), uint32(0))
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true, info.Name, info.ChainId, info.FinalizationBlocks, info.AddressLength)
+}
@@ -0,0 +1,61 @@
+package deposit_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/precompiles/deposit"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TestQueries tests the view methods of the deposit precompile.
+func (s *PrecompileTestSuite) TestQueries() {
+	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint16(101)
+	assetAddr := paddingClientChainAddress(usdtAddress, types.GeneralClientChainAddrLength)
+	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
+
+	method := s.precompile.Methods[deposit.MethodGetClientChain]
+	bz, err := s.precompile.GetClientChain(s.ctx, nil, &method, []interface{}{clientChainLzID})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+	s.Require().Equal(uint32(20), out[4])
+
+	// the client chain hasn't been registered
+	bz, err = s.precompile.GetClientChain(s.ctx, nil, &method, []interface{}{uint16(1)})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+
+	// the amounts are zero before the deposit
+	method = s.precompile.Methods[deposit.MethodGetStakerAssetInfo]
+	queryArgs := []interface{}{clientChainLzID, assetAddr, stakerAddr}
+	bz, err = s.precompile.GetStakerAssetInfo(s.ctx, nil, &method, queryArgs)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	for _, amount := range out {
+		s.Require().Equal(int64(0), amount.(*big.Int).Int64())
+	}
+
+	err = s.app.DepositKeeper.Deposit(s.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: uint64(clientChainLzID),
+		Action:          types.Deposit,
+		StakerAddress:   s.address.Bytes(),
+		AssetsAddress:   usdtAddress,
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	s.Require().NoError(err)
+	bz, err = s.precompile.GetStakerAssetInfo(s.ctx, nil, &method, queryArgs)
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), out[0].(*big.Int).Int64())
+	s.Require().Equal(int64(100), out[1].(*big.Int).Int64())
+	s.Require().Equal(int64(0), out[2].(*big.Int).Int64())
+}
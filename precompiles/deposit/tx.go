@@ -21,7 +21,7 @@ func (p Precompile) DepositTo(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
@@ -40,6 +40,10 @@ func (p Precompile) DepositTo(
 	if err != nil {
 		return nil, err
 	}
+	// reject the replayed or unordered cross-chain message
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, depositParams.ClientChainLzID, depositParams.LzNonce); err != nil {
+		return nil, err
+	}
 
 	// call depositKeeper to execute the deposit action
 	err = p.depositKeeper.Deposit(ctx, depositParams)
@@ -54,5 +58,9 @@ func (p Precompile) DepositTo(
 		return nil, err
 	}
 
+	if err = p.EmitDepositedEvent(ctx, stateDB, depositParams, info.TotalDepositAmountOrWantChangeValue.BigInt()); err != nil {
+		return nil, err
+	}
+
 	return method.Outputs.Pack(true, info.TotalDepositAmountOrWantChangeValue.BigInt())
 }
@@ -13,8 +13,8 @@ import (
 )
 
 func (p Precompile) GetDepositToParamsFromInputs(ctx sdk.Context, args []interface{}) (*keeper.DepositParams, error) {
-	if len(args) != 4 {
-		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
 	}
 	depositParams := &keeper.DepositParams{}
 	clientChainLzID, ok := args[0].(uint16)
@@ -29,28 +29,34 @@ func (p Precompile) GetDepositToParamsFromInputs(ctx sdk.Context, args []interfa
 	}
 	clientChainAddrLength := info.AddressLength
 
+	lzNonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+	depositParams.LzNonce = lzNonce
+
 	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
-	assetAddr, ok := args[1].([]byte)
+	assetAddr, ok := args[2].([]byte)
 	if !ok || assetAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[0]), assetAddr)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[0]), assetAddr)
 	}
 	if len(assetAddr) != types.GeneralAssetsAddrLength {
 		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
 	}
 	depositParams.AssetsAddress = assetAddr[:clientChainAddrLength]
 
-	stakerAddr, ok := args[2].([]byte)
+	stakerAddr, ok := args[3].([]byte)
 	if !ok || stakerAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[0]), stakerAddr)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[0]), stakerAddr)
 	}
 	if len(stakerAddr) != types.GeneralClientChainAddrLength {
 		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
 	}
 	depositParams.StakerAddress = stakerAddr[:clientChainAddrLength]
 
-	opAmount, ok := args[3].(*big.Int)
+	opAmount, ok := args[4].(*big.Int)
 	if !ok || opAmount == nil || opAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[0]), opAmount)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 4, reflect.TypeOf(args[0]), opAmount)
 	}
 	depositParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 
@@ -0,0 +1,10 @@
+package gateway
+
+const (
+	ErrContractInputParaOrType = "the contract input parameter type or value error,arg index:%d, type is:%s,value:%v"
+	ErrContractCaller          = "the caller doesn't have the permission to call this function,caller:%s,need:%s"
+	ErrInvalidPayloadLength    = "the length of the payload doesn't match,action:%d,input:%d,need:%d"
+	ErrUnsupportedAction       = "the action of the payload isn't supported,action:%d"
+	ErrCtxTxHash               = "can't get the tx hash from the context,type:%s,value:%v"
+	ErrUint64Overflow          = "the uint256 field of the payload overflows uint64,field:%s,value:%s"
+)
@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// EventTypeMessageProcessed defines the event type for the handleMessage transaction.
+	EventTypeMessageProcessed = "MessageProcessed"
+)
+
+// EmitMessageProcessedEvent creates a new event emitted on a handleMessage transaction, the indexed
+// fields are the clientChainLzID and the lzNonce.
+func (p Precompile) EmitMessageProcessedEvent(ctx sdk.Context, stateDB vm.StateDB, msg *Message, success bool, latestAssetState *big.Int, reason string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeMessageProcessed]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(uint16(msg.ClientChainLzID))
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(msg.LzNonce)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(uint8(msg.Action), success, latestAssetState, reason)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
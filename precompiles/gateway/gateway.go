@@ -0,0 +1,151 @@
+package gateway
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	depositKeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	nativeTokenKeeper "github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	stakingStateKeeper "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	rewardKeeper "github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	exoslashKeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	withdrawKeeper "github.com/ExocoreNetwork/exocore/x/withdraw/keeper"
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the cross-chain message gateway. It accepts the raw
+// LayerZero payload from the exoCore lzApp and dispatches it to the restaking modules according to its action.
+type Precompile struct {
+	cmn.Precompile
+	stakingStateKeeper stakingStateKeeper.Keeper
+	depositKeeper      depositKeeper.Keeper
+	delegationKeeper   delegationKeeper.Keeper
+	withdrawKeeper     withdrawKeeper.Keeper
+	rewardKeeper       rewardKeeper.Keeper
+	slashKeeper        exoslashKeeper.Keeper
+	nativeTokenKeeper  nativeTokenKeeper.Keeper
+}
+
+// NewPrecompile creates a new gateway Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	stakingStateKeeper stakingStateKeeper.Keeper,
+	depositKeeper depositKeeper.Keeper,
+	delegationKeeper delegationKeeper.Keeper,
+	withdrawKeeper withdrawKeeper.Keeper,
+	rewardKeeper rewardKeeper.Keeper,
+	slashKeeper exoslashKeeper.Keeper,
+	nativeTokenKeeper nativeTokenKeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		return nil, fmt.Errorf("error loading the gateway ABI %s", err)
+	}
+
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidABI, err)
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		stakingStateKeeper: stakingStateKeeper,
+		depositKeeper:      depositKeeper,
+		delegationKeeper:   delegationKeeper,
+		withdrawKeeper:     withdrawKeeper,
+		rewardKeeper:       rewardKeeper,
+		slashKeeper:        slashKeeper,
+		nativeTokenKeeper:  nativeTokenKeeper,
+	}, nil
+}
+
+// Address defines the address of the gateway compile contract.
+// address: 0x0000000000000000000000000000000000000809
+func (p Precompile) Address() common.Address {
+	return common.HexToAddress("0x0000000000000000000000000000000000000809")
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract gateway methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// gateway transactions
+	case MethodHandleMessage:
+		bz, err = p.HandleMessage(ctx, contract, stateDB, method, args)
+	}
+
+	if err != nil {
+		p.Logger(ctx).Error("call gateway precompile error", "err", err)
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given methodID corresponds to a transaction or query.
+//
+// Available gateway transactions are:
+//   - HandleMessage
+func (Precompile) IsTransaction(methodID string) bool {
+	switch methodID {
+	case MethodHandleMessage:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("ExoCore module", "gateway")
+}
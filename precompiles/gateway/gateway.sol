@@ -0,0 +1,53 @@
+pragma solidity >=0.8.17;
+
+/// @dev The GATEWAY contract's address.
+address constant GATEWAY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The GATEWAY contract's instance.
+IGateway constant GATEWAY_CONTRACT = IGateway(
+    GATEWAY_PRECOMPILE_ADDRESS
+);
+
+/// @author Exocore Team
+/// @title Gateway Precompile Contract
+/// @dev The interface through which the exoCore lzApp hands the cross-chain messages to Exocore
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IGateway {
+/// EVENTS
+/// @dev Emitted when the cross-chain message is handled, the failed message is also emitted with the reason
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param action The action of the message, it's the CrossChainOpType in restaking_assets_manage
+/// @param success Whether the operation of the message succeeds
+/// @param latestAssetState The total deposit amount of the staker after the message is handled
+/// @param reason The error of the failed operation, it's empty if the operation succeeds
+    event MessageProcessed(
+        uint16 indexed clientChainLzID,
+        uint64 indexed lzNonce,
+        uint8 action,
+        bool success,
+        uint256 latestAssetState,
+        string reason
+    );
+
+/// TRANSACTIONS
+/// @dev handle the LayerZero message from the client chain, the transaction is reverted if the caller or the
+/// nonce is invalid, otherwise the result is returned to be acknowledged to the client chain.
+/// The payload is abi.encodePacked(action, actionArgs), the client chain addresses are padded to 32 bytes:
+///   - deposit, withdrawPrinciple, withdrawReward: asset | staker | amount
+///   - delegateTo, undelegateFrom: asset | staker | operator(bech32, 44 bytes) | amount
+///   - slash: asset | operator(bech32, 44 bytes) | middleware(20 bytes) | proportion(18 decimals) | proof
+///   - registerNativePod: staker | pod
+///   - updateNativeValidatorBalance: staker | validatorPubkey(48 bytes) | slot | stateRootProof | validatorProof | balanceProof
+///   - withdrawNativeValidator: staker | validatorPubkey(48 bytes) | slot | stateRootProof | validatorProof | withdrawalProof
+/// The beacon chain proofs are verified against the beacon block root of the slot submitted by the beacon oracle,
+/// their layouts are documented in the Message of the gateway precompile.
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param payload The LayerZero payload
+    function handleMessage(
+        uint16 clientChainLzID,
+        uint64 lzNonce,
+        bytes memory payload
+    ) external returns (uint64 nonce, uint8 action, bool success, uint256 latestAssetState);
+}
@@ -0,0 +1,376 @@
+package gateway_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/app"
+	"github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	"github.com/ExocoreNetwork/exocore/precompiles/gateway"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	nativetokentypes "github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v14/types"
+	"github.com/evmos/evmos/v14/x/evm/statedb"
+	evmtypes "github.com/evmos/evmos/v14/x/evm/types"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.Require().True(s.precompile.IsTransaction(s.precompile.Methods[gateway.MethodHandleMessage].Name))
+	s.Require().False(s.precompile.IsTransaction("invalid"))
+}
+
+func paddingClientChainAddress(input []byte, outputLength int) []byte {
+	if len(input) < outputLength {
+		padding := make([]byte, outputLength-len(input))
+		return append(input, padding...)
+	}
+	return input
+}
+
+// packPayload packs the payload as abi.encodePacked(action, actionArgs) in the lzApp.
+func packPayload(action types.CrossChainOpType, args ...[]byte) []byte {
+	payload := []byte{byte(action)}
+	for _, arg := range args {
+		payload = append(payload, arg...)
+	}
+	return payload
+}
+
+func uint256Bytes(amount int64) []byte {
+	return common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)
+}
+
+// runHandleMessage calls the handleMessage method of the gateway precompile by the caller.
+func (s *PrecompileTestSuite) runHandleMessage(caller common.Address, lzNonce uint64, payload []byte) ([]byte, error) {
+	input, err := s.precompile.Pack(gateway.MethodHandleMessage, uint16(101), lzNonce, payload)
+	s.Require().NoError(err, "failed to pack input")
+
+	baseFee := s.app.FeeMarketKeeper.GetBaseFee(s.ctx)
+	contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, big.NewInt(0), uint64(1e6))
+	contract.Input = input
+
+	contractAddr := contract.Address()
+	// Build and sign Ethereum transaction
+	txArgs := evmtypes.EvmTxArgs{
+		ChainID:   s.app.EvmKeeper.ChainID(),
+		Nonce:     lzNonce,
+		To:        &contractAddr,
+		Amount:    nil,
+		GasLimit:  100000,
+		GasPrice:  app.MainnetMinGasPrices.BigInt(),
+		GasFeeCap: baseFee,
+		GasTipCap: big.NewInt(1),
+		Accesses:  &ethtypes.AccessList{},
+	}
+	msgEthereumTx := evmtypes.NewTx(&txArgs)
+	msgEthereumTx.From = s.address.String()
+	err = msgEthereumTx.Sign(s.ethSigner, s.signer)
+	s.Require().NoError(err, "failed to sign Ethereum message")
+
+	proposerAddress := s.ctx.BlockHeader().ProposerAddress
+	cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, proposerAddress, s.app.EvmKeeper.ChainID())
+	s.Require().NoError(err, "failed to instantiate EVM config")
+	msg, err := msgEthereumTx.AsMessage(s.ethSigner, baseFee)
+	s.Require().NoError(err, "failed to instantiate Ethereum message")
+
+	// set txHash for the undelegation
+	s.ctx = s.ctx.WithValue(delegation.CtxKeyTxHash, common.HexToHash(msgEthereumTx.Hash))
+	s.stateDB = statedb.New(s.ctx, s.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(s.ctx.HeaderHash().Bytes())))
+	evm := s.app.EvmKeeper.NewEVM(s.ctx, msg, cfg, nil, s.stateDB)
+	params := s.app.EvmKeeper.GetParams(s.ctx)
+	activePrecompiles := params.GetActivePrecompilesAddrs()
+	precompileMap := s.app.EvmKeeper.Precompiles(activePrecompiles...)
+	err = vm.ValidatePrecompiles(precompileMap, activePrecompiles)
+	s.Require().NoError(err, "invalid precompiles", activePrecompiles)
+	evm.WithPrecompiles(precompileMap, activePrecompiles)
+
+	return s.precompile.Run(evm, contract, false)
+}
+
+func (s *PrecompileTestSuite) TestHandleMessage() {
+	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetAddr := paddingClientChainAddress(usdtAddress, types.GeneralAssetsAddrLength)
+	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
+	operator := "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl"
+	method := s.precompile.Methods[gateway.MethodHandleMessage]
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, s.address.Bytes(), usdtAddress)
+
+	exoCoreLzAppEventTopic := "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec"
+
+	// only the exoCore lzApp can call the gateway
+	err := s.app.DepositKeeper.SetParams(s.ctx, &deposittype.Params{
+		ExoCoreLzAppAddress:    "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+	})
+	s.Require().NoError(err)
+	depositPayload := packPayload(types.Deposit, assetAddr, stakerAddr, uint256Bytes(100))
+	_, err = s.runHandleMessage(s.address, 1, depositPayload)
+	s.Require().ErrorContains(err, "the caller doesn't have the permission to call this function")
+
+	err = s.app.DepositKeeper.SetParams(s.ctx, &deposittype.Params{
+		ExoCoreLzAppAddress:    s.address.String(),
+		ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+	})
+	s.Require().NoError(err)
+	bz, err := s.runHandleMessage(s.address, 1, depositPayload)
+	s.Require().NoError(err)
+	expected, err := method.Outputs.Pack(uint64(1), uint8(types.Deposit), true, big.NewInt(100))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	logs := s.stateDB.Logs()
+	s.Require().Equal(1, len(logs))
+	s.Require().Equal(s.precompile.ABI.Events[gateway.EventTypeMessageProcessed].ID, logs[0].Topics[0])
+
+	// the replayed message is rejected
+	_, err = s.runHandleMessage(s.address, 1, depositPayload)
+	s.Require().ErrorContains(err, types.ErrDuplicateLzNonce.Error())
+
+	// the invalid payload is rejected without consuming the nonce
+	_, err = s.runHandleMessage(s.address, 2, depositPayload[:len(depositPayload)-1])
+	s.Require().ErrorContains(err, "the length of the payload doesn't match")
+	_, err = s.runHandleMessage(s.address, 2, packPayload(types.CrossChainOpType(10)))
+	s.Require().ErrorContains(err, "the action of the payload isn't supported")
+
+	// the failed operation is acknowledged and consumes the nonce, but its state changes are discarded
+	bz, err = s.runHandleMessage(s.address, 2, packPayload(types.WithdrawPrinciple, assetAddr, stakerAddr, uint256Bytes(200)))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(2), uint8(types.WithdrawPrinciple), false, big.NewInt(100))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	s.Require().Equal(uint64(2), s.app.StakingAssetsManageKeeper.GetLastLzNonce(s.ctx, 101))
+
+	bz, err = s.runHandleMessage(s.address, 3, packPayload(types.WithdrawPrinciple, assetAddr, stakerAddr, uint256Bytes(10)))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(3), uint8(types.WithdrawPrinciple), true, big.NewInt(90))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+
+	// delegate to and undelegate from the operator
+	_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: operator,
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: operator,
+		},
+	})
+	s.Require().NoError(err)
+	bz, err = s.runHandleMessage(s.address, 4, packPayload(types.DelegateTo, assetAddr, stakerAddr, []byte(operator), uint256Bytes(50)))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(4), uint8(types.DelegateTo), true, big.NewInt(90))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	_, err = s.runHandleMessage(s.address, 5, packPayload(types.UndelegateFrom, assetAddr, stakerAddr, []byte(operator), uint256Bytes(20)))
+	s.Require().NoError(err)
+	delegationAmounts, err := s.app.DelegationKeeper.GetSingleDelegationInfo(s.ctx, stakerID, assetID, operator)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(30), delegationAmounts.CanUndelegationAmount)
+	s.Require().Equal(sdkmath.NewInt(20), delegationAmounts.WaitUndelegationAmount)
+
+	// slash 10% of the operator's assets by the AVS that the operator has opted into
+	middleware := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	err = s.app.AVSKeeper.SetAVSInfo(s.ctx, &avstypes.AVSInfo{
+		Name:                      "avs",
+		MiddlewareContractAddress: middleware.String(),
+		AssetIDs:                  []string{assetID},
+		UnbondingPeriod:           1,
+	})
+	s.Require().NoError(err)
+	opAccAddr := sdk.MustAccAddressFromBech32(operator)
+	s.Require().NoError(s.app.AVSKeeper.OptIn(s.ctx, opAccAddr, middleware.String()))
+	proportion := sdkmath.LegacyNewDecWithPrec(1, 1)
+	bz, err = s.runHandleMessage(s.address, 6, packPayload(types.Slash, assetAddr, []byte(operator), middleware.Bytes(), common.LeftPadBytes(proportion.BigInt().Bytes(), 32)))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(6), uint8(types.Slash), true, big.NewInt(0))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	operatorInfo, err := s.app.StakingAssetsManageKeeper.GetOperatorSpecifiedAssetInfo(s.ctx, opAccAddr, assetID)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(45), operatorInfo.TotalAmountOrWantChangeValue)
+
+	// the message of the paused asset is acknowledged as failed with the reason in the event
+	err = s.app.StakingAssetsManageKeeper.SetAssetPaused(s.ctx, assetID, true)
+	s.Require().NoError(err)
+	bz, err = s.runHandleMessage(s.address, 7, depositPayload)
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(7), uint8(types.Deposit), false, big.NewInt(85))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	logs = s.stateDB.Logs()
+	s.Require().Contains(string(logs[len(logs)-1].Data), types.ErrAssetPaused.Error())
+
+	// the messages of the paused client chain are rejected without consuming the nonce
+	err = s.app.StakingAssetsManageKeeper.SetClientChainPaused(s.ctx, 101, true)
+	s.Require().NoError(err)
+	_, err = s.runHandleMessage(s.address, 8, depositPayload)
+	s.Require().ErrorContains(err, types.ErrClientChainPaused.Error())
+	s.Require().Equal(uint64(7), s.app.StakingAssetsManageKeeper.GetLastLzNonce(s.ctx, 101))
+}
+
+func (s *PrecompileTestSuite) TestHandleDelegationApproval() {
+	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	assetAddr := paddingClientChainAddress(usdtAddress, types.GeneralAssetsAddrLength)
+	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
+	operator := "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl"
+	method := s.precompile.Methods[gateway.MethodHandleMessage]
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, s.address.Bytes(), usdtAddress)
+
+	err := s.app.DepositKeeper.SetParams(s.ctx, &deposittype.Params{
+		ExoCoreLzAppAddress:    s.address.String(),
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+	})
+	s.Require().NoError(err)
+	_, err = s.runHandleMessage(s.address, 1, packPayload(types.Deposit, assetAddr, stakerAddr, uint256Bytes(100)))
+	s.Require().NoError(err)
+
+	// the operator requires the approval of its approveAddr
+	approveKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: operator,
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: operator,
+			ApproveAddr:  sdk.AccAddress(crypto.PubkeyToAddress(approveKey.PublicKey).Bytes()).String(),
+		},
+	})
+	s.Require().NoError(err)
+	delegatePayload := packPayload(types.DelegateTo, assetAddr, stakerAddr, []byte(operator), uint256Bytes(50))
+	bz, err := s.runHandleMessage(s.address, 2, delegatePayload)
+	s.Require().NoError(err)
+	ret, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().False(ret[2].(bool))
+
+	chainID, err := evmostypes.ParseChainID(s.ctx.ChainID())
+	s.Require().NoError(err)
+	salt := common.HexToHash("0x01")
+	expiry := uint64(s.ctx.BlockTime().Unix()) + 3600
+	digest := delegationtype.GetDelegationApprovalDigest(chainID, stakerID, operator, assetID, big.NewInt(50), hexutil.Encode(salt.Bytes()), expiry)
+	sig, err := crypto.Sign(digest, approveKey)
+	s.Require().NoError(err)
+	approval := append(append(sig, salt.Bytes()...), uint256Bytes(int64(expiry))...)
+	s.Require().Equal(delegation.ApprovalLength, len(approval))
+
+	// the approval is only accepted in the delegation payload
+	_, err = s.runHandleMessage(s.address, 3, packPayload(types.UndelegateFrom, assetAddr, stakerAddr, []byte(operator), uint256Bytes(50), approval))
+	s.Require().ErrorContains(err, "the length of the payload doesn't match")
+
+	bz, err = s.runHandleMessage(s.address, 3, append(delegatePayload, approval...))
+	s.Require().NoError(err)
+	ret, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().True(ret[2].(bool))
+	delegationAmounts, err := s.app.DelegationKeeper.GetSingleDelegationInfo(s.ctx, stakerID, assetID, operator)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(50), delegationAmounts.CanUndelegationAmount)
+}
+
+func (s *PrecompileTestSuite) TestHandleNativeTokenMessage() {
+	stakerAddr := paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength)
+	podAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	validatorPubkey := make([]byte, nativetokentypes.ValidatorPubkeyLength)
+	validatorPubkey[0] = 1
+	method := s.precompile.Methods[gateway.MethodHandleMessage]
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, s.address.Bytes(), nativetokentypes.NativeETHAssetAddr.Bytes())
+
+	err := s.app.DepositKeeper.SetParams(s.ctx, &deposittype.Params{
+		ExoCoreLzAppAddress:    s.address.String(),
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+	})
+	s.Require().NoError(err)
+	err = s.app.StakingAssetsManageKeeper.SetStakingAssetInfo(s.ctx, &types.StakingAssetInfo{
+		AssetBasicInfo: &types.AssetInfo{
+			Name:             "native ETH",
+			Symbol:           "ETH",
+			Address:          nativetokentypes.NativeETHAssetAddr.String(),
+			Decimals:         18,
+			TotalSupply:      sdkmath.NewInt(1e18),
+			LayerZeroChainID: 101,
+		},
+		StakingTotalAmount: sdkmath.NewInt(0),
+	})
+	s.Require().NoError(err)
+
+	bz, err := s.runHandleMessage(s.address, 1, packPayload(types.RegisterNativePod, stakerAddr, paddingClientChainAddress(podAddr.Bytes(), types.GeneralClientChainAddrLength)))
+	s.Require().NoError(err)
+	expected, err := method.Outputs.Pack(uint64(1), uint8(types.RegisterNativePod), true, big.NewInt(0))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+
+	// the acknowledged asset state is the native ETH balance of the staker proven against the beacon block root, the
+	// proofs are packed by the layout of the fork at the slot
+	denebSlot := uint64(beacon.MainnetDenebForkEpoch*32 + 1000)
+	proofs := s.submitBeaconBlock(denebSlot, testutil.NewBeaconBlockBuilder(denebSlot).AddValidator(10, validatorPubkey, podAddr, 32e9, testutil.FarFutureEpoch))
+	balanceWei := new(big.Int).Mul(big.NewInt(32e9), big.NewInt(1e9))
+	bz, err = s.runHandleMessage(s.address, 2, packPayload(types.UpdateNativeValidatorBalance, stakerAddr, validatorPubkey, uint256Bytes(int64(denebSlot)),
+		packStateRootProof(proofs.StateRootProof()), packValidatorProof(proofs.ValidatorProof(10)), packBalanceProof(proofs.BalanceProof(10))))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(2), uint8(types.UpdateNativeValidatorBalance), true, balanceWei)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	info := s.app.NativeTokenKeeper.GetStakerInfo(s.ctx, stakerID)
+	s.Require().Equal(sdkmath.NewIntFromBigInt(balanceWei), info.TotalValidatorBalances)
+
+	// the native ETH can't be deposited or withdrawn directly
+	nativeAssetAddr := paddingClientChainAddress(nativetokentypes.NativeETHAssetAddr.Bytes(), types.GeneralAssetsAddrLength)
+	bz, err = s.runHandleMessage(s.address, 3, packPayload(types.WithdrawPrinciple, nativeAssetAddr, stakerAddr, uint256Bytes(1)))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(3), uint8(types.WithdrawPrinciple), false, balanceWei)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+
+	electraSlot := uint64(beacon.MainnetElectraForkEpoch * 32)
+	proofs = s.submitBeaconBlock(electraSlot, testutil.NewBeaconBlockBuilder(electraSlot).AddValidator(10, validatorPubkey, podAddr, 0, electraSlot/32).AddWithdrawal(10, podAddr, 32e9))
+	bz, err = s.runHandleMessage(s.address, 4, packPayload(types.WithdrawNativeValidator, stakerAddr, validatorPubkey, uint256Bytes(int64(electraSlot)),
+		packStateRootProof(proofs.StateRootProof()), packValidatorProof(proofs.ValidatorProof(10)), packWithdrawalProof(proofs.WithdrawalProof(0))))
+	s.Require().NoError(err)
+	expected, err = method.Outputs.Pack(uint64(4), uint8(types.WithdrawNativeValidator), true, big.NewInt(0))
+	s.Require().NoError(err)
+	s.Require().Equal(expected, bz)
+	assetInfo, err := s.app.StakingAssetsManageKeeper.GetStakingAssetInfo(s.ctx, assetID)
+	s.Require().NoError(err)
+	s.Require().True(assetInfo.StakingTotalAmount.IsZero())
+}
+
+// submitBeaconBlock builds the beacon block and stores its root as if it's submitted by the beacon oracle
+func (s *PrecompileTestSuite) submitBeaconBlock(slot uint64, builder *testutil.BeaconBlockBuilder) *testutil.BeaconBlockProofs {
+	proofs := builder.Build()
+	err := s.app.NativeTokenKeeper.SetBeaconBlockRoot(s.ctx, slot, proofs.BlockRoot)
+	s.Require().NoError(err)
+	return proofs
+}
+
+func packNodes(nodes ...common.Hash) []byte {
+	ret := make([]byte, 0, len(nodes)*common.HashLength)
+	for _, node := range nodes {
+		ret = append(ret, node.Bytes()...)
+	}
+	return ret
+}
+
+func packStateRootProof(proof beacon.StateRootProof) []byte {
+	return append(proof.StateRoot.Bytes(), packNodes(proof.Proof...)...)
+}
+
+func packValidatorProof(proof beacon.ValidatorProof) []byte {
+	ret := append(uint256Bytes(int64(proof.ValidatorIndex)), packNodes(proof.Fields...)...)
+	return append(ret, packNodes(proof.Proof...)...)
+}
+
+func packBalanceProof(proof beacon.BalanceProof) []byte {
+	return append(proof.BalanceRoot.Bytes(), packNodes(proof.Proof...)...)
+}
+
+func packWithdrawalProof(proof beacon.WithdrawalProof) []byte {
+	ret := append(uint256Bytes(int64(proof.WithdrawalIndex)), packNodes(proof.Fields...)...)
+	return append(ret, packNodes(proof.Proof...)...)
+}
@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	nativetokenkeeper "github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	nativetokentypes "github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	rewardkeeper "github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	slashkeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	withdrawkeeper "github.com/ExocoreNetwork/exocore/x/withdraw/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// MethodHandleMessage defines the ABI method name for handling the cross-chain message.
+	MethodHandleMessage = "handleMessage"
+)
+
+// HandleMessage handles the LayerZero message from the client chain. The caller and the nonce are checked
+// before the message is dispatched, and the transaction is reverted if they are invalid. Then the operation
+// is executed in a cached context, its state changes are discarded if it fails, but the nonce is still consumed
+// and the failure is returned, so the lzApp can acknowledge the result to the client chain.
+func (p Precompile) HandleMessage(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// check the invalidation of caller contract, the caller must be exoCore LzApp contract
+	exoCoreLzAppAddr, err := p.depositKeeper.GetExoCoreLzAppAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if contract.CallerAddress != exoCoreLzAppAddr {
+		return nil, fmt.Errorf(ErrContractCaller, contract.CallerAddress, exoCoreLzAppAddr)
+	}
+
+	msg, err := p.GetMessageFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, msg.ClientChainLzID, msg.LzNonce); err != nil {
+		return nil, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	reason := ""
+	if err = p.dispatch(cacheCtx, msg); err != nil {
+		reason = err.Error()
+		p.Logger(ctx).Info("the cross-chain message failed", "clientChainLzID", msg.ClientChainLzID, "lzNonce", msg.LzNonce, "action", msg.Action, "err", err)
+	} else {
+		writeCache()
+	}
+	success := reason == ""
+
+	latestAssetState := p.getLatestAssetState(ctx, msg)
+	if err = p.EmitMessageProcessedEvent(ctx, stateDB, msg, success, latestAssetState, reason); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(msg.LzNonce, uint8(msg.Action), success, latestAssetState)
+}
+
+// dispatch executes the operation of the message by the corresponding keeper.
+func (p Precompile) dispatch(ctx sdk.Context, msg *Message) error {
+	// the native ETH is deposited and withdrawn through the beacon chain validators
+	if (msg.Action == types.Deposit || msg.Action == types.WithdrawPrinciple) && nativetokentypes.IsNativeETHAsset(msg.AssetsAddress) {
+		return errorsmod.Wrap(nativetokentypes.ErrNativeAssetInvalidOp, fmt.Sprintf("action:%d", msg.Action))
+	}
+	switch msg.Action {
+	case types.Deposit:
+		return p.depositKeeper.Deposit(ctx, &depositkeeper.DepositParams{
+			ClientChainLzID: msg.ClientChainLzID,
+			LzNonce:         msg.LzNonce,
+			Action:          msg.Action,
+			AssetsAddress:   msg.AssetsAddress,
+			StakerAddress:   msg.StakerAddress,
+			OpAmount:        msg.OpAmount,
+		})
+	case types.WithdrawPrinciple:
+		_, err := p.withdrawKeeper.Withdraw(ctx, &withdrawkeeper.WithdrawParams{
+			ClientChainLzID: msg.ClientChainLzID,
+			LzNonce:         msg.LzNonce,
+			Action:          msg.Action,
+			AssetsAddress:   msg.AssetsAddress,
+			WithdrawAddress: msg.StakerAddress,
+			OpAmount:        msg.OpAmount,
+		})
+		return err
+	case types.WithDrawReward:
+		return p.rewardKeeper.RewardForWithdraw(ctx, &rewardkeeper.RewardParams{
+			ClientChainLzID:       msg.ClientChainLzID,
+			LzNonce:               msg.LzNonce,
+			Action:                msg.Action,
+			AssetsAddress:         msg.AssetsAddress,
+			WithdrawRewardAddress: msg.StakerAddress,
+			OpAmount:              msg.OpAmount,
+		})
+	case types.DelegateTo, types.UndelegateFrom:
+		params := &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: msg.ClientChainLzID,
+			Action:          msg.Action,
+			AssetsAddress:   msg.AssetsAddress,
+			OperatorAddress: msg.OperatorAddress,
+			StakerAddress:   msg.StakerAddress,
+			OpAmount:        msg.OpAmount,
+			LzNonce:         msg.LzNonce,
+			TxHash:          msg.TxHash,
+			ApprovedInfo:    msg.ApprovedInfo,
+		}
+		if msg.Action == types.DelegateTo {
+			return p.delegationKeeper.DelegateTo(ctx, params)
+		}
+		return p.delegationKeeper.UndelegateFrom(ctx, params)
+	case types.Slash:
+		return p.slashKeeper.Slash(ctx, &slashkeeper.SlashParams{
+			ClientChainLzID:           msg.ClientChainLzID,
+			LzNonce:                   msg.LzNonce,
+			Action:                    msg.Action,
+			AssetsAddress:             msg.AssetsAddress,
+			OperatorAddress:           msg.OperatorAddress,
+			MiddlewareContractAddress: msg.MiddlewareContractAddress,
+			Proportion:                msg.Proportion,
+			Proof:                     msg.Proof,
+		})
+	case types.RegisterNativePod:
+		return p.nativeTokenKeeper.RegisterPod(ctx, msg.ClientChainLzID, msg.StakerAddress, msg.PodAddress)
+	case types.UpdateNativeValidatorBalance:
+		return p.nativeTokenKeeper.UpdateValidatorBalance(ctx, &nativetokenkeeper.BalanceUpdateParams{
+			ClientChainLzID: msg.ClientChainLzID,
+			StakerAddress:   msg.StakerAddress,
+			ValidatorPubkey: msg.ValidatorPubkey,
+			Slot:            msg.BeaconSlot,
+			StateRootProof:  msg.StateRootProof,
+			ValidatorProof:  msg.ValidatorProof,
+			BalanceProof:    msg.BalanceProof,
+		})
+	case types.WithdrawNativeValidator:
+		return p.nativeTokenKeeper.WithdrawValidator(ctx, &nativetokenkeeper.WithdrawalParams{
+			ClientChainLzID: msg.ClientChainLzID,
+			StakerAddress:   msg.StakerAddress,
+			ValidatorPubkey: msg.ValidatorPubkey,
+			Slot:            msg.BeaconSlot,
+			StateRootProof:  msg.StateRootProof,
+			ValidatorProof:  msg.ValidatorProof,
+			WithdrawalProof: msg.WithdrawalProof,
+		})
+	default:
+		return fmt.Errorf(ErrUnsupportedAction, msg.Action)
+	}
+}
+
+// getLatestAssetState returns the total deposit amount of the staker after the message is handled, so the
+// client chain can sync the principal balance from the acknowledgement. It's zero for the slash message,
+// and it's the native ETH balance of the staker for the native token messages.
+func (p Precompile) getLatestAssetState(ctx sdk.Context, msg *Message) *big.Int {
+	if msg.StakerAddress == nil {
+		return big.NewInt(0)
+	}
+	stakerID, assetID := types.GetStakeIDAndAssetID(msg.ClientChainLzID, msg.StakerAddress, msg.AssetsAddress)
+	info, err := p.stakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err != nil {
+		return big.NewInt(0)
+	}
+	return info.TotalDepositAmountOrWantChangeValue.BigInt()
+}
@@ -0,0 +1,268 @@
+package gateway
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	nativetokentypes "github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// MiddlewareContractAddrLength is the length of the AVS middleware contract address in the slash payload.
+	MiddlewareContractAddrLength = common.AddressLength
+	// SlashProportionLength is the length of the slash proportion, it's an uint256 with 18 decimals.
+	SlashProportionLength = 32
+	// BeaconUintLength is the length of the beacon chain slot and indices, they're uint256 in the payload.
+	BeaconUintLength = 32
+	// BeaconNodeLength is the length of the SSZ node in the beacon chain proofs
+	BeaconNodeLength = common.HashLength
+)
+
+// beaconSlotOffset is the offset of the slot in the payload of the native token messages, it follows the staker
+// and the validator pubkey.
+const beaconSlotOffset = types.CrossChainActionLength + types.GeneralClientChainAddrLength + nativetokentypes.ValidatorPubkeyLength
+
+// validatorProofsOffset returns the end offset of the staker, validator pubkey, slot, state root proof and validator
+// proof in the payload of the native token messages, the length of the validator proof depends on the fork.
+func validatorProofsOffset(fork beacon.Fork) int {
+	return beaconSlotOffset + BeaconUintLength + BeaconNodeLength*(1+beacon.BeaconBlockHeaderTreeDepth) +
+		BeaconUintLength + BeaconNodeLength*(beacon.ValidatorFieldsCount+beacon.GIndexDepth(fork.ValidatorGIndex(0)))
+}
+
+// Message is the cross-chain message decoded from the LayerZero payload. The payload is packed by the lzApp
+// as abi.encodePacked(action, actionArgs), the client chain addresses in actionArgs are padded to 32 bytes and
+// the amounts are uint256:
+//   - Deposit, WithdrawPrinciple and WithDrawReward: asset(32) | staker(32) | amount(32)
+//   - DelegateTo: asset(32) | staker(32) | operator(44) | amount(32) | approval, the approval is optional
+//   - UndelegateFrom: asset(32) | staker(32) | operator(44) | amount(32)
+//   - Slash: asset(32) | operator(44) | middleware(20) | proportion(32) | proof
+//   - RegisterNativePod: staker(32) | pod(32)
+//   - UpdateNativeValidatorBalance: staker(32) | validatorPubkey(48) | slot(32) | stateRootProof | validatorProof | balanceProof
+//   - WithdrawNativeValidator: staker(32) | validatorPubkey(48) | slot(32) | stateRootProof | validatorProof | withdrawalProof
+//
+// The approval of the operator is packed as signature(65) | salt(32) | expiry(32), it's only needed when the
+// operator requires it. The AssetsAddress of the native token messages is the virtual native ETH asset. The beacon chain proofs are
+// packed as the nodes whose count depends on the fork of the slot:
+//   - stateRootProof: stateRoot(32) | proof(3*32)
+//   - validatorProof: validatorIndex(32) | validatorFields(8*32) | proof(46*32 in Deneb, 47*32 since Electra)
+//   - balanceProof: balanceRoot(32) | proof(44*32 in Deneb, 45*32 since Electra)
+//   - withdrawalProof: withdrawalIndex(32) | withdrawalFields(4*32) | proof(17*32)
+type Message struct {
+	ClientChainLzID           uint64
+	LzNonce                   uint64
+	Action                    types.CrossChainOpType
+	AssetsAddress             []byte
+	StakerAddress             []byte
+	OperatorAddress           sdk.AccAddress
+	MiddlewareContractAddress []byte
+	Proportion                sdkmath.LegacyDec
+	OpAmount                  sdkmath.Int
+	ApprovedInfo              *delegationtype.DelegationApproveInfo
+	Proof                     []byte
+	TxHash                    common.Hash
+	PodAddress                []byte
+	ValidatorPubkey           []byte
+	BeaconSlot                uint64
+	StateRootProof            beacon.StateRootProof
+	ValidatorProof            beacon.ValidatorProof
+	BalanceProof              beacon.BalanceProof
+	WithdrawalProof           beacon.WithdrawalProof
+}
+
+// payloadReader reads the fixed-length fields of the payload in order.
+type payloadReader struct {
+	payload []byte
+	offset  int
+}
+
+func (r *payloadReader) next(length int) []byte {
+	field := r.payload[r.offset : r.offset+length]
+	r.offset += length
+	return field
+}
+
+// nextNodes reads the SSZ nodes of the beacon chain proof
+func (r *payloadReader) nextNodes(count int) []common.Hash {
+	nodes := make([]common.Hash, count)
+	for i := range nodes {
+		nodes[i] = common.BytesToHash(r.next(BeaconNodeLength))
+	}
+	return nodes
+}
+
+// nextUint64 reads the uint256 field that should fit in uint64
+func (r *payloadReader) nextUint64(name string) (uint64, error) {
+	value := new(big.Int).SetBytes(r.next(BeaconUintLength))
+	if !value.IsUint64() {
+		return 0, fmt.Errorf(ErrUint64Overflow, name, value)
+	}
+	return value.Uint64(), nil
+}
+
+// beaconFork returns the fork of the beacon block whose proofs are in the payload of the native token message
+func beaconFork(payload []byte) (beacon.Fork, error) {
+	if len(payload) < beaconSlotOffset+BeaconUintLength {
+		return beacon.Fork{}, fmt.Errorf(ErrInvalidPayloadLength, types.CrossChainOpType(payload[0]), len(payload), beaconSlotOffset+BeaconUintLength)
+	}
+	r := &payloadReader{payload: payload, offset: beaconSlotOffset}
+	slot, err := r.nextUint64("slot")
+	if err != nil {
+		return beacon.Fork{}, err
+	}
+	return beacon.ForkAtSlot(slot)
+}
+
+// nextValidatorProofs reads the slot, the state root proof and the validator proof shared by the native token messages
+func (r *payloadReader) nextValidatorProofs(msg *Message, fork beacon.Fork) (err error) {
+	if msg.BeaconSlot, err = r.nextUint64("slot"); err != nil {
+		return err
+	}
+	msg.StateRootProof.StateRoot = common.BytesToHash(r.next(BeaconNodeLength))
+	msg.StateRootProof.Proof = r.nextNodes(beacon.BeaconBlockHeaderTreeDepth)
+	if msg.ValidatorProof.ValidatorIndex, err = r.nextUint64("validatorIndex"); err != nil {
+		return err
+	}
+	msg.ValidatorProof.Fields = r.nextNodes(beacon.ValidatorFieldsCount)
+	msg.ValidatorProof.Proof = r.nextNodes(beacon.GIndexDepth(fork.ValidatorGIndex(0)))
+	return nil
+}
+
+// GetMessageFromInputs parses the inputs of the handleMessage method and decodes the payload according to its action.
+func (p Precompile) GetMessageFromInputs(ctx sdk.Context, args []interface{}) (*Message, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	msg := &Message{}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), args[0])
+	}
+	msg.ClientChainLzID = uint64(clientChainLzID)
+
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, msg.ClientChainLzID)
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	msg.LzNonce, ok = args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+
+	payload, ok := args[2].([]byte)
+	if !ok || len(payload) < types.CrossChainActionLength {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), args[2])
+	}
+	msg.Action = types.CrossChainOpType(payload[0])
+	r := &payloadReader{payload: payload, offset: types.CrossChainActionLength}
+
+	switch msg.Action {
+	case types.Deposit, types.WithdrawPrinciple, types.WithDrawReward:
+		needLength := types.CrossChainActionLength + types.GeneralAssetsAddrLength + types.GeneralClientChainAddrLength + types.CrossChainOpAmountLength
+		if len(payload) != needLength {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), needLength)
+		}
+		msg.AssetsAddress = r.next(types.GeneralAssetsAddrLength)[:clientChainAddrLength]
+		msg.StakerAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+		msg.OpAmount = sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(r.next(types.CrossChainOpAmountLength)))
+	case types.DelegateTo, types.UndelegateFrom:
+		needLength := types.CrossChainActionLength + types.GeneralAssetsAddrLength + types.GeneralClientChainAddrLength + types.ExoCoreOperatorAddrLength + types.CrossChainOpAmountLength
+		hasApproval := msg.Action == types.DelegateTo && len(payload) == needLength+delegation.ApprovalLength
+		if len(payload) != needLength && !hasApproval {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), needLength)
+		}
+		msg.AssetsAddress = r.next(types.GeneralAssetsAddrLength)[:clientChainAddrLength]
+		msg.StakerAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+		msg.OperatorAddress, err = delegation.GetOperatorAddrFromInput(r.next(types.ExoCoreOperatorAddrLength), 2)
+		if err != nil {
+			return nil, err
+		}
+		msg.OpAmount = sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(r.next(types.CrossChainOpAmountLength)))
+		if hasApproval {
+			msg.ApprovedInfo, err = delegation.GetApprovalFromInput(r.next(delegation.ApprovalLength), 2)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if msg.Action == types.UndelegateFrom {
+			// the tx hash is a part of the undelegation record key
+			txHash, ok := ctx.Value(delegation.CtxKeyTxHash).(common.Hash)
+			if !ok {
+				return nil, fmt.Errorf(ErrCtxTxHash, reflect.TypeOf(ctx.Value(delegation.CtxKeyTxHash)), ctx.Value(delegation.CtxKeyTxHash))
+			}
+			msg.TxHash = txHash
+		}
+	case types.Slash:
+		minLength := types.CrossChainActionLength + types.GeneralAssetsAddrLength + types.ExoCoreOperatorAddrLength + MiddlewareContractAddrLength + SlashProportionLength
+		if len(payload) < minLength {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), minLength)
+		}
+		msg.AssetsAddress = r.next(types.GeneralAssetsAddrLength)[:clientChainAddrLength]
+		msg.OperatorAddress, err = delegation.GetOperatorAddrFromInput(r.next(types.ExoCoreOperatorAddrLength), 2)
+		if err != nil {
+			return nil, err
+		}
+		msg.MiddlewareContractAddress = r.next(MiddlewareContractAddrLength)
+		proportion := new(big.Int).SetBytes(r.next(SlashProportionLength))
+		msg.Proportion = sdkmath.LegacyNewDecFromBigIntWithPrec(proportion, sdkmath.LegacyPrecision)
+		msg.Proof = payload[r.offset:]
+	case types.RegisterNativePod:
+		needLength := types.CrossChainActionLength + types.GeneralClientChainAddrLength + types.GeneralClientChainAddrLength
+		if len(payload) != needLength {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), needLength)
+		}
+		msg.AssetsAddress = nativetokentypes.NativeETHAssetAddr.Bytes()
+		msg.StakerAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+		msg.PodAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+	case types.UpdateNativeValidatorBalance:
+		fork, err := beaconFork(payload)
+		if err != nil {
+			return nil, err
+		}
+		needLength := validatorProofsOffset(fork) + BeaconNodeLength*(1+beacon.GIndexDepth(fork.BalanceGIndex(0)))
+		if len(payload) != needLength {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), needLength)
+		}
+		msg.AssetsAddress = nativetokentypes.NativeETHAssetAddr.Bytes()
+		msg.StakerAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+		msg.ValidatorPubkey = r.next(nativetokentypes.ValidatorPubkeyLength)
+		if err = r.nextValidatorProofs(msg, fork); err != nil {
+			return nil, err
+		}
+		msg.BalanceProof.BalanceRoot = common.BytesToHash(r.next(BeaconNodeLength))
+		msg.BalanceProof.Proof = r.nextNodes(beacon.GIndexDepth(fork.BalanceGIndex(0)))
+	case types.WithdrawNativeValidator:
+		fork, err := beaconFork(payload)
+		if err != nil {
+			return nil, err
+		}
+		needLength := validatorProofsOffset(fork) + BeaconUintLength + BeaconNodeLength*(beacon.WithdrawalFieldsCount+beacon.GIndexDepth(beacon.WithdrawalGIndex(0)))
+		if len(payload) != needLength {
+			return nil, fmt.Errorf(ErrInvalidPayloadLength, msg.Action, len(payload), needLength)
+		}
+		msg.AssetsAddress = nativetokentypes.NativeETHAssetAddr.Bytes()
+		msg.StakerAddress = r.next(types.GeneralClientChainAddrLength)[:clientChainAddrLength]
+		msg.ValidatorPubkey = r.next(nativetokentypes.ValidatorPubkeyLength)
+		if err = r.nextValidatorProofs(msg, fork); err != nil {
+			return nil, err
+		}
+		if msg.WithdrawalProof.WithdrawalIndex, err = r.nextUint64("withdrawalIndex"); err != nil {
+			return nil, err
+		}
+		msg.WithdrawalProof.Fields = r.nextNodes(beacon.WithdrawalFieldsCount)
+		msg.WithdrawalProof.Proof = r.nextNodes(beacon.GIndexDepth(beacon.WithdrawalGIndex(0)))
+	default:
+		return nil, fmt.Errorf(ErrUnsupportedAction, msg.Action)
+	}
+	return msg, nil
+}
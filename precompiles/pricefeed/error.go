@@ -0,0 +1,6 @@
+package pricefeed
+
+const (
+	ErrContractInputParaOrType    = "the contract input parameter type or value error,arg index:%d, type is:%s,value:%v"
+	ErrInputClientChainAddrLength = "the length of input client chain addr doesn't match,input:%d,need:%d"
+)
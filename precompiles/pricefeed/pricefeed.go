@@ -0,0 +1,120 @@
+package pricefeed
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	priceFeedKeeper "github.com/ExocoreNetwork/exocore/x/price_feed/keeper"
+	stakingStateKeeper "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// Precompile defines the precompiled contract for the price feed, it only has view methods.
+type Precompile struct {
+	cmn.Precompile
+	stakingStateKeeper stakingStateKeeper.Keeper
+	priceFeedKeeper    priceFeedKeeper.Keeper
+}
+
+// NewPrecompile creates a new price feed Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	stakingStateKeeper stakingStateKeeper.Keeper,
+	priceFeedKeeper priceFeedKeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		return nil, fmt.Errorf("error loading the price feed ABI %s", err)
+	}
+
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidABI, err)
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		stakingStateKeeper: stakingStateKeeper,
+		priceFeedKeeper:    priceFeedKeeper,
+	}, nil
+}
+
+// Address defines the address of the price feed compile contract.
+// address: 0x000000000000000000000000000000000000080A
+func (p Precompile) Address() common.Address {
+	return common.HexToAddress("0x000000000000000000000000000000000000080A")
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract price feed methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, _, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// price feed queries
+	case MethodGetPrice:
+		bz, err = p.GetPrice(ctx, contract, method, args)
+	case MethodGetOperatorValue:
+		bz, err = p.GetOperatorValue(ctx, contract, method, args)
+	case MethodGetOperatorAVSValue:
+		bz, err = p.GetOperatorAVSValue(ctx, contract, method, args)
+	}
+
+	if err != nil {
+		ctx.Logger().Error("call price feed precompile error", "module", "price feed precompile", "err", err)
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given methodID corresponds to a transaction or query.
+// There isn't any price feed transaction, the prices are submitted through the cosmos msgs.
+func (Precompile) IsTransaction(_ string) bool {
+	return false
+}
@@ -0,0 +1,41 @@
+pragma solidity >=0.8.17;
+
+/// @dev The PRICE_FEED contract's address.
+address constant PRICE_FEED_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080A;
+
+/// @dev The PRICE_FEED contract's instance.
+IPriceFeed constant PRICE_FEED_CONTRACT = IPriceFeed(
+    PRICE_FEED_PRECOMPILE_ADDRESS
+);
+
+/// @author Exocore Team
+/// @title PriceFeed Precompile Contract
+/// @dev The interface through which solidity contracts will read the asset prices and the restaked values
+/// of the operators. The values are in USD with 18 decimals, so the AVSs can use them as the operator weights.
+/// @custom:address 0x000000000000000000000000000000000000080A
+interface IPriceFeed {
+/// QUERIES
+/// @dev get the latest USD price of the asset, the price of a whole asset is price/10^decimal.
+/// `found` is false and the other outputs are empty if the price hasn't been submitted.
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+    function getPrice(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress
+    ) external view returns (bool found, uint256 price, uint8 decimal, uint64 updateTime, bool stale);
+
+/// @dev get the USD value of all the assets restaked to the operator, it reverts if a price is stale
+/// @param operatorAddr The bech32 address of the operator
+    function getOperatorValue(
+        bytes memory operatorAddr
+    ) external view returns (uint256 value);
+
+/// @dev get the USD value of the assets restaked to the operator that secure the AVS, it's zero if the operator
+/// hasn't opted into the AVS, and it reverts if a price is stale
+/// @param operatorAddr The bech32 address of the operator
+/// @param avsAddress The middleware contract address of the AVS
+    function getOperatorAVSValue(
+        bytes memory operatorAddr,
+        address avsAddress
+    ) external view returns (uint256 value);
+}
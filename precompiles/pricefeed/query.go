@@ -0,0 +1,118 @@
+package pricefeed
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/precompiles/delegation"
+	pricefeedtypes "github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// MethodGetPrice defines the ABI method name for the query of the asset price.
+	MethodGetPrice = "getPrice"
+	// MethodGetOperatorValue defines the ABI method name for the query of the operator total value.
+	MethodGetOperatorValue = "getOperatorValue"
+	// MethodGetOperatorAVSValue defines the ABI method name for the query of the operator value in the AVS.
+	MethodGetOperatorAVSValue = "getOperatorAVSValue"
+)
+
+// GetPrice returns the latest price of the asset and whether it's stale.
+// The `found` output is false and the other outputs are empty if the price hasn't been submitted.
+func (p Precompile) GetPrice(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), clientChainLzID)
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return nil, err
+	}
+	assetAddr, ok := args[1].([]byte)
+	if !ok || assetAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), assetAddr)
+	}
+	if len(assetAddr) != types.GeneralAssetsAddrLength {
+		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralAssetsAddrLength)
+	}
+
+	_, assetID := types.GetStakeIDAndAssetID(uint64(clientChainLzID), nil, assetAddr[:info.AddressLength])
+	price, err := p.priceFeedKeeper.GetPrice(ctx, assetID)
+	if err != nil {
+		if errorsmod.IsOf(err, pricefeedtypes.ErrPriceNotFound) {
+			return method.Outputs.Pack(false, big.NewInt(0), uint8(0), uint64(0), false)
+		}
+		return nil, err
+	}
+	params, err := p.priceFeedKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stale := price.IsStale(ctx.BlockTime().Unix(), params.MaxPriceAge)
+	// the decimal of the stored price isn't larger than MaxPriceDecimal, so it fits in uint8
+	return method.Outputs.Pack(true, price.Price.BigInt(), uint8(price.Decimal), uint64(price.UpdateTime), stale)
+}
+
+// GetOperatorValue returns the USD value of all the assets restaked to the operator.
+func (p Precompile) GetOperatorValue(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	opAccAddr, err := delegation.GetOperatorAddrFromInput(args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.priceFeedKeeper.GetOperatorTotalValue(ctx, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(value.BigInt())
+}
+
+// GetOperatorAVSValue returns the USD value of the assets restaked to the operator that secure the AVS,
+// it's the weight of the operator in the AVS.
+func (p Precompile) GetOperatorAVSValue(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+	opAccAddr, err := delegation.GetOperatorAddrFromInput(args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	avsAddress, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), avsAddress)
+	}
+	value, err := p.priceFeedKeeper.GetOperatorAVSValue(ctx, opAccAddr, strings.ToLower(avsAddress.Hex()))
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(value.BigInt())
+}
@@ -0,0 +1,97 @@
+package pricefeed_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/precompiles/pricefeed"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	pricefeedtype "github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func paddingClientChainAddress(input []byte, outputLength int) []byte {
+	if len(input) < outputLength {
+		padding := make([]byte, outputLength-len(input))
+		return append(input, padding...)
+	}
+	return input
+}
+
+// TestQueries tests the view methods of the price feed precompile.
+func (s *PrecompileTestSuite) TestQueries() {
+	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint16(101)
+	_, assetID := types.GetStakeIDAndAssetID(uint64(clientChainLzID), nil, usdtAddress)
+	assetAddr := paddingClientChainAddress(common.CopyBytes(usdtAddress), types.GeneralClientChainAddrLength)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	s.Require().NoError(err)
+
+	// the price hasn't been submitted
+	method := s.precompile.Methods[pricefeed.MethodGetPrice]
+	bz, err := s.precompile.GetPrice(s.ctx, nil, &method, []interface{}{clientChainLzID, assetAddr})
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(false, out[0])
+
+	_, err = s.app.PriceFeedKeeper.SubmitPrices(s.ctx, &pricefeedtype.MsgSubmitPrices{
+		FromAddress: s.app.PriceFeedKeeper.GetAuthority(),
+		Prices:      []pricefeedtype.AssetPrice{{AssetID: assetID, Price: sdkmath.NewInt(99990000), Decimal: 8}},
+	})
+	s.Require().NoError(err)
+	bz, err = s.precompile.GetPrice(s.ctx, nil, &method, []interface{}{clientChainLzID, assetAddr})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(true, out[0])
+	s.Require().Equal(int64(99990000), out[1].(*big.Int).Int64())
+	s.Require().Equal(uint8(8), out[2])
+	s.Require().Equal(false, out[4])
+
+	// the operator holds 100 USDT
+	_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	s.Require().NoError(err)
+	err = s.app.DepositKeeper.Deposit(s.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: uint64(clientChainLzID),
+		Action:          types.Deposit,
+		StakerAddress:   s.address.Bytes(),
+		AssetsAddress:   usdtAddress,
+		OpAmount:        sdkmath.NewInt(100e6),
+	})
+	s.Require().NoError(err)
+	err = s.app.DelegationKeeper.DelegateTo(s.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: uint64(clientChainLzID),
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress,
+		OperatorAddress: opAccAddr,
+		StakerAddress:   s.address.Bytes(),
+		OpAmount:        sdkmath.NewInt(100e6),
+	})
+	s.Require().NoError(err)
+
+	// 100 USDT * 0.9999 = 99.99 USD with 18 decimals
+	expectedValue, ok := new(big.Int).SetString("99990000000000000000", 10)
+	s.Require().True(ok)
+	method = s.precompile.Methods[pricefeed.MethodGetOperatorValue]
+	bz, err = s.precompile.GetOperatorValue(s.ctx, nil, &method, []interface{}{[]byte(opAccAddr.String())})
+	s.Require().NoError(err)
+	out, err = method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedValue, out[0])
+
+	// the AVS hasn't been registered
+	method = s.precompile.Methods[pricefeed.MethodGetOperatorAVSValue]
+	avsAddress := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	_, err = s.precompile.GetOperatorAVSValue(s.ctx, nil, &method, []interface{}{[]byte(opAccAddr.String()), avsAddress})
+	s.Require().Error(err)
+}
@@ -13,15 +13,32 @@ IClaimReward constant CLAIM_REWARD_CONTRACT = IClaimReward(
 /// @dev The interface through which solidity contracts will interact with ClaimReward
 /// @custom:address 0x0000000000000000000000000000000000000806
 interface IClaimReward {
+/// EVENTS
+/// @dev Emitted when the reward is claimed by the staker
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param withdrawRewardAddress The claim reward address
+/// @param opAmount The reward amount
+/// @param latestAssetState The total deposit amount of the staker after the claim
+    event RewardClaimed(
+        uint16 indexed clientChainLzID,
+        bytes assetsAddress,
+        bytes withdrawRewardAddress,
+        uint256 opAmount,
+        uint256 latestAssetState
+    );
+
 /// TRANSACTIONS
 /// @dev ClaimReward To the staker, that will change the state in reward module
 /// Note that this address cannot be a module account.
 /// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
 /// @param assetsAddress The client chain asset Address
 /// @param withdrawRewardAddress The claim reward address
-/// @param opAmount The reward amount
+/// @param opAmount The reward amount, it can't exceed the rewards accrued by the staker
     function claimReward(
     uint16 clientChainLzID,
+    uint64 lzNonce,
     bytes memory assetsAddress,
     bytes memory withdrawRewardAddress,
     uint256 opAmount
@@ -0,0 +1,50 @@
+package reward
+
+import (
+	"math/big"
+
+	rewardkeeper "github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// EventTypeRewardClaimed defines the event type for the claimReward transaction.
+	EventTypeRewardClaimed = "RewardClaimed"
+)
+
+// EmitRewardClaimedEvent creates a new event emitted on a claimReward transaction.
+func (p Precompile) EmitRewardClaimedEvent(ctx sdk.Context, stateDB vm.StateDB, params *rewardkeeper.RewardParams, latestAssetState *big.Int) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRewardClaimed]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(uint16(params.ClientChainLzID))
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(params.AssetsAddress, params.WithdrawRewardAddress, params.OpAmount.BigInt(), latestAssetState)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
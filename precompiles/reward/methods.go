@@ -21,7 +21,7 @@ func (p Precompile) Reward(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
@@ -39,6 +39,9 @@ func (p Precompile) Reward(
 	if err != nil {
 		return nil, err
 	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, rewardParam.ClientChainLzID, rewardParam.LzNonce); err != nil {
+		return nil, err
+	}
 
 	err = p.rewardKeeper.RewardForWithdraw(ctx, rewardParam)
 	if err != nil {
@@ -50,5 +53,9 @@ func (p Precompile) Reward(
 	if err != nil {
 		return nil, err
 	}
+
+	if err = p.EmitRewardClaimedEvent(ctx, stateDB, rewardParam, info.TotalDepositAmountOrWantChangeValue.BigInt()); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true, info.TotalDepositAmountOrWantChangeValue.BigInt())
 }
@@ -6,10 +6,13 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/app"
 	"github.com/ExocoreNetwork/exocore/precompiles/reward"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	depositParams "github.com/ExocoreNetwork/exocore/x/deposit/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	rewardParams "github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -72,11 +75,39 @@ func (s *PrecompileTestSuite) TestRunRewardThroughClientChain() {
 		s.Require().NoError(err)
 	}
 
+	accrueReward := func(staker []byte, rewardAmount sdkmath.Int) {
+		// delegate to an operator and fund its reward pool, so the staker accrues the reward
+		opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+		s.Require().NoError(err)
+		_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+			FromAddress: opAccAddr.String(),
+			Info: &delegationtype.OperatorInfo{
+				EarningsAddr: opAccAddr.String(),
+			},
+		})
+		s.Require().NoError(err)
+		err = s.app.DelegationKeeper.DelegateTo(s.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: 101,
+			Action:          types.DelegateTo,
+			AssetsAddress:   usdtAddress,
+			OperatorAddress: opAccAddr,
+			StakerAddress:   staker,
+			OpAmount:        sdkmath.NewInt(50),
+		})
+		s.Require().NoError(err)
+		// the rewards are escrowed from the deposit of the operator's address
+		depositAsset(opAccAddr, rewardAmount)
+		_, assetID := types.GetStakeIDAndAssetID(101, staker, usdtAddress)
+		err = s.app.RewardKeeper.FundRewardPool(s.ctx, opAccAddr.String(), opAccAddr.String(), assetID, rewardAmount)
+		s.Require().NoError(err)
+	}
+
 	commonMalleate := func() (common.Address, []byte) {
 		// Prepare the call input for reward test
 		input, err := s.precompile.Pack(
 			reward.MethodReward,
 			uint16(clientChainLzID),
+			uint64(1),
 			assetAddr,
 			paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength),
 			withdrawAmount,
@@ -110,12 +141,36 @@ func (s *PrecompileTestSuite) TestRunRewardThroughClientChain() {
 				}
 				err = s.app.RewardKeeper.SetParams(s.ctx, rewardModuleParam)
 				s.Require().NoError(err)
+				accrueReward(s.address.Bytes(), sdkmath.NewIntFromBigInt(withdrawAmount))
 				return commonMalleate()
 			},
 			returnBytes: successRet,
 			readOnly:    false,
 			expPass:     true,
 		},
+		{
+			name: "fail - the staker hasn't accrued enough reward",
+			malleate: func() (common.Address, []byte) {
+				depositModuleParam := &depositParams.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
+				s.Require().NoError(err)
+				depositAsset(s.address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				rewardModuleParam := &rewardParams.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				err = s.app.RewardKeeper.SetParams(s.ctx, rewardModuleParam)
+				s.Require().NoError(err)
+				accrueReward(s.address.Bytes(), sdkmath.NewInt(5))
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     false,
+			errContains: rewardParams.ErrInsufficientRewards.Error(),
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -0,0 +1,48 @@
+package slash
+
+import (
+	slashkeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// EventTypeSlashed defines the event type for the submitSlash transaction.
+	EventTypeSlashed = "Slashed"
+)
+
+// EmitSlashedEvent creates a new event emitted on a submitSlash transaction.
+func (p Precompile) EmitSlashedEvent(ctx sdk.Context, stateDB vm.StateDB, params *slashkeeper.SlashParams) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSlashed]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(uint16(params.ClientChainLzID))
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3], event.Inputs[4]}
+	packed, err := arguments.Pack(params.AssetsAddress, params.OperatorAddress.String(), params.MiddlewareContractAddress, params.Proportion.String())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
@@ -20,7 +20,7 @@ func (p Precompile) SubmitSlash(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
@@ -38,10 +38,17 @@ func (p Precompile) SubmitSlash(
 	if err != nil {
 		return nil, err
 	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, slashParam.ClientChainLzID, slashParam.LzNonce); err != nil {
+		return nil, err
+	}
 
 	err = p.slashKeeper.Slash(ctx, slashParam)
 	if err != nil {
 		return nil, err
 	}
+
+	if err = p.EmitSlashedEvent(ctx, stateDB, slashParam); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true)
 }
@@ -2,9 +2,9 @@ package slash
 
 import (
 	"fmt"
-	"math/big"
 	"reflect"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	"github.com/ExocoreNetwork/exocore/x/slash/keeper"
@@ -13,8 +13,8 @@ import (
 )
 
 func (p Precompile) GetSlashParamsFromInputs(ctx sdk.Context, args []interface{}) (*keeper.SlashParams, error) {
-	if len(args) != 8 {
-		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	if len(args) != 7 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
 	}
 	slashParams := &keeper.SlashParams{}
 	clientChainLzID, ok := args[0].(uint16)
@@ -29,30 +29,56 @@ func (p Precompile) GetSlashParamsFromInputs(ctx sdk.Context, args []interface{}
 	}
 	clientChainAddrLength := info.AddressLength
 
+	lzNonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+	slashParams.LzNonce = lzNonce
+
 	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
-	assetAddr, ok := args[1].([]byte)
+	assetAddr, ok := args[2].([]byte)
 	if !ok || assetAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[0]), assetAddr)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), assetAddr)
 	}
 	if len(assetAddr) != types.GeneralClientChainAddrLength {
 		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
 	}
 	slashParams.AssetsAddress = assetAddr[:clientChainAddrLength]
 
-	stakerAddr, ok := args[2].([]byte)
-	if !ok || stakerAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[0]), stakerAddr)
+	// the input operator address is cosmos accAddress type,so we need to check the length and decode it through Bench32
+	operatorAddr, ok := args[3].([]byte)
+	if !ok || operatorAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[3]), operatorAddr)
 	}
-	if len(assetAddr) != types.GeneralClientChainAddrLength {
-		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
+	if len(operatorAddr) != types.ExoCoreOperatorAddrLength {
+		return nil, fmt.Errorf(ErrInputOperatorAddrLength, len(operatorAddr), types.ExoCoreOperatorAddrLength)
+	}
+	opAccAddr, err := sdk.AccAddressFromBech32(string(operatorAddr))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", string(operatorAddr)))
 	}
-	slashParams.StakerAddress = stakerAddr[:clientChainAddrLength]
+	slashParams.OperatorAddress = opAccAddr
 
-	opAmount, ok := args[3].(*big.Int)
-	if !ok || opAmount == nil || opAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[0]), opAmount)
+	middlewareContractAddr, ok := args[4].([]byte)
+	if !ok || middlewareContractAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 4, reflect.TypeOf(args[4]), middlewareContractAddr)
 	}
+	slashParams.MiddlewareContractAddress = middlewareContractAddr
 
-	slashParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
+	proportionStr, ok := args[5].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 5, reflect.TypeOf(args[5]), args[5])
+	}
+	proportion, err := sdkmath.LegacyNewDecFromStr(proportionStr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse the proportion:%s", proportionStr))
+	}
+	slashParams.Proportion = proportion
+
+	proof, ok := args[6].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 6, reflect.TypeOf(args[6]), args[6])
+	}
+	slashParams.Proof = []byte(proof)
 	return slashParams, nil
 }
@@ -13,22 +13,36 @@ ISlash constant SLASH_CONTRACT = ISlash(
 /// @dev The interface through which solidity contracts will interact with Slash
 /// @custom:address 0x0000000000000000000000000000000000000807
 interface ISlash {
+/// EVENTS
+/// @dev Emitted when the operator is slashed
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param operator The bech32 address of the slashed operator
+/// @param middlewareContractAddress The middleware address
+/// @param proportion The Slash proportion
+    event Slashed(
+        uint16 indexed clientChainLzID,
+        bytes assetsAddress,
+        string operator,
+        bytes middlewareContractAddress,
+        string proportion
+    );
+
 /// TRANSACTIONS
 /// @dev Slash the oprator, that will change the state in Slash module
 /// Note that this address cannot be a module account.
 /// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
 /// @param assetsAddress The client chain asset Address
-/// @param opAmount The Slash amount
 /// @param operatorAddress The Slashed OperatorAddress
 /// @param middlewareContractAddress The middleware address
-/// @param proportion The Slash proportion
+/// @param proportion The Slash proportion, it's a decimal string in the range (0, 1]
 /// @param proof The Slash proof
 
     function submitSlash(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
-        bytes memory stakerAddress,
-        uint256 opAmount,
         bytes memory operatorAddress,
         bytes memory middlewareContractAddress,
         string memory proportion,
@@ -6,10 +6,14 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/app"
 	"github.com/ExocoreNetwork/exocore/precompiles/slash"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	depositParams "github.com/ExocoreNetwork/exocore/x/deposit/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	slashParams "github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -56,9 +60,11 @@ func (s *PrecompileTestSuite) TestRunSlash() {
 	exoCoreLzAppEventTopic := "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec"
 	usdtAddress := common.FromHex("0xdAC17F958D2ee523a2206206994597C13D831ec7")
 	clientChainLzID := 101
-	slashAmount := big.NewInt(10)
 	depositAmount := big.NewInt(100)
+	delegationAmount := big.NewInt(50)
+	opAccAddr := "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl"
 	assetAddr := paddingClientChainAddress(usdtAddress, types.GeneralClientChainAddrLength)
+	avsAddress := "0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea"
 	depositAsset := func(staker []byte, depositAmount sdkmath.Int) {
 		// deposit asset for slash test
 		params := &keeper.DepositParams{
@@ -71,18 +77,51 @@ func (s *PrecompileTestSuite) TestRunSlash() {
 		err := s.app.DepositKeeper.Deposit(s.ctx, params)
 		s.Require().NoError(err)
 	}
+	delegateAsset := func(staker []byte, delegationAmount sdkmath.Int) {
+		// register the operator and delegate asset to it for slash test
+		opAccAddr, err := sdk.AccAddressFromBech32(opAccAddr)
+		s.Require().NoError(err)
+		_, err = s.app.DelegationKeeper.RegisterOperator(s.ctx, &delegationtype.RegisterOperatorReq{
+			FromAddress: opAccAddr.String(),
+			Info: &delegationtype.OperatorInfo{
+				EarningsAddr: opAccAddr.String(),
+			},
+		})
+		s.Require().NoError(err)
+		params := &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: 101,
+			Action:          types.DelegateTo,
+			StakerAddress:   staker,
+			AssetsAddress:   usdtAddress,
+			OperatorAddress: opAccAddr,
+			OpAmount:        delegationAmount,
+		}
+		err = s.app.DelegationKeeper.DelegateTo(s.ctx, params)
+		s.Require().NoError(err)
+
+		// the operator opts into the AVS that slashes it
+		_, assetID := types.GetStakeIDAndAssetID(101, nil, usdtAddress)
+		err = s.app.AVSKeeper.SetAVSInfo(s.ctx, &avstypes.AVSInfo{
+			Name:                      "avs",
+			MiddlewareContractAddress: avsAddress,
+			AssetIDs:                  []string{assetID},
+			UnbondingPeriod:           1,
+		})
+		s.Require().NoError(err)
+		err = s.app.AVSKeeper.OptIn(s.ctx, opAccAddr, avsAddress)
+		s.Require().NoError(err)
+	}
 
 	commonMalleate := func() (common.Address, []byte) {
 		// Prepare the call input for slash test
 		input, err := s.precompile.Pack(
 			slash.MethodSlash,
 			uint16(clientChainLzID),
+			uint64(1),
 			assetAddr,
-			paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength),
-			slashAmount,
-			common.FromHex("0x2E756b8faBeA234b9900767b69D6387400CDC396"),
-			common.FromHex("0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea"),
-			"5",
+			[]byte(opAccAddr),
+			common.FromHex(avsAddress),
+			"0.1",
 			"slash",
 		)
 		s.Require().NoError(err, "failed to pack input")
@@ -108,6 +147,7 @@ func (s *PrecompileTestSuite) TestRunSlash() {
 				err := s.app.DepositKeeper.SetParams(s.ctx, depositModuleParam)
 				s.Require().NoError(err)
 				depositAsset(s.address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				delegateAsset(s.address.Bytes(), sdkmath.NewIntFromBigInt(delegationAmount))
 				slashModuleParam := &slashParams.Params{
 					ExoCoreLzAppAddress:    s.address.String(),
 					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
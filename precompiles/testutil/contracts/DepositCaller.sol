@@ -10,6 +10,7 @@ contract DepositCaller {
 
     function testDepositTo(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
@@ -17,6 +18,7 @@ contract DepositCaller {
         return
             deposit.DEPOSIT_CONTRACT.depositTo(
             clientChainLzID,
+            lzNonce,
             assetsAddress,
             stakerAddress,
             opAmount
@@ -25,12 +27,14 @@ contract DepositCaller {
 
     function testCallDepositToAndEmitEvent(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
     ) public returns (bool, uint256) {
         (bool success,uint256 latestAssetState) = deposit.DEPOSIT_CONTRACT.depositTo(
             clientChainLzID,
+            lzNonce,
             assetsAddress,
             stakerAddress,
             opAmount
@@ -42,12 +46,14 @@ contract DepositCaller {
 
     function testCallDepositToWithTryCatch(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
     ) public returns (bool, uint256) {
         try deposit.DEPOSIT_CONTRACT.depositTo(
             clientChainLzID,
+            lzNonce,
             assetsAddress,
             stakerAddress,
             opAmount
@@ -58,19 +64,24 @@ contract DepositCaller {
         }catch Error(string memory errorMessage){
             // An error occurred, handle it
             emit ErrorOccurred(errorMessage);
+        }catch {
+            // the precompile fails without a revert reason
+            emit ErrorOccurred("failed to call the deposit precompile");
         }
         return (false,0);
     }
 /*    function testDelegateCallDepositTo(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
     ) public returns (bool, uint256) {
         (bool success,uint256 latestAssetState) = deposit.DEPOSIT_PRECOMPILE_ADDRESS.delegatecall(
             abi.encodeWithSignature(
-                "depositTo(uint16,bytes,bytes,uint256)",
+                "depositTo(uint16,uint64,bytes,bytes,uint256)",
                 clientChainLzID,
+                lzNonce,
                 assetsAddress,
                 stakerAddress,
                 opAmount
@@ -82,6 +93,7 @@ contract DepositCaller {
 
     function testStaticCallDepositTo(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory stakerAddress,
         uint256 opAmount
@@ -90,8 +102,9 @@ contract DepositCaller {
             .DEPOSIT_PRECOMPILE_ADDRESS
             .staticcall(
             abi.encodeWithSignature(
-                "depositTo(uint16,bytes,bytes,uint256)",
+                "depositTo(uint16,uint64,bytes,bytes,uint256)",
                 clientChainLzID,
+                lzNonce,
                 assetsAddress,
                 stakerAddress,
                 opAmount
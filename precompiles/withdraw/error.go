@@ -4,4 +4,5 @@ const (
 	ErrContractInputParaOrType    = "the contract input parameter type or value error,arg index:%d, type is:%s,value:%v"
 	ErrContractCaller             = "the caller doesn't have the permission to call this function,caller:%s,need:%s"
 	ErrInputClientChainAddrLength = "the length of input client chain addr doesn't match,input:%d,need:%d"
+	ErrWithdrawalClientChain      = "the withdrawal isn't from the client chain,withdrawalID:%d,stakerID:%s,clientChainLzID:%d"
 )
@@ -0,0 +1,113 @@
+package withdraw
+
+import (
+	"math/big"
+
+	withdrawkeeper "github.com/ExocoreNetwork/exocore/x/withdraw/keeper"
+	withdrawtype "github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// EventTypeWithdrawalRequested defines the event type for the withdrawal transaction.
+	EventTypeWithdrawalRequested = "WithdrawalRequested"
+	// EventTypeWithdrawalCancelled defines the event type for the cancellation of a withdrawal.
+	EventTypeWithdrawalCancelled = "WithdrawalCancelled"
+	// EventTypeWithdrawalCompleted defines the event type for the completion of a withdrawal.
+	EventTypeWithdrawalCompleted = "WithdrawalCompleted"
+)
+
+// EmitWithdrawalRequestedEvent creates a new event emitted on a withdrawal transaction.
+func (p Precompile) EmitWithdrawalRequestedEvent(ctx sdk.Context, stateDB vm.StateDB, params *withdrawkeeper.WithdrawParams, record *withdrawtype.WithdrawalRecord) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeWithdrawalRequested]
+	topics, err := makeWithdrawalTopics(event, uint16(params.ClientChainLzID), record.Id)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5]}
+	packed, err := arguments.Pack(params.AssetsAddress, params.WithdrawAddress, params.OpAmount.BigInt(), record.MaturityHeight)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitWithdrawalCancelledEvent creates a new event emitted on the cancellation of a withdrawal.
+func (p Precompile) EmitWithdrawalCancelledEvent(ctx sdk.Context, stateDB vm.StateDB, clientChainLzID uint64, withdrawAddress []byte, withdrawalID uint64, latestAssetState *big.Int) error {
+	event := p.ABI.Events[EventTypeWithdrawalCancelled]
+	topics, err := makeWithdrawalTopics(event, uint16(clientChainLzID), withdrawalID)
+	if err != nil {
+		return err
+	}
+
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(withdrawAddress, latestAssetState)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitWithdrawalCompletedEvent creates a new event emitted on the completion of a withdrawal.
+func (p Precompile) EmitWithdrawalCompletedEvent(ctx sdk.Context, stateDB vm.StateDB, withdrawalID uint64) error {
+	event := p.ABI.Events[EventTypeWithdrawalCompleted]
+	topics := make([]common.Hash, 2)
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(withdrawalID)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// makeWithdrawalTopics returns the topics of the events indexed by the client chain id and the withdrawal id.
+func makeWithdrawalTopics(event abi.Event, clientChainLzID uint16, withdrawalID uint64) ([]common.Hash, error) {
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(clientChainLzID)
+	if err != nil {
+		return nil, err
+	}
+	topics[2], err = cmn.MakeTopic(withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	return topics, nil
+}
@@ -13,33 +13,35 @@ import (
 const (
 	// MethodWithdraw defines the ABI method name for the withdrawal transaction.
 	MethodWithdraw = "withdrawPrinciple"
+	// MethodCancelWithdrawal defines the ABI method name for the cancellation of a pending withdrawal.
+	MethodCancelWithdrawal = "cancelWithdrawal"
+	// MethodCompleteWithdrawal defines the ABI method name for the confirmation of a claimed withdrawal.
+	MethodCompleteWithdrawal = "completeWithdrawal"
 )
 
-// Withdraw assets to the staker, that will change the state in withdraw module.
+// Withdraw requests a withdrawal for the staker, the withdrawal is recorded in the withdraw module and can
+// be claimed after the maturity height.
 func (p Precompile) Withdraw(
 	ctx sdk.Context,
 	_ common.Address,
 	contract *vm.Contract,
-	_ vm.StateDB,
+	stateDB vm.StateDB,
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
-	// check the invalidation of caller contract
-	withdrawModuleParam, err := p.withdrawKeeper.GetParams(ctx)
-	if err != nil {
+	if err := p.checkCaller(ctx, contract); err != nil {
 		return nil, err
 	}
-	exoCoreLzAppAddr := common.HexToAddress(withdrawModuleParam.ExoCoreLzAppAddress)
-	if contract.CallerAddress != exoCoreLzAppAddr {
-		return nil, fmt.Errorf(ErrContractCaller, contract.CallerAddress, exoCoreLzAppAddr)
-	}
 
 	withdrawParam, err := p.GetWithdrawParamsFromInputs(ctx, args)
 	if err != nil {
 		return nil, err
 	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, withdrawParam.ClientChainLzID, withdrawParam.LzNonce); err != nil {
+		return nil, err
+	}
 
-	err = p.withdrawKeeper.Withdraw(ctx, withdrawParam)
+	record, err := p.withdrawKeeper.Withdraw(ctx, withdrawParam)
 	if err != nil {
 		return nil, err
 	}
@@ -49,5 +51,94 @@ func (p Precompile) Withdraw(
 	if err != nil {
 		return nil, err
 	}
+
+	if err = p.EmitWithdrawalRequestedEvent(ctx, stateDB, withdrawParam, record); err != nil {
+		return nil, err
+	}
 	return method.Outputs.Pack(true, info.TotalDepositAmountOrWantChangeValue.BigInt())
 }
+
+// CancelWithdrawal cancels a pending withdrawal of the staker, the amount is returned to the staker.
+func (p Precompile) CancelWithdrawal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := p.checkCaller(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	clientChainLzID, lzNonce, withdrawAddress, withdrawalID, err := p.GetCancelWithdrawalParamsFromInputs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, clientChainLzID, lzNonce); err != nil {
+		return nil, err
+	}
+	stakerID, _ := types.GetStakeIDAndAssetID(clientChainLzID, withdrawAddress, nil)
+	record, err := p.withdrawKeeper.CancelWithdrawal(ctx, stakerID, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.stakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, record.StakerID, record.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitWithdrawalCancelledEvent(ctx, stateDB, clientChainLzID, withdrawAddress, withdrawalID, info.TotalDepositAmountOrWantChangeValue.BigInt()); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true, info.TotalDepositAmountOrWantChangeValue.BigInt())
+}
+
+// CompleteWithdrawal confirms that the claimable withdrawal has been released on the client chain.
+func (p Precompile) CompleteWithdrawal(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if err := p.checkCaller(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	clientChainLzID, lzNonce, withdrawalID, err := p.GetCompleteWithdrawalParamsFromInputs(args)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.stakingStateKeeper.CheckAndUpdateLzNonce(ctx, clientChainLzID, lzNonce); err != nil {
+		return nil, err
+	}
+	// the withdrawal should be completed by the message from the client chain of the staker
+	record, err := p.withdrawKeeper.GetWithdrawalRecord(ctx, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if _, stakerLzID, err := types.ParseID(record.StakerID); err != nil || stakerLzID != clientChainLzID {
+		return nil, fmt.Errorf(ErrWithdrawalClientChain, withdrawalID, record.StakerID, clientChainLzID)
+	}
+	if _, err = p.withdrawKeeper.CompleteWithdrawal(ctx, withdrawalID); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitWithdrawalCompletedEvent(ctx, stateDB, withdrawalID); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+// checkCaller checks that the caller is the exoCore LayerZero app
+func (p Precompile) checkCaller(ctx sdk.Context, contract *vm.Contract) error {
+	withdrawModuleParam, err := p.withdrawKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	exoCoreLzAppAddr := common.HexToAddress(withdrawModuleParam.ExoCoreLzAppAddress)
+	if contract.CallerAddress != exoCoreLzAppAddr {
+		return fmt.Errorf(ErrContractCaller, contract.CallerAddress, exoCoreLzAppAddr)
+	}
+	return nil
+}
@@ -13,8 +13,8 @@ import (
 )
 
 func (p Precompile) GetWithdrawParamsFromInputs(ctx sdk.Context, args []interface{}) (*keeper.WithdrawParams, error) {
-	if len(args) != 4 {
-		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
 	}
 	withdrawParams := &keeper.WithdrawParams{}
 	clientChainLzID, ok := args[0].(uint16)
@@ -29,30 +29,92 @@ func (p Precompile) GetWithdrawParamsFromInputs(ctx sdk.Context, args []interfac
 	}
 	clientChainAddrLength := info.AddressLength
 
+	lzNonce, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+	withdrawParams.LzNonce = lzNonce
+
 	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
-	assetAddr, ok := args[1].([]byte)
+	assetAddr, ok := args[2].([]byte)
 	if !ok || assetAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[0]), assetAddr)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[0]), assetAddr)
 	}
 	if len(assetAddr) != types.GeneralAssetsAddrLength {
 		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
 	}
 	withdrawParams.AssetsAddress = assetAddr[:clientChainAddrLength]
 
-	stakerAddr, ok := args[2].([]byte)
+	stakerAddr, ok := args[3].([]byte)
 	if !ok || stakerAddr == nil {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[0]), stakerAddr)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[0]), stakerAddr)
 	}
 	if len(stakerAddr) != types.GeneralClientChainAddrLength {
 		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralClientChainAddrLength)
 	}
 	withdrawParams.WithdrawAddress = stakerAddr[:clientChainAddrLength]
 
-	opAmount, ok := args[3].(*big.Int)
+	opAmount, ok := args[4].(*big.Int)
 	if !ok || opAmount == nil || opAmount.Cmp(big.NewInt(0)) == 0 {
-		return nil, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[0]), opAmount)
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 4, reflect.TypeOf(args[0]), opAmount)
 	}
 
 	withdrawParams.OpAmount = sdkmath.NewIntFromBigInt(opAmount)
 	return withdrawParams, nil
 }
+
+// GetCancelWithdrawalParamsFromInputs returns the client chain id, the LayerZero nonce, the withdraw address
+// without the padding and the withdrawal id from the inputs of the cancelWithdrawal method.
+func (p Precompile) GetCancelWithdrawalParamsFromInputs(ctx sdk.Context, args []interface{}) (uint64, uint64, []byte, uint64, error) {
+	if len(args) != 4 {
+		return 0, 0, nil, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return 0, 0, nil, 0, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), args[0])
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return 0, 0, nil, 0, err
+	}
+
+	lzNonce, ok := args[1].(uint64)
+	if !ok {
+		return 0, 0, nil, 0, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+
+	stakerAddr, ok := args[2].([]byte)
+	if !ok || stakerAddr == nil {
+		return 0, 0, nil, 0, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), stakerAddr)
+	}
+	if len(stakerAddr) != types.GeneralClientChainAddrLength {
+		return 0, 0, nil, 0, fmt.Errorf(ErrInputClientChainAddrLength, len(stakerAddr), types.GeneralClientChainAddrLength)
+	}
+
+	withdrawalID, ok := args[3].(uint64)
+	if !ok {
+		return 0, 0, nil, 0, fmt.Errorf(ErrContractInputParaOrType, 3, reflect.TypeOf(args[3]), args[3])
+	}
+	return uint64(clientChainLzID), lzNonce, stakerAddr[:info.AddressLength], withdrawalID, nil
+}
+
+// GetCompleteWithdrawalParamsFromInputs returns the client chain id, the LayerZero nonce and the withdrawal id
+// from the inputs of the completeWithdrawal method.
+func (p Precompile) GetCompleteWithdrawalParamsFromInputs(args []interface{}) (uint64, uint64, uint64, error) {
+	if len(args) != 3 {
+		return 0, 0, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), args[0])
+	}
+	lzNonce, ok := args[1].(uint64)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), args[1])
+	}
+	withdrawalID, ok := args[2].(uint64)
+	if !ok {
+		return 0, 0, 0, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), args[2])
+	}
+	return uint64(clientChainLzID), lzNonce, withdrawalID, nil
+}
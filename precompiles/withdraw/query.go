@@ -0,0 +1,90 @@
+package withdraw
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v14/precompiles/common"
+)
+
+const (
+	// MethodGetWithdrawableAmount defines the ABI method name for the query of the withdrawable amount.
+	MethodGetWithdrawableAmount = "getWithdrawableAmount"
+	// MethodGetWithdrawal defines the ABI method name for the query of a withdrawal record.
+	MethodGetWithdrawal = "getWithdrawal"
+)
+
+// GetWithdrawableAmount returns the amount of the asset that can be withdrawn by the staker,
+// it's zero if the staker hasn't deposited the asset.
+func (p Precompile) GetWithdrawableAmount(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+	clientChainLzID, ok := args[0].(uint16)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), clientChainLzID)
+	}
+	info, err := p.stakingStateKeeper.GetClientChainInfoByIndex(ctx, uint64(clientChainLzID))
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddrLength := info.AddressLength
+
+	// the length of client chain address inputted by caller is 32, so we need to check the length and remove the padding according to the actual length.
+	assetAddr, ok := args[1].([]byte)
+	if !ok || assetAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 1, reflect.TypeOf(args[1]), assetAddr)
+	}
+	if len(assetAddr) != types.GeneralAssetsAddrLength {
+		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(assetAddr), types.GeneralAssetsAddrLength)
+	}
+	stakerAddr, ok := args[2].([]byte)
+	if !ok || stakerAddr == nil {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 2, reflect.TypeOf(args[2]), stakerAddr)
+	}
+	if len(stakerAddr) != types.GeneralClientChainAddrLength {
+		return nil, fmt.Errorf(ErrInputClientChainAddrLength, len(stakerAddr), types.GeneralClientChainAddrLength)
+	}
+
+	stakerID, assetID := types.GetStakeIDAndAssetID(uint64(clientChainLzID), stakerAddr[:clientChainAddrLength], assetAddr[:clientChainAddrLength])
+	assetInfo, err := p.stakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err != nil {
+		if errorsmod.IsOf(err, types.ErrNoStakerAssetKey) {
+			return method.Outputs.Pack(big.NewInt(0))
+		}
+		return nil, err
+	}
+	return method.Outputs.Pack(assetInfo.CanWithdrawAmountOrWantChangeValue.BigInt())
+}
+
+// GetWithdrawal returns the status, amount and maturity height of the withdrawal record.
+func (p Precompile) GetWithdrawal(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	withdrawalID, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf(ErrContractInputParaOrType, 0, reflect.TypeOf(args[0]), args[0])
+	}
+	record, err := p.withdrawKeeper.GetWithdrawalRecord(ctx, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(uint8(record.Status), record.Amount.BigInt(), record.MaturityHeight)
+}
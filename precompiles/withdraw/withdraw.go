@@ -91,8 +91,19 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
 	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
 
-	if method.Name == MethodWithdraw {
+	switch method.Name {
+	// withdraw transactions
+	case MethodWithdraw:
 		bz, err = p.Withdraw(ctx, evm.Origin, contract, stateDB, method, args)
+	case MethodCancelWithdrawal:
+		bz, err = p.CancelWithdrawal(ctx, contract, stateDB, method, args)
+	case MethodCompleteWithdrawal:
+		bz, err = p.CompleteWithdrawal(ctx, contract, stateDB, method, args)
+	// withdraw queries
+	case MethodGetWithdrawableAmount:
+		bz, err = p.GetWithdrawableAmount(ctx, contract, method, args)
+	case MethodGetWithdrawal:
+		bz, err = p.GetWithdrawal(ctx, contract, method, args)
 	}
 
 	if err != nil {
@@ -112,9 +123,11 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available Withdraw transactions are:
 //   - Withdraw
+//   - CancelWithdrawal
+//   - CompleteWithdrawal
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
-	case MethodWithdraw:
+	case MethodWithdraw, MethodCancelWithdrawal, MethodCompleteWithdrawal:
 		return true
 	default:
 		return false
@@ -13,17 +13,94 @@ IWithdraw constant WITHDRAW_CONTRACT = IWithdraw(
 /// @dev The interface through which solidity contracts will interact with WITHDRAW
 /// @custom:address 0x0000000000000000000000000000000000000808
 interface IWithdraw {
+/// EVENTS
+/// @dev Emitted when a withdrawal is requested for the staker
+/// @param clientChainLzID The lzId of client chain
+/// @param withdrawalID The id of the withdrawal record
+/// @param assetsAddress The client chain asset Address
+/// @param withdrawAddress The withdraw address
+/// @param opAmount The withdraw amount
+/// @param maturityHeight The height after which the withdrawal can be claimed
+    event WithdrawalRequested(
+        uint16 indexed clientChainLzID,
+        uint64 indexed withdrawalID,
+        bytes assetsAddress,
+        bytes withdrawAddress,
+        uint256 opAmount,
+        uint64 maturityHeight
+    );
+
+/// @dev Emitted when a pending withdrawal is cancelled
+/// @param clientChainLzID The lzId of client chain
+/// @param withdrawalID The id of the withdrawal record
+/// @param withdrawAddress The withdraw address
+/// @param latestAssetState The total deposit amount of the staker after the cancellation
+    event WithdrawalCancelled(
+        uint16 indexed clientChainLzID,
+        uint64 indexed withdrawalID,
+        bytes withdrawAddress,
+        uint256 latestAssetState
+    );
+
+/// @dev Emitted when the release of a claimable withdrawal is confirmed
+/// @param withdrawalID The id of the withdrawal record
+    event WithdrawalCompleted(
+        uint64 indexed withdrawalID
+    );
+
 /// TRANSACTIONS
-/// @dev withdraw To the staker, that will change the state in withdraw module
+/// @dev request a withdrawal for the staker, the amount can be claimed after the maturity height
 /// Note that this address cannot be a module account.
 /// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
 /// @param assetsAddress The client chain asset Address
 /// @param withdrawAddress The withdraw address
 /// @param opAmount The withdraw amount
     function withdrawPrinciple(
         uint16 clientChainLzID,
+        uint64 lzNonce,
         bytes memory assetsAddress,
         bytes memory withdrawAddress,
         uint256 opAmount
     ) external returns (bool success,uint256 latestAssetState);
+
+/// @dev cancel a pending withdrawal of the staker, the amount is returned to the staker
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param withdrawAddress The withdraw address
+/// @param withdrawalID The id of the withdrawal record
+    function cancelWithdrawal(
+        uint16 clientChainLzID,
+        uint64 lzNonce,
+        bytes memory withdrawAddress,
+        uint64 withdrawalID
+    ) external returns (bool success,uint256 latestAssetState);
+
+/// @dev confirm that the claimable withdrawal has been released on the client chain
+/// @param clientChainLzID The lzId of client chain
+/// @param lzNonce The cross chain tx layerZero nonce
+/// @param withdrawalID The id of the withdrawal record
+    function completeWithdrawal(
+        uint16 clientChainLzID,
+        uint64 lzNonce,
+        uint64 withdrawalID
+    ) external returns (bool success);
+
+/// QUERIES
+/// @dev get the amount that can be withdrawn by the staker, it's zero if the staker hasn't deposited the asset
+/// @param clientChainLzID The lzId of client chain
+/// @param assetsAddress The client chain asset Address
+/// @param withdrawAddress The withdraw address
+    function getWithdrawableAmount(
+        uint16 clientChainLzID,
+        bytes memory assetsAddress,
+        bytes memory withdrawAddress
+    ) external view returns (uint256 canWithdrawAmount);
+
+/// @dev get the status, amount and maturity height of the withdrawal record
+/// the status is 0 for pending, 1 for claimable, 2 for completed and 3 for cancelled
+/// @param withdrawalID The id of the withdrawal record
+    function getWithdrawal(
+        uint64 withdrawalID
+    ) external view returns (uint8 status, uint256 amount, uint64 maturityHeight);
 }
\ No newline at end of file
@@ -62,6 +62,7 @@ func (s *PrecompileTestSuite) TestCallWithdrawFromEOA() {
 		defaultWithdrawArgs := defaultCallArgs.WithMethodName(method)
 		return defaultWithdrawArgs.WithArgs(
 			uint16(clientChainLzID),
+			uint64(1),
 			assetAddr,
 			stakerAddr,
 			opAmount)
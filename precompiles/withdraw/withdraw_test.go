@@ -67,6 +67,7 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 				input, err := s.precompile.Pack(
 					withdraw.MethodWithdraw,
 					uint16(clientChainLzID),
+					uint64(1),
 					assetAddr,
 					withdrawAddr,
 					opAmount,
@@ -74,7 +75,7 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 				s.Require().NoError(err)
 				return input
 			},
-			9680,
+			10640,
 		},
 	}
 
@@ -118,6 +119,7 @@ func (s *PrecompileTestSuite) TestRunWithdrawThroughClientChain() {
 		input, err := s.precompile.Pack(
 			withdraw.MethodWithdraw,
 			uint16(clientChainLzID),
+			uint64(1),
 			assetAddr,
 			paddingClientChainAddress(s.address.Bytes(), types.GeneralClientChainAddrLength),
 			withdrawAmount,
@@ -135,6 +137,23 @@ func (s *PrecompileTestSuite) TestRunWithdrawThroughClientChain() {
 		errContains string
 		returnBytes []byte
 	}{
+		{
+			name: "fail - withdraw via pre-compiles will fail because the lzNonce has been processed",
+			malleate: func() (common.Address, []byte) {
+				depositAsset(s.address.Bytes(), sdkmath.NewIntFromBigInt(depositAmount))
+				withdrawModuleParam := &depositparams.Params{
+					ExoCoreLzAppAddress:    s.address.String(),
+					ExoCoreLzAppEventTopic: exoCoreLzAppEventTopic,
+				}
+				err := s.app.WithdrawKeeper.SetParams(s.ctx, withdrawModuleParam)
+				s.Require().NoError(err)
+				s.app.StakingAssetsManageKeeper.SetLastLzNonce(s.ctx, uint64(clientChainLzID), 1)
+				return commonMalleate()
+			},
+			readOnly:    false,
+			expPass:     false,
+			errContains: types.ErrDuplicateLzNonce.Error(),
+		},
 		{
 			name: "pass - withdraw via pre-compiles",
 			malleate: func() (common.Address, []byte) {
@@ -0,0 +1,203 @@
+package testutil
+
+import (
+	"encoding/binary"
+
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FarFutureEpoch is the withdrawable epoch of the validator that hasn't exited
+const FarFutureEpoch = ^uint64(0)
+
+// sparseTree is a SSZ Merkle tree whose leaves are mostly zero, only the non-zero nodes are stored.
+type sparseTree struct {
+	levels []map[uint64]common.Hash
+}
+
+func newSparseTree(leaves map[uint64]common.Hash, depth int) *sparseTree {
+	t := &sparseTree{levels: make([]map[uint64]common.Hash, depth+1)}
+	t.levels[0] = leaves
+	for level := 0; level < depth; level++ {
+		parents := make(map[uint64]common.Hash)
+		for index := range t.levels[level] {
+			parent := index / 2
+			if _, ok := parents[parent]; ok {
+				continue
+			}
+			parents[parent] = beacon.HashPair(t.node(level, parent*2), t.node(level, parent*2+1))
+		}
+		t.levels[level+1] = parents
+	}
+	return t
+}
+
+func (t *sparseTree) node(level int, index uint64) common.Hash {
+	if node, ok := t.levels[level][index]; ok {
+		return node
+	}
+	return beacon.ZeroHashes[level]
+}
+
+func (t *sparseTree) root() common.Hash {
+	return t.node(len(t.levels)-1, 0)
+}
+
+func (t *sparseTree) proof(index uint64) []common.Hash {
+	proof := make([]common.Hash, 0, len(t.levels)-1)
+	for level := 0; level < len(t.levels)-1; level++ {
+		proof = append(proof, t.node(level, index^1))
+		index /= 2
+	}
+	return proof
+}
+
+// BeaconBlockBuilder builds a synthetic beacon block that includes the added validators, balances and
+// withdrawals, the other fields of the block are zero. The containers follow the fork of the slot on the
+// Ethereum mainnet. It's used to generate the proofs in the tests.
+type BeaconBlockBuilder struct {
+	slot            uint64
+	fork            beacon.Fork
+	validatorCount  uint64
+	validatorFields map[uint64][]common.Hash
+	balances        map[uint64]uint64
+	withdrawals     [][]common.Hash
+}
+
+func NewBeaconBlockBuilder(slot uint64) *BeaconBlockBuilder {
+	fork, err := beacon.ForkAtSlot(slot)
+	if err != nil {
+		panic(err)
+	}
+	return &BeaconBlockBuilder{
+		slot:            slot,
+		fork:            fork,
+		validatorFields: make(map[uint64][]common.Hash),
+		balances:        make(map[uint64]uint64),
+	}
+}
+
+// AddValidator adds the validator whose withdrawal credentials point to the pod
+func (b *BeaconBlockBuilder) AddValidator(index uint64, pubkey []byte, podAddress common.Address, balanceGwei, withdrawableEpoch uint64) *BeaconBlockBuilder {
+	fields := make([]common.Hash, beacon.ValidatorFieldsCount)
+	fields[beacon.ValidatorPubkeyIndex] = beacon.PubkeyRoot(pubkey)
+	fields[beacon.ValidatorWithdrawalCredentialsIndex] = beacon.WithdrawalCredentials(podAddress)
+	fields[beacon.ValidatorEffectiveBalanceIndex] = beacon.Uint64Leaf(balanceGwei)
+	fields[beacon.ValidatorExitEpochIndex] = beacon.Uint64Leaf(withdrawableEpoch)
+	fields[beacon.ValidatorWithdrawableEpochIndex] = beacon.Uint64Leaf(withdrawableEpoch)
+	b.validatorFields[index] = fields
+	b.balances[index] = balanceGwei
+	if index >= b.validatorCount {
+		b.validatorCount = index + 1
+	}
+	return b
+}
+
+// AddWithdrawal adds the withdrawal of the validator to the execution payload
+func (b *BeaconBlockBuilder) AddWithdrawal(validatorIndex uint64, address common.Address, amountGwei uint64) *BeaconBlockBuilder {
+	fields := make([]common.Hash, beacon.WithdrawalFieldsCount)
+	fields[beacon.WithdrawalIndexIndex] = beacon.Uint64Leaf(uint64(len(b.withdrawals)))
+	fields[beacon.WithdrawalValidatorIndexIndex] = beacon.Uint64Leaf(validatorIndex)
+	fields[beacon.WithdrawalAddressIndex] = beacon.AddressLeaf(address)
+	fields[beacon.WithdrawalAmountIndex] = beacon.Uint64Leaf(amountGwei)
+	b.withdrawals = append(b.withdrawals, fields)
+	return b
+}
+
+// BeaconBlockProofs generates the proofs of the built beacon block
+type BeaconBlockProofs struct {
+	BlockRoot common.Hash
+
+	builder         *BeaconBlockBuilder
+	header          *sparseTree
+	state           *sparseTree
+	validators      *sparseTree
+	balances        *sparseTree
+	body            *sparseTree
+	payload         *sparseTree
+	withdrawals     *sparseTree
+	validatorLength common.Hash
+	withdrawLength  common.Hash
+}
+
+// Build merkleizes the beacon block
+func (b *BeaconBlockBuilder) Build() *BeaconBlockProofs {
+	p := &BeaconBlockProofs{builder: b}
+
+	validatorRoots := make(map[uint64]common.Hash)
+	for index, fields := range b.validatorFields {
+		validatorRoots[index] = beacon.MerkleizeChunks(fields, beacon.ValidatorFieldTreeDepth)
+	}
+	p.validators = newSparseTree(validatorRoots, beacon.ValidatorListTreeDepth)
+	p.validatorLength = beacon.Uint64Leaf(b.validatorCount)
+
+	balanceLeaves := make(map[uint64]common.Hash)
+	for index, balance := range b.balances {
+		leaf := balanceLeaves[index/beacon.BalancesPerLeaf]
+		offset := index % beacon.BalancesPerLeaf * 8
+		binary.LittleEndian.PutUint64(leaf[offset:offset+8], balance)
+		balanceLeaves[index/beacon.BalancesPerLeaf] = leaf
+	}
+	p.balances = newSparseTree(balanceLeaves, beacon.BalanceListTreeDepth)
+
+	p.state = newSparseTree(map[uint64]common.Hash{
+		beacon.ValidatorListIndex: beacon.HashPair(p.validators.root(), p.validatorLength),
+		beacon.BalanceListIndex:   beacon.HashPair(p.balances.root(), p.validatorLength),
+	}, b.fork.BeaconStateTreeDepth)
+
+	withdrawalRoots := make(map[uint64]common.Hash)
+	for i, fields := range b.withdrawals {
+		withdrawalRoots[uint64(i)] = beacon.MerkleizeChunks(fields, beacon.WithdrawalFieldTreeDepth)
+	}
+	p.withdrawals = newSparseTree(withdrawalRoots, beacon.WithdrawalListTreeDepth)
+	p.withdrawLength = beacon.Uint64Leaf(uint64(len(b.withdrawals)))
+	p.payload = newSparseTree(map[uint64]common.Hash{
+		beacon.WithdrawalListIndex: beacon.HashPair(p.withdrawals.root(), p.withdrawLength),
+	}, beacon.ExecutionPayloadTreeDepth)
+	p.body = newSparseTree(map[uint64]common.Hash{
+		beacon.ExecutionPayloadIndex: p.payload.root(),
+	}, beacon.BeaconBlockBodyTreeDepth)
+
+	p.header = newSparseTree(map[uint64]common.Hash{
+		0:                             beacon.Uint64Leaf(b.slot),
+		beacon.StateRootIndexInHeader: p.state.root(),
+		beacon.BodyRootIndexInHeader:  p.body.root(),
+	}, beacon.BeaconBlockHeaderTreeDepth)
+	p.BlockRoot = p.header.root()
+	return p
+}
+
+func (p *BeaconBlockProofs) StateRootProof() beacon.StateRootProof {
+	return beacon.StateRootProof{
+		StateRoot: p.state.root(),
+		Proof:     p.header.proof(beacon.StateRootIndexInHeader),
+	}
+}
+
+func (p *BeaconBlockProofs) ValidatorProof(index uint64) beacon.ValidatorProof {
+	proof := append(p.validators.proof(index), p.validatorLength)
+	return beacon.ValidatorProof{
+		ValidatorIndex: index,
+		Fields:         p.builder.validatorFields[index],
+		Proof:          append(proof, p.state.proof(beacon.ValidatorListIndex)...),
+	}
+}
+
+func (p *BeaconBlockProofs) BalanceProof(index uint64) beacon.BalanceProof {
+	proof := append(p.balances.proof(index/beacon.BalancesPerLeaf), p.validatorLength)
+	return beacon.BalanceProof{
+		BalanceRoot: p.balances.node(0, index/beacon.BalancesPerLeaf),
+		Proof:       append(proof, p.state.proof(beacon.BalanceListIndex)...),
+	}
+}
+
+func (p *BeaconBlockProofs) WithdrawalProof(withdrawalIndex uint64) beacon.WithdrawalProof {
+	proof := append(p.withdrawals.proof(withdrawalIndex), p.withdrawLength)
+	proof = append(proof, p.payload.proof(beacon.WithdrawalListIndex)...)
+	proof = append(proof, p.body.proof(beacon.ExecutionPayloadIndex)...)
+	return beacon.WithdrawalProof{
+		WithdrawalIndex: withdrawalIndex,
+		Fields:          p.builder.withdrawals[withdrawalIndex],
+		Proof:           append(proof, p.header.proof(beacon.BodyRootIndexInHeader)...),
+	}
+}
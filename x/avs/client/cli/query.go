@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"context"
+
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the parent command for all avs CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        avstype.ModuleName,
+		Short:                      "Querying commands for the avs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryAVSInfo(),
+		QueryOperatorAVSList(),
+		QueryAVSOperatorList(),
+	)
+	return cmd
+}
+
+// QueryAVSInfo queries the registered info of the AVS
+func QueryAVSInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryAVSInfo avsAddress",
+		Short: "Get the AVS info",
+		Long:  "Get the AVS info",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := avstype.NewQueryClient(clientCtx)
+			req := &avstype.QueryAVSInfoReq{
+				AvsAddress: args[0],
+			}
+			res, err := queryClient.QueryAVSInfo(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryOperatorAVSList queries the AVSs that the operator has opted into
+func QueryOperatorAVSList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryOperatorAVSList operatorAddr",
+		Short: "Get the AVSs that the operator has opted into",
+		Long:  "Get the AVSs that the operator has opted into",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := avstype.NewQueryClient(clientCtx)
+			req := &avstype.QueryOperatorAVSListReq{
+				OperatorAddr: args[0],
+			}
+			res, err := queryClient.QueryOperatorAVSList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryAVSOperatorList queries the operators that have opted into the AVS
+func QueryAVSOperatorList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryAVSOperatorList avsAddress",
+		Short: "Get the operators that have opted into the AVS",
+		Long:  "Get the operators that have opted into the AVS",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := avstype.NewQueryClient(clientCtx)
+			req := &avstype.QueryAVSOperatorListReq{
+				AvsAddress: args[0],
+			}
+			res, err := queryClient.QueryAVSOperatorList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+// NewTxCmd returns a root CLI command handler for avs commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        avstype.ModuleName,
+		Short:                      "avs subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		RegisterAVS(),
+		OptIntoAVS(),
+		OptOutOfAVS(),
+	)
+	return txCmd
+}
+
+// RegisterAVS registers the AVS with the sender as its owner
+func RegisterAVS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "RegisterAVS name middlewareContractAddress unbondingPeriod assetID1,assetID2",
+		Short: "register an AVS",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			unbondingPeriod, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			msg := &avstype.MsgRegisterAVS{
+				FromAddress: sender.String(),
+				Info: &avstype.AVSInfo{
+					Name:                      args[0],
+					Owner:                     sender.String(),
+					MiddlewareContractAddress: args[1],
+					AssetIDs:                  strings.Split(args[3], ","),
+					UnbondingPeriod:           unbondingPeriod,
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// OptIntoAVS the sender operator opts into the AVS
+func OptIntoAVS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "OptIntoAVS avsAddress",
+		Short: "the operator opts into the AVS",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &avstype.MsgOptIntoAVS{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				AvsAddress:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// OptOutOfAVS the sender operator opts out of the AVS
+func OptOutOfAVS() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "OptOutOfAVS avsAddress",
+		Short: "the operator opts out of the AVS",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &avstype.MsgOptOutOfAVS{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				AvsAddress:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,34 @@
+package avs
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/avs/keeper"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis imports the AVS infos and the opt-in records of the operators, the restaking_assets_manage and
+// delegation modules should be initialized before this module to check the assets and the operators.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for i := range data.AvsInfos {
+		if err := k.SetAVSInfo(ctx, &data.AvsInfos[i]); err != nil {
+			panic(err)
+		}
+	}
+	for i := range data.OptedInInfos {
+		if err := k.SetOperatorOptedInInfo(ctx, &data.OptedInInfos[i]); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis exports the AVS infos and the opt-in records of the operators
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	optedInInfos, err := k.GetAllOperatorOptedInInfos(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		AvsInfos:     k.GetAllAVSInfos(ctx),
+		OptedInInfos: optedInInfos,
+	}
+}
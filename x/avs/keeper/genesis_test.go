@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/avs"
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/delegation"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	restaking "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	_, assetID := types.GetStakeIDAndAssetID(101, nil, usdtAddress[:])
+	avsAddress := "0x3e108c058e8066da635321dc3018294ca82ddedf"
+	anotherAVSAddress := "0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea"
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	for addr, unbondingPeriod := range map[string]uint64{avsAddress: 100, anotherAVSAddress: 5} {
+		_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, &avstype.MsgRegisterAVS{
+			FromAddress: suite.accAddress.String(),
+			Info: &avstype.AVSInfo{
+				Name:                      "avs",
+				MiddlewareContractAddress: addr,
+				AssetIDs:                  []string{assetID},
+				UnbondingPeriod:           unbondingPeriod,
+			},
+		})
+		suite.NoError(err)
+		_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, &avstype.MsgOptIntoAVS{
+			FromAddress: opAccAddr.String(),
+			AvsAddress:  addr,
+		})
+		suite.NoError(err)
+	}
+	optOutHeight := uint64(suite.ctx.BlockHeight() + 10)
+	suite.ctx = suite.ctx.WithBlockHeight(int64(optOutHeight))
+	_, err = suite.app.AVSKeeper.OptOutOfAVS(suite.ctx, &avstype.MsgOptOutOfAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.NoError(err)
+
+	restakingGenesis := restaking.ExportGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper)
+	delegationGenesis := delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper)
+	avsGenesis := avs.ExportGenesis(suite.ctx, suite.app.AVSKeeper)
+	suite.NoError(avsGenesis.Validate())
+	suite.Equal(2, len(avsGenesis.AvsInfos))
+	suite.Equal(2, len(avsGenesis.OptedInInfos))
+
+	// the AVS of the opt-in record should be registered
+	invalidGenesis := *avsGenesis
+	invalidGenesis.AvsInfos = avsGenesis.AvsInfos[:1]
+	suite.ErrorIs(invalidGenesis.Validate(), avstype.ErrInvalidGenesisData)
+
+	// import the genesis into a new chain
+	suite.SetupTest()
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	delegation.InitGenesis(suite.ctx, suite.app.DelegationKeeper, *delegationGenesis)
+	avs.InitGenesis(suite.ctx, suite.app.AVSKeeper, *avsGenesis)
+	suite.Equal(avsGenesis, avs.ExportGenesis(suite.ctx, suite.app.AVSKeeper))
+
+	// the opted-out AVS is still in effect for the undelegations until its unbonding period has passed
+	suite.False(suite.app.AVSKeeper.IsOptedIn(suite.ctx, opAccAddr.String(), avsAddress))
+	suite.True(suite.app.AVSKeeper.IsOptedIn(suite.ctx, opAccAddr.String(), anotherAVSAddress))
+	suite.Equal(optOutHeight+100, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, optOutHeight))
+	operatorList, err := suite.app.AVSKeeper.GetAVSOperatorList(suite.ctx, anotherAVSAddress)
+	suite.NoError(err)
+	suite.Equal([]string{opAccAddr.String()}, operatorList)
+	operatorList, err = suite.app.AVSKeeper.GetAVSOperatorList(suite.ctx, avsAddress)
+	suite.NoError(err)
+	suite.Equal(0, len(operatorList))
+
+	// the operator of the opt-in record should be registered
+	suite.SetupTest()
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	suite.Panics(func() {
+		avs.InitGenesis(suite.ctx, suite.app.AVSKeeper, *avsGenesis)
+	})
+}
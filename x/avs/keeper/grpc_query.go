@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) QueryAVSInfo(ctx context.Context, req *types.QueryAVSInfoReq) (*types.AVSInfo, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return k.GetAVSInfo(c, req.AvsAddress)
+}
+
+func (k Keeper) QueryOperatorAVSList(ctx context.Context, req *types.QueryOperatorAVSListReq) (*types.QueryOperatorAVSListResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	avsList, err := k.GetOperatorAVSList(c, req.OperatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOperatorAVSListResponse{AvsAddressList: avsList}, nil
+}
+
+func (k Keeper) QueryAVSOperatorList(ctx context.Context, req *types.QueryAVSOperatorListReq) (*types.QueryAVSOperatorListResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	operatorList, err := k.GetAVSOperatorList(c, req.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAVSOperatorListResponse{OperatorAddrList: operatorList}, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// other keepers
+	restakingStateKeeper keeper.Keeper
+	delegationKeeper     types.DelegationKeeper
+}
+
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	restakingStateKeeper keeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:             storeKey,
+		cdc:                  cdc,
+		restakingStateKeeper: restakingStateKeeper,
+		delegationKeeper:     delegationKeeper,
+	}
+}
+
+var _ delegationtype.OperatorOptedInMiddlewareKeeper = Keeper{}
+
+// SetAVSInfo registers the AVS, the AVS is identified by the lowercase address of its middleware contract
+// and can only be registered once, so the registered AVS can't be overwritten by others.
+func (k Keeper) SetAVSInfo(ctx sdk.Context, info *types.AVSInfo) error {
+	info.MiddlewareContractAddress = strings.ToLower(info.MiddlewareContractAddress)
+	for _, assetID := range info.AssetIDs {
+		if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
+			return errorsmod.Wrap(types.ErrInvalidAVSAssets, fmt.Sprintf("the asset hasn't been registered, assetID:%s", assetID))
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
+	key := []byte(info.MiddlewareContractAddress)
+	if store.Has(key) {
+		return errorsmod.Wrap(types.ErrAVSAlreadyRegistered, fmt.Sprintf("the AVS address is:%s", info.MiddlewareContractAddress))
+	}
+	store.Set(key, k.cdc.MustMarshal(info))
+	return nil
+}
+
+func (k Keeper) GetAVSInfo(ctx sdk.Context, avsAddress string) (*types.AVSInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
+	value := store.Get([]byte(strings.ToLower(avsAddress)))
+	if value == nil {
+		return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("GetAVSInfo: the AVS address is:%s", avsAddress))
+	}
+	ret := types.AVSInfo{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return &ret, nil
+}
+
+func (k Keeper) IsAVS(ctx sdk.Context, avsAddress string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
+	return store.Has([]byte(strings.ToLower(avsAddress)))
+}
+
+// GetAllAVSInfos returns the infos of all registered AVSs, it's used to export the genesis state.
+func (k Keeper) GetAllAVSInfos(ctx sdk.Context) []types.AVSInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSInfo)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.AVSInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.AVSInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, info)
+	}
+	return ret
+}
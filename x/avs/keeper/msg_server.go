@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterAVS registers the AVS with the signer as its owner, the middleware contract address that has been
+// registered is rejected.
+func (k *Keeper) RegisterAVS(goCtx context.Context, req *types.MsgRegisterAVS) (*types.RegisterAVSResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Info.Owner != "" && req.Info.Owner != req.FromAddress {
+		return nil, errorsmod.Wrap(types.ErrAVSOwnerNotMatchedMsg, fmt.Sprintf("owner:%s,signer:%s", req.Info.Owner, req.FromAddress))
+	}
+	req.Info.Owner = req.FromAddress
+	if err := k.SetAVSInfo(ctx, req.Info); err != nil {
+		return nil, err
+	}
+	return &types.RegisterAVSResponse{}, nil
+}
+
+// OptIntoAVS the signer should be a registered operator
+func (k *Keeper) OptIntoAVS(goCtx context.Context, req *types.MsgOptIntoAVS) (*types.OptIntoAVSResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", req.FromAddress))
+	}
+	if err = k.OptIn(ctx, opAccAddr, req.AvsAddress); err != nil {
+		return nil, err
+	}
+	return &types.OptIntoAVSResponse{}, nil
+}
+
+// OptOutOfAVS the signer should be an operator that has opted into the AVS
+func (k *Keeper) OptOutOfAVS(goCtx context.Context, req *types.MsgOptOutOfAVS) (*types.OptOutOfAVSResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", req.FromAddress))
+	}
+	if err = k.OptOut(ctx, opAccAddr, req.AvsAddress); err != nil {
+		return nil, err
+	}
+	return &types.OptOutOfAVSResponse{}, nil
+}
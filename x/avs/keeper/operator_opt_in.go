@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OptIn the operator opts into the AVS, then the stake delegated to the operator will be used to secure the AVS
+// and can be slashed by it.
+func (k Keeper) OptIn(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddress string) error {
+	avsAddress = strings.ToLower(avsAddress)
+	if !k.delegationKeeper.IsOperator(ctx, operatorAddr) {
+		return errorsmod.Wrap(types.ErrOperatorNotExist, fmt.Sprintf("the operator is:%s", operatorAddr))
+	}
+	if !k.IsAVS(ctx, avsAddress) {
+		return errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("the AVS isn't registered, address:%s", avsAddress))
+	}
+	if k.IsOptedIn(ctx, operatorAddr.String(), avsAddress) {
+		return errorsmod.Wrap(types.ErrAlreadyOptedIn, fmt.Sprintf("operator:%s,AVS:%s", operatorAddr, avsAddress))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	optedInInfo := &types.OperatorOptedInInfo{OptedInHeight: uint64(ctx.BlockHeight())}
+	store.Set(types.GetOperatorOptedInAVSKey(operatorAddr.String(), avsAddress), k.cdc.MustMarshal(optedInInfo))
+
+	reverseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSOptedInOperator)
+	reverseStore.Set(types.GetAVSOptedInOperatorKey(avsAddress, operatorAddr.String()), []byte{})
+	return nil
+}
+
+// OptOut the operator opts out of the AVS. The operator isn't counted by the AVS anymore, but the AVS stays in
+// effect for the undelegations from the operator until its unbonding period has passed since the opt-out,
+// because the AVS can still slash the operator for the faults before the opt-out in this period.
+func (k Keeper) OptOut(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddress string) error {
+	avsAddress = strings.ToLower(avsAddress)
+	optedInInfo := k.getOptedInInfo(ctx, operatorAddr.String(), avsAddress)
+	if optedInInfo == nil || optedInInfo.OptedOutHeight != 0 {
+		return errorsmod.Wrap(types.ErrNotOptedIn, fmt.Sprintf("operator:%s,AVS:%s", operatorAddr, avsAddress))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	optedInInfo.OptedOutHeight = uint64(ctx.BlockHeight())
+	store.Set(types.GetOperatorOptedInAVSKey(operatorAddr.String(), avsAddress), k.cdc.MustMarshal(optedInInfo))
+
+	reverseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSOptedInOperator)
+	reverseStore.Delete(types.GetAVSOptedInOperatorKey(avsAddress, operatorAddr.String()))
+	return nil
+}
+
+// IsOptedIn returns true if the operator has opted into the AVS and hasn't opted out
+func (k Keeper) IsOptedIn(ctx sdk.Context, operatorAddr, avsAddress string) bool {
+	optedInInfo := k.getOptedInInfo(ctx, operatorAddr, strings.ToLower(avsAddress))
+	return optedInInfo != nil && optedInInfo.OptedOutHeight == 0
+}
+
+func (k Keeper) getOptedInInfo(ctx sdk.Context, operatorAddr, avsAddress string) *types.OperatorOptedInInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	value := store.Get(types.GetOperatorOptedInAVSKey(operatorAddr, avsAddress))
+	if value == nil {
+		return nil
+	}
+	ret := &types.OperatorOptedInInfo{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret
+}
+
+// iterateOperatorOptedInInfos iterates the opt-in records of the operator, including the ones of the AVSs that
+// the operator has opted out of.
+func (k Keeper) iterateOperatorOptedInInfos(ctx sdk.Context, operatorAddr string, fn func(avsAddress string, info *types.OperatorOptedInInfo) error) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetJoinedStoreKeyPrefix(operatorAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keyList := strings.Split(string(iterator.Key()), "/")
+		if len(keyList) != 2 {
+			return errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("invalid opted-in key:%s", iterator.Key()))
+		}
+		var info types.OperatorOptedInInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		if err := fn(keyList[1], &info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetOperatorAVSList returns the addresses of the AVSs that the operator has opted into
+func (k Keeper) GetOperatorAVSList(ctx sdk.Context, operatorAddr string) ([]string, error) {
+	ret := make([]string, 0)
+	err := k.iterateOperatorOptedInInfos(ctx, operatorAddr, func(avsAddress string, info *types.OperatorOptedInInfo) error {
+		if info.OptedOutHeight == 0 {
+			ret = append(ret, avsAddress)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// GetAVSOperatorList returns the addresses of the operators that have opted into the AVS
+func (k Keeper) GetAVSOperatorList(ctx sdk.Context, avsAddress string) ([]string, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSOptedInOperator)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetJoinedStoreKeyPrefix(strings.ToLower(avsAddress)))
+	defer iterator.Close()
+
+	ret := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keyList := strings.Split(string(iterator.Key()), "/")
+		if len(keyList) != 2 {
+			return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("invalid opted-in key:%s", iterator.Key()))
+		}
+		ret = append(ret, keyList[1])
+	}
+	return ret, nil
+}
+
+// GetOperatorCanUndelegateHeight returns the height at which the undelegation started at startHeight can be
+// completed. It's the maximum unbonding end of the AVSs that the operator serves with the asset, and the default
+// delay of the delegation module is used as the minimum. The unbonding of an AVS that the operator has opted out
+// of ends at the unbonding period after the opt-out.
+func (k Keeper) GetOperatorCanUndelegateHeight(ctx sdk.Context, assetID string, opAddr sdk.AccAddress, startHeight uint64) uint64 {
+	canUndelegateHeight := startHeight + delegationtype.CanUndelegationDelayHeight
+	err := k.iterateOperatorOptedInInfos(ctx, opAddr.String(), func(avsAddress string, optedInInfo *types.OperatorOptedInInfo) error {
+		info, err := k.GetAVSInfo(ctx, avsAddress)
+		if err != nil {
+			return err
+		}
+		if !info.IsAcceptedAsset(assetID) {
+			return nil
+		}
+		unbondingEnd := startHeight + info.UnbondingPeriod
+		if optedInInfo.OptedOutHeight != 0 && optedInInfo.OptedOutHeight+info.UnbondingPeriod < unbondingEnd {
+			unbondingEnd = optedInInfo.OptedOutHeight + info.UnbondingPeriod
+		}
+		if unbondingEnd > canUndelegateHeight {
+			canUndelegateHeight = unbondingEnd
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return canUndelegateHeight
+}
+
+// SetOperatorOptedInInfo stores the opt-in record of the operator as it is, it's used to import the genesis state.
+// The reverse index is only set for the AVS that the operator hasn't opted out of.
+func (k Keeper) SetOperatorOptedInInfo(ctx sdk.Context, record *types.OperatorOptedInGenesis) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(record.OperatorAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", record.OperatorAddr))
+	}
+	if !k.delegationKeeper.IsOperator(ctx, opAccAddr) {
+		return errorsmod.Wrap(types.ErrOperatorNotExist, fmt.Sprintf("the operator is:%s", record.OperatorAddr))
+	}
+	avsAddress := strings.ToLower(record.AvsAddress)
+	if !k.IsAVS(ctx, avsAddress) {
+		return errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("the AVS isn't registered, address:%s", avsAddress))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	store.Set(types.GetOperatorOptedInAVSKey(record.OperatorAddr, avsAddress), k.cdc.MustMarshal(&record.Info))
+	if record.Info.OptedOutHeight == 0 {
+		reverseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAVSOptedInOperator)
+		reverseStore.Set(types.GetAVSOptedInOperatorKey(avsAddress, record.OperatorAddr), []byte{})
+	}
+	return nil
+}
+
+// GetAllOperatorOptedInInfos returns the opt-in records of all operators, including the ones of the AVSs that
+// the operators have opted out of. It's used to export the genesis state.
+func (k Keeper) GetAllOperatorOptedInInfos(ctx sdk.Context) ([]types.OperatorOptedInGenesis, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorOptedInAVS)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.OperatorOptedInGenesis, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keyList := strings.Split(string(iterator.Key()), "/")
+		if len(keyList) != 2 {
+			return nil, errorsmod.Wrap(types.ErrNoKeyInTheStore, fmt.Sprintf("invalid opted-in key:%s", iterator.Key()))
+		}
+		record := types.OperatorOptedInGenesis{
+			OperatorAddr: keyList[0],
+			AvsAddress:   keyList[1],
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &record.Info)
+		ret = append(ret, record)
+	}
+	return ret, nil
+}
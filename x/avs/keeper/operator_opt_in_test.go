@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestAVSOptIn() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+	avsAddress := "0x3e108c058e8066da635321dc3018294ca82ddedf"
+	anotherAVSAddress := "0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea"
+
+	registerMsg := &avstype.MsgRegisterAVS{
+		FromAddress: suite.accAddress.String(),
+		Info: &avstype.AVSInfo{
+			Name:                      "avs",
+			MiddlewareContractAddress: avsAddress,
+			AssetIDs:                  []string{"0x0000000000000000000000000000000000000001_0x65"},
+			UnbondingPeriod:           100,
+		},
+	}
+	suite.NoError(registerMsg.ValidateBasic())
+	// the asset hasn't been registered
+	_, err := suite.app.AVSKeeper.RegisterAVS(suite.ctx, registerMsg)
+	suite.ErrorIs(err, avstype.ErrInvalidAVSAssets)
+
+	registerMsg.Info.AssetIDs = []string{assetID}
+	// the owner should be the signer
+	anotherAccAddress := sdk.AccAddress(common.HexToAddress("0x0000000000000000000000000000000000000002").Bytes())
+	registerMsg.Info.Owner = anotherAccAddress.String()
+	suite.ErrorIs(registerMsg.ValidateBasic(), avstype.ErrAVSOwnerNotMatchedMsg)
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, registerMsg)
+	suite.ErrorIs(err, avstype.ErrAVSOwnerNotMatchedMsg)
+	registerMsg.Info.Owner = ""
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, registerMsg)
+	suite.NoError(err)
+	info, err := suite.app.AVSKeeper.QueryAVSInfo(suite.ctx, &avstype.QueryAVSInfoReq{AvsAddress: avsAddress})
+	suite.NoError(err)
+	suite.Equal(suite.accAddress.String(), info.Owner)
+	suite.Equal(uint64(100), info.UnbondingPeriod)
+
+	// the AVS can only be registered once, so it can't be overwritten by others
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, registerMsg)
+	suite.ErrorIs(err, avstype.ErrAVSAlreadyRegistered)
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, &avstype.MsgRegisterAVS{
+		FromAddress: anotherAccAddress.String(),
+		Info: &avstype.AVSInfo{
+			Name:                      "avs",
+			MiddlewareContractAddress: "0x3E108C058E8066DA635321DC3018294CA82DDEDF",
+			AssetIDs:                  []string{assetID},
+			UnbondingPeriod:           1,
+		},
+	})
+	suite.ErrorIs(err, avstype.ErrAVSAlreadyRegistered)
+	info, err = suite.app.AVSKeeper.QueryAVSInfo(suite.ctx, &avstype.QueryAVSInfoReq{AvsAddress: avsAddress})
+	suite.NoError(err)
+	suite.Equal(suite.accAddress.String(), info.Owner)
+	suite.Equal(uint64(100), info.UnbondingPeriod)
+
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, &avstype.MsgRegisterAVS{
+		FromAddress: suite.accAddress.String(),
+		Info: &avstype.AVSInfo{
+			Name:                      "another avs",
+			MiddlewareContractAddress: anotherAVSAddress,
+			AssetIDs:                  []string{assetID},
+			UnbondingPeriod:           5,
+		},
+	})
+	suite.NoError(err)
+
+	// only the registered operator can opt into the AVS
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	optInMsg := &avstype.MsgOptIntoAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	}
+	_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, optInMsg)
+	suite.ErrorIs(err, avstype.ErrOperatorNotExist)
+
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	for _, addr := range []string{avsAddress, anotherAVSAddress} {
+		optInMsg.AvsAddress = addr
+		_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, optInMsg)
+		suite.NoError(err)
+	}
+	_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, optInMsg)
+	suite.ErrorIs(err, avstype.ErrAlreadyOptedIn)
+
+	avsList, err := suite.app.AVSKeeper.QueryOperatorAVSList(suite.ctx, &avstype.QueryOperatorAVSListReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.ElementsMatch([]string{avsAddress, anotherAVSAddress}, avsList.AvsAddressList)
+	operatorList, err := suite.app.AVSKeeper.QueryAVSOperatorList(suite.ctx, &avstype.QueryAVSOperatorListReq{AvsAddress: avsAddress})
+	suite.NoError(err)
+	suite.Equal([]string{opAccAddr.String()}, operatorList.OperatorAddrList)
+
+	// the undelegation waits for the maximum unbonding period of the AVSs accepting the asset
+	startHeight := uint64(suite.ctx.BlockHeight())
+	suite.Equal(startHeight+100, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, startHeight))
+	suite.Equal(startHeight+delegationtype.CanUndelegationDelayHeight, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, "0x0000000000000000000000000000000000000001_0x65", opAccAddr, startHeight))
+
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.NoError(err)
+	delegationParams.LzNonce = 1
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams)
+	suite.NoError(err)
+	records, err := suite.app.DelegationKeeper.GetStakerUndelegationRecords(suite.ctx, stakerID, assetID, delegationkeeper.PendingRecords)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	suite.Equal(startHeight+100, records[0].CompleteBlockNumber)
+
+	// the AVS isn't served by the operator after opting out, but it's still in effect for the undelegations
+	// until its unbonding period has passed since the opt-out
+	optOutHeight := startHeight + 20
+	suite.ctx = suite.ctx.WithBlockHeight(int64(optOutHeight))
+	_, err = suite.app.AVSKeeper.OptOutOfAVS(suite.ctx, &avstype.MsgOptOutOfAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.NoError(err)
+	_, err = suite.app.AVSKeeper.OptOutOfAVS(suite.ctx, &avstype.MsgOptOutOfAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.ErrorIs(err, avstype.ErrNotOptedIn)
+	suite.False(suite.app.AVSKeeper.IsOptedIn(suite.ctx, opAccAddr.String(), avsAddress))
+	operatorList, err = suite.app.AVSKeeper.QueryAVSOperatorList(suite.ctx, &avstype.QueryAVSOperatorListReq{AvsAddress: avsAddress})
+	suite.NoError(err)
+	suite.Equal(0, len(operatorList.OperatorAddrList))
+	avsList, err = suite.app.AVSKeeper.QueryOperatorAVSList(suite.ctx, &avstype.QueryOperatorAVSListReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal([]string{anotherAVSAddress}, avsList.AvsAddressList)
+
+	suite.Equal(optOutHeight+100, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, optOutHeight))
+	laterHeight := optOutHeight + 50
+	suite.Equal(optOutHeight+100, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, laterHeight))
+	expiredHeight := optOutHeight + 100
+	suite.Equal(expiredHeight+delegationtype.CanUndelegationDelayHeight, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, expiredHeight))
+
+	// the operator can opt into the AVS again
+	_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, &avstype.MsgOptIntoAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.NoError(err)
+	suite.True(suite.app.AVSKeeper.IsOptedIn(suite.ctx, opAccAddr.String(), avsAddress))
+	suite.Equal(expiredHeight+100, suite.app.AVSKeeper.GetOperatorCanUndelegateHeight(suite.ctx, assetID, opAccAddr, expiredHeight))
+}
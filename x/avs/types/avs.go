@@ -0,0 +1,11 @@
+package types
+
+// IsAcceptedAsset returns true if the asset is accepted by the AVS
+func (m *AVSInfo) IsAcceptedAsset(assetID string) bool {
+	for _, id := range m.AssetIDs {
+		if id == assetID {
+			return true
+		}
+	}
+	return false
+}
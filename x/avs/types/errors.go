@@ -0,0 +1,20 @@
+package types
+
+// DONTCOVER
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// x/avs module sentinel errors
+var (
+	ErrNoKeyInTheStore       = errorsmod.Register(ModuleName, 1, "there is not the key for in the store")
+	ErrAVSAlreadyRegistered  = errorsmod.Register(ModuleName, 2, "the AVS has been registered")
+	ErrInvalidAVSAddress     = errorsmod.Register(ModuleName, 3, "the AVS address isn't a valid hex address")
+	ErrInvalidAVSAssets      = errorsmod.Register(ModuleName, 4, "the assets accepted by the AVS are invalid")
+	ErrOperatorNotExist      = errorsmod.Register(ModuleName, 5, "the operator has not been registered")
+	ErrAlreadyOptedIn        = errorsmod.Register(ModuleName, 6, "the operator has opted into the AVS")
+	ErrNotOptedIn            = errorsmod.Register(ModuleName, 7, "the operator hasn't opted into the AVS")
+	ErrAVSOwnerNotMatchedMsg = errorsmod.Register(ModuleName, 8, "the owner of AVS info doesn't match the signer")
+	ErrInvalidGenesisData    = errorsmod.Register(ModuleName, 9, "the genesis data supplied is invalid")
+)
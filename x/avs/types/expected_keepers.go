@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationKeeper defines the expected delegation keeper used to check the operator
+type DelegationKeeper interface {
+	IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+}
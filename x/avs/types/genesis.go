@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure. The registration of
+// the assets and the operators is checked in InitGenesis.
+func (gs GenesisState) Validate() error {
+	avsInfos := make(map[string]struct{}, len(gs.AvsInfos))
+	for _, info := range gs.AvsInfos {
+		if !common.IsHexAddress(info.MiddlewareContractAddress) {
+			return errorsmod.Wrap(ErrInvalidAVSAddress, fmt.Sprintf("the address is:%s", info.MiddlewareContractAddress))
+		}
+		avsAddress := strings.ToLower(info.MiddlewareContractAddress)
+		if _, ok := avsInfos[avsAddress]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated AVS:%s", avsAddress))
+		}
+		avsInfos[avsAddress] = struct{}{}
+		if _, err := sdk.AccAddressFromBech32(info.Owner); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the owner address of the AVS is invalid:%s", avsAddress))
+		}
+		if len(info.AssetIDs) == 0 {
+			return errorsmod.Wrap(ErrInvalidAVSAssets, fmt.Sprintf("the assetIDs are empty, AVS:%s", avsAddress))
+		}
+		for _, assetID := range info.AssetIDs {
+			if _, _, err := restakingtype.ParseID(assetID); err != nil {
+				return errorsmod.Wrap(ErrInvalidAVSAssets, fmt.Sprintf("AVS:%s,error:%s", avsAddress, err))
+			}
+		}
+	}
+
+	records := make(map[string]struct{}, len(gs.OptedInInfos))
+	for _, record := range gs.OptedInInfos {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the opt-in record is invalid:%s", record.OperatorAddr))
+		}
+		avsAddress := strings.ToLower(record.AvsAddress)
+		key := string(GetOperatorOptedInAVSKey(record.OperatorAddr, avsAddress))
+		if _, ok := avsInfos[avsAddress]; !ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the AVS of the opt-in record isn't registered:%s", key))
+		}
+		if _, ok := records[key]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated opt-in record:%s", key))
+		}
+		records[key] = struct{}{}
+		if record.Info.OptedOutHeight != 0 && record.Info.OptedOutHeight < record.Info.OptedInHeight {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the opted-out height is less than the opted-in height:%s", key))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,679 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/avs/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the avs module's genesis state.
+type GenesisState struct {
+	// avsInfos are the registered AVSs
+	AvsInfos []AVSInfo `protobuf:"bytes,1,rep,name=avsInfos,proto3" json:"avsInfos"`
+	// optedInInfos are the opt-in records of the operators, including the ones
+	// that have been opted out of.
+	OptedInInfos []OperatorOptedInGenesis `protobuf:"bytes,2,rep,name=optedInInfos,proto3" json:"optedInInfos"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f7a9069172c0bef, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetAvsInfos() []AVSInfo {
+	if m != nil {
+		return m.AvsInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOptedInInfos() []OperatorOptedInGenesis {
+	if m != nil {
+		return m.OptedInInfos
+	}
+	return nil
+}
+
+// OperatorOptedInGenesis is the opt-in record of the operator for the AVS.
+type OperatorOptedInGenesis struct {
+	OperatorAddr string              `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AvsAddress   string              `protobuf:"bytes,2,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+	Info         OperatorOptedInInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info"`
+}
+
+func (m *OperatorOptedInGenesis) Reset()         { *m = OperatorOptedInGenesis{} }
+func (m *OperatorOptedInGenesis) String() string { return proto.CompactTextString(m) }
+func (*OperatorOptedInGenesis) ProtoMessage()    {}
+func (*OperatorOptedInGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f7a9069172c0bef, []int{1}
+}
+func (m *OperatorOptedInGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorOptedInGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorOptedInGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorOptedInGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorOptedInGenesis.Merge(m, src)
+}
+func (m *OperatorOptedInGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorOptedInGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorOptedInGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorOptedInGenesis proto.InternalMessageInfo
+
+func (m *OperatorOptedInGenesis) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *OperatorOptedInGenesis) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *OperatorOptedInGenesis) GetInfo() OperatorOptedInInfo {
+	if m != nil {
+		return m.Info
+	}
+	return OperatorOptedInInfo{}
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "exocore.avs.GenesisState")
+	proto.RegisterType((*OperatorOptedInGenesis)(nil), "exocore.avs.OperatorOptedInGenesis")
+}
+
+func init() { proto.RegisterFile("exocore/avs/genesis.proto", fileDescriptor_6f7a9069172c0bef) }
+
+var fileDescriptor_6f7a9069172c0bef = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x73, 0x6d, 0x11, 0xbd, 0x76, 0x0a, 0x41, 0xd2, 0x0e, 0x67, 0xa8, 0x4b, 0x97, 0x26,
+	0x50, 0xc1, 0x41, 0x5c, 0x5a, 0x90, 0xd2, 0x41, 0x0b, 0x2d, 0x38, 0xb8, 0xc8, 0xb5, 0xbd, 0xc6,
+	0x20, 0xcd, 0x17, 0xee, 0x3b, 0x63, 0xfd, 0x17, 0x2e, 0xfe, 0x11, 0xf1, 0x47, 0x74, 0x2c, 0x4e,
+	0x4e, 0x22, 0xed, 0x1f, 0x91, 0xe4, 0x4e, 0x49, 0x41, 0xdc, 0x92, 0xf7, 0x7d, 0x9f, 0xef, 0xbd,
+	0xbb, 0x8f, 0xd6, 0xc5, 0x12, 0xa6, 0x20, 0x45, 0xc0, 0x53, 0x0c, 0x42, 0x11, 0x0b, 0x8c, 0xd0,
+	0x4f, 0x24, 0x28, 0xb0, 0xab, 0xc6, 0xf2, 0x79, 0x8a, 0x0d, 0x27, 0x84, 0x10, 0x72, 0x3d, 0xc8,
+	0xbe, 0x74, 0xa4, 0x51, 0x9f, 0x02, 0x2e, 0x00, 0x6f, 0xb5, 0xa1, 0x7f, 0x8c, 0xe5, 0x14, 0x07,
+	0xab, 0xa5, 0x56, 0x9b, 0x2f, 0x84, 0xd6, 0xfa, 0xba, 0x65, 0xac, 0xb8, 0x12, 0xf6, 0x29, 0xdd,
+	0xe7, 0x29, 0x0e, 0xe2, 0x39, 0xa0, 0x4b, 0xbc, 0x72, 0xab, 0xda, 0x71, 0xfc, 0x42, 0xaf, 0xdf,
+	0xbd, 0x1e, 0x67, 0x66, 0xaf, 0xb2, 0xfa, 0x3c, 0xb2, 0x46, 0xbf, 0x59, 0xfb, 0x92, 0xd6, 0x20,
+	0x51, 0x62, 0x36, 0x88, 0x35, 0x5b, 0xca, 0xd9, 0xe3, 0x1d, 0x76, 0x98, 0x08, 0xc9, 0x15, 0xc8,
+	0xa1, 0x0e, 0x9a, 0x5e, 0x33, 0x6a, 0x07, 0x6f, 0xbe, 0x12, 0x7a, 0xf8, 0x77, 0xdc, 0x3e, 0xcf,
+	0x9a, 0xb4, 0xd3, 0x9d, 0xcd, 0xa4, 0x4b, 0x3c, 0xd2, 0x3a, 0xe8, 0xb9, 0xef, 0x6f, 0x6d, 0xc7,
+	0x5c, 0x38, 0x93, 0x05, 0xe2, 0x58, 0xc9, 0x28, 0x0e, 0x47, 0x3b, 0x69, 0x9b, 0x51, 0xca, 0x53,
+	0x34, 0x09, 0xb7, 0x94, 0xb1, 0xa3, 0x82, 0x62, 0x9f, 0xd1, 0x4a, 0x14, 0xcf, 0xc1, 0x2d, 0x7b,
+	0xa4, 0x55, 0xed, 0x78, 0xff, 0x9d, 0xbf, 0xf0, 0x0e, 0x39, 0xd3, 0xeb, 0xaf, 0x36, 0x8c, 0xac,
+	0x37, 0x8c, 0x7c, 0x6d, 0x18, 0x79, 0xde, 0x32, 0x6b, 0xbd, 0x65, 0xd6, 0xc7, 0x96, 0x59, 0x37,
+	0xed, 0x30, 0x52, 0x77, 0x0f, 0x13, 0x7f, 0x0a, 0x8b, 0xe0, 0x42, 0x4f, 0xbc, 0x12, 0xea, 0x11,
+	0xe4, 0x7d, 0xf0, 0xb3, 0x96, 0xa5, 0x5e, 0xcc, 0x53, 0x22, 0x70, 0xb2, 0x97, 0x2f, 0xe7, 0xe4,
+	0x7b, 0x00, 0x4d, 0x96, 0xce, 0xa7, 0x0d, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OptedInInfos) > 0 {
+		for iNdEx := len(m.OptedInInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptedInInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AvsInfos) > 0 {
+		for iNdEx := len(m.AvsInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AvsInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorOptedInGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorOptedInGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorOptedInGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvsInfos) > 0 {
+		for _, e := range m.AvsInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OptedInInfos) > 0 {
+		for _, e := range m.OptedInInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperatorOptedInGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsInfos = append(m.AvsInfos, AVSInfo{})
+			if err := m.AvsInfos[len(m.AvsInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptedInInfos = append(m.OptedInInfos, OperatorOptedInGenesis{})
+			if err := m.OptedInInfos[len(m.OptedInInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorOptedInGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorOptedInGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorOptedInGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
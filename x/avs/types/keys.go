@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+)
+
+// constants
+const (
+	// ModuleName module name
+	ModuleName = "avs"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+const (
+	prefixAVSInfo = iota + 1
+	prefixOperatorOptedInAVS
+	prefixAVSOptedInOperator
+)
+
+var (
+	// KeyPrefixAVSInfo key-value: avsAddress->AVSInfo
+	KeyPrefixAVSInfo = []byte{prefixAVSInfo}
+	// KeyPrefixOperatorOptedInAVS key-value: operatorAddr+'/'+avsAddress->OperatorOptedInInfo
+	KeyPrefixOperatorOptedInAVS = []byte{prefixOperatorOptedInAVS}
+	// KeyPrefixAVSOptedInOperator is the reverse index of KeyPrefixOperatorOptedInAVS
+	// key-value: avsAddress+'/'+operatorAddr->struct{}
+	KeyPrefixAVSOptedInOperator = []byte{prefixAVSOptedInOperator}
+)
+
+func GetOperatorOptedInAVSKey(operatorAddr, avsAddress string) []byte {
+	return []byte(strings.Join([]string{operatorAddr, avsAddress}, "/"))
+}
+
+func GetAVSOptedInOperatorKey(avsAddress, operatorAddr string) []byte {
+	return []byte(strings.Join([]string{avsAddress, operatorAddr}, "/"))
+}
+
+// GetJoinedStoreKeyPrefix returns the iterator prefix of the keys joined by '/'
+func GetJoinedStoreKeyPrefix(key string) []byte {
+	return []byte(key + "/")
+}
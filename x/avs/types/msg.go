@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterAVS{}
+	_ sdk.Msg = &MsgOptIntoAVS{}
+	_ sdk.Msg = &MsgOptOutOfAVS{}
+)
+
+// GetSigners returns the expected signers for a MsgRegisterAVS message.
+func (m *MsgRegisterAVS) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterAVS) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidAVSAssets, "the AVS info is nil")
+	}
+	if m.Info.Owner != "" && m.Info.Owner != m.FromAddress {
+		return errorsmod.Wrap(ErrAVSOwnerNotMatchedMsg, fmt.Sprintf("owner:%s,signer:%s", m.Info.Owner, m.FromAddress))
+	}
+	if !common.IsHexAddress(m.Info.MiddlewareContractAddress) {
+		return errorsmod.Wrap(ErrInvalidAVSAddress, fmt.Sprintf("the address is:%s", m.Info.MiddlewareContractAddress))
+	}
+	if len(m.Info.AssetIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidAVSAssets, "the assetIDs are empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgRegisterAVS) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgOptIntoAVS message.
+func (m *MsgOptIntoAVS) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgOptIntoAVS) ValidateBasic() error {
+	return validateOptInOrOutInfo(m.FromAddress, m.AvsAddress)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgOptIntoAVS) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgOptOutOfAVS message.
+func (m *MsgOptOutOfAVS) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgOptOutOfAVS) ValidateBasic() error {
+	return validateOptInOrOutInfo(m.FromAddress, m.AvsAddress)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgOptOutOfAVS) GetSignBytes() []byte {
+	return nil
+}
+
+func validateOptInOrOutInfo(operatorAddr, avsAddress string) error {
+	if _, err := sdk.AccAddressFromBech32(operatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if !common.IsHexAddress(avsAddress) {
+		return errorsmod.Wrap(ErrInvalidAVSAddress, fmt.Sprintf("the address is:%s", avsAddress))
+	}
+	return nil
+}
@@ -0,0 +1,1186 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/avs/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryAVSInfoReq struct {
+	AvsAddress string `protobuf:"bytes,1,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *QueryAVSInfoReq) Reset()         { *m = QueryAVSInfoReq{} }
+func (m *QueryAVSInfoReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSInfoReq) ProtoMessage()    {}
+func (*QueryAVSInfoReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9df5f5965203ddc, []int{0}
+}
+func (m *QueryAVSInfoReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSInfoReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSInfoReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSInfoReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSInfoReq.Merge(m, src)
+}
+func (m *QueryAVSInfoReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSInfoReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSInfoReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSInfoReq proto.InternalMessageInfo
+
+func (m *QueryAVSInfoReq) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+type QueryOperatorAVSListReq struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+}
+
+func (m *QueryOperatorAVSListReq) Reset()         { *m = QueryOperatorAVSListReq{} }
+func (m *QueryOperatorAVSListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAVSListReq) ProtoMessage()    {}
+func (*QueryOperatorAVSListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9df5f5965203ddc, []int{1}
+}
+func (m *QueryOperatorAVSListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorAVSListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorAVSListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorAVSListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAVSListReq.Merge(m, src)
+}
+func (m *QueryOperatorAVSListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorAVSListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAVSListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorAVSListReq proto.InternalMessageInfo
+
+func (m *QueryOperatorAVSListReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+type QueryOperatorAVSListResponse struct {
+	AvsAddressList []string `protobuf:"bytes,1,rep,name=avsAddressList,proto3" json:"avsAddressList,omitempty"`
+}
+
+func (m *QueryOperatorAVSListResponse) Reset()         { *m = QueryOperatorAVSListResponse{} }
+func (m *QueryOperatorAVSListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAVSListResponse) ProtoMessage()    {}
+func (*QueryOperatorAVSListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9df5f5965203ddc, []int{2}
+}
+func (m *QueryOperatorAVSListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorAVSListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorAVSListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorAVSListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAVSListResponse.Merge(m, src)
+}
+func (m *QueryOperatorAVSListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorAVSListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAVSListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorAVSListResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorAVSListResponse) GetAvsAddressList() []string {
+	if m != nil {
+		return m.AvsAddressList
+	}
+	return nil
+}
+
+type QueryAVSOperatorListReq struct {
+	AvsAddress string `protobuf:"bytes,1,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *QueryAVSOperatorListReq) Reset()         { *m = QueryAVSOperatorListReq{} }
+func (m *QueryAVSOperatorListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSOperatorListReq) ProtoMessage()    {}
+func (*QueryAVSOperatorListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9df5f5965203ddc, []int{3}
+}
+func (m *QueryAVSOperatorListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSOperatorListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSOperatorListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSOperatorListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSOperatorListReq.Merge(m, src)
+}
+func (m *QueryAVSOperatorListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSOperatorListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSOperatorListReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSOperatorListReq proto.InternalMessageInfo
+
+func (m *QueryAVSOperatorListReq) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+type QueryAVSOperatorListResponse struct {
+	OperatorAddrList []string `protobuf:"bytes,1,rep,name=operatorAddrList,proto3" json:"operatorAddrList,omitempty"`
+}
+
+func (m *QueryAVSOperatorListResponse) Reset()         { *m = QueryAVSOperatorListResponse{} }
+func (m *QueryAVSOperatorListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSOperatorListResponse) ProtoMessage()    {}
+func (*QueryAVSOperatorListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9df5f5965203ddc, []int{4}
+}
+func (m *QueryAVSOperatorListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSOperatorListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSOperatorListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSOperatorListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSOperatorListResponse.Merge(m, src)
+}
+func (m *QueryAVSOperatorListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSOperatorListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSOperatorListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSOperatorListResponse proto.InternalMessageInfo
+
+func (m *QueryAVSOperatorListResponse) GetOperatorAddrList() []string {
+	if m != nil {
+		return m.OperatorAddrList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAVSInfoReq)(nil), "exocore.avs.QueryAVSInfoReq")
+	proto.RegisterType((*QueryOperatorAVSListReq)(nil), "exocore.avs.QueryOperatorAVSListReq")
+	proto.RegisterType((*QueryOperatorAVSListResponse)(nil), "exocore.avs.QueryOperatorAVSListResponse")
+	proto.RegisterType((*QueryAVSOperatorListReq)(nil), "exocore.avs.QueryAVSOperatorListReq")
+	proto.RegisterType((*QueryAVSOperatorListResponse)(nil), "exocore.avs.QueryAVSOperatorListResponse")
+}
+
+func init() { proto.RegisterFile("exocore/avs/query.proto", fileDescriptor_d9df5f5965203ddc) }
+
+var fileDescriptor_d9df5f5965203ddc = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x2c, 0x0a, 0x1d, 0x8b, 0xca, 0x10, 0xe8, 0x36, 0x0d, 0xa1, 0x46, 0x29, 0x6d,
+	0xa1, 0x19, 0xaa, 0x27, 0xc1, 0x4b, 0x0a, 0x2a, 0x8a, 0x28, 0x26, 0xb0, 0x82, 0x17, 0x49, 0x37,
+	0x63, 0x8c, 0xda, 0xbc, 0x74, 0x66, 0x1a, 0xb7, 0x57, 0x4f, 0x1e, 0x05, 0x0f, 0x7e, 0x04, 0xaf,
+	0x1e, 0xfc, 0x10, 0x1e, 0x17, 0xbd, 0x78, 0x94, 0x5d, 0xc1, 0xaf, 0x21, 0x9b, 0x99, 0xdd, 0x9d,
+	0x4d, 0x02, 0xdd, 0xe3, 0xbc, 0xff, 0xfb, 0xff, 0xe7, 0x37, 0xef, 0x31, 0x78, 0x9d, 0x0d, 0xa0,
+	0x0f, 0x9c, 0xd1, 0xb8, 0x14, 0xf4, 0xe4, 0x94, 0xf1, 0x33, 0xbf, 0xe0, 0x20, 0x81, 0x5c, 0xd6,
+	0x82, 0x1f, 0x97, 0xc2, 0x76, 0x52, 0x80, 0xf4, 0x1d, 0xa3, 0x71, 0x91, 0xd1, 0x38, 0xcf, 0x41,
+	0xc6, 0x32, 0x83, 0x5c, 0xa8, 0x56, 0x7b, 0xb3, 0x0f, 0xe2, 0x18, 0xb4, 0x9d, 0x96, 0x07, 0x66,
+	0x8e, 0xbd, 0xa1, 0xc4, 0x97, 0xd5, 0x89, 0xaa, 0x83, 0x96, 0x2c, 0xf3, 0x6e, 0x39, 0x50, 0x55,
+	0xef, 0x00, 0x5f, 0x7d, 0x36, 0xf1, 0x07, 0xbd, 0xe8, 0x61, 0xfe, 0x0a, 0x42, 0x76, 0x42, 0x5c,
+	0x8c, 0xe3, 0x52, 0x04, 0x49, 0xc2, 0x99, 0x10, 0x5d, 0xb4, 0x85, 0x76, 0x56, 0x43, 0xa3, 0xe2,
+	0x3d, 0xc7, 0xeb, 0x95, 0xe5, 0x69, 0xc1, 0x78, 0x2c, 0x81, 0x07, 0xbd, 0xe8, 0x71, 0x26, 0xe4,
+	0xc4, 0x7a, 0x17, 0xaf, 0xc1, 0xb4, 0x9a, 0x24, 0x5c, 0x99, 0x0f, 0xbb, 0x3f, 0xbf, 0xef, 0x5b,
+	0x9a, 0x45, 0x87, 0x44, 0x92, 0x67, 0x79, 0x1a, 0x2e, 0x74, 0x7b, 0xf7, 0xb1, 0xd3, 0x1e, 0x2c,
+	0x0a, 0xc8, 0x05, 0x23, 0xdb, 0xf8, 0xca, 0x1c, 0x63, 0xa2, 0x74, 0xd1, 0xd6, 0xca, 0xce, 0x6a,
+	0x58, 0xab, 0x7a, 0x77, 0x34, 0x60, 0xd0, 0x8b, 0xa6, 0x51, 0x53, 0xc0, 0xf3, 0xde, 0xf6, 0x08,
+	0x3b, 0xed, 0x56, 0x8d, 0xb0, 0x87, 0xaf, 0x99, 0xc8, 0x06, 0x44, 0xa3, 0x7e, 0xeb, 0xeb, 0x0a,
+	0xbe, 0x58, 0x85, 0x91, 0x37, 0x78, 0xcd, 0x1c, 0x32, 0x71, 0x7c, 0x63, 0xdd, 0x7e, 0x6d, 0xfe,
+	0xb6, 0xb5, 0xa0, 0x6a, 0xc1, 0xdb, 0xfe, 0xf8, 0xef, 0xdb, 0x1e, 0xfa, 0xf0, 0xeb, 0xef, 0xe7,
+	0x0b, 0x9b, 0x64, 0x83, 0x9a, 0xcb, 0x5c, 0xc8, 0xfe, 0x82, 0xb0, 0xd5, 0x36, 0x45, 0x72, 0xb3,
+	0x79, 0x69, 0x73, 0x83, 0xf6, 0xee, 0x12, 0x5d, 0x6a, 0x16, 0x9e, 0x3f, 0x27, 0xba, 0x41, 0xae,
+	0x37, 0x89, 0xea, 0x00, 0x33, 0xb2, 0xda, 0x70, 0xdb, 0xc8, 0x9a, 0xab, 0xb3, 0x77, 0x97, 0xe8,
+	0x5a, 0x92, 0xac, 0xe6, 0x3b, 0x7c, 0xf0, 0x63, 0xe4, 0xa2, 0xe1, 0xc8, 0x45, 0x7f, 0x46, 0x2e,
+	0xfa, 0x34, 0x76, 0x3b, 0xc3, 0xb1, 0xdb, 0xf9, 0x3d, 0x76, 0x3b, 0x2f, 0xf6, 0xd3, 0x4c, 0xbe,
+	0x3e, 0x3d, 0xf2, 0xfb, 0x70, 0x4c, 0xef, 0xa9, 0x98, 0x27, 0x4c, 0xbe, 0x07, 0xfe, 0x76, 0x96,
+	0x3a, 0x50, 0x1f, 0xea, 0xac, 0x60, 0xe2, 0xe8, 0x52, 0xf5, 0xa9, 0x6e, 0xff, 0x1f, 0x00, 0x5a,
+	0x0c, 0x17, 0x99, 0xe8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryAVSInfo queries the registered information of the AVS
+	QueryAVSInfo(ctx context.Context, in *QueryAVSInfoReq, opts ...grpc.CallOption) (*AVSInfo, error)
+	// QueryOperatorAVSList queries the AVSs that the operator has opted into
+	QueryOperatorAVSList(ctx context.Context, in *QueryOperatorAVSListReq, opts ...grpc.CallOption) (*QueryOperatorAVSListResponse, error)
+	// QueryAVSOperatorList queries the operators that have opted into the AVS
+	QueryAVSOperatorList(ctx context.Context, in *QueryAVSOperatorListReq, opts ...grpc.CallOption) (*QueryAVSOperatorListResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryAVSInfo(ctx context.Context, in *QueryAVSInfoReq, opts ...grpc.CallOption) (*AVSInfo, error) {
+	out := new(AVSInfo)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Query/QueryAVSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOperatorAVSList(ctx context.Context, in *QueryOperatorAVSListReq, opts ...grpc.CallOption) (*QueryOperatorAVSListResponse, error) {
+	out := new(QueryOperatorAVSListResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Query/QueryOperatorAVSList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAVSOperatorList(ctx context.Context, in *QueryAVSOperatorListReq, opts ...grpc.CallOption) (*QueryAVSOperatorListResponse, error) {
+	out := new(QueryAVSOperatorListResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Query/QueryAVSOperatorList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryAVSInfo queries the registered information of the AVS
+	QueryAVSInfo(context.Context, *QueryAVSInfoReq) (*AVSInfo, error)
+	// QueryOperatorAVSList queries the AVSs that the operator has opted into
+	QueryOperatorAVSList(context.Context, *QueryOperatorAVSListReq) (*QueryOperatorAVSListResponse, error)
+	// QueryAVSOperatorList queries the operators that have opted into the AVS
+	QueryAVSOperatorList(context.Context, *QueryAVSOperatorListReq) (*QueryAVSOperatorListResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryAVSInfo(ctx context.Context, req *QueryAVSInfoReq) (*AVSInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAVSInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryOperatorAVSList(ctx context.Context, req *QueryOperatorAVSListReq) (*QueryOperatorAVSListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorAVSList not implemented")
+}
+func (*UnimplementedQueryServer) QueryAVSOperatorList(ctx context.Context, req *QueryAVSOperatorListReq) (*QueryAVSOperatorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAVSOperatorList not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryAVSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAVSInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAVSInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Query/QueryAVSInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAVSInfo(ctx, req.(*QueryAVSInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOperatorAVSList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAVSListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOperatorAVSList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Query/QueryOperatorAVSList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOperatorAVSList(ctx, req.(*QueryOperatorAVSListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAVSOperatorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAVSOperatorListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAVSOperatorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Query/QueryAVSOperatorList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAVSOperatorList(ctx, req.(*QueryAVSOperatorListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.avs.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryAVSInfo",
+			Handler:    _Query_QueryAVSInfo_Handler,
+		},
+		{
+			MethodName: "QueryOperatorAVSList",
+			Handler:    _Query_QueryOperatorAVSList_Handler,
+		},
+		{
+			MethodName: "QueryAVSOperatorList",
+			Handler:    _Query_QueryAVSOperatorList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/avs/query.proto",
+}
+
+func (m *QueryAVSInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAVSListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAVSListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAVSListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAVSListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAVSListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAVSListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddressList) > 0 {
+		for iNdEx := len(m.AvsAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AvsAddressList[iNdEx])
+			copy(dAtA[i:], m.AvsAddressList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddressList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAVSOperatorListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSOperatorListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSOperatorListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAVSOperatorListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSOperatorListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSOperatorListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddrList) > 0 {
+		for iNdEx := len(m.OperatorAddrList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OperatorAddrList[iNdEx])
+			copy(dAtA[i:], m.OperatorAddrList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddrList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAVSInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAVSListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAVSListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvsAddressList) > 0 {
+		for _, s := range m.AvsAddressList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAVSOperatorListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAVSOperatorListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorAddrList) > 0 {
+		for _, s := range m.OperatorAddrList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAVSInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorAVSListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorAVSListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorAVSListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorAVSListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorAVSListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorAVSListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddressList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddressList = append(m.AvsAddressList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSOperatorListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSOperatorListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSOperatorListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSOperatorListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSOperatorListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSOperatorListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddrList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddrList = append(m.OperatorAddrList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
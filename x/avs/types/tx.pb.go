@@ -0,0 +1,1888 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/avs/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AVSInfo is the information registered by the AVS, the AVS is identified by its
+// middleware contract address.
+type AVSInfo struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// owner is the exoCore address that registers the AVS
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// middlewareContractAddress is the hex address of the AVS middleware contract
+	MiddlewareContractAddress string `protobuf:"bytes,3,opt,name=middlewareContractAddress,proto3" json:"middlewareContractAddress,omitempty"`
+	// assetIDs are the assets accepted by the AVS, the stake of these assets that
+	// is delegated to the opted-in operators secures the AVS.
+	AssetIDs []string `protobuf:"bytes,4,rep,name=assetIDs,proto3" json:"assetIDs,omitempty"`
+	// unbondingPeriod is the number of blocks the undelegation from an opted-in
+	// operator needs to wait before it can be completed.
+	UnbondingPeriod uint64 `protobuf:"varint,5,opt,name=unbondingPeriod,proto3" json:"unbondingPeriod,omitempty"`
+}
+
+func (m *AVSInfo) Reset()         { *m = AVSInfo{} }
+func (m *AVSInfo) String() string { return proto.CompactTextString(m) }
+func (*AVSInfo) ProtoMessage()    {}
+func (*AVSInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{0}
+}
+func (m *AVSInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AVSInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AVSInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AVSInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AVSInfo.Merge(m, src)
+}
+func (m *AVSInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AVSInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AVSInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AVSInfo proto.InternalMessageInfo
+
+func (m *AVSInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AVSInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AVSInfo) GetMiddlewareContractAddress() string {
+	if m != nil {
+		return m.MiddlewareContractAddress
+	}
+	return ""
+}
+
+func (m *AVSInfo) GetAssetIDs() []string {
+	if m != nil {
+		return m.AssetIDs
+	}
+	return nil
+}
+
+func (m *AVSInfo) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+// OperatorOptedInInfo records the operator's opt-in of an AVS
+type OperatorOptedInInfo struct {
+	OptedInHeight uint64 `protobuf:"varint,1,opt,name=optedInHeight,proto3" json:"optedInHeight,omitempty"`
+	// optedOutHeight is the height at which the operator opted out of the AVS, it's
+	// zero if the operator is still opted in. The AVS stays in effect for the
+	// undelegations from the operator until the unbonding period has passed since then.
+	OptedOutHeight uint64 `protobuf:"varint,2,opt,name=optedOutHeight,proto3" json:"optedOutHeight,omitempty"`
+}
+
+func (m *OperatorOptedInInfo) Reset()         { *m = OperatorOptedInInfo{} }
+func (m *OperatorOptedInInfo) String() string { return proto.CompactTextString(m) }
+func (*OperatorOptedInInfo) ProtoMessage()    {}
+func (*OperatorOptedInInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{1}
+}
+func (m *OperatorOptedInInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorOptedInInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorOptedInInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorOptedInInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorOptedInInfo.Merge(m, src)
+}
+func (m *OperatorOptedInInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorOptedInInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorOptedInInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorOptedInInfo proto.InternalMessageInfo
+
+func (m *OperatorOptedInInfo) GetOptedInHeight() uint64 {
+	if m != nil {
+		return m.OptedInHeight
+	}
+	return 0
+}
+
+func (m *OperatorOptedInInfo) GetOptedOutHeight() uint64 {
+	if m != nil {
+		return m.OptedOutHeight
+	}
+	return 0
+}
+
+// MsgRegisterAVS registers the AVS with the signer as its owner, the middleware
+// contract address can only be registered once.
+type MsgRegisterAVS struct {
+	// fromAddress is the owner of the AVS
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	// info is the information of the AVS, the owner is set to fromAddress
+	Info *AVSInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *MsgRegisterAVS) Reset()         { *m = MsgRegisterAVS{} }
+func (m *MsgRegisterAVS) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAVS) ProtoMessage()    {}
+func (*MsgRegisterAVS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{2}
+}
+func (m *MsgRegisterAVS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAVS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAVS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAVS.Merge(m, src)
+}
+func (m *MsgRegisterAVS) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAVS) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAVS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAVS proto.InternalMessageInfo
+
+type RegisterAVSResponse struct {
+}
+
+func (m *RegisterAVSResponse) Reset()         { *m = RegisterAVSResponse{} }
+func (m *RegisterAVSResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterAVSResponse) ProtoMessage()    {}
+func (*RegisterAVSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{3}
+}
+func (m *RegisterAVSResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterAVSResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterAVSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterAVSResponse.Merge(m, src)
+}
+func (m *RegisterAVSResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterAVSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterAVSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterAVSResponse proto.InternalMessageInfo
+
+type MsgOptIntoAVS struct {
+	// fromAddress is the operator address
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	AvsAddress  string `protobuf:"bytes,2,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *MsgOptIntoAVS) Reset()         { *m = MsgOptIntoAVS{} }
+func (m *MsgOptIntoAVS) String() string { return proto.CompactTextString(m) }
+func (*MsgOptIntoAVS) ProtoMessage()    {}
+func (*MsgOptIntoAVS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{4}
+}
+func (m *MsgOptIntoAVS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptIntoAVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptIntoAVS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOptIntoAVS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptIntoAVS.Merge(m, src)
+}
+func (m *MsgOptIntoAVS) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptIntoAVS) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptIntoAVS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOptIntoAVS proto.InternalMessageInfo
+
+type OptIntoAVSResponse struct {
+}
+
+func (m *OptIntoAVSResponse) Reset()         { *m = OptIntoAVSResponse{} }
+func (m *OptIntoAVSResponse) String() string { return proto.CompactTextString(m) }
+func (*OptIntoAVSResponse) ProtoMessage()    {}
+func (*OptIntoAVSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{5}
+}
+func (m *OptIntoAVSResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptIntoAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptIntoAVSResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptIntoAVSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptIntoAVSResponse.Merge(m, src)
+}
+func (m *OptIntoAVSResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptIntoAVSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptIntoAVSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptIntoAVSResponse proto.InternalMessageInfo
+
+type MsgOptOutOfAVS struct {
+	// fromAddress is the operator address
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	AvsAddress  string `protobuf:"bytes,2,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *MsgOptOutOfAVS) Reset()         { *m = MsgOptOutOfAVS{} }
+func (m *MsgOptOutOfAVS) String() string { return proto.CompactTextString(m) }
+func (*MsgOptOutOfAVS) ProtoMessage()    {}
+func (*MsgOptOutOfAVS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{6}
+}
+func (m *MsgOptOutOfAVS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptOutOfAVS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptOutOfAVS.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOptOutOfAVS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptOutOfAVS.Merge(m, src)
+}
+func (m *MsgOptOutOfAVS) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptOutOfAVS) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptOutOfAVS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOptOutOfAVS proto.InternalMessageInfo
+
+type OptOutOfAVSResponse struct {
+}
+
+func (m *OptOutOfAVSResponse) Reset()         { *m = OptOutOfAVSResponse{} }
+func (m *OptOutOfAVSResponse) String() string { return proto.CompactTextString(m) }
+func (*OptOutOfAVSResponse) ProtoMessage()    {}
+func (*OptOutOfAVSResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c92b5dfe90086a66, []int{7}
+}
+func (m *OptOutOfAVSResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptOutOfAVSResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptOutOfAVSResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptOutOfAVSResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptOutOfAVSResponse.Merge(m, src)
+}
+func (m *OptOutOfAVSResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptOutOfAVSResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptOutOfAVSResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptOutOfAVSResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AVSInfo)(nil), "exocore.avs.AVSInfo")
+	proto.RegisterType((*OperatorOptedInInfo)(nil), "exocore.avs.OperatorOptedInInfo")
+	proto.RegisterType((*MsgRegisterAVS)(nil), "exocore.avs.MsgRegisterAVS")
+	proto.RegisterType((*RegisterAVSResponse)(nil), "exocore.avs.RegisterAVSResponse")
+	proto.RegisterType((*MsgOptIntoAVS)(nil), "exocore.avs.MsgOptIntoAVS")
+	proto.RegisterType((*OptIntoAVSResponse)(nil), "exocore.avs.OptIntoAVSResponse")
+	proto.RegisterType((*MsgOptOutOfAVS)(nil), "exocore.avs.MsgOptOutOfAVS")
+	proto.RegisterType((*OptOutOfAVSResponse)(nil), "exocore.avs.OptOutOfAVSResponse")
+}
+
+func init() { proto.RegisterFile("exocore/avs/tx.proto", fileDescriptor_c92b5dfe90086a66) }
+
+var fileDescriptor_c92b5dfe90086a66 = []byte{
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xb5, 0x29, 0xd0, 0x8b, 0x5a, 0xc4, 0x35, 0x05, 0xd7, 0x95, 0x9c, 0xc8, 0x42, 0x28,
+	0x8a, 0x54, 0x5b, 0xa4, 0x5b, 0xc4, 0x92, 0x02, 0x82, 0x48, 0x04, 0x23, 0x47, 0xea, 0xc0, 0x82,
+	0x9c, 0xf8, 0xe2, 0x5a, 0xe0, 0x3b, 0xeb, 0xee, 0xf2, 0x83, 0x0d, 0x31, 0x21, 0x26, 0xfe, 0x03,
+	0xca, 0xcc, 0x92, 0x81, 0x3f, 0x82, 0xb1, 0x30, 0x31, 0xa2, 0x64, 0x08, 0x3b, 0xff, 0x00, 0xca,
+	0xd9, 0x6e, 0xec, 0x10, 0x7e, 0x48, 0x88, 0x25, 0xb9, 0xf7, 0xbd, 0xcf, 0xef, 0xbe, 0xf7, 0xbd,
+	0xa7, 0x83, 0x45, 0x3c, 0xa2, 0x5d, 0xca, 0xb0, 0xe9, 0x0c, 0xb8, 0x29, 0x46, 0x46, 0xc8, 0xa8,
+	0xa0, 0xa8, 0x10, 0xa3, 0x86, 0x33, 0xe0, 0xea, 0xb5, 0x2e, 0xe5, 0x01, 0xe5, 0x66, 0xc0, 0x3d,
+	0x73, 0x70, 0x73, 0xfe, 0x17, 0xb1, 0xd4, 0xbd, 0x28, 0xf1, 0x44, 0x46, 0x66, 0x14, 0xc4, 0xa9,
+	0xa2, 0x47, 0x3d, 0x1a, 0xe1, 0xf3, 0x53, 0x8c, 0x5e, 0x71, 0x02, 0x9f, 0x50, 0x53, 0xfe, 0x46,
+	0x90, 0xfe, 0x09, 0xc0, 0x8b, 0x8d, 0xe3, 0x76, 0x93, 0xf4, 0x28, 0x42, 0x30, 0x4f, 0x9c, 0x00,
+	0x2b, 0xa0, 0x0c, 0x2a, 0x9b, 0xb6, 0x3c, 0x23, 0x03, 0x6e, 0xd0, 0x21, 0xc1, 0x4c, 0x59, 0x9b,
+	0x83, 0x47, 0xca, 0xe7, 0x0f, 0x07, 0xc5, 0xf8, 0xa6, 0x86, 0xeb, 0x32, 0xcc, 0x79, 0x5b, 0x30,
+	0x9f, 0x78, 0x76, 0x44, 0x43, 0xb7, 0xe0, 0x5e, 0xe0, 0xbb, 0xee, 0x33, 0x3c, 0x74, 0x18, 0xbe,
+	0x4d, 0x89, 0x60, 0x4e, 0x57, 0xc4, 0x4c, 0x65, 0x5d, 0x16, 0xfe, 0x35, 0x01, 0xa9, 0xf0, 0x92,
+	0xc3, 0x39, 0x16, 0xcd, 0x3b, 0x5c, 0xc9, 0x97, 0xd7, 0x2b, 0x9b, 0xf6, 0x79, 0x8c, 0x2a, 0xf0,
+	0x72, 0x9f, 0x74, 0x28, 0x71, 0x7d, 0xe2, 0x3d, 0xc2, 0xcc, 0xa7, 0xae, 0xb2, 0x51, 0x06, 0x95,
+	0xbc, 0xbd, 0x0c, 0xeb, 0x5d, 0xb8, 0x63, 0x85, 0x98, 0x39, 0x82, 0x32, 0x2b, 0x14, 0xd8, 0x6d,
+	0x12, 0xd9, 0xde, 0x75, 0xb8, 0x45, 0xa3, 0xf0, 0x3e, 0xf6, 0xbd, 0x13, 0x21, 0xfb, 0xcc, 0xdb,
+	0x59, 0x10, 0xdd, 0x80, 0xdb, 0x12, 0xb0, 0xfa, 0x22, 0xa6, 0xad, 0x49, 0xda, 0x12, 0xaa, 0xbf,
+	0x07, 0x70, 0xbb, 0xc5, 0x3d, 0x1b, 0x7b, 0x3e, 0x17, 0x98, 0x35, 0x8e, 0xdb, 0xa8, 0x0e, 0x0b,
+	0x3d, 0x46, 0x83, 0xa4, 0x5b, 0xf0, 0x07, 0xc7, 0xd2, 0x64, 0x54, 0x81, 0x79, 0x9f, 0xf4, 0xa8,
+	0xbc, 0xac, 0x50, 0x2b, 0x1a, 0xa9, 0x05, 0x30, 0xe2, 0xf9, 0xd8, 0x92, 0x51, 0x3f, 0x7c, 0x75,
+	0x5a, 0xca, 0x7d, 0x3b, 0x2d, 0xe5, 0x5e, 0xce, 0xc6, 0xd5, 0x74, 0x8d, 0xd7, 0xb3, 0x71, 0xf5,
+	0x6a, 0xb2, 0x4e, 0x59, 0x69, 0xfa, 0x2e, 0xdc, 0x49, 0x85, 0x36, 0xe6, 0x21, 0x25, 0x1c, 0xeb,
+	0x6f, 0x01, 0xdc, 0x6a, 0x71, 0xcf, 0x0a, 0x45, 0x93, 0x08, 0xfa, 0xaf, 0x3d, 0x68, 0x10, 0x3a,
+	0x03, 0x9e, 0x7c, 0x2a, 0x17, 0xc6, 0x4e, 0x21, 0xf5, 0xda, 0xef, 0x94, 0xef, 0xa6, 0x94, 0x2f,
+	0xf4, 0xe8, 0x45, 0x88, 0x16, 0xd1, 0xb9, 0xee, 0x77, 0x91, 0xf9, 0x56, 0x28, 0xac, 0xbe, 0xb0,
+	0x7a, 0xff, 0x5b, 0xf8, 0x5f, 0x5b, 0x9e, 0x12, 0x34, 0xb7, 0x3c, 0x15, 0x26, 0xd2, 0x6b, 0xdf,
+	0x01, 0x5c, 0x6f, 0x71, 0x0f, 0x3d, 0x80, 0x85, 0xf4, 0xee, 0xec, 0x67, 0x26, 0x9e, 0x9d, 0x9e,
+	0x5a, 0xce, 0x24, 0x57, 0x0c, 0x12, 0x35, 0x21, 0x4c, 0x0d, 0x51, 0x5d, 0x2e, 0xb6, 0xc8, 0xa9,
+	0xa5, 0x4c, 0xee, 0x67, 0x6f, 0xe7, 0xc2, 0xd2, 0xbe, 0xee, 0xaf, 0xa8, 0x95, 0x24, 0x97, 0x84,
+	0xad, 0x68, 0x57, 0xdd, 0x78, 0x31, 0x1b, 0x57, 0xc1, 0xd1, 0xbd, 0x8f, 0x13, 0x0d, 0x9c, 0x4d,
+	0x34, 0xf0, 0x75, 0xa2, 0x81, 0x37, 0x53, 0x2d, 0x77, 0x36, 0xd5, 0x72, 0x5f, 0xa6, 0x5a, 0xee,
+	0xf1, 0x81, 0xe7, 0x8b, 0x93, 0x7e, 0xc7, 0xe8, 0xd2, 0xc0, 0xbc, 0x1b, 0x15, 0x7b, 0x88, 0xc5,
+	0x90, 0xb2, 0xa7, 0x66, 0x62, 0xec, 0x28, 0x7a, 0x1c, 0x9f, 0x87, 0x98, 0x77, 0x2e, 0xc8, 0x67,
+	0xeb, 0xf0, 0xc7, 0x00, 0x90, 0x81, 0x2c, 0x6c, 0x38, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterAVS(ctx context.Context, in *MsgRegisterAVS, opts ...grpc.CallOption) (*RegisterAVSResponse, error)
+	OptIntoAVS(ctx context.Context, in *MsgOptIntoAVS, opts ...grpc.CallOption) (*OptIntoAVSResponse, error)
+	OptOutOfAVS(ctx context.Context, in *MsgOptOutOfAVS, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAVS(ctx context.Context, in *MsgRegisterAVS, opts ...grpc.CallOption) (*RegisterAVSResponse, error) {
+	out := new(RegisterAVSResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Msg/RegisterAVS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OptIntoAVS(ctx context.Context, in *MsgOptIntoAVS, opts ...grpc.CallOption) (*OptIntoAVSResponse, error) {
+	out := new(OptIntoAVSResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Msg/OptIntoAVS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OptOutOfAVS(ctx context.Context, in *MsgOptOutOfAVS, opts ...grpc.CallOption) (*OptOutOfAVSResponse, error) {
+	out := new(OptOutOfAVSResponse)
+	err := c.cc.Invoke(ctx, "/exocore.avs.Msg/OptOutOfAVS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterAVS(context.Context, *MsgRegisterAVS) (*RegisterAVSResponse, error)
+	OptIntoAVS(context.Context, *MsgOptIntoAVS) (*OptIntoAVSResponse, error)
+	OptOutOfAVS(context.Context, *MsgOptOutOfAVS) (*OptOutOfAVSResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAVS(ctx context.Context, req *MsgRegisterAVS) (*RegisterAVSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAVS not implemented")
+}
+func (*UnimplementedMsgServer) OptIntoAVS(ctx context.Context, req *MsgOptIntoAVS) (*OptIntoAVSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptIntoAVS not implemented")
+}
+func (*UnimplementedMsgServer) OptOutOfAVS(ctx context.Context, req *MsgOptOutOfAVS) (*OptOutOfAVSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutOfAVS not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAVS)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAVS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Msg/RegisterAVS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAVS(ctx, req.(*MsgRegisterAVS))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OptIntoAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptIntoAVS)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptIntoAVS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Msg/OptIntoAVS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptIntoAVS(ctx, req.(*MsgOptIntoAVS))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OptOutOfAVS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptOutOfAVS)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptOutOfAVS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.avs.Msg/OptOutOfAVS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptOutOfAVS(ctx, req.(*MsgOptOutOfAVS))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.avs.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAVS",
+			Handler:    _Msg_RegisterAVS_Handler,
+		},
+		{
+			MethodName: "OptIntoAVS",
+			Handler:    _Msg_OptIntoAVS_Handler,
+		},
+		{
+			MethodName: "OptOutOfAVS",
+			Handler:    _Msg_OptOutOfAVS_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/avs/tx.proto",
+}
+
+func (m *AVSInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AVSInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AVSInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AssetIDs) > 0 {
+		for iNdEx := len(m.AssetIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AssetIDs[iNdEx])
+			copy(dAtA[i:], m.AssetIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AssetIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MiddlewareContractAddress) > 0 {
+		i -= len(m.MiddlewareContractAddress)
+		copy(dAtA[i:], m.MiddlewareContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MiddlewareContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorOptedInInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorOptedInInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorOptedInInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptedOutHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OptedOutHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OptedInHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OptedInHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAVS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAVS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptIntoAVS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptIntoAVS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptIntoAVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptIntoAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptIntoAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptIntoAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptOutOfAVS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptOutOfAVS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptOutOfAVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptOutOfAVSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptOutOfAVSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptOutOfAVSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AVSInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MiddlewareContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AssetIDs) > 0 {
+		for _, s := range m.AssetIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingPeriod))
+	}
+	return n
+}
+
+func (m *OperatorOptedInInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptedInHeight != 0 {
+		n += 1 + sovTx(uint64(m.OptedInHeight))
+	}
+	if m.OptedOutHeight != 0 {
+		n += 1 + sovTx(uint64(m.OptedOutHeight))
+	}
+	return n
+}
+
+func (m *MsgRegisterAVS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOptIntoAVS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OptIntoAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOptOutOfAVS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OptOutOfAVSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AVSInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AVSInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AVSInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiddlewareContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiddlewareContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetIDs = append(m.AssetIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorOptedInInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorOptedInInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorOptedInInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInHeight", wireType)
+			}
+			m.OptedInHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptedInHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOutHeight", wireType)
+			}
+			m.OptedOutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptedOutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAVS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAVS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAVS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &AVSInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterAVSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterAVSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterAVSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptIntoAVS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptIntoAVS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptIntoAVS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptIntoAVSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptIntoAVSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptIntoAVSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptOutOfAVS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptOutOfAVS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptOutOfAVS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptOutOfAVSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptOutOfAVSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptOutOfAVSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,6 +28,11 @@ func GetQueryCmd() *cobra.Command {
 		QuerySingleDelegationInfo(),
 		QueryDelegationInfo(),
 		QueryOperatorInfo(),
+		QueryStakerUndelegations(),
+		QueryWaitCompleteUndelegations(),
+		QueryOperatorDelegators(),
+		QueryChainValidators(),
+		QueryOperatorSnapshot(),
 	)
 	return cmd
 }
@@ -125,3 +131,203 @@ func QueryOperatorInfo() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+const FlagRecordType = "record-type"
+
+// QueryStakerUndelegations queries the undelegation records of the staker asset
+func QueryStakerUndelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryStakerUndelegations clientChainId stakerAddr assetAddr",
+		Short: "Get the undelegation records of the staker asset",
+		Long:  "Get the undelegation records of the staker asset, they can be filtered by the --record-type flag whose value is all, pending or completed",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, err.Error())
+			}
+			recordTypeStr, err := cmd.Flags().GetString(FlagRecordType)
+			if err != nil {
+				return err
+			}
+			var recordType delegationtype.UndelegationRecordType
+			switch recordTypeStr {
+			case "all":
+				recordType = delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_ALL
+			case "pending":
+				recordType = delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_PENDING
+			case "completed":
+				recordType = delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_COMPLETED
+			default:
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, fmt.Sprintf("invalid record type:%s", recordTypeStr))
+			}
+			stakerID, assetID := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, args[1], args[2])
+			req := &delegationtype.QueryStakerUndelegationsReq{
+				StakerID:   stakerID,
+				AssetID:    assetID,
+				RecordType: recordType,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryStakerUndelegations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRecordType, "all", "the type of the undelegation records: all, pending or completed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueryStakerUndelegations")
+	return cmd
+}
+
+// QueryWaitCompleteUndelegations queries the pending undelegation records maturing at or before the height
+func QueryWaitCompleteUndelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryWaitCompleteUndelegations height",
+		Short: "Get the pending undelegation records maturing at or before the height",
+		Long:  "Get the pending undelegation records maturing at or before the height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, err.Error())
+			}
+			req := &delegationtype.QueryWaitCompleteUndelegationsReq{
+				Height:     height,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryWaitCompleteUndelegations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueryWaitCompleteUndelegations")
+	return cmd
+}
+
+// QueryOperatorDelegators queries the stakers that have delegated the asset to the operator
+func QueryOperatorDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryOperatorDelegators operatorAddr clientChainId assetAddr",
+		Short: "Get the stakers that have delegated the asset to the operator",
+		Long:  "Get the stakers that have delegated the asset to the operator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			clientChainLzID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, err.Error())
+			}
+			_, assetID := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, "", args[2])
+			req := &delegationtype.QueryOperatorDelegatorsReq{
+				OperatorAddr: args[0],
+				AssetID:      assetID,
+				Pagination:   pageReq,
+			}
+			res, err := queryClient.QueryOperatorDelegators(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueryOperatorDelegators")
+	return cmd
+}
+
+// QueryChainValidators queries the validator set derived from the restaked assets
+func QueryChainValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryChainValidators",
+		Short: "Get the operators in the validator set derived from the restaked assets",
+		Long:  "Get the operators in the validator set derived from the restaked assets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			res, err := queryClient.QueryChainValidators(context.Background(), &delegationtype.QueryChainValidatorsReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryOperatorSnapshot queries the stake snapshot of the operator taken at the start of an epoch
+func QueryOperatorSnapshot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryOperatorSnapshot operatorAddr epochNumber",
+		Short: "Get the stake snapshot of the operator taken at the start of the epoch, the latest one is returned if the epochNumber is 0",
+		Long:  "Get the stake snapshot of the operator taken at the start of the epoch, the latest one is returned if the epochNumber is 0",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := delegationtype.NewQueryClient(clientCtx)
+			epochNumber, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, err.Error())
+			}
+			req := &delegationtype.QueryOperatorSnapshotReq{
+				OperatorAddr: args[0],
+				EpochNumber:  epochNumber,
+			}
+			res, err := queryClient.QueryOperatorSnapshot(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,13 +1,16 @@
 package cli
 
 import (
+	"encoding/base64"
 	"fmt"
 	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,6 +29,13 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		RegisterOperator(),
+		DelegateAssetToOperator(),
+		UndelegateAssetFromOperator(),
+		UpdateApprovedStakers(),
+		UpdateOperatorInfo(),
+		DeregisterOperator(),
+		OptIntoChainValidation(),
+		OptOutOfChainValidation(),
 	)
 	return txCmd
 }
@@ -51,22 +61,9 @@ func RegisterOperator() *cobra.Command {
 					OperatorMetaInfo: args[2],
 				},
 			}
-			lastArgs := args[3:]
-			clientChainEarningAddress := &delegationtype.ClientChainEarningAddrList{}
-			clientChainEarningAddress.EarningInfoList = make([]*delegationtype.ClientChainEarningAddrInfo, 0)
-			for _, arg := range lastArgs {
-				strList := strings.Split(arg, ":")
-				if len(strList) != 2 {
-					return errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the error input arg is:%s", arg))
-				}
-				clientChainLzID, err := strconv.ParseUint(strList[0], 10, 64)
-				if err != nil {
-					return err
-				}
-				clientChainEarningAddress.EarningInfoList = append(clientChainEarningAddress.EarningInfoList,
-					&delegationtype.ClientChainEarningAddrInfo{
-						LzClientChainID: clientChainLzID, ClientChainEarningAddr: strList[1],
-					})
+			clientChainEarningAddress, err := newClientChainEarningAddrList(args[3:])
+			if err != nil {
+				return err
 			}
 			msg.Info.ClientChainEarningsAddr = clientChainEarningAddress
 			if err := msg.ValidateBasic(); err != nil {
@@ -79,3 +76,252 @@ func RegisterOperator() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// DelegateAssetToOperator delegate the deposited assets to operators from exoCore directly
+func DelegateAssetToOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "DelegateAssetToOperator stakerID assetID operatorAddr:amount",
+		Short: "delegate the deposited assets to operators, the sender must be the exoCore address linked to the staker",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			baseInfo, err := newDelegationIncOrDecInfo(cliCtx.GetFromAddress().String(), args)
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgDelegation{
+				BaseInfo: baseInfo,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UndelegateAssetFromOperator undelegate the assets from operators from exoCore directly
+func UndelegateAssetFromOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "UndelegateAssetFromOperator stakerID assetID operatorAddr:amount",
+		Short: "undelegate the assets from operators, the sender must be the exoCore address linked to the staker",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			baseInfo, err := newDelegationIncOrDecInfo(cliCtx.GetFromAddress().String(), args)
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgUndelegation{
+				BaseInfo: baseInfo,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateApprovedStakers update the stakers that can delegate to the operator without the approval signature
+func UpdateApprovedStakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "UpdateApprovedStakers approvedStakerID1,approvedStakerID2 revokedStakerID1,revokedStakerID2",
+		Short: "add or remove the stakers that can delegate to the operator without the approval signature, use \"\" to skip one of the lists",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgUpdateApprovedStakers{
+				FromAddress: cliCtx.GetFromAddress().String(),
+			}
+			if args[0] != "" {
+				msg.ApprovedStakerIDs = strings.Split(args[0], ",")
+			}
+			if args[1] != "" {
+				msg.RevokedStakerIDs = strings.Split(args[1], ",")
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateOperatorInfo update the info of the operator, the EarningsAddr can't be updated
+func UpdateOperatorInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "UpdateOperatorInfo ApproveAddr OperatorMetaInfo clientChainLzID:ClientChainEarningsAddr",
+		Short: "update the approve address, meta info and client chain earnings addresses of the operator",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientChainEarningAddress, err := newClientChainEarningAddrList(args[2:])
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgUpdateOperatorInfo{
+				FromAddress:             cliCtx.GetFromAddress().String(),
+				ApproveAddr:             args[0],
+				OperatorMetaInfo:        args[1],
+				ClientChainEarningsAddr: clientChainEarningAddress,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeregisterOperator deregister the operator without any delegation
+func DeregisterOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "DeregisterOperator",
+		Short: "deregister the operator without any delegation, the bond will be returned after the deregistration delay",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgDeregisterOperator{
+				FromAddress: cliCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// OptIntoChainValidation opt in to the Exocore chain validation with the consensus key
+func OptIntoChainValidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "OptIntoChainValidation consensusPubKey selfBond",
+		Short: "opt in to the Exocore chain validation, the consensusPubKey is the base64 encoded ed25519 key and the selfBond is added to the operator bond",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			pubKey, err := base64.StdEncoding.DecodeString(args[0])
+			if err != nil {
+				return errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the consensusPubKey is invalid:%s", err))
+			}
+			selfBond, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the selfBond is invalid:%s", err))
+			}
+			msg := &delegationtype.MsgOptIntoChainValidation{
+				FromAddress:     cliCtx.GetFromAddress().String(),
+				ConsensusPubKey: pubKey,
+				SelfBond:        selfBond,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// OptOutOfChainValidation opt out of the Exocore chain validation
+func OptOutOfChainValidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "OptOutOfChainValidation",
+		Short: "opt out of the Exocore chain validation, the operator is removed from the validator set at the end of the block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := &delegationtype.MsgOptOutOfChainValidation{
+				FromAddress: cliCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newClientChainEarningAddrList parses the args: clientChainLzID:ClientChainEarningsAddr...
+func newClientChainEarningAddrList(args []string) (*delegationtype.ClientChainEarningAddrList, error) {
+	clientChainEarningAddress := &delegationtype.ClientChainEarningAddrList{}
+	clientChainEarningAddress.EarningInfoList = make([]*delegationtype.ClientChainEarningAddrInfo, 0)
+	for _, arg := range args {
+		strList := strings.Split(arg, ":")
+		if len(strList) != 2 {
+			return nil, errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the error input arg is:%s", arg))
+		}
+		clientChainLzID, err := strconv.ParseUint(strList[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		clientChainEarningAddress.EarningInfoList = append(clientChainEarningAddress.EarningInfoList,
+			&delegationtype.ClientChainEarningAddrInfo{
+				LzClientChainID: clientChainLzID, ClientChainEarningAddr: strList[1],
+			})
+	}
+	return clientChainEarningAddress, nil
+}
+
+// newDelegationIncOrDecInfo parses the args: stakerID assetID operatorAddr:amount...
+func newDelegationIncOrDecInfo(fromAddress string, args []string) (*delegationtype.DelegationIncOrDecInfo, error) {
+	info := &delegationtype.DelegationIncOrDecInfo{
+		FromAddress:        fromAddress,
+		StakerID:           args[0],
+		AssetID:            args[1],
+		PerOperatorAmounts: make(map[string]*delegationtype.ValueField),
+	}
+	for _, arg := range args[2:] {
+		strList := strings.Split(arg, ":")
+		if len(strList) != 2 {
+			return nil, errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the error input arg is:%s", arg))
+		}
+		amount, ok := sdkmath.NewIntFromString(strList[1])
+		if !ok {
+			return nil, errorsmod.Wrap(delegationtype.ErrCliCmdInputArg, fmt.Sprintf("the error input amount is:%s", strList[1]))
+		}
+		info.PerOperatorAmounts[strList[0]] = &delegationtype.ValueField{Amount: amount}
+	}
+	return info, nil
+}
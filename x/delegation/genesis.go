@@ -0,0 +1,269 @@
+package delegation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(
+	operators []delegationtype.OperatorGenesisInfo,
+	delegations []delegationtype.DelegationState,
+	undelegations []delegationtype.UndelegationRecord,
+	params delegationtype.Params,
+	operatorBonds []delegationtype.OperatorBondGenesis,
+	chainValidators []delegationtype.ChainValidator,
+) *delegationtype.GenesisState {
+	return &delegationtype.GenesisState{
+		Operators:       operators,
+		Delegations:     delegations,
+		Undelegations:   undelegations,
+		Params:          params,
+		OperatorBonds:   operatorBonds,
+		ChainValidators: chainValidators,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *delegationtype.GenesisState {
+	return NewGenesisState(nil, nil, nil, delegationtype.DefaultParams(), nil, nil)
+}
+
+// GetGenesisStateFromAppState returns x/delegation GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) delegationtype.GenesisState {
+	var genesisState delegationtype.GenesisState
+
+	if appState[delegationtype.ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[delegationtype.ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
+
+// ValidateGenesis performs basic validation of delegation genesis data returning an
+// error for any failed validation criteria. The consistency with the asset states of
+// restaking_assets_manage module is checked in InitGenesis.
+func ValidateGenesis(data delegationtype.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	operators := make(map[string]struct{}, len(data.Operators))
+	for _, operator := range data.Operators {
+		if _, err := sdk.AccAddressFromBech32(operator.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address is invalid:%s", operator.OperatorAddr))
+		}
+		if _, ok := operators[operator.OperatorAddr]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated operator:%s", operator.OperatorAddr))
+		}
+		if err := operator.Info.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("operator:%s", operator.OperatorAddr))
+		}
+		operators[operator.OperatorAddr] = struct{}{}
+	}
+
+	// the bond of a registered operator shouldn't have a release height, and the deregistered operator
+	// whose bond is waiting to be returned shouldn't be in the operator list.
+	bonds := make(map[string]struct{}, len(data.OperatorBonds))
+	for _, bond := range data.OperatorBonds {
+		if _, err := sdk.AccAddressFromBech32(bond.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the bond is invalid:%s", bond.OperatorAddr))
+		}
+		if _, ok := bonds[bond.OperatorAddr]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated operator bond:%s", bond.OperatorAddr))
+		}
+		bonds[bond.OperatorAddr] = struct{}{}
+		if err := bond.Bond.Amount.Validate(); err != nil || bond.Bond.Amount.IsZero() {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the operator bond should be positive:%s", bond.OperatorAddr))
+		}
+		_, registered := operators[bond.OperatorAddr]
+		if registered != (bond.Bond.ReleaseHeight == 0) {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the release height of the operator bond doesn't match the registration:%s", bond.OperatorAddr))
+		}
+	}
+
+	// the consensus keys should be registered by the registered operators and shouldn't be duplicated
+	chainValidators := make(map[string]struct{}, len(data.ChainValidators))
+	consAddrs := make(map[string]struct{}, len(data.ChainValidators))
+	for _, validator := range data.ChainValidators {
+		if _, ok := operators[validator.OperatorAddr]; !ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the operator of the chain validator isn't registered:%s", validator.OperatorAddr))
+		}
+		if _, ok := chainValidators[validator.OperatorAddr]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated chain validator:%s", validator.OperatorAddr))
+		}
+		chainValidators[validator.OperatorAddr] = struct{}{}
+		if err := delegationtype.ValidateConsensusPubKey(validator.ConsensusPubKey); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("operator:%s", validator.OperatorAddr))
+		}
+		consAddr := delegationtype.GetConsAddr(validator.ConsensusPubKey).String()
+		if _, ok := consAddrs[consAddr]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated consensus key:%s", consAddr))
+		}
+		consAddrs[consAddr] = struct{}{}
+	}
+
+	// the pending undelegation amounts of each delegation should be equal to its waitUndelegation amount
+	waitUndelegations := make(map[string]sdkmath.Int, len(data.Delegations))
+	for _, delegation := range data.Delegations {
+		if _, ok := operators[delegation.OperatorAddr]; !ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the operator delegated to isn't registered:%s", delegation.OperatorAddr))
+		}
+		if _, _, err := restakingtype.ParseID(delegation.StakerID); err != nil {
+			return err
+		}
+		if _, _, err := restakingtype.ParseID(delegation.AssetID); err != nil {
+			return err
+		}
+		key := string(delegationtype.GetDelegationStateKey(delegation.StakerID, delegation.AssetID, delegation.OperatorAddr))
+		if _, ok := waitUndelegations[key]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated delegation state:%s", key))
+		}
+		amounts := delegation.Amounts
+		if amounts.CanUndelegationAmount.IsNil() || amounts.CanUndelegationAmount.IsNegative() ||
+			amounts.WaitUndelegationAmount.IsNil() || amounts.WaitUndelegationAmount.IsNegative() {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the delegation amounts should be non-negative:%s", key))
+		}
+		waitUndelegations[key] = amounts.WaitUndelegationAmount
+	}
+
+	records := make(map[string]struct{}, len(data.Undelegations))
+	for _, record := range data.Undelegations {
+		recordKey := string(delegationtype.GetUndelegationRecordKey(record.LzTxNonce, record.TxHash, record.OperatorAddr))
+		if _, ok := records[recordKey]; ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated undelegation record:%s", recordKey))
+		}
+		records[recordKey] = struct{}{}
+		if !record.IsPending {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the undelegation record isn't pending:%s", recordKey))
+		}
+		if record.Amount.IsNil() || !record.Amount.IsPositive() {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the undelegation amount should be positive:%s", recordKey))
+		}
+		if record.CompleteBlockNumber < record.BlockNumber {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the complete block number is less than the block number:%s", recordKey))
+		}
+		key := string(delegationtype.GetDelegationStateKey(record.StakerID, record.AssetID, record.OperatorAddr))
+		waitUndelegation, ok := waitUndelegations[key]
+		if !ok {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the delegation state of undelegation record doesn't exist:%s", recordKey))
+		}
+		waitUndelegations[key] = waitUndelegation.Sub(record.Amount)
+	}
+	for key, amount := range waitUndelegations {
+		if !amount.IsZero() {
+			return errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, fmt.Sprintf("the waitUndelegation amount doesn't match the pending undelegation records:%s", key))
+		}
+	}
+	return nil
+}
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data delegationtype.GenesisState,
+) {
+	err := k.SetParams(ctx, &data.Params)
+	if err != nil {
+		panic(err)
+	}
+	for i := range data.Operators {
+		err = k.SetOperatorInfo(ctx, data.Operators[i].OperatorAddr, &data.Operators[i].Info)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for i := range data.OperatorBonds {
+		bond := &data.OperatorBonds[i]
+		k.SetOperatorBond(ctx, sdk.MustAccAddressFromBech32(bond.OperatorAddr), &bond.Bond)
+	}
+
+	// the operators take over the validator set from the staking module at the end of the first block
+	// if the chain validation is enabled
+	for i := range data.ChainValidators {
+		err = k.SetChainValidator(ctx, &data.ChainValidators[i])
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for i := range data.Delegations {
+		delegation := &data.Delegations[i]
+		err = k.UpdateDelegationState(ctx, delegation.StakerID, delegation.AssetID, map[string]*delegationtype.DelegationAmounts{
+			delegation.OperatorAddr: &delegation.Amounts,
+		})
+		if err != nil {
+			panic(err)
+		}
+		// the total delegation amount includes the pending undelegation amounts
+		err = k.UpdateStakerDelegationTotalAmount(ctx, delegation.StakerID, delegation.AssetID, delegation.Amounts.CanUndelegationAmount.Add(delegation.Amounts.WaitUndelegationAmount))
+		if err != nil {
+			panic(err)
+		}
+	}
+	// the reverse index from the operators to the delegators and the reward shares aren't exported, they're
+	// rebuilt from the imported delegations
+	err = k.BuildDelegationIndexes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	records := make([]*delegationtype.UndelegationRecord, 0, len(data.Undelegations))
+	nativeNonce := uint64(0)
+	for i := range data.Undelegations {
+		records = append(records, &data.Undelegations[i])
+		if data.Undelegations[i].LzTxNonce >= delegationtype.NativeUndelegationNonceStart && data.Undelegations[i].LzTxNonce > nativeNonce {
+			nativeNonce = data.Undelegations[i].LzTxNonce
+		}
+	}
+	err = k.SetUndelegationRecords(ctx, records)
+	if err != nil {
+		panic(err)
+	}
+	// the nonce of the undelegation initiated from exoCore directly shouldn't conflict with the imported records
+	if nativeNonce != 0 {
+		k.SetNativeUndelegationNonce(ctx, nativeNonce)
+	}
+
+	// the restaking_assets_manage module has been initialized before this module
+	err = k.CheckAssetStatesConsistency(ctx)
+	if err != nil {
+		panic(errorsmod.Wrap(delegationtype.ErrInvalidGenesisData, err.Error()))
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *delegationtype.GenesisState {
+	operators, err := k.GetAllOperatorInfos(ctx)
+	if err != nil {
+		panic(err)
+	}
+	delegations, err := k.GetAllDelegationStates(ctx)
+	if err != nil {
+		panic(err)
+	}
+	undelegations, err := k.GetAllPendingUndelegationRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+	// the records are sorted by the complete height to make them easier to read
+	sort.SliceStable(undelegations, func(i, j int) bool {
+		return undelegations[i].CompleteBlockNumber < undelegations[j].CompleteBlockNumber
+	})
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return NewGenesisState(operators, delegations, undelegations, *params, k.GetAllOperatorBonds(ctx), k.GetAllChainValidators(ctx))
+}
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddSelfBond sends the self bond from the operator to the module account and adds it to the operator bond,
+// it's returned together with the operator bond when the operator is deregistered.
+func (k Keeper) AddSelfBond(ctx sdk.Context, opAccAddr sdk.AccAddress, selfBond sdk.Coin) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if selfBond.Denom != params.OperatorBond.Denom {
+		return errorsmod.Wrap(delegationtype.ErrInvalidSelfBond, fmt.Sprintf("the denom should be %s, got:%s", params.OperatorBond.Denom, selfBond.Denom))
+	}
+	if selfBond.IsZero() {
+		return nil
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, opAccAddr, delegationtype.ModuleName, sdk.NewCoins(selfBond))
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("failed to lock the self bond:%s", selfBond))
+	}
+	bond := k.GetOperatorBond(ctx, opAccAddr)
+	if bond == nil {
+		bond = &delegationtype.OperatorBond{Amount: selfBond}
+	} else {
+		bond.Amount = bond.Amount.Add(selfBond)
+	}
+	k.SetOperatorBond(ctx, opAccAddr, bond)
+	return nil
+}
+
+// SetChainValidator stores the consensus key of the operator opted in to the chain validation. It doesn't check
+// if the key has been used, so it's also used to import the genesis state.
+func (k Keeper) SetChainValidator(ctx sdk.Context, validator *delegationtype.ChainValidator) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(validator.OperatorAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", validator.OperatorAddr))
+	}
+	if err = delegationtype.ValidateConsensusPubKey(validator.ConsensusPubKey); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidator)
+	store.Set(opAccAddr, k.cdc.MustMarshal(validator))
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidatorByConsAddr)
+	indexStore.Set(delegationtype.GetConsAddr(validator.ConsensusPubKey), opAccAddr)
+	return nil
+}
+
+// GetChainValidator returns the consensus key of the operator, it's nil if the operator hasn't opted in to
+// the chain validation.
+func (k Keeper) GetChainValidator(ctx sdk.Context, opAccAddr sdk.AccAddress) *delegationtype.ChainValidator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidator)
+	value := store.Get(opAccAddr)
+	if value == nil {
+		return nil
+	}
+	ret := &delegationtype.ChainValidator{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret
+}
+
+// IsConsAddrUsed returns true if the consensus address has been used by an operator or a validator of the
+// staking module.
+func (k Keeper) IsConsAddrUsed(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidatorByConsAddr)
+	if indexStore.Has(consAddr) {
+		return true
+	}
+	return k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr) != nil
+}
+
+// DeleteChainValidator removes the consensus key of the operator, the operator is removed from the
+// validator set at the end of the block.
+func (k Keeper) DeleteChainValidator(ctx sdk.Context, opAccAddr sdk.AccAddress) {
+	validator := k.GetChainValidator(ctx, opAccAddr)
+	if validator == nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidator)
+	store.Delete(opAccAddr)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidatorByConsAddr)
+	indexStore.Delete(delegationtype.GetConsAddr(validator.ConsensusPubKey))
+}
+
+// GetAllChainValidators returns the consensus keys of all operators opted in to the chain validation,
+// it's used to export the genesis state.
+func (k Keeper) GetAllChainValidators(ctx sdk.Context) []delegationtype.ChainValidator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixChainValidator)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]delegationtype.ChainValidator, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var validator delegationtype.ChainValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		ret = append(ret, validator)
+	}
+	return ret
+}
+
+// GetLastValidatorPowers returns the validator set returned by the EndBlock of the last block, it's empty
+// if the operators haven't taken over the validator set.
+func (k Keeper) GetLastValidatorPowers(ctx sdk.Context) []delegationtype.ValidatorPower {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLastValidatorPower)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]delegationtype.ValidatorPower, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var power delegationtype.ValidatorPower
+		k.cdc.MustUnmarshal(iterator.Value(), &power)
+		ret = append(ret, power)
+	}
+	return ret
+}
+
+// IsChainValidationActive returns true if the validator set has been taken over by the operators,
+// the validator updates of the staking module should be dropped in this case.
+func (k Keeper) IsChainValidationActive(ctx sdk.Context) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLastValidatorPower)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// GetChainValidatorPowers returns the validator set derived from the operators opted in to the chain validation.
+// The operators are weighted by the USD value of their restaked assets, the frozen operators and the operators
+// whose bond is less than the min self bond are excluded. At most params.MaxValidators operators are returned.
+// The assets without a fresh price aren't counted, so a stale price doesn't block the update of the whole set.
+func (k Keeper) GetChainValidatorPowers(ctx sdk.Context, params *delegationtype.Params) ([]delegationtype.ValidatorPower, error) {
+	ret := make([]delegationtype.ValidatorPower, 0)
+	for _, validator := range k.GetAllChainValidators(ctx) {
+		opAccAddr := sdk.MustAccAddressFromBech32(validator.OperatorAddr)
+		if !k.IsOperator(ctx, opAccAddr) || k.slashKeeper.IsOperatorFrozen(ctx, opAccAddr) {
+			continue
+		}
+		bond := k.GetOperatorBond(ctx, opAccAddr)
+		if bond == nil || bond.ReleaseHeight != 0 || bond.Amount.Amount.LT(params.MinSelfBond) {
+			continue
+		}
+		value, err := k.getOperatorPricedValue(ctx, opAccAddr)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, fmt.Sprintf("failed to get the USD value of the operator:%s", validator.OperatorAddr))
+		}
+		power := sdk.TokensToConsensusPower(value, delegationtype.USDValuePowerReduction)
+		if power <= 0 {
+			continue
+		}
+		ret = append(ret, delegationtype.ValidatorPower{
+			OperatorAddr:    validator.OperatorAddr,
+			ConsensusPubKey: validator.ConsensusPubKey,
+			Power:           power,
+		})
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Power != ret[j].Power {
+			return ret[i].Power > ret[j].Power
+		}
+		return ret[i].OperatorAddr < ret[j].OperatorAddr
+	})
+	if uint32(len(ret)) > params.MaxValidators {
+		ret = ret[:params.MaxValidators]
+	}
+	return ret, nil
+}
+
+// getOperatorPricedValue returns the USD value of the assets restaked to the operator, the assets whose price
+// can't be used are skipped.
+func (k Keeper) getOperatorPricedValue(ctx sdk.Context, opAccAddr sdk.AccAddress) (sdkmath.Int, error) {
+	assetsInfo, err := k.restakingStateKeeper.GetOperatorAssetInfos(ctx, opAccAddr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	assetIDs := make([]string, 0, len(assetsInfo))
+	for assetID := range assetsInfo {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
+
+	total := sdkmath.ZeroInt()
+	for _, assetID := range assetIDs {
+		value, err := k.priceFeedKeeper.GetAssetUSDValue(ctx, assetID, assetsInfo[assetID].TotalAmountOrWantChangeValue)
+		if err != nil {
+			ctx.Logger().Error("the restaked asset isn't counted in the validator power", "operator", opAccAddr.String(), "assetID", assetID, "error", err)
+			continue
+		}
+		total = total.Add(value)
+	}
+	return total, nil
+}
+
+// UpdateChainValidatorSet returns the validator updates when the chain validation is enabled. The validators
+// of the staking module are removed when the operators take over the validator set, and the last validator set
+// is kept if there isn't any eligible operator, because an empty validator set would halt the chain.
+// The validator set is handed back to the staking module if the chain validation is disabled.
+func (k Keeper) UpdateChainValidatorSet(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !params.ChainValidationEnabled {
+		return k.releaseChainValidatorSet(ctx), nil
+	}
+	validators, err := k.GetChainValidatorPowers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return []abci.ValidatorUpdate{}, nil
+	}
+
+	updates := make([]abci.ValidatorUpdate, 0)
+	lastPowers := k.GetLastValidatorPowers(ctx)
+	if len(lastPowers) == 0 {
+		for _, validator := range k.stakingKeeper.GetLastValidators(ctx) {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
+	}
+
+	// the validators are compared by the consensus address because the operator may change its consensus key
+	newPowers := make(map[string]struct{}, len(validators))
+	for _, validator := range validators {
+		newPowers[delegationtype.GetConsAddr(validator.ConsensusPubKey).String()] = struct{}{}
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLastValidatorPower)
+	oldPowers := make(map[string]int64, len(lastPowers))
+	for _, last := range lastPowers {
+		consAddr := delegationtype.GetConsAddr(last.ConsensusPubKey).String()
+		oldPowers[consAddr] = last.Power
+		if _, ok := newPowers[consAddr]; !ok {
+			updates = append(updates, delegationtype.ABCIValidatorUpdate(last.ConsensusPubKey, 0))
+		}
+		store.Delete(sdk.MustAccAddressFromBech32(last.OperatorAddr))
+	}
+	for i := range validators {
+		validator := &validators[i]
+		if power, ok := oldPowers[delegationtype.GetConsAddr(validator.ConsensusPubKey).String()]; !ok || power != validator.Power {
+			updates = append(updates, delegationtype.ABCIValidatorUpdate(validator.ConsensusPubKey, validator.Power))
+		}
+		store.Set(sdk.MustAccAddressFromBech32(validator.OperatorAddr), k.cdc.MustMarshal(validator))
+	}
+	return updates, nil
+}
+
+// releaseChainValidatorSet removes the operators from the validator set and restores the last validator set of
+// the staking module, the staking module returns the validator updates again after that.
+func (k Keeper) releaseChainValidatorSet(ctx sdk.Context) []abci.ValidatorUpdate {
+	lastPowers := k.GetLastValidatorPowers(ctx)
+	if len(lastPowers) == 0 {
+		return []abci.ValidatorUpdate{}
+	}
+	updates := make([]abci.ValidatorUpdate, 0)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLastValidatorPower)
+	for _, last := range lastPowers {
+		updates = append(updates, delegationtype.ABCIValidatorUpdate(last.ConsensusPubKey, 0))
+		store.Delete(sdk.MustAccAddressFromBech32(last.OperatorAddr))
+	}
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	for _, validator := range k.stakingKeeper.GetLastValidators(ctx) {
+		updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+	}
+	return updates
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	"github.com/ExocoreNetwork/exocore/utils"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	pricefeedtype "github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestChainValidation() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	_, usdtAssetID := types.GetStakeIDAndAssetID(clientChainLzID, nil, usdtAddress[:])
+
+	// the chain validation is disabled by the default params like an upgraded chain, it's switched by the
+	// governance
+	defaultParams := delegationtype.DefaultParams()
+	suite.NoError(suite.app.DelegationKeeper.SetParams(suite.ctx, &defaultParams))
+	params := delegationtype.NewParams(sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()), 10, true, 1, sdkmath.NewInt(100), delegationtype.DefaultEpochIdentifier)
+	updateParams := func() {
+		_, err := suite.app.DelegationKeeper.UpdateParams(suite.ctx, &delegationtype.MsgUpdateParams{
+			Authority: suite.app.DelegationKeeper.GetAuthority(),
+			Params:    params,
+		})
+		suite.NoError(err)
+	}
+
+	opAccAddr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	opAccAddr2 := suite.accAddress
+	consKey1 := ed25519.GenPrivKey().PubKey().Bytes()
+	consKey2 := ed25519.GenPrivKey().PubKey().Bytes()
+	selfBond := sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(100))
+
+	// only the operator can opt in
+	optInMsg := &delegationtype.MsgOptIntoChainValidation{
+		FromAddress:     opAccAddr1.String(),
+		ConsensusPubKey: consKey1,
+		SelfBond:        selfBond,
+	}
+	_, err := suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, optInMsg)
+	suite.ErrorIs(err, delegationtype.ErrOperatorNotExist)
+
+	// operator1 gets 1000 USDT and operator2 gets 500 USDT
+	amounts := map[string]sdkmath.Int{
+		opAccAddr1.String(): sdkmath.NewInt(1000e6),
+		opAccAddr2.String(): sdkmath.NewInt(500e6),
+	}
+	for _, opAccAddr := range []sdk.AccAddress{opAccAddr1, opAccAddr2} {
+		_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+			FromAddress: opAccAddr.String(),
+			Info: &delegationtype.OperatorInfo{
+				EarningsAddr: opAccAddr.String(),
+			},
+		})
+		suite.NoError(err)
+		err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, opAccAddr, sdk.NewCoins(selfBond))
+		suite.NoError(err)
+
+		amount := amounts[opAccAddr.String()]
+		err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.Deposit,
+			StakerAddress:   suite.address[:],
+			AssetsAddress:   usdtAddress[:],
+			OpAmount:        amount,
+		})
+		suite.NoError(err)
+		err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.DelegateTo,
+			AssetsAddress:   usdtAddress[:],
+			OperatorAddress: opAccAddr,
+			StakerAddress:   suite.address[:],
+			OpAmount:        amount,
+		})
+		suite.NoError(err)
+	}
+
+	_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, optInMsg)
+	suite.NoError(err)
+	suite.Equal(&delegationtype.OperatorBond{Amount: selfBond}, suite.app.DelegationKeeper.GetOperatorBond(suite.ctx, opAccAddr1))
+	_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, optInMsg)
+	suite.ErrorIs(err, delegationtype.ErrAlreadyOptedIntoChainValidation)
+
+	// the consensus keys of the other operators and the staking validators can't be used
+	stakingValidators := suite.app.StakingKeeper.GetLastValidators(suite.ctx)
+	suite.NotEmpty(stakingValidators)
+	stakingConsKey, err := stakingValidators[0].ConsPubKey()
+	suite.NoError(err)
+	for _, consKey := range [][]byte{consKey1, stakingConsKey.Bytes()} {
+		_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, &delegationtype.MsgOptIntoChainValidation{
+			FromAddress:     opAccAddr2.String(),
+			ConsensusPubKey: consKey,
+			SelfBond:        selfBond,
+		})
+		suite.ErrorIs(err, delegationtype.ErrConsensusPubKeyUsed)
+	}
+
+	// the bond of operator2 is less than the min self bond
+	_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, &delegationtype.MsgOptIntoChainValidation{
+		FromAddress:     opAccAddr2.String(),
+		ConsensusPubKey: consKey2,
+		SelfBond:        sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(50)),
+	})
+	suite.NoError(err)
+
+	updateValidatorSet := func() []abci.ValidatorUpdate {
+		updates, err := suite.app.DelegationKeeper.UpdateChainValidatorSet(suite.ctx)
+		suite.NoError(err)
+		return updates
+	}
+
+	// the validator set isn't changed without the prices
+	updateParams()
+	suite.Empty(updateValidatorSet())
+	suite.False(suite.app.DelegationKeeper.IsChainValidationActive(suite.ctx))
+
+	_, err = suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, &pricefeedtype.MsgSubmitPrices{
+		FromAddress: suite.app.PriceFeedKeeper.GetAuthority(),
+		Prices: []pricefeedtype.AssetPrice{
+			{AssetID: usdtAssetID, Price: sdkmath.NewInt(1), Decimal: 0},
+		},
+	})
+	suite.NoError(err)
+
+	// the operators don't take over the validator set when the chain validation is disabled
+	params.ChainValidationEnabled = false
+	updateParams()
+	suite.Empty(suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{}).ValidatorUpdates)
+	suite.False(suite.app.DelegationKeeper.IsChainValidationActive(suite.ctx))
+
+	// operator1 takes over the validator set from the staking validators after the governance enables
+	// the chain validation
+	params.ChainValidationEnabled = true
+	updateParams()
+	expectedUpdates := make([]abci.ValidatorUpdate, 0)
+	for _, validator := range stakingValidators {
+		expectedUpdates = append(expectedUpdates, validator.ABCIValidatorUpdateZero())
+	}
+	expectedUpdates = append(expectedUpdates, delegationtype.ABCIValidatorUpdate(consKey1, 1000))
+	suite.Equal(expectedUpdates, suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{}).ValidatorUpdates)
+	suite.True(suite.app.DelegationKeeper.IsChainValidationActive(suite.ctx))
+	res, err := suite.app.DelegationKeeper.QueryChainValidators(suite.ctx, &delegationtype.QueryChainValidatorsReq{})
+	suite.NoError(err)
+	suite.Equal([]delegationtype.ValidatorPower{
+		{OperatorAddr: opAccAddr1.String(), ConsensusPubKey: consKey1, Power: 1000},
+	}, res.Validators)
+	suite.Empty(updateValidatorSet())
+
+	// operator2 opts in again with enough self bond, but there is only one seat
+	_, err = suite.app.DelegationKeeper.OptOutOfChainValidation(suite.ctx, &delegationtype.MsgOptOutOfChainValidation{FromAddress: opAccAddr2.String()})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, &delegationtype.MsgOptIntoChainValidation{
+		FromAddress:     opAccAddr2.String(),
+		ConsensusPubKey: consKey2,
+		SelfBond:        sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(50)),
+	})
+	suite.NoError(err)
+	suite.Empty(updateValidatorSet())
+
+	params.MaxValidators = 2
+	updateParams()
+	suite.Equal([]abci.ValidatorUpdate{
+		delegationtype.ABCIValidatorUpdate(consKey2, 500),
+	}, updateValidatorSet())
+
+	// operator1 leaves the validator set after opting out
+	_, err = suite.app.DelegationKeeper.OptOutOfChainValidation(suite.ctx, &delegationtype.MsgOptOutOfChainValidation{FromAddress: opAccAddr1.String()})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.OptOutOfChainValidation(suite.ctx, &delegationtype.MsgOptOutOfChainValidation{FromAddress: opAccAddr1.String()})
+	suite.ErrorIs(err, delegationtype.ErrNotOptedIntoChainValidation)
+	suite.Equal([]abci.ValidatorUpdate{
+		delegationtype.ABCIValidatorUpdate(consKey1, 0),
+	}, updateValidatorSet())
+	suite.Equal([]delegationtype.ValidatorPower{
+		{OperatorAddr: opAccAddr2.String(), ConsensusPubKey: consKey2, Power: 500},
+	}, suite.app.DelegationKeeper.GetLastValidatorPowers(suite.ctx))
+
+	// the asset without a price isn't counted, the other assets of the operator are still counted
+	wethAddress := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	err = suite.app.StakingAssetsManageKeeper.SetStakingAssetInfo(suite.ctx, &types.StakingAssetInfo{
+		AssetBasicInfo: &types.AssetInfo{
+			Name:             "Wrapped Ether",
+			Symbol:           "WETH",
+			Address:          wethAddress.String(),
+			Decimals:         18,
+			LayerZeroChainID: clientChainLzID,
+		},
+		StakingTotalAmount: sdkmath.NewInt(0),
+	})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   wethAddress[:],
+		OpAmount:        sdkmath.NewInt(1e18),
+	})
+	suite.NoError(err)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   wethAddress[:],
+		OperatorAddress: opAccAddr2,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(1e18),
+		LzNonce:         1,
+	})
+	suite.NoError(err)
+	suite.Empty(updateValidatorSet())
+	suite.True(suite.app.DelegationKeeper.IsChainValidationActive(suite.ctx))
+
+	// the validator set is handed back to the staking module after the chain validation is disabled
+	params.ChainValidationEnabled = false
+	updateParams()
+	expectedUpdates = []abci.ValidatorUpdate{delegationtype.ABCIValidatorUpdate(consKey2, 0)}
+	powerReduction := suite.app.StakingKeeper.PowerReduction(suite.ctx)
+	for _, validator := range stakingValidators {
+		expectedUpdates = append(expectedUpdates, validator.ABCIValidatorUpdate(powerReduction))
+	}
+	suite.Equal(expectedUpdates, suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{}).ValidatorUpdates)
+	suite.False(suite.app.DelegationKeeper.IsChainValidationActive(suite.ctx))
+	suite.Empty(suite.app.DelegationKeeper.GetLastValidatorPowers(suite.ctx))
+	suite.Empty(updateValidatorSet())
+}
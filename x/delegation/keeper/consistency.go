@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type delegatedAmounts struct {
+	total sdkmath.Int
+	wait  sdkmath.Int
+}
+
+func (d *delegatedAmounts) add(amounts *delegationtype.DelegationAmounts) {
+	d.total = d.total.Add(amounts.CanUndelegationAmount).Add(amounts.WaitUndelegationAmount)
+	d.wait = d.wait.Add(amounts.WaitUndelegationAmount)
+}
+
+func addDelegatedAmounts(m map[string]*delegatedAmounts, key string, amounts *delegationtype.DelegationAmounts) {
+	if _, ok := m[key]; !ok {
+		m[key] = &delegatedAmounts{total: sdkmath.NewInt(0), wait: sdkmath.NewInt(0)}
+	}
+	m[key].add(amounts)
+}
+
+// CheckAssetStatesConsistency checks if the delegation states are consistent with the asset states stored in the
+// restaking_assets_manage module. The pending undelegation amounts are still counted in the delegated amounts
+// until they are completed, so the following equations should be held:
+// operator total amount = sum of the delegated and pending undelegation amounts of all stakers
+// staker total deposit amount = canWithdraw amount + sum of the delegated and pending undelegation amounts
+// staker total delegation amount = sum of the delegated and pending undelegation amounts
+func (k Keeper) CheckAssetStatesConsistency(ctx sdk.Context) error {
+	delegationStates, err := k.GetAllDelegationStates(ctx)
+	if err != nil {
+		return err
+	}
+	operatorDelegated := make(map[string]*delegatedAmounts)
+	stakerDelegated := make(map[string]*delegatedAmounts)
+	for i := range delegationStates {
+		state := &delegationStates[i]
+		if !k.IsOperator(ctx, sdk.MustAccAddressFromBech32(state.OperatorAddr)) {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("the operator delegated to isn't registered:%s", state.OperatorAddr))
+		}
+		addDelegatedAmounts(operatorDelegated, string(types.GetAssetStateKey(state.OperatorAddr, state.AssetID)), &state.Amounts)
+		addDelegatedAmounts(stakerDelegated, string(types.GetAssetStateKey(state.StakerID, state.AssetID)), &state.Amounts)
+	}
+
+	operatorStates, err := k.restakingStateKeeper.GetAllOperatorAssetStates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, state := range operatorStates {
+		key := string(types.GetAssetStateKey(state.OperatorAddr, state.AssetID))
+		delegated, ok := operatorDelegated[key]
+		if !ok {
+			delegated = &delegatedAmounts{total: sdkmath.NewInt(0), wait: sdkmath.NewInt(0)}
+		}
+		if !state.Info.TotalAmountOrWantChangeValue.Equal(delegated.total) ||
+			!state.Info.WaitUndelegationAmountOrWantChangeValue.Equal(delegated.wait) {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("operator:%s,total:%s,waitUndelegation:%s,delegated:%s,delegatedWaitUndelegation:%s",
+				key, state.Info.TotalAmountOrWantChangeValue, state.Info.WaitUndelegationAmountOrWantChangeValue, delegated.total, delegated.wait))
+		}
+		delete(operatorDelegated, key)
+	}
+	for key, delegated := range operatorDelegated {
+		if !delegated.total.IsZero() {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("the operator asset state doesn't exist:%s", key))
+		}
+	}
+
+	stakerStates, err := k.restakingStateKeeper.GetAllStakerAssetStates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, state := range stakerStates {
+		key := string(types.GetAssetStateKey(state.StakerID, state.AssetID))
+		delegated, ok := stakerDelegated[key]
+		if !ok {
+			delegated = &delegatedAmounts{total: sdkmath.NewInt(0), wait: sdkmath.NewInt(0)}
+		}
+		if !state.Info.TotalDepositAmountOrWantChangeValue.Equal(state.Info.CanWithdrawAmountOrWantChangeValue.Add(delegated.total)) ||
+			!state.Info.WaitUndelegationAmountOrWantChangeValue.Equal(delegated.wait) {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("staker:%s,totalDeposit:%s,canWithdraw:%s,waitUndelegation:%s,delegated:%s,delegatedWaitUndelegation:%s",
+				key, state.Info.TotalDepositAmountOrWantChangeValue, state.Info.CanWithdrawAmountOrWantChangeValue, state.Info.WaitUndelegationAmountOrWantChangeValue, delegated.total, delegated.wait))
+		}
+		totalDelegated, err := k.GetStakerDelegationTotalAmount(ctx, state.StakerID, state.AssetID)
+		if err != nil {
+			if !errorsmod.IsOf(err, delegationtype.ErrNoKeyInTheStore) {
+				return err
+			}
+			totalDelegated = sdkmath.NewInt(0)
+		}
+		if !totalDelegated.Equal(delegated.total) {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("staker:%s,totalDelegated:%s,delegated:%s", key, totalDelegated, delegated.total))
+		}
+		delete(stakerDelegated, key)
+	}
+	for key, delegated := range stakerDelegated {
+		if !delegated.total.IsZero() {
+			return errorsmod.Wrap(delegationtype.ErrInconsistentAssetState, fmt.Sprintf("the staker asset state doesn't exist:%s", key))
+		}
+	}
+	return nil
+}
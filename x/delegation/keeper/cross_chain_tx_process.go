@@ -20,7 +20,9 @@ type DelegationOrUndelegationParams struct {
 	OpAmount        sdkmath.Int
 	LzNonce         uint64
 	TxHash          common.Hash
-	// todo: The operator approved signature might be needed here in future
+	// ApprovedInfo is the approval signature of the operator, it's only needed
+	// when the operator has set the ApproveAddr and the staker isn't in its approved list.
+	ApprovedInfo *delegationtype.DelegationApproveInfo
 }
 
 // The event hook process has been deprecated, now we use precompile contract to trigger the calls.
@@ -120,14 +122,21 @@ func (k Keeper) DelegateTo(ctx sdk.Context, params *DelegationOrUndelegationPara
 		return delegationtype.ErrOperatorIsFrozen
 	}
 
-	// todo: The operator approved signature might be needed here in future
-
 	// update the related states
 	if params.OpAmount.IsNegative() {
 		return delegationtype.ErrOpAmountIsNegative
 	}
 
 	stakerID, assetID := types.GetStakeIDAndAssetID(params.ClientChainLzID, params.StakerAddress, params.AssetsAddress)
+	if err := k.restakingStateKeeper.CheckAssetNotPaused(ctx, assetID); err != nil {
+		return err
+	}
+
+	// check if the staker has been approved by the operator
+	err := k.CheckOperatorApproval(ctx, stakerID, assetID, params.OpAmount, params.OperatorAddress, params.ApprovedInfo)
+	if err != nil {
+		return err
+	}
 
 	info, err := k.restakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
 	if err != nil {
@@ -180,6 +189,9 @@ func (k Keeper) UndelegateFrom(ctx sdk.Context, params *DelegationOrUndelegation
 	}
 	// get staker delegation state, then check the validation of Undelegation amount
 	stakerID, assetID := types.GetStakeIDAndAssetID(params.ClientChainLzID, params.StakerAddress, params.AssetsAddress)
+	if err := k.restakingStateKeeper.CheckAssetNotPaused(ctx, assetID); err != nil {
+		return err
+	}
 	delegationState, err := k.GetSingleDelegationInfo(ctx, stakerID, assetID, params.OperatorAddress.String())
 	if err != nil {
 		return err
@@ -262,3 +274,79 @@ func (k Keeper) UndelegateFrom(ctx sdk.Context, params *DelegationOrUndelegation
 	return nil
 }
 */
+
+// CompleteUndelegations completes the undelegation records whose complete height is at or before the current height.
+// If the operator is frozen, the complete height of the record is reset and the unbonding period restarts from the
+// current height.
+func (k Keeper) CompleteUndelegations(ctx sdk.Context) error {
+	records, err := k.GetWaitCompleteUndelegationRecords(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return err
+	}
+	for _, record := range records {
+		// the record is indexed by the reset height if the operator is frozen, otherwise it's completed
+		recordKey := string(delegationtype.GetUndelegationRecordKey(record.LzTxNonce, record.TxHash, record.OperatorAddr))
+		k.DeleteWaitCompleteUndelegationInfo(ctx, record.CompleteBlockNumber, recordKey)
+
+		// check if the operator has been slashed or frozen
+		operatorAccAddress, err := sdk.AccAddressFromBech32(record.OperatorAddr)
+		if err != nil {
+			return err
+		}
+		if k.slashKeeper.IsOperatorFrozen(ctx, operatorAccAddress) {
+			record.CompleteBlockNumber = k.operatorOptedInKeeper.GetOperatorCanUndelegateHeight(ctx, record.AssetID, operatorAccAddress, uint64(ctx.BlockHeight()))
+			if record.CompleteBlockNumber <= uint64(ctx.BlockHeight()) {
+				return fmt.Errorf("the reset completedHeight isn't in future,setHeight:%v,curHeight:%v", record.CompleteBlockNumber, ctx.BlockHeight())
+			}
+			if _, err := k.SetSingleUndelegationRecord(ctx, record); err != nil {
+				return err
+			}
+			// index the record by the reset height, so it can be handled when the new height is reached
+			if err := k.SetWaitCompleteUndelegationInfo(ctx, record.CompleteBlockNumber, recordKey); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the slashes of the operator have been applied to the record when they happened
+		record.ActualCompletedAmount = record.Amount
+		recordAmountNeg := record.Amount.Neg()
+
+		// update delegation state
+		delegatorAndAmount := make(map[string]*delegationtype.DelegationAmounts)
+		delegatorAndAmount[record.OperatorAddr] = &delegationtype.DelegationAmounts{
+			WaitUndelegationAmount: recordAmountNeg,
+		}
+		if err := k.UpdateDelegationState(ctx, record.StakerID, record.AssetID, delegatorAndAmount); err != nil {
+			return err
+		}
+		if err := k.UpdateStakerDelegationTotalAmount(ctx, record.StakerID, record.AssetID, recordAmountNeg); err != nil {
+			return err
+		}
+
+		// update the staker state
+		err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, record.StakerID, record.AssetID, types.StakerSingleAssetOrChangeInfo{
+			CanWithdrawAmountOrWantChangeValue:      record.Amount,
+			WaitUndelegationAmountOrWantChangeValue: recordAmountNeg,
+		})
+		if err != nil {
+			return err
+		}
+
+		// update the operator state
+		err = k.restakingStateKeeper.UpdateOperatorAssetState(ctx, operatorAccAddress, record.AssetID, types.OperatorSingleAssetOrChangeInfo{
+			TotalAmountOrWantChangeValue:            recordAmountNeg,
+			WaitUndelegationAmountOrWantChangeValue: recordAmountNeg,
+		})
+		if err != nil {
+			return err
+		}
+
+		// update Undelegation record
+		record.IsPending = false
+		if _, err := k.SetSingleUndelegationRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
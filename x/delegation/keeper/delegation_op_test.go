@@ -6,6 +6,8 @@ import (
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	slashkeeper "github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	slashtype "github.com/ExocoreNetwork/exocore/x/slash/types"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -224,8 +226,26 @@ func (suite *KeeperTestSuite) TestCompleteUndelegation() {
 
 	// test complete Undelegation
 	completeBlockNumber := UndelegateHeight + int64(delegationtype.CanUndelegationDelayHeight)
-	suite.ctx = suite.ctx.WithBlockHeight(completeBlockNumber)
+	params, err := suite.app.DelegationKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	hooks := suite.app.DelegationKeeper.Hooks()
+	suite.ctx = suite.ctx.WithBlockHeight(completeBlockNumber - 1)
+	hooks.AfterEpochEnd(suite.ctx, params.EpochIdentifier, 1)
+	waitUndelegationRecords, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, uint64(completeBlockNumber))
+	suite.NoError(err)
+	suite.Equal(1, len(waitUndelegationRecords))
+
+	// the undelegations are only completed at the end of the configured epoch
+	suite.ctx = suite.ctx.WithBlockHeight(completeBlockNumber + 2)
 	suite.app.DelegationKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	hooks.AfterEpochEnd(suite.ctx, params.EpochIdentifier+"-other", 2)
+	waitUndelegationRecords, err = suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, uint64(completeBlockNumber))
+	suite.NoError(err)
+	suite.Equal(1, len(waitUndelegationRecords))
+	suite.True(waitUndelegationRecords[0].IsPending)
+
+	// the record is still completed if the complete height is skipped
+	hooks.AfterEpochEnd(suite.ctx, params.EpochIdentifier, 2)
 
 	// check state
 	stakerID, assetID := types.GetStakeIDAndAssetID(delegationEvent.ClientChainLzID, delegationEvent.StakerAddress, delegationEvent.AssetsAddress)
@@ -273,8 +293,118 @@ func (suite *KeeperTestSuite) TestCompleteUndelegation() {
 	}
 	suite.Equal(UndelegationRecord, records[0])
 
-	waitUndelegationRecords, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, UndelegationRecord.CompleteBlockNumber)
+	// the completed record is removed from the wait complete index
+	recordKeys, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecKeys(suite.ctx, uint64(suite.ctx.BlockHeight()))
 	suite.NoError(err)
-	suite.Equal(1, len(waitUndelegationRecords))
-	suite.Equal(UndelegationRecord, waitUndelegationRecords[0])
+	suite.Empty(recordKeys)
+}
+
+func (suite *KeeperTestSuite) TestFrozenOperatorUndelegation() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	err := suite.app.DepositKeeper.Deposit(suite.ctx, &keeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	delegationEvent := &keeper2.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationEvent))
+	delegationEvent.LzNonce = 1
+	suite.NoError(suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationEvent))
+	undelegateHeight := suite.ctx.BlockHeight()
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+
+	// only the governance can freeze the operator
+	msgServer := slashkeeper.NewMsgServerImpl(suite.app.ExoSlashKeeper)
+	_, err = msgServer.FreezeOperator(suite.ctx, &slashtype.MsgFreezeOperator{
+		Authority:    opAccAddr.String(),
+		OperatorAddr: opAccAddr.String(),
+	})
+	suite.ErrorIs(err, slashtype.ErrInvalidAuthority)
+	_, err = msgServer.FreezeOperator(suite.ctx, &slashtype.MsgFreezeOperator{
+		Authority:    suite.app.ExoSlashKeeper.GetAuthority(),
+		OperatorAddr: opAccAddr.String(),
+	})
+	suite.NoError(err)
+	suite.True(suite.app.ExoSlashKeeper.IsOperatorFrozen(suite.ctx, opAccAddr))
+
+	// the due undelegation of the frozen operator is postponed from the current height
+	dueHeight := undelegateHeight + int64(delegationtype.CanUndelegationDelayHeight) + 2
+	suite.ctx = suite.ctx.WithBlockHeight(dueHeight)
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+	resetHeight := uint64(dueHeight) + delegationtype.CanUndelegationDelayHeight
+	records, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, resetHeight-1)
+	suite.NoError(err)
+	suite.Empty(records)
+	records, err = suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, resetHeight)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	suite.True(records[0].IsPending)
+	suite.Equal(resetHeight, records[0].CompleteBlockNumber)
+
+	// the undelegation is completed at the reset height after the operator is unfrozen
+	_, err = msgServer.UnfreezeOperator(suite.ctx, &slashtype.MsgUnfreezeOperator{
+		Authority:    suite.app.ExoSlashKeeper.GetAuthority(),
+		OperatorAddr: opAccAddr.String(),
+	})
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockHeight(int64(resetHeight))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+	completed, err := suite.app.DelegationKeeper.GetStakerUndelegationRecords(suite.ctx, stakerID, assetID, keeper2.CompletedRecords)
+	suite.NoError(err)
+	suite.Equal(1, len(completed))
+	suite.Equal(delegationEvent.OpAmount, completed[0].ActualCompletedAmount)
+	restakerState, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(100), restakerState.CanWithdrawAmountOrWantChangeValue)
+}
+
+func (suite *KeeperTestSuite) TestWaitCompleteIndexAcrossClientChains() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	completeHeight := uint64(suite.ctx.BlockHeight()) + delegationtype.CanUndelegationDelayHeight
+	records := make([]*delegationtype.UndelegationRecord, 0, 2)
+	// the lzNonces of the undelegations from different client chains are the same
+	for _, clientChainLzID := range []uint64{101, 102} {
+		stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+		records = append(records, &delegationtype.UndelegationRecord{
+			StakerID:              stakerID,
+			AssetID:               assetID,
+			OperatorAddr:          "evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl",
+			TxHash:                common.BigToHash(sdkmath.NewIntFromUint64(clientChainLzID).BigInt()).String(),
+			IsPending:             true,
+			BlockNumber:           uint64(suite.ctx.BlockHeight()),
+			CompleteBlockNumber:   completeHeight,
+			LzTxNonce:             1,
+			Amount:                sdkmath.NewInt(10),
+			ActualCompletedAmount: sdkmath.NewInt(0),
+		})
+	}
+	suite.NoError(suite.app.DelegationKeeper.SetUndelegationRecords(suite.ctx, records))
+
+	recordKeys, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecKeys(suite.ctx, completeHeight)
+	suite.NoError(err)
+	suite.Equal(2, len(recordKeys))
+	waitRecords, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, completeHeight)
+	suite.NoError(err)
+	suite.ElementsMatch(records, waitRecords)
 }
@@ -57,6 +57,7 @@ func (k Keeper) GetStakerDelegationTotalAmount(ctx sdk.Context, stakerID string,
 // Compared to `UpdateStakerDelegationTotalAmount`,they use the same kv store, but in this function the store key needs to add the operator address as a suffix.
 func (k Keeper) UpdateDelegationState(ctx sdk.Context, stakerID string, assetID string, delegationAmounts map[string]*delegationtype.DelegationAmounts) (err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixRestakerDelegationInfo)
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorDelegators)
 	// todo: think about the difference between init and update in future
 
 	for opAddr, amounts := range delegationAmounts {
@@ -94,6 +95,55 @@ func (k Keeper) UpdateDelegationState(ctx sdk.Context, stakerID string, assetID
 
 		bz := k.cdc.MustMarshal(&delegationState)
 		store.Set(singleStateKey, bz)
+
+		// the amount that can be undelegated is the reward shares of the staker
+		err = k.rewardKeeper.UpdateDelegatorRewardShares(ctx, stakerID, assetID, opAddr, delegationState.CanUndelegationAmount)
+		if err != nil {
+			return err
+		}
+
+		// update the reverse index from the operator to its delegators
+		delegatorKey := delegationtype.GetOperatorDelegatorKey(opAddr, assetID, stakerID)
+		if delegationState.CanUndelegationAmount.IsZero() && delegationState.WaitUndelegationAmount.IsZero() {
+			delegatorStore.Delete(delegatorKey)
+		} else {
+			delegatorStore.Set(delegatorKey, []byte{})
+		}
+	}
+	return nil
+}
+
+// BuildDelegationIndexes builds the reverse index from the operators to their delegators and the reward shares of
+// the delegators from the existing delegation states. The indexes aren't exported, so it's used to rebuild them
+// from the imported delegations. It's idempotent.
+func (k Keeper) BuildDelegationIndexes(ctx sdk.Context) error {
+	states, err := k.GetAllDelegationStates(ctx)
+	if err != nil {
+		return err
+	}
+	delegatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorDelegators)
+	for _, state := range states {
+		if state.Amounts.CanUndelegationAmount.IsZero() && state.Amounts.WaitUndelegationAmount.IsZero() {
+			continue
+		}
+		delegatorStore.Set(delegationtype.GetOperatorDelegatorKey(state.OperatorAddr, state.AssetID, state.StakerID), []byte{})
+	}
+	return k.BuildDelegatorRewardShares(ctx)
+}
+
+// BuildDelegatorRewardShares sets the reward shares of the delegators to the amounts that can be undelegated in
+// the existing delegation states. The shares are only updated when a delegation changes, so it's used to cover
+// the delegations made before the shares are introduced. It's idempotent.
+func (k Keeper) BuildDelegatorRewardShares(ctx sdk.Context) error {
+	states, err := k.GetAllDelegationStates(ctx)
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		err = k.rewardKeeper.UpdateDelegatorRewardShares(ctx, state.StakerID, state.AssetID, state.OperatorAddr, state.Amounts.CanUndelegationAmount)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -141,3 +191,67 @@ func (k Keeper) GetDelegationInfo(ctx sdk.Context, stakerID, assetID string) (*d
 
 	return &ret, nil
 }
+
+// IterateOperatorAssetDelegations iterates all the stakers' delegations of the specified asset to the operator.
+// The delegations are collected before calling `fn`, so it's safe to update the delegation states in `fn`.
+func (k Keeper) IterateOperatorAssetDelegations(ctx sdk.Context, operatorAddr, assetID string, fn func(stakerID string, amounts *delegationtype.DelegationAmounts) error) error {
+	stakerIDs, err := k.GetOperatorAssetDelegators(ctx, operatorAddr, assetID)
+	if err != nil {
+		return err
+	}
+
+	delegations := make([]*delegationtype.DelegationAmounts, 0, len(stakerIDs))
+	for _, stakerID := range stakerIDs {
+		amounts, err := k.GetSingleDelegationInfo(ctx, stakerID, assetID, operatorAddr)
+		if err != nil {
+			return err
+		}
+		delegations = append(delegations, amounts)
+	}
+
+	for i, stakerID := range stakerIDs {
+		if err := fn(stakerID, delegations[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetOperatorAssetDelegators returns the stakers that have delegated the asset to the operator through the reverse index.
+func (k Keeper) GetOperatorAssetDelegators(ctx sdk.Context, operatorAddr, assetID string) ([]string, error) {
+	prefixKey := delegationtype.GetOperatorDelegatorsIteratorPrefix(operatorAddr, assetID)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(delegationtype.KeyPrefixOperatorDelegators, prefixKey...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, string(iterator.Key()))
+	}
+	return ret, nil
+}
+
+// GetAllDelegationStates returns the delegation states of all stakers, it's used to export the genesis state.
+func (k Keeper) GetAllDelegationStates(ctx sdk.Context) ([]delegationtype.DelegationState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixRestakerDelegationInfo)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]delegationtype.DelegationState, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys, err := delegationtype.ParseStakerAssetIDAndOperatorAddrFromKey(iterator.Key())
+		if err != nil {
+			// the key of the staker's total delegation amount is skipped
+			continue
+		}
+		var amounts delegationtype.DelegationAmounts
+		k.cdc.MustUnmarshal(iterator.Value(), &amounts)
+		ret = append(ret, delegationtype.DelegationState{
+			StakerID:     keys.StakerID,
+			AssetID:      keys.AssetID,
+			OperatorAddr: keys.OperatorAddr,
+			Amounts:      amounts,
+		})
+	}
+	return ret, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	"github.com/ExocoreNetwork/exocore/x/delegation"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	restaking "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+
+	err = suite.app.DepositKeeper.SetParams(suite.ctx, &deposittype.Params{
+		ExoCoreLzAppAddress:    "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+	})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.OptIntoChainValidation(suite.ctx, &delegationtype.MsgOptIntoChainValidation{
+		FromAddress:     opAccAddr.String(),
+		ConsensusPubKey: ed25519.GenPrivKey().PubKey().Bytes(),
+		SelfBond:        sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()),
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.NoError(err)
+	delegationParams.OpAmount = sdkmath.NewInt(20)
+	delegationParams.LzNonce = 1
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams)
+	suite.NoError(err)
+	_, assetID := types.GetStakeIDAndAssetID(clientChainLzID, nil, usdtAddress[:])
+	_, err = suite.app.StakingAssetsManageKeeper.DeprecateAsset(suite.ctx, &types.DeprecateAssetReq{
+		FromAddress: suite.app.StakingAssetsManageKeeper.GetAuthority(),
+		AssetID:     assetID,
+		Deprecated:  true,
+	})
+	suite.NoError(err)
+
+	restakingGenesis := restaking.ExportGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper)
+	depositGenesis := deposit.ExportGenesis(suite.ctx, suite.app.DepositKeeper)
+	delegationGenesis := delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper)
+	suite.NoError(restaking.ValidateGenesis(*restakingGenesis))
+	suite.NoError(deposit.ValidateGenesis(*depositGenesis))
+	suite.NoError(delegation.ValidateGenesis(*delegationGenesis))
+	suite.Equal([]string{assetID}, restakingGenesis.DeprecatedAssetIDs)
+	suite.Equal(1, len(restakingGenesis.StakerAssetStates))
+	suite.Equal(1, len(restakingGenesis.OperatorAssetStates))
+	suite.Equal(1, len(delegationGenesis.Operators))
+	suite.Equal(opAccAddr.String(), delegationGenesis.Operators[0].OperatorAddr)
+	suite.Equal(1, len(delegationGenesis.Delegations))
+	suite.Equal(1, len(delegationGenesis.Undelegations))
+	suite.Equal(1, len(delegationGenesis.ChainValidators))
+
+	// the pending undelegation records should match the waitUndelegation amount
+	invalidGenesis := *delegationGenesis
+	invalidGenesis.Undelegations = nil
+	suite.ErrorIs(delegation.ValidateGenesis(invalidGenesis), delegationtype.ErrInvalidGenesisData)
+	// the chain validator should be a registered operator
+	invalidGenesis = *delegationGenesis
+	invalidGenesis.ChainValidators = []delegationtype.ChainValidator{{
+		OperatorAddr:    suite.accAddress.String(),
+		ConsensusPubKey: ed25519.GenPrivKey().PubKey().Bytes(),
+	}}
+	suite.ErrorIs(delegation.ValidateGenesis(invalidGenesis), delegationtype.ErrInvalidGenesisData)
+
+	// import the genesis into a new chain
+	suite.SetupTest()
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	deposit.InitGenesis(suite.ctx, suite.app.DepositKeeper, *depositGenesis)
+	delegation.InitGenesis(suite.ctx, suite.app.DelegationKeeper, *delegationGenesis)
+	suite.Equal(restakingGenesis, restaking.ExportGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper))
+	suite.Equal(depositGenesis, deposit.ExportGenesis(suite.ctx, suite.app.DepositKeeper))
+	suite.Equal(delegationGenesis, delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper))
+
+	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(100), assetInfo.StakingTotalAmount)
+	suite.True(assetInfo.Deprecated)
+	stakerID := delegationGenesis.Delegations[0].StakerID
+	totalDelegated, err := suite.app.DelegationKeeper.GetStakerDelegationTotalAmount(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(50), totalDelegated)
+	records, err := suite.app.DelegationKeeper.GetStakerUndelegationRecords(suite.ctx, stakerID, assetID, delegationkeeper.PendingRecords)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	// the reverse index and the reward shares are built from the imported delegations
+	delegators, err := suite.app.DelegationKeeper.GetOperatorAssetDelegators(suite.ctx, opAccAddr.String(), assetID)
+	suite.NoError(err)
+	suite.Equal([]string{stakerID}, delegators)
+	operatorDelegators, err := suite.app.DelegationKeeper.QueryOperatorDelegators(suite.ctx, &delegationtype.QueryOperatorDelegatorsReq{
+		OperatorAddr: opAccAddr.String(),
+		AssetID:      assetID,
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(operatorDelegators.Delegators))
+	suite.Equal(sdkmath.NewInt(30), suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID).TotalShares)
+	// the reverse indexes from the asset to its stakers and operators are built from the imported asset states
+	assetStakers, err := suite.app.StakingAssetsManageKeeper.QueAssetStakers(suite.ctx, &types.QueryAssetStakersReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(restakingGenesis.StakerAssetStates, assetStakers.States)
+	assetOperators, err := suite.app.StakingAssetsManageKeeper.QueAssetOperators(suite.ctx, &types.QueryAssetOperatorsReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(restakingGenesis.OperatorAssetStates, assetOperators.States)
+
+	// the operator total amount should be equal to the sum of the delegations
+	suite.SetupTest()
+	restakingGenesis.OperatorAssetStates[0].Info.TotalAmountOrWantChangeValue = sdkmath.NewInt(60)
+	suite.NoError(restaking.ValidateGenesis(*restakingGenesis))
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	suite.Panics(func() {
+		delegation.InitGenesis(suite.ctx, suite.app.DelegationKeeper, *delegationGenesis)
+	})
+}
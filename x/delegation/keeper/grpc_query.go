@@ -4,7 +4,11 @@ import (
 	"context"
 
 	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ delegationtype.QueryServer = Keeper{}
@@ -23,3 +27,130 @@ func (k Keeper) QueryOperatorInfo(ctx context.Context, req *delegationtype.Query
 	c := sdk.UnwrapSDKContext(ctx)
 	return k.GetOperatorInfo(c, req.OperatorAddr)
 }
+
+func (k Keeper) QueryStakerUndelegations(ctx context.Context, req *delegationtype.QueryStakerUndelegationsReq) (*delegationtype.QueryUndelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := delegationtype.GetStakerUndelegationIteratorPrefix(req.StakerID, req.AssetID)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(delegationtype.KeyPrefixStakerUndelegationInfo, prefixKey...))
+	records := make([]*delegationtype.UndelegationRecord, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		record, err := k.getUndelegationRecord(c, value)
+		if err != nil {
+			return false, err
+		}
+		switch req.RecordType {
+		case delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_PENDING:
+			if !record.IsPending {
+				return false, nil
+			}
+		case delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_COMPLETED:
+			if record.IsPending {
+				return false, nil
+			}
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &delegationtype.QueryUndelegationsResponse{Undelegations: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueryWaitCompleteUndelegations(ctx context.Context, req *delegationtype.QueryWaitCompleteUndelegationsReq) (*delegationtype.QueryUndelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	// the keys are ordered by the complete height, so the records are paginated in that order
+	store := prefix.NewStore(c.KVStore(k.storeKey), delegationtype.KeyPrefixWaitCompleteUndelegations)
+	records := make([]*delegationtype.UndelegationRecord, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height, err := delegationtype.ParseHeightFromWaitCompleteKey(key)
+		if err != nil {
+			return false, err
+		}
+		if height > req.Height {
+			return false, nil
+		}
+		if accumulate {
+			record, err := k.getUndelegationRecord(c, value)
+			if err != nil {
+				return false, err
+			}
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &delegationtype.QueryUndelegationsResponse{Undelegations: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueryOperatorDelegators(ctx context.Context, req *delegationtype.QueryOperatorDelegatorsReq) (*delegationtype.QueryOperatorDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := delegationtype.GetOperatorDelegatorsIteratorPrefix(req.OperatorAddr, req.AssetID)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(delegationtype.KeyPrefixOperatorDelegators, prefixKey...))
+	delegators := make([]delegationtype.DelegatorInfo, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		stakerID := string(key)
+		amounts, err := k.GetSingleDelegationInfo(c, stakerID, req.AssetID, req.OperatorAddr)
+		if err != nil {
+			return err
+		}
+		delegators = append(delegators, delegationtype.DelegatorInfo{StakerID: stakerID, Amounts: *amounts})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &delegationtype.QueryOperatorDelegatorsResponse{Delegators: delegators, Pagination: pageRes}, nil
+}
+
+// getUndelegationRecord returns the undelegation record stored with the record key
+func (k Keeper) getUndelegationRecord(ctx sdk.Context, recordKey []byte) (*delegationtype.UndelegationRecord, error) {
+	records, err := k.GetUndelegationRecords(ctx, []string{string(recordKey)}, AllRecords)
+	if err != nil {
+		return nil, err
+	}
+	return records[0], nil
+}
+
+// QueryChainValidators returns the validator set returned by the EndBlock of the last block, it's empty if the
+// operators haven't taken over the validator set.
+func (k Keeper) QueryChainValidators(ctx context.Context, _ *delegationtype.QueryChainValidatorsReq) (*delegationtype.QueryChainValidatorsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return &delegationtype.QueryChainValidatorsResponse{
+		Validators: k.GetLastValidatorPowers(c),
+	}, nil
+}
+
+// QueryOperatorSnapshot returns the stake snapshot of the operator taken at the start of the epoch with the
+// identifier in the params, the latest snapshot is returned if the epoch number isn't specified.
+func (k Keeper) QueryOperatorSnapshot(ctx context.Context, req *delegationtype.QueryOperatorSnapshotReq) (*delegationtype.OperatorSnapshot, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	params, err := k.GetParams(c)
+	if err != nil {
+		return nil, err
+	}
+	epochNumber := req.EpochNumber
+	if epochNumber == 0 {
+		epochNumber = k.GetLatestSnapshotEpoch(c, params.EpochIdentifier)
+	}
+	return k.GetOperatorSnapshot(c, params.EpochIdentifier, epochNumber, req.OperatorAddr)
+}
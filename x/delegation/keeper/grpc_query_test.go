@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestUndelegationQueries() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	anotherStaker := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+	anotherStakerID, _ := types.GetStakeIDAndAssetID(clientChainLzID, anotherStaker[:], nil)
+
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	for _, staker := range [][]byte{suite.address[:], anotherStaker[:]} {
+		err = suite.app.DepositKeeper.Deposit(suite.ctx, &keeper.DepositParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.Deposit,
+			StakerAddress:   staker,
+			AssetsAddress:   usdtAddress[:],
+			OpAmount:        sdkmath.NewInt(100),
+		})
+		suite.NoError(err)
+		err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.DelegateTo,
+			AssetsAddress:   usdtAddress[:],
+			OperatorAddress: opAccAddr,
+			StakerAddress:   staker,
+			OpAmount:        sdkmath.NewInt(50),
+		})
+		suite.NoError(err)
+	}
+
+	// the delegators of the operator are paginated
+	delegatorsReq := &delegationtype.QueryOperatorDelegatorsReq{
+		OperatorAddr: opAccAddr.String(),
+		AssetID:      assetID,
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	delegators, err := suite.app.DelegationKeeper.QueryOperatorDelegators(suite.ctx, delegatorsReq)
+	suite.NoError(err)
+	suite.Equal(1, len(delegators.Delegators))
+	suite.Equal(uint64(2), delegators.Pagination.Total)
+	delegatorsReq.Pagination = &query.PageRequest{Key: delegators.Pagination.NextKey}
+	nextDelegators, err := suite.app.DelegationKeeper.QueryOperatorDelegators(suite.ctx, delegatorsReq)
+	suite.NoError(err)
+	suite.Equal(1, len(nextDelegators.Delegators))
+	suite.ElementsMatch([]string{stakerID, anotherStakerID}, []string{delegators.Delegators[0].StakerID, nextDelegators.Delegators[0].StakerID})
+	suite.Equal(sdkmath.NewInt(50), delegators.Delegators[0].Amounts.CanUndelegationAmount)
+
+	// undelegate twice, the first undelegation is completed before the second one
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.UndelegateFrom,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(20),
+		LzNonce:         1,
+	}
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams)
+	suite.NoError(err)
+	firstCompleteHeight := uint64(suite.ctx.BlockHeight()) + delegationtype.CanUndelegationDelayHeight
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	delegationParams.OpAmount = sdkmath.NewInt(30)
+	delegationParams.LzNonce = 2
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams)
+	suite.NoError(err)
+
+	waitCompleteReq := &delegationtype.QueryWaitCompleteUndelegationsReq{Height: firstCompleteHeight}
+	waitComplete, err := suite.app.DelegationKeeper.QueryWaitCompleteUndelegations(suite.ctx, waitCompleteReq)
+	suite.NoError(err)
+	suite.Equal(1, len(waitComplete.Undelegations))
+	suite.Equal(uint64(1), waitComplete.Undelegations[0].LzTxNonce)
+	waitCompleteReq.Height = firstCompleteHeight + 1
+	waitComplete, err = suite.app.DelegationKeeper.QueryWaitCompleteUndelegations(suite.ctx, waitCompleteReq)
+	suite.NoError(err)
+	suite.Equal(2, len(waitComplete.Undelegations))
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(firstCompleteHeight))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+
+	undelegationsReq := &delegationtype.QueryStakerUndelegationsReq{
+		StakerID: stakerID,
+		AssetID:  assetID,
+	}
+	undelegations, err := suite.app.DelegationKeeper.QueryStakerUndelegations(suite.ctx, undelegationsReq)
+	suite.NoError(err)
+	suite.Equal(2, len(undelegations.Undelegations))
+	undelegationsReq.RecordType = delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_PENDING
+	undelegations, err = suite.app.DelegationKeeper.QueryStakerUndelegations(suite.ctx, undelegationsReq)
+	suite.NoError(err)
+	suite.Equal(1, len(undelegations.Undelegations))
+	suite.Equal(uint64(2), undelegations.Undelegations[0].LzTxNonce)
+	undelegationsReq.RecordType = delegationtype.UndelegationRecordType_UNDELEGATION_RECORD_TYPE_COMPLETED
+	undelegations, err = suite.app.DelegationKeeper.QueryStakerUndelegations(suite.ctx, undelegationsReq)
+	suite.NoError(err)
+	suite.Equal(1, len(undelegations.Undelegations))
+	suite.Equal(uint64(1), undelegations.Undelegations[0].LzTxNonce)
+
+	// the staker is removed from the index once all its delegation is undelegated
+	suite.ctx = suite.ctx.WithBlockHeight(int64(firstCompleteHeight) + 1)
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+	stakerIDs, err := suite.app.DelegationKeeper.GetOperatorAssetDelegators(suite.ctx, opAccAddr.String(), assetID)
+	suite.NoError(err)
+	suite.Equal([]string{anotherStakerID}, stakerIDs)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/evmos/evmos/v14/x/epochs/types"
+)
+
+// Hooks wrapper struct for delegation keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart takes the stake snapshots of the operators if the epoch is the one configured in the params.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params, err := h.k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if epochIdentifier != params.EpochIdentifier {
+		return
+	}
+	if err := h.k.TakeOperatorSnapshots(ctx, epochIdentifier, epochNumber); err != nil {
+		panic(err)
+	}
+}
+
+// AfterEpochEnd completes the matured undelegations if the epoch is the one configured in the params, so the
+// undelegations are batched per epoch. A record completes at the end of the first such epoch at or after its
+// complete height.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	params, err := h.k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if epochIdentifier != params.EpochIdentifier {
+		return
+	}
+	if err := h.k.CompleteUndelegations(ctx); err != nil {
+		panic(err)
+	}
+}
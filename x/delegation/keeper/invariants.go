@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all delegation invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(delegationtype.ModuleName, "asset-states", AssetStatesInvariant(k))
+	ir.RegisterRoute(delegationtype.ModuleName, "undelegation-records", UndelegationRecordsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the delegation module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := AssetStatesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return UndelegationRecordsInvariant(k)(ctx)
+	}
+}
+
+// AssetStatesInvariant checks that the staker and operator asset states in the restaking_assets_manage module
+// are consistent with the delegation states, see `CheckAssetStatesConsistency` for the details.
+func AssetStatesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		if err := k.CheckAssetStatesConsistency(ctx); err != nil {
+			broken = true
+			msg = fmt.Sprintf("\t%s\n", err)
+		}
+		return sdk.FormatInvariant(delegationtype.ModuleName, "asset-states", msg), broken
+	}
+}
+
+// UndelegationRecordsInvariant checks that every pending undelegation record is indexed by the staker and the
+// complete height, so it can be queried and completed at the end of the epoch. The sum of pending undelegation amounts
+// should also be equal to the waitUndelegation amount of the delegation.
+func UndelegationRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		stakerUndelegationStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixStakerUndelegationInfo)
+		waitCompleteStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixWaitCompleteUndelegations)
+
+		records, err := k.GetAllPendingUndelegationRecords(ctx)
+		if err != nil {
+			panic(err)
+		}
+		pendingAmounts := make(map[string]sdkmath.Int)
+		for _, record := range records {
+			recordKey := delegationtype.GetUndelegationRecordKey(record.LzTxNonce, record.TxHash, record.OperatorAddr)
+			stakerKey := delegationtype.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
+			if !bytes.Equal(stakerUndelegationStore.Get(stakerKey), recordKey) {
+				broken = true
+				msg += fmt.Sprintf("\tthe pending undelegation record isn't indexed by the staker:%s\n", recordKey)
+			}
+			waitCompleteKey := delegationtype.GetWaitCompleteRecordKey(record.CompleteBlockNumber, recordKey)
+			if !bytes.Equal(waitCompleteStore.Get(waitCompleteKey), recordKey) {
+				broken = true
+				msg += fmt.Sprintf("\tthe pending undelegation record isn't indexed by the complete height:%s,height:%d\n", recordKey, record.CompleteBlockNumber)
+			}
+
+			key := string(delegationtype.GetDelegationStateKey(record.StakerID, record.AssetID, record.OperatorAddr))
+			if _, ok := pendingAmounts[key]; !ok {
+				pendingAmounts[key] = sdkmath.NewInt(0)
+			}
+			pendingAmounts[key] = pendingAmounts[key].Add(record.Amount)
+		}
+
+		delegationStates, err := k.GetAllDelegationStates(ctx)
+		if err != nil {
+			panic(err)
+		}
+		for _, state := range delegationStates {
+			key := string(delegationtype.GetDelegationStateKey(state.StakerID, state.AssetID, state.OperatorAddr))
+			amount, ok := pendingAmounts[key]
+			if !ok {
+				amount = sdkmath.NewInt(0)
+			}
+			if !state.Amounts.WaitUndelegationAmount.Equal(amount) {
+				broken = true
+				msg += fmt.Sprintf("\tdelegation:%s,waitUndelegation:%s,sum of pending undelegations:%s\n", key, state.Amounts.WaitUndelegationAmount, amount)
+			}
+			delete(pendingAmounts, key)
+		}
+		for _, record := range records {
+			key := string(delegationtype.GetDelegationStateKey(record.StakerID, record.AssetID, record.OperatorAddr))
+			if amount, ok := pendingAmounts[key]; ok {
+				broken = true
+				msg += fmt.Sprintf("\tthe delegation of pending undelegations doesn't exist:%s,sum of pending undelegations:%s\n", key, amount)
+				delete(pendingAmounts, key)
+			}
+		}
+		return sdk.FormatInvariant(delegationtype.ModuleName, "undelegation-records", msg), broken
+	}
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	restakingkeeper "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+
+	checkInvariants := func(broken bool) {
+		_, stop := restakingkeeper.AllInvariants(suite.app.StakingAssetsManageKeeper)(suite.ctx)
+		suite.False(stop)
+		_, stop = delegationkeeper.AllInvariants(suite.app.DelegationKeeper)(suite.ctx)
+		suite.Equal(broken, stop)
+	}
+
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &keeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	checkInvariants(false)
+
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.NoError(err)
+	delegationParams.OpAmount = sdkmath.NewInt(20)
+	delegationParams.LzNonce = 1
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams)
+	suite.NoError(err)
+	checkInvariants(false)
+
+	records, err := suite.app.DelegationKeeper.GetStakerUndelegationRecords(suite.ctx, stakerID, assetID, delegationkeeper.PendingRecords)
+	suite.NoError(err)
+	suite.Equal(1, len(records))
+	suite.ctx = suite.ctx.WithBlockHeight(int64(records[0].CompleteBlockNumber))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+	checkInvariants(false)
+
+	// the operator total amount isn't equal to the sum of the delegations
+	err = suite.app.StakingAssetsManageKeeper.UpdateOperatorAssetState(suite.ctx, opAccAddr, assetID, types.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: sdkmath.NewInt(1),
+	})
+	suite.NoError(err)
+	msg, broken := delegationkeeper.AssetStatesInvariant(suite.app.DelegationKeeper)(suite.ctx)
+	suite.True(broken, msg)
+	err = suite.app.StakingAssetsManageKeeper.UpdateOperatorAssetState(suite.ctx, opAccAddr, assetID, types.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: sdkmath.NewInt(-1),
+	})
+	suite.NoError(err)
+	checkInvariants(false)
+
+	// the pending undelegation isn't indexed by the complete height
+	record := *records[0]
+	record.LzTxNonce = 2
+	record.IsPending = true
+	_, err = suite.app.DelegationKeeper.SetSingleUndelegationRecord(suite.ctx, &record)
+	suite.NoError(err)
+	msg, broken = delegationkeeper.UndelegationRecordsInvariant(suite.app.DelegationKeeper)(suite.ctx)
+	suite.True(broken, msg)
+
+	// the staking total amount isn't equal to the sum of the deposits
+	err = suite.app.StakingAssetsManageKeeper.UpdateStakingAssetTotalAmount(suite.ctx, assetID, sdkmath.NewInt(1))
+	suite.NoError(err)
+	msg, broken = restakingkeeper.StakingTotalAmountInvariant(suite.app.StakingAssetsManageKeeper)(suite.ctx)
+	suite.True(broken, msg)
+}
@@ -20,11 +20,17 @@ import (
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
+	// the address capable of updating the params, it should be the gov module account.
+	authority string
 
 	restakingStateKeeper  keeper.Keeper
 	depositKeeper         depositkeeper.Keeper
 	slashKeeper           delegationtype.ISlashKeeper
 	operatorOptedInKeeper delegationtype.OperatorOptedInMiddlewareKeeper
+	rewardKeeper          delegationtype.RewardKeeper
+	bankKeeper            delegationtype.BankKeeper
+	priceFeedKeeper       delegationtype.PriceFeedKeeper
+	stakingKeeper         delegationtype.StakingKeeper
 }
 
 func NewKeeper(
@@ -34,35 +40,75 @@ func NewKeeper(
 	depositKeeper depositkeeper.Keeper,
 	slashKeeper delegationtype.ISlashKeeper,
 	operatorOptedInKeeper delegationtype.OperatorOptedInMiddlewareKeeper,
+	rewardKeeper delegationtype.RewardKeeper,
+	bankKeeper delegationtype.BankKeeper,
+	priceFeedKeeper delegationtype.PriceFeedKeeper,
+	stakingKeeper delegationtype.StakingKeeper,
+	authority string,
 ) Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
 	return Keeper{
 		storeKey:              storeKey,
 		cdc:                   cdc,
+		authority:             authority,
 		restakingStateKeeper:  restakingStateKeeper,
 		depositKeeper:         depositKeeper,
 		slashKeeper:           slashKeeper,
 		operatorOptedInKeeper: operatorOptedInKeeper,
+		rewardKeeper:          rewardKeeper,
+		bankKeeper:            bankKeeper,
+		priceFeedKeeper:       priceFeedKeeper,
+		stakingKeeper:         stakingKeeper,
 	}
 }
 
-// SetOperatorInfo This function is used to register to be an operator in exoCore, the provided info will be stored on the chain.
-// Once an address has become an operator,the operator can't return to a normal address.But the operator can update the info through this function
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetOperatorInfo stores the info of the operator after checking its addresses. The registration, update and
+// deregistration of the operator should go through the msg service, this function doesn't check if the operator
+// has been registered, so it's also used to import the genesis state.
 // As for the operator opt-in function,it needs to be implemented in operator opt-in or AVS module
 func (k Keeper) SetOperatorInfo(ctx sdk.Context, addr string, info *delegationtype.OperatorInfo) (err error) {
 	opAccAddr, err := sdk.AccAddressFromBech32(addr)
 	if err != nil {
 		return errorsmod.Wrap(err, "SetOperatorInfo: error occurred when parse acc address from Bech32")
 	}
-	// todo: to check the validation of input info
+	if info == nil {
+		return errorsmod.Wrap(delegationtype.ErrInvalidOperatorInfo, "SetOperatorInfo: the operator info is nil")
+	}
+	if err = info.ValidateBasic(); err != nil {
+		return err
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorInfo)
-	// todo: think about the difference between init and update in future
-
 	bz := k.cdc.MustMarshal(info)
-
 	store.Set(opAccAddr, bz)
 	return nil
 }
 
+// DeleteOperatorInfo removes the info and the approved stakers of the deregistered operator
+func (k Keeper) DeleteOperatorInfo(ctx sdk.Context, opAccAddr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorInfo)
+	store.Delete(opAccAddr)
+
+	prefixKey := append([]byte(opAccAddr.String()), '/')
+	approvedStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(delegationtype.KeyPrefixOperatorApprovedInfo, prefixKey...))
+	iterator := approvedStore.Iterator(nil, nil)
+	defer iterator.Close()
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		approvedStore.Delete(key)
+	}
+}
+
 func (k Keeper) GetOperatorInfo(ctx sdk.Context, addr string) (info *delegationtype.OperatorInfo, err error) {
 	opAccAddr, err := sdk.AccAddressFromBech32(addr)
 	if err != nil {
@@ -87,6 +133,24 @@ func (k Keeper) IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
 	return store.Has(addr)
 }
 
+// GetAllOperatorInfos returns the infos of all registered operators, it's used to export the genesis state.
+func (k Keeper) GetAllOperatorInfos(ctx sdk.Context) ([]delegationtype.OperatorGenesisInfo, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorInfo)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]delegationtype.OperatorGenesisInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info delegationtype.OperatorInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, delegationtype.OperatorGenesisInfo{
+			OperatorAddr: sdk.AccAddress(iterator.Key()).String(),
+			Info:         info,
+		})
+	}
+	return ret, nil
+}
+
 // GetExoCoreLzAppAddress Get exoCoreLzAppAddr from deposit keeper,it will be used when check the caller of precompile contract.
 // This function needs to be moved to `restaking_assets_manage` module,which will facilitate its use for the other modules
 func (k Keeper) GetExoCoreLzAppAddress(ctx sdk.Context) (common.Address, error) {
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	v2 "github.com/ExocoreNetwork/exocore/x/delegation/migrations/v2"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper          Keeper
+	depositStoreKey storetypes.StoreKey
+}
+
+// NewMigrator returns a new Migrator. The store key of the deposit module is needed because the
+// delegation states were stored in it before the consensus version 2.
+func NewMigrator(keeper Keeper, depositStoreKey storetypes.StoreKey) Migrator {
+	return Migrator{
+		keeper:          keeper,
+		depositStoreKey: depositStoreKey,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. The layout of the delegation store is
+// migrated by v2.MigrateStore, and the reward shares of the moved delegation states are built after that
+// because they're stored in the reward module.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.depositStoreKey, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	return m.keeper.BuildDelegatorRewardShares(ctx)
+}
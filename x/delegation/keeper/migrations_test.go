@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdkmath "cosmossdk.io/math"
+	v2 "github.com/ExocoreNetwork/exocore/app/upgrades/v2"
+	"github.com/ExocoreNetwork/exocore/x/delegation"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	rewardtype "github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// TestMigrateDelegationStore builds the state before the v2 upgrade and checks that the upgrade migrates it to
+// the current layout without losing any delegation states.
+func (suite *KeeperTestSuite) TestMigrateDelegationStore() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+
+	depositParams := &deposittype.Params{
+		ExoCoreLzAppAddress:    "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+	}
+	suite.NoError(suite.app.DepositKeeper.SetParams(suite.ctx, depositParams))
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams))
+	delegationParams.OpAmount = sdkmath.NewInt(20)
+	delegationParams.LzNonce = 1
+	suite.NoError(suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams))
+	pendingRecords, err := suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, uint64(suite.ctx.BlockHeight())+delegationtype.CanUndelegationDelayHeight)
+	suite.NoError(err)
+	suite.Equal(1, len(pendingRecords))
+	pendingRecord := pendingRecords[0]
+	pendingRecordKey := delegationtype.GetUndelegationRecordKey(pendingRecord.LzTxNonce, pendingRecord.TxHash, pendingRecord.OperatorAddr)
+	completedRecord := *pendingRecord
+	completedRecord.LzTxNonce = 2
+	completedRecord.IsPending = false
+	completedRecordKey, err := suite.app.DelegationKeeper.SetSingleUndelegationRecord(suite.ctx, &completedRecord)
+	suite.NoError(err)
+	params, err := suite.app.DelegationKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	params.MaxValidators = 50
+	suite.NoError(suite.app.DelegationKeeper.SetParams(suite.ctx, params))
+	delegationGenesis := delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper)
+
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+	suite.Equal(sdkmath.NewInt(30), suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID).TotalShares)
+
+	// build the state before the upgrade. The delegation states were stored in the deposit store, the indexes of
+	// the undelegations and the operator bonds were keyed by the hex encoded height, the index of the completed
+	// record was left in the store, the reverse index from the operators to their delegators and the reward
+	// shares didn't exist, and the params didn't have the epoch identifier.
+	legacyKey := func(height uint64, rest string) []byte {
+		return []byte(hexutil.EncodeUint64(height) + "/" + rest)
+	}
+	delegationStore := suite.ctx.KVStore(suite.app.GetKey(delegationtype.StoreKey))
+	waitCompleteStore := prefix.NewStore(delegationStore, delegationtype.KeyPrefixWaitCompleteUndelegations)
+	waitCompleteStore.Delete(delegationtype.GetWaitCompleteRecordKey(pendingRecord.CompleteBlockNumber, pendingRecordKey))
+	waitCompleteStore.Set(legacyKey(pendingRecord.CompleteBlockNumber, hexutil.EncodeUint64(pendingRecord.LzTxNonce)), pendingRecordKey)
+	waitCompleteStore.Set(legacyKey(completedRecord.CompleteBlockNumber, hexutil.EncodeUint64(completedRecord.LzTxNonce)), completedRecordKey)
+	releaseStore := prefix.NewStore(delegationStore, delegationtype.KeyPrefixOperatorBondRelease)
+	releaseStore.Set(legacyKey(20, opAccAddr.String()), []byte{})
+	params.EpochIdentifier = ""
+	delegationStore.Set(append(delegationtype.KeyPrefixParams, delegationtype.ParamsKey...), suite.app.AppCodec().MustMarshal(params))
+
+	depositStore := suite.ctx.KVStore(suite.app.GetKey(deposittype.StoreKey))
+	iterator := delegationStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		if !bytes.HasPrefix(iterator.Key(), delegationtype.KeyPrefixOperatorDelegators) {
+			depositStore.Set(iterator.Key(), iterator.Value())
+		}
+	}
+	iterator.Close()
+	suite.Greater(len(keys), 5)
+	for _, key := range keys {
+		delegationStore.Delete(key)
+	}
+	suite.False(suite.app.DelegationKeeper.IsOperator(suite.ctx, opAccAddr))
+	rewardStore := suite.ctx.KVStore(suite.app.GetKey(rewardtype.StoreKey))
+	for _, keyPrefix := range [][]byte{rewardtype.KeyPrefixRewardPool, rewardtype.KeyPrefixDelegatorReward} {
+		iterator = sdk.KVStorePrefixIterator(rewardStore, keyPrefix)
+		keys = make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			rewardStore.Delete(key)
+		}
+	}
+	suite.True(suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID).TotalShares.IsZero())
+
+	versionMap := suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)
+	versionMap[delegationtype.ModuleName] = 1
+	suite.app.UpgradeKeeper.SetModuleVersionMap(suite.ctx, versionMap)
+	suite.NotPanics(func() {
+		suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: suite.ctx.BlockHeight()})
+	})
+	suite.Equal(uint64(2), suite.app.UpgradeKeeper.GetModuleVersionMap(suite.ctx)[delegationtype.ModuleName])
+
+	// all the delegation states are moved and the deposit params are kept in the deposit store
+	suite.Equal(delegationGenesis, delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper))
+	params, err = suite.app.DelegationKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(delegationtype.DefaultEpochIdentifier, params.EpochIdentifier)
+	suite.Equal(uint32(50), params.MaxValidators)
+	depositParamsAfter, err := suite.app.DepositKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(depositParams.ExoCoreLzAppAddress, depositParamsAfter.ExoCoreLzAppAddress)
+	iterator = depositStore.Iterator(nil, nil)
+	suite.True(iterator.Valid())
+	suite.Equal(append(deposittype.KeyPrefixParams, depositkeeper.ParamsKey...), iterator.Key())
+	iterator.Next()
+	suite.False(iterator.Valid())
+	iterator.Close()
+
+	// the reverse index and the reward shares of the existing delegations are built
+	stakerIDs, err := suite.app.DelegationKeeper.GetOperatorAssetDelegators(suite.ctx, opAccAddr.String(), assetID)
+	suite.NoError(err)
+	suite.Equal([]string{stakerID}, stakerIDs)
+	suite.Equal(sdkmath.NewInt(30), suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID).TotalShares)
+
+	// only the index of the pending record is kept, keyed by the height and the full record key
+	iterator = waitCompleteStore.Iterator(nil, nil)
+	suite.True(iterator.Valid())
+	suite.Equal(delegationtype.GetWaitCompleteRecordKey(pendingRecord.CompleteBlockNumber, pendingRecordKey), iterator.Key())
+	suite.Equal(pendingRecordKey, iterator.Value())
+	iterator.Next()
+	suite.False(iterator.Valid())
+	iterator.Close()
+	pendingRecords, err = suite.app.DelegationKeeper.GetWaitCompleteUndelegationRecords(suite.ctx, pendingRecord.CompleteBlockNumber)
+	suite.NoError(err)
+	suite.Equal([]*delegationtype.UndelegationRecord{pendingRecord}, pendingRecords)
+
+	suite.True(releaseStore.Has(delegationtype.GetOperatorBondReleaseKey(20, opAccAddr.String())))
+	suite.False(releaseStore.Has(legacyKey(20, opAccAddr.String())))
+}
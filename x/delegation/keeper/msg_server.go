@@ -2,28 +2,272 @@ package keeper
 
 import (
 	context "context"
+	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/x/delegation/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 var _ types.MsgServer = &Keeper{}
 
+// RegisterOperator registers the signer as an operator and locks the operator bond. An address can only be
+// registered once, and it can't register again until the bond of its previous registration is returned.
 func (k Keeper) RegisterOperator(ctx context.Context, req *types.RegisterOperatorReq) (*types.RegisterOperatorResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
-	err := k.SetOperatorInfo(c, req.FromAddress, req.Info)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
 	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", req.FromAddress))
+	}
+	if req.Info == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidOperatorInfo, "the operator info is nil")
+	}
+	if err = req.Info.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if k.IsOperator(c, opAccAddr) {
+		return nil, errorsmod.Wrap(types.ErrOperatorAlreadyExist, fmt.Sprintf("operator:%s", req.FromAddress))
+	}
+	if k.GetOperatorBond(c, opAccAddr) != nil {
+		return nil, errorsmod.Wrap(types.ErrOperatorDeregistering, fmt.Sprintf("operator:%s", req.FromAddress))
+	}
+
+	err = k.LockOperatorBond(c, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	err = k.SetOperatorInfo(c, req.FromAddress, req.Info)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RegisterOperatorResponse{}, nil
+}
+
+// UpdateOperatorInfo updates the mutable fields of the operator info, the EarningsAddr is kept unchanged.
+func (k Keeper) UpdateOperatorInfo(ctx context.Context, msg *types.MsgUpdateOperatorInfo) (*types.UpdateOperatorInfoResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", msg.FromAddress))
+	}
+	if !k.IsOperator(c, opAccAddr) {
+		return nil, types.ErrOperatorNotExist
+	}
+	info, err := k.GetOperatorInfo(c, msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	info.ApproveAddr = msg.ApproveAddr
+	info.OperatorMetaInfo = msg.OperatorMetaInfo
+	info.ClientChainEarningsAddr = msg.ClientChainEarningsAddr
+	err = k.SetOperatorInfo(c, msg.FromAddress, info)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateOperatorInfoResponse{}, nil
+}
+
+// DeregisterOperator removes the operator without any delegation, including the pending undelegations.
+// The bond will be returned after the deregistration delay.
+func (k Keeper) DeregisterOperator(ctx context.Context, msg *types.MsgDeregisterOperator) (*types.DeregisterOperatorResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", msg.FromAddress))
+	}
+	if !k.IsOperator(c, opAccAddr) {
+		return nil, types.ErrOperatorNotExist
+	}
+	if k.slashKeeper.IsOperatorFrozen(c, opAccAddr) {
+		return nil, types.ErrOperatorIsFrozen
+	}
+	if k.HasDelegations(c, msg.FromAddress) {
+		return nil, errorsmod.Wrap(types.ErrOperatorHasDelegations, fmt.Sprintf("operator:%s", msg.FromAddress))
+	}
+
+	k.DeleteOperatorInfo(c, opAccAddr)
+	k.DeleteChainValidator(c, opAccAddr)
+	err = k.ScheduleOperatorBondRelease(c, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DeregisterOperatorResponse{}, nil
+}
+
+// OptIntoChainValidation registers the consensus key of the operator for the Exocore chain validation, and
+// adds the self bond to the operator bond. The key can't be changed unless the operator opts out first.
+func (k Keeper) OptIntoChainValidation(ctx context.Context, msg *types.MsgOptIntoChainValidation) (*types.OptIntoChainValidationResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", msg.FromAddress))
+	}
+	if !k.IsOperator(c, opAccAddr) {
+		return nil, types.ErrOperatorNotExist
+	}
+	if k.GetChainValidator(c, opAccAddr) != nil {
+		return nil, errorsmod.Wrap(types.ErrAlreadyOptedIntoChainValidation, fmt.Sprintf("operator:%s", msg.FromAddress))
+	}
+	if err = types.ValidateConsensusPubKey(msg.ConsensusPubKey); err != nil {
+		return nil, err
+	}
+	consAddr := types.GetConsAddr(msg.ConsensusPubKey)
+	if k.IsConsAddrUsed(c, consAddr) {
+		return nil, errorsmod.Wrap(types.ErrConsensusPubKeyUsed, fmt.Sprintf("consAddr:%s", consAddr))
+	}
+
+	err = k.AddSelfBond(c, opAccAddr, msg.SelfBond)
+	if err != nil {
+		return nil, err
+	}
+	err = k.SetChainValidator(c, &types.ChainValidator{
+		OperatorAddr:    msg.FromAddress,
+		ConsensusPubKey: msg.ConsensusPubKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.OptIntoChainValidationResponse{}, nil
+}
+
+// OptOutOfChainValidation removes the consensus key of the operator, the self bond is kept in the operator
+// bond until the operator is deregistered.
+func (k Keeper) OptOutOfChainValidation(ctx context.Context, msg *types.MsgOptOutOfChainValidation) (*types.OptOutOfChainValidationResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", msg.FromAddress))
+	}
+	if k.GetChainValidator(c, opAccAddr) == nil {
+		return nil, errorsmod.Wrap(types.ErrNotOptedIntoChainValidation, fmt.Sprintf("operator:%s", msg.FromAddress))
+	}
+	k.DeleteChainValidator(c, opAccAddr)
+	return &types.OptOutOfChainValidationResponse{}, nil
+}
+
+// UpdateParams updates the params of the delegation module, it can only be called by the governance.
+// The operator bond only applies to the operators registered after the update, and its denom can't be changed
+// because the self bonds are added to the locked bonds.
+func (k Keeper) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.UpdateParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, msg.Authority))
+	}
+	params, err := k.GetParams(c)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Params.OperatorBond.Denom != params.OperatorBond.Denom {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, fmt.Sprintf("the denom of the operator bond can't be changed from %s to %s", params.OperatorBond.Denom, msg.Params.OperatorBond.Denom))
+	}
+	if err := k.SetParams(c, &msg.Params); err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &types.UpdateParamsResponse{}, nil
 }
 
-// DelegateAssetToOperator todo: Delegation and Undelegation from exoCore chain directly will be implemented in future.At the moment,they are executed from client chain
-func (k Keeper) DelegateAssetToOperator(context.Context, *types.MsgDelegation) (*types.DelegationResponse, error) {
-	return nil, errorsmod.Wrap(types.ErrNotSupportYet, "func:DelegateAssetToOperator")
+// DelegateAssetToOperator delegates the assets that have been deposited to the operators from exoCore directly.
+// The signer must be the exoCore address linked to the staker, and the assets can be delegated to several operators in one tx.
+// The approval of each operator requiring it is taken from PerOperatorApprovedInfos by the operator address.
+func (k Keeper) DelegateAssetToOperator(ctx context.Context, msg *types.MsgDelegation) (*types.DelegationResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	paramsList, err := k.getParamsFromDelegationInfo(c, msg.BaseInfo, restakingtype.DelegateTo)
+	if err != nil {
+		return nil, err
+	}
+	for _, params := range paramsList {
+		params.ApprovedInfo = msg.PerOperatorApprovedInfos[params.OperatorAddress.String()]
+		err = k.DelegateTo(c, params)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, fmt.Sprintf("failed to delegate to operator:%s", params.OperatorAddress))
+		}
+	}
+	return &types.DelegationResponse{}, nil
 }
 
-func (k Keeper) UndelegateAssetFromOperator(context.Context, *types.MsgUndelegation) (*types.UndelegationResponse, error) {
-	return nil, errorsmod.Wrap(types.ErrNotSupportYet, "func:UndelegateAssetFromOperator")
+// UndelegateAssetFromOperator undelegates the assets from the operators from exoCore directly.
+// The undelegation records will be completed at the end of the epoch like the ones from client chain.
+func (k Keeper) UndelegateAssetFromOperator(ctx context.Context, msg *types.MsgUndelegation) (*types.UndelegationResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	paramsList, err := k.getParamsFromDelegationInfo(c, msg.BaseInfo, restakingtype.UndelegateFrom)
+	if err != nil {
+		return nil, err
+	}
+	for _, params := range paramsList {
+		params.LzNonce = k.GetNextNativeUndelegationNonce(c)
+		err = k.UndelegateFrom(c, params)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, fmt.Sprintf("failed to undelegate from operator:%s", params.OperatorAddress))
+		}
+	}
+	return &types.UndelegationResponse{}, nil
+}
+
+// UpdateApprovedStakers updates the stakers that can delegate to the operator without the approval signature
+func (k Keeper) UpdateApprovedStakers(ctx context.Context, msg *types.MsgUpdateApprovedStakers) (*types.UpdateApprovedStakersResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", msg.FromAddress))
+	}
+	if !k.IsOperator(c, opAccAddr) {
+		return nil, types.ErrOperatorNotExist
+	}
+	k.SetApprovedStakers(c, msg.FromAddress, msg.ApprovedStakerIDs, msg.RevokedStakerIDs)
+	return &types.UpdateApprovedStakersResponse{}, nil
+}
+
+// getParamsFromDelegationInfo checks if the signer is linked to the staker and converts the
+// perOperatorAmounts to the params used by DelegateTo and UndelegateFrom. The operators are sorted
+// to make sure the execution order is deterministic.
+func (k Keeper) getParamsFromDelegationInfo(ctx sdk.Context, info *types.DelegationIncOrDecInfo, action restakingtype.CrossChainOpType) ([]*DelegationOrUndelegationParams, error) {
+	stakerAddr, clientChainLzID, err := restakingtype.ParseID(info.StakerID)
+	if err != nil {
+		return nil, err
+	}
+	assetAddr, assetClientChainLzID, err := restakingtype.ParseID(info.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if clientChainLzID != assetClientChainLzID {
+		return nil, errorsmod.Wrap(types.ErrMismatchedClientChain, fmt.Sprintf("stakerID:%s,assetID:%s", info.StakerID, info.AssetID))
+	}
+
+	stakerID, _ := restakingtype.GetStakeIDAndAssetID(clientChainLzID, stakerAddr, nil)
+	exoCoreAddr, err := k.restakingStateKeeper.GetStakerExoCoreAddr(ctx, stakerID)
+	if err != nil {
+		return nil, err
+	}
+	if exoCoreAddr != info.FromAddress {
+		return nil, errorsmod.Wrap(types.ErrNotLinkedExoCoreAddr, fmt.Sprintf("signer:%s,linked address:%s", info.FromAddress, exoCoreAddr))
+	}
+
+	operators := make([]string, 0, len(info.PerOperatorAmounts))
+	for operator := range info.PerOperatorAmounts {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	txHash := common.BytesToHash(tmhash.Sum(ctx.TxBytes()))
+	paramsList := make([]*DelegationOrUndelegationParams, 0, len(operators))
+	for _, operator := range operators {
+		opAccAddr, err := sdk.AccAddressFromBech32(operator)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", operator))
+		}
+		paramsList = append(paramsList, &DelegationOrUndelegationParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          action,
+			AssetsAddress:   assetAddr,
+			OperatorAddress: opAccAddr,
+			StakerAddress:   stakerAddr,
+			OpAmount:        info.PerOperatorAmounts[operator].Amount,
+			TxHash:          txHash,
+		})
+	}
+	return paramsList, nil
 }
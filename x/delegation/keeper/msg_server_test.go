@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	keeper2 "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v14/types"
+)
+
+func (suite *KeeperTestSuite) TestNativeDelegateAndUndelegate() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+
+	depositEvent := &keeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(100),
+	}
+	depositEvent.AssetsAddress = usdtAddress[:]
+	err := suite.app.DepositKeeper.Deposit(suite.ctx, depositEvent)
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+
+	// register two operators, the second one requires the approval
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	anotherOpAccAddr := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").Bytes())
+	approveKey, err := crypto.GenerateKey()
+	suite.NoError(err)
+	approveAddrs := map[string]string{
+		anotherOpAccAddr.String(): sdk.AccAddress(crypto.PubkeyToAddress(approveKey.PublicKey).Bytes()).String(),
+	}
+	for _, addr := range []sdk.AccAddress{opAccAddr, anotherOpAccAddr} {
+		_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+			FromAddress: addr.String(),
+			Info: &delegationtype.OperatorInfo{
+				EarningsAddr: addr.String(),
+				ApproveAddr:  approveAddrs[addr.String()],
+			},
+		})
+		suite.NoError(err)
+	}
+
+	delegationMsg := &delegationtype.MsgDelegation{
+		BaseInfo: &delegationtype.DelegationIncOrDecInfo{
+			FromAddress: suite.accAddress.String(),
+			StakerID:    stakerID,
+			AssetID:     assetID,
+			PerOperatorAmounts: map[string]*delegationtype.ValueField{
+				opAccAddr.String():        {Amount: sdkmath.NewInt(30)},
+				anotherOpAccAddr.String(): {Amount: sdkmath.NewInt(20)},
+			},
+		},
+	}
+	suite.NoError(delegationMsg.ValidateBasic())
+
+	// the staker hasn't linked an exoCore address
+	_, err = suite.app.DelegationKeeper.DelegateAssetToOperator(suite.ctx, delegationMsg)
+	suite.ErrorIs(err, types.ErrNoStakerExoCoreAddr)
+
+	bindingMsg := types.GetExoCoreAddrBindingMsg(suite.ctx.ChainID(), stakerID, suite.accAddress.String(), 0)
+	sig, _, err := suite.signer.Sign("", accounts.TextHash(bindingMsg))
+	suite.NoError(err)
+	_, err = suite.app.StakingAssetsManageKeeper.SetStakerExoCoreAddr(suite.ctx, &types.MsgSetExoCoreAddr{
+		FromAddress:                suite.accAddress.String(),
+		SetAddress:                 suite.accAddress.String(),
+		ClientChainAddr:            hexutil.Encode(suite.address[:]),
+		ClientChainIndex:           clientChainLzID,
+		StakerClientChainSignature: hexutil.Encode(sig),
+	})
+	suite.NoError(err)
+
+	// the signer isn't the linked address
+	delegationMsg.BaseInfo.FromAddress = opAccAddr.String()
+	_, err = suite.app.DelegationKeeper.DelegateAssetToOperator(suite.ctx, delegationMsg)
+	suite.ErrorIs(err, delegationtype.ErrNotLinkedExoCoreAddr)
+
+	// the second operator requires its own approval
+	delegationMsg.BaseInfo.FromAddress = suite.accAddress.String()
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err = suite.app.DelegationKeeper.DelegateAssetToOperator(cacheCtx, delegationMsg)
+	suite.ErrorIs(err, delegationtype.ErrInvalidApproveSignature)
+
+	// the approval can't be attached to an operator that isn't delegated to
+	chainID, err := evmostypes.ParseChainID(suite.ctx.ChainID())
+	suite.NoError(err)
+	salt := "0x01"
+	expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+	digest := delegationtype.GetDelegationApprovalDigest(chainID, stakerID, anotherOpAccAddr.String(), assetID, big.NewInt(20), salt, expiry)
+	approvalSig, err := crypto.Sign(digest, approveKey)
+	suite.NoError(err)
+	approvedInfo := &delegationtype.DelegationApproveInfo{Signature: hexutil.Encode(approvalSig), Salt: salt, Expiry: expiry}
+	delegationMsg.PerOperatorApprovedInfos = map[string]*delegationtype.DelegationApproveInfo{
+		sdk.AccAddress(suite.address.Bytes()).String(): approvedInfo,
+	}
+	suite.ErrorIs(delegationMsg.ValidateBasic(), delegationtype.ErrCliCmdInputArg)
+
+	delegationMsg.PerOperatorApprovedInfos = map[string]*delegationtype.DelegationApproveInfo{
+		anotherOpAccAddr.String(): approvedInfo,
+	}
+	suite.NoError(delegationMsg.ValidateBasic())
+	_, err = suite.app.DelegationKeeper.DelegateAssetToOperator(suite.ctx, delegationMsg)
+	suite.NoError(err)
+
+	restakerState, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(50), restakerState.CanWithdrawAmountOrWantChangeValue)
+	totalDelegationAmount, err := suite.app.DelegationKeeper.GetStakerDelegationTotalAmount(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(50), totalDelegationAmount)
+	for opAddr, amount := range delegationMsg.BaseInfo.PerOperatorAmounts {
+		delegationAmount, err := suite.app.DelegationKeeper.GetSingleDelegationInfo(suite.ctx, stakerID, assetID, opAddr)
+		suite.NoError(err)
+		suite.Equal(amount.Amount, delegationAmount.CanUndelegationAmount)
+	}
+
+	// undelegate from both operators in one tx
+	undelegationMsg := &delegationtype.MsgUndelegation{
+		BaseInfo: &delegationtype.DelegationIncOrDecInfo{
+			FromAddress: suite.accAddress.String(),
+			StakerID:    stakerID,
+			AssetID:     assetID,
+			PerOperatorAmounts: map[string]*delegationtype.ValueField{
+				opAccAddr.String():        {Amount: sdkmath.NewInt(10)},
+				anotherOpAccAddr.String(): {Amount: sdkmath.NewInt(20)},
+			},
+		},
+	}
+	_, err = suite.app.DelegationKeeper.UndelegateAssetFromOperator(suite.ctx, undelegationMsg)
+	suite.NoError(err)
+
+	records, err := suite.app.DelegationKeeper.GetStakerUndelegationRecords(suite.ctx, stakerID, assetID, keeper2.PendingRecords)
+	suite.NoError(err)
+	suite.Equal(2, len(records))
+	for _, record := range records {
+		suite.Equal(undelegationMsg.BaseInfo.PerOperatorAmounts[record.OperatorAddr].Amount, record.Amount)
+		suite.True(record.LzTxNonce >= delegationtype.NativeUndelegationNonceStart)
+	}
+	suite.NotEqual(records[0].LzTxNonce, records[1].LzTxNonce)
+
+	restakerState, err = suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(30), restakerState.WaitUndelegationAmountOrWantChangeValue)
+
+	// the undelegation amount is bigger than the delegated amount
+	_, err = suite.app.DelegationKeeper.UndelegateAssetFromOperator(suite.ctx, undelegationMsg)
+	suite.ErrorIs(err, delegationtype.ErrUndelegationAmountTooBig)
+}
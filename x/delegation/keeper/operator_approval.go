@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v14/types"
+)
+
+// CheckOperatorApproval checks if the staker is allowed to delegate to the operator.
+// Anyone can delegate to the operator if its ApproveAddr isn't set. Otherwise, the staker should be in the
+// approved list of the operator, or provide an EIP-712 signature of the ApproveAddr. The signature covers the asset,
+// amount and expiry of the delegation, and its salt can only be used once, so an approval signature can't be replayed.
+func (k Keeper) CheckOperatorApproval(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int, operatorAddr sdk.AccAddress, approvedInfo *delegationtype.DelegationApproveInfo) error {
+	opInfo, err := k.GetOperatorInfo(ctx, operatorAddr.String())
+	if err != nil {
+		return err
+	}
+	if opInfo.ApproveAddr == "" {
+		return nil
+	}
+	if k.IsStakerApproved(ctx, operatorAddr.String(), stakerID) {
+		return nil
+	}
+
+	if approvedInfo == nil || approvedInfo.Signature == "" || approvedInfo.Salt == "" {
+		return errorsmod.Wrap(delegationtype.ErrInvalidApproveSignature, fmt.Sprintf("the signature or salt is empty, operator:%s", operatorAddr))
+	}
+	if approvedInfo.Expiry < uint64(ctx.BlockTime().Unix()) {
+		return errorsmod.Wrap(delegationtype.ErrApprovalExpired, fmt.Sprintf("expiry:%d,blockTime:%d", approvedInfo.Expiry, ctx.BlockTime().Unix()))
+	}
+	approveAccAddr, err := sdk.AccAddressFromBech32(opInfo.ApproveAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", opInfo.ApproveAddr))
+	}
+	if k.IsSaltUsed(ctx, opInfo.ApproveAddr, approvedInfo.Salt) {
+		return errorsmod.Wrap(delegationtype.ErrUsedSalt, fmt.Sprintf("approveAddr:%s,salt:%s", opInfo.ApproveAddr, approvedInfo.Salt))
+	}
+
+	chainID, err := evmostypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return err
+	}
+	digest := delegationtype.GetDelegationApprovalDigest(chainID, stakerID, operatorAddr.String(), assetID, amount.BigInt(), approvedInfo.Salt, approvedInfo.Expiry)
+	sig, err := hexutil.Decode(approvedInfo.Signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrap(delegationtype.ErrInvalidApproveSignature, fmt.Sprintf("can't decode the signature:%s", approvedInfo.Signature))
+	}
+	// the signature of eth_signTypedData uses 27/28 as the recovery id
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return errorsmod.Wrap(delegationtype.ErrInvalidApproveSignature, err.Error())
+	}
+	signer := crypto.PubkeyToAddress(*pubKey)
+	if !bytes.Equal(signer.Bytes(), approveAccAddr.Bytes()) {
+		return errorsmod.Wrap(delegationtype.ErrInvalidApproveSignature, fmt.Sprintf("the signer is:%s,approveAddr:%s", sdk.AccAddress(signer.Bytes()), opInfo.ApproveAddr))
+	}
+
+	k.SetSaltUsed(ctx, opInfo.ApproveAddr, approvedInfo.Salt)
+	return nil
+}
+
+func (k Keeper) IsSaltUsed(ctx sdk.Context, approveAddr, salt string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixDelegationUsedSalt)
+	return store.Has(delegationtype.GetUsedSaltKey(approveAddr, salt))
+}
+
+func (k Keeper) SetSaltUsed(ctx sdk.Context, approveAddr, salt string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixDelegationUsedSalt)
+	store.Set(delegationtype.GetUsedSaltKey(approveAddr, salt), []byte{})
+}
+
+// SetApprovedStakers adds or removes the stakers that can delegate to the operator without an approval signature
+func (k Keeper) SetApprovedStakers(ctx sdk.Context, operatorAddr string, approvedStakerIDs, revokedStakerIDs []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorApprovedInfo)
+	for _, stakerID := range approvedStakerIDs {
+		store.Set(delegationtype.GetOperatorApprovedStakerKey(operatorAddr, stakerID), []byte{})
+	}
+	for _, stakerID := range revokedStakerIDs {
+		store.Delete(delegationtype.GetOperatorApprovedStakerKey(operatorAddr, stakerID))
+	}
+}
+
+func (k Keeper) IsStakerApproved(ctx sdk.Context, operatorAddr, stakerID string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorApprovedInfo)
+	return store.Has(delegationtype.GetOperatorApprovedStakerKey(operatorAddr, stakerID))
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	keeper2 "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmostypes "github.com/evmos/evmos/v14/types"
+)
+
+func (suite *KeeperTestSuite) TestOperatorApproval() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+
+	depositEvent := &keeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	}
+	err := suite.app.DepositKeeper.Deposit(suite.ctx, depositEvent)
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+
+	// register an operator that requires the approval
+	approveKey, err := crypto.GenerateKey()
+	suite.NoError(err)
+	approveAddr := sdk.AccAddress(crypto.PubkeyToAddress(approveKey.PublicKey).Bytes())
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+			ApproveAddr:  approveAddr.String(),
+		},
+	})
+	suite.NoError(err)
+
+	delegationParams := &keeper2.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(10),
+	}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrInvalidApproveSignature)
+
+	// the signature isn't signed by the approveAddr
+	chainID, err := evmostypes.ParseChainID(suite.ctx.ChainID())
+	suite.NoError(err)
+	salt := "0x01"
+	expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+	digest := delegationtype.GetDelegationApprovalDigest(chainID, stakerID, opAccAddr.String(), assetID, delegationParams.OpAmount.BigInt(), salt, expiry)
+	otherKey, err := crypto.GenerateKey()
+	suite.NoError(err)
+	sig, err := crypto.Sign(digest, otherKey)
+	suite.NoError(err)
+	delegationParams.ApprovedInfo = &delegationtype.DelegationApproveInfo{Signature: hexutil.Encode(sig), Salt: salt, Expiry: expiry}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrInvalidApproveSignature)
+
+	sig, err = crypto.Sign(digest, approveKey)
+	suite.NoError(err)
+	delegationParams.ApprovedInfo.Signature = hexutil.Encode(sig)
+
+	// the signature doesn't cover another amount
+	delegationParams.OpAmount = sdkmath.NewInt(20)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrInvalidApproveSignature)
+	delegationParams.OpAmount = sdkmath.NewInt(10)
+
+	// the signature can't be used after the expiry
+	expiredCtx := suite.ctx.WithBlockTime(time.Unix(int64(expiry)+1, 0))
+	err = suite.app.DelegationKeeper.DelegateTo(expiredCtx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrApprovalExpired)
+
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.NoError(err)
+	suite.True(suite.app.DelegationKeeper.IsSaltUsed(suite.ctx, approveAddr.String(), salt))
+
+	// the salt can't be reused
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrUsedSalt)
+
+	// the approved staker doesn't need the signature
+	_, err = suite.app.DelegationKeeper.UpdateApprovedStakers(suite.ctx, &delegationtype.MsgUpdateApprovedStakers{
+		FromAddress:       opAccAddr.String(),
+		ApprovedStakerIDs: []string{stakerID},
+	})
+	suite.NoError(err)
+	delegationParams.ApprovedInfo = nil
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.NoError(err)
+
+	_, err = suite.app.DelegationKeeper.UpdateApprovedStakers(suite.ctx, &delegationtype.MsgUpdateApprovedStakers{
+		FromAddress:      opAccAddr.String(),
+		RevokedStakerIDs: []string{stakerID},
+	})
+	suite.NoError(err)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams)
+	suite.ErrorIs(err, delegationtype.ErrInvalidApproveSignature)
+
+	// only the operator can update its approved stakers
+	_, err = suite.app.DelegationKeeper.UpdateApprovedStakers(suite.ctx, &delegationtype.MsgUpdateApprovedStakers{
+		FromAddress:       suite.accAddress.String(),
+		ApprovedStakerIDs: []string{stakerID},
+	})
+	suite.ErrorIs(err, delegationtype.ErrOperatorNotExist)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockOperatorBond sends the operator bond in the params from the operator to the module account.
+// Nothing is locked if the bond is zero.
+func (k Keeper) LockOperatorBond(ctx sdk.Context, opAccAddr sdk.AccAddress) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.OperatorBond.IsZero() {
+		return nil
+	}
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, opAccAddr, delegationtype.ModuleName, sdk.NewCoins(params.OperatorBond))
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("failed to lock the operator bond:%s", params.OperatorBond))
+	}
+	k.SetOperatorBond(ctx, opAccAddr, &delegationtype.OperatorBond{Amount: params.OperatorBond})
+	return nil
+}
+
+// ScheduleOperatorBondRelease sets the height at which the bond of the deregistered operator is returned,
+// the bond will be returned in the EndBlock of that height.
+func (k Keeper) ScheduleOperatorBondRelease(ctx sdk.Context, opAccAddr sdk.AccAddress) error {
+	bond := k.GetOperatorBond(ctx, opAccAddr)
+	if bond == nil {
+		return nil
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	bond.ReleaseHeight = uint64(ctx.BlockHeight()) + params.DeregistrationDelayBlocks
+	k.SetOperatorBond(ctx, opAccAddr, bond)
+	return nil
+}
+
+// ReleaseOperatorBonds returns the bonds whose release height is at or before the current height.
+func (k Keeper) ReleaseOperatorBonds(ctx sdk.Context) error {
+	height := uint64(ctx.BlockHeight())
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBondRelease)
+	iterator := store.Iterator(nil, delegationtype.GetHeightIteratorEnd(height))
+	defer iterator.Close()
+
+	operators := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		_, operatorAddr, err := delegationtype.ParseOperatorBondReleaseKey(iterator.Key())
+		if err != nil {
+			return err
+		}
+		opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+		if err != nil {
+			return err
+		}
+		operators = append(operators, opAccAddr)
+	}
+
+	for _, opAccAddr := range operators {
+		bond := k.GetOperatorBond(ctx, opAccAddr)
+		if bond == nil || bond.ReleaseHeight == 0 || bond.ReleaseHeight > height {
+			return errorsmod.Wrap(delegationtype.ErrNoKeyInTheStore, fmt.Sprintf("the bond to be released doesn't exist, operator:%s", opAccAddr))
+		}
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, delegationtype.ModuleName, opAccAddr, sdk.NewCoins(bond.Amount))
+		if err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("failed to return the operator bond, operator:%s", opAccAddr))
+		}
+		k.DeleteOperatorBond(ctx, opAccAddr)
+	}
+	return nil
+}
+
+// SetOperatorBond stores the bond of the operator, the release index is updated if the bond has a release height.
+func (k Keeper) SetOperatorBond(ctx sdk.Context, opAccAddr sdk.AccAddress, bond *delegationtype.OperatorBond) {
+	k.deleteOperatorBondReleaseIndex(ctx, opAccAddr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBond)
+	store.Set(opAccAddr, k.cdc.MustMarshal(bond))
+	if bond.ReleaseHeight != 0 {
+		releaseStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBondRelease)
+		releaseStore.Set(delegationtype.GetOperatorBondReleaseKey(bond.ReleaseHeight, opAccAddr.String()), []byte{})
+	}
+}
+
+// GetOperatorBond returns the bond locked by the operator, it's nil if the operator hasn't locked any bond.
+func (k Keeper) GetOperatorBond(ctx sdk.Context, opAccAddr sdk.AccAddress) *delegationtype.OperatorBond {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBond)
+	value := store.Get(opAccAddr)
+	if value == nil {
+		return nil
+	}
+	ret := &delegationtype.OperatorBond{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret
+}
+
+func (k Keeper) DeleteOperatorBond(ctx sdk.Context, opAccAddr sdk.AccAddress) {
+	k.deleteOperatorBondReleaseIndex(ctx, opAccAddr)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBond)
+	store.Delete(opAccAddr)
+}
+
+func (k Keeper) deleteOperatorBondReleaseIndex(ctx sdk.Context, opAccAddr sdk.AccAddress) {
+	bond := k.GetOperatorBond(ctx, opAccAddr)
+	if bond == nil || bond.ReleaseHeight == 0 {
+		return
+	}
+	releaseStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBondRelease)
+	releaseStore.Delete(delegationtype.GetOperatorBondReleaseKey(bond.ReleaseHeight, opAccAddr.String()))
+}
+
+// GetAllOperatorBonds returns the bonds of all operators, it's used to export the genesis state.
+func (k Keeper) GetAllOperatorBonds(ctx sdk.Context) []delegationtype.OperatorBondGenesis {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorBond)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]delegationtype.OperatorBondGenesis, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var bond delegationtype.OperatorBond
+		k.cdc.MustUnmarshal(iterator.Value(), &bond)
+		ret = append(ret, delegationtype.OperatorBondGenesis{
+			OperatorAddr: sdk.AccAddress(iterator.Key()).String(),
+			Bond:         bond,
+		})
+	}
+	return ret
+}
+
+// HasDelegations returns true if any asset is delegated to the operator or waiting to be undelegated from it.
+func (k Keeper) HasDelegations(ctx sdk.Context, operatorAddr string) bool {
+	prefixKey := append([]byte(operatorAddr), '/')
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(delegationtype.KeyPrefixOperatorDelegators, prefixKey...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
@@ -1,6 +1,13 @@
 package keeper_test
 
-import delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	"github.com/ExocoreNetwork/exocore/utils"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 func (suite *KeeperTestSuite) TestOperatorInfo() {
 	info := &delegationtype.OperatorInfo{
@@ -9,7 +16,7 @@ func (suite *KeeperTestSuite) TestOperatorInfo() {
 		OperatorMetaInfo: "test operator",
 		ClientChainEarningsAddr: &delegationtype.ClientChainEarningAddrList{
 			EarningInfoList: []*delegationtype.ClientChainEarningAddrInfo{
-				{101, "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"},
+				{LzClientChainID: 101, ClientChainEarningAddr: "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"},
 			},
 		},
 	}
@@ -19,4 +26,119 @@ func (suite *KeeperTestSuite) TestOperatorInfo() {
 	getOperatorInfo, err := suite.app.DelegationKeeper.GetOperatorInfo(suite.ctx, suite.accAddress.String())
 	suite.NoError(err)
 	suite.Equal(*info, *getOperatorInfo)
+
+	// the earnings address is required
+	err = suite.app.DelegationKeeper.SetOperatorInfo(suite.ctx, suite.accAddress.String(), &delegationtype.OperatorInfo{EarningsAddr: "invalid"})
+	suite.ErrorIs(err, delegationtype.ErrInvalidOperatorInfo)
+}
+
+func (suite *KeeperTestSuite) TestOperatorLifecycle() {
+	bond := sdk.NewCoin(utils.BaseDenom, sdkmath.NewInt(1000))
+	params := delegationtype.NewParams(bond, 10, false, delegationtype.DefaultMaxValidators, sdkmath.ZeroInt(), delegationtype.DefaultEpochIdentifier)
+
+	// the operator bond is set by the governance
+	updateParamsMsg := &delegationtype.MsgUpdateParams{
+		Authority: suite.accAddress.String(),
+		Params:    params,
+	}
+	suite.NoError(updateParamsMsg.ValidateBasic())
+	_, err := suite.app.DelegationKeeper.UpdateParams(suite.ctx, updateParamsMsg)
+	suite.ErrorIs(err, delegationtype.ErrInvalidAuthority)
+	updateParamsMsg.Authority = suite.app.DelegationKeeper.GetAuthority()
+	updateParamsMsg.Params.MaxValidators = 0
+	suite.ErrorIs(updateParamsMsg.ValidateBasic(), delegationtype.ErrInvalidParams)
+	_, err = suite.app.DelegationKeeper.UpdateParams(suite.ctx, updateParamsMsg)
+	suite.ErrorIs(err, delegationtype.ErrInvalidParams)
+	updateParamsMsg.Params = params
+	updateParamsMsg.Params.OperatorBond = sdk.NewCoin("stake", bond.Amount)
+	_, err = suite.app.DelegationKeeper.UpdateParams(suite.ctx, updateParamsMsg)
+	suite.ErrorIs(err, delegationtype.ErrInvalidParams)
+	updateParamsMsg.Params = params
+	_, err = suite.app.DelegationKeeper.UpdateParams(suite.ctx, updateParamsMsg)
+	suite.NoError(err)
+	storedParams, err := suite.app.DelegationKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(params, *storedParams)
+
+	opAccAddr := suite.accAddress
+	registerReq := &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+			ApproveAddr:  "invalid",
+		},
+	}
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.ErrorIs(err, delegationtype.ErrInvalidOperatorInfo)
+
+	// the operator can't pay the bond
+	registerReq.Info.ApproveAddr = ""
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.Error(err)
+	suite.False(suite.app.DelegationKeeper.IsOperator(suite.ctx, opAccAddr))
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, opAccAddr, utils.BaseDenom)
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, opAccAddr, sdk.NewCoins(bond))
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.NoError(err)
+	suite.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, opAccAddr, utils.BaseDenom))
+	suite.Equal(&delegationtype.OperatorBond{Amount: bond}, suite.app.DelegationKeeper.GetOperatorBond(suite.ctx, opAccAddr))
+
+	// re-registration is rejected
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.ErrorIs(err, delegationtype.ErrOperatorAlreadyExist)
+
+	// the earnings address isn't changed by the update
+	updateMsg := &delegationtype.MsgUpdateOperatorInfo{
+		FromAddress:      opAccAddr.String(),
+		ApproveAddr:      opAccAddr.String(),
+		OperatorMetaInfo: "updated operator",
+	}
+	_, err = suite.app.DelegationKeeper.UpdateOperatorInfo(suite.ctx, updateMsg)
+	suite.NoError(err)
+	info, err := suite.app.DelegationKeeper.GetOperatorInfo(suite.ctx, opAccAddr.String())
+	suite.NoError(err)
+	suite.Equal(delegationtype.OperatorInfo{
+		EarningsAddr:     opAccAddr.String(),
+		ApproveAddr:      opAccAddr.String(),
+		OperatorMetaInfo: "updated operator",
+	}, *info)
+
+	// the operator with delegations can't be deregistered
+	stakerID, assetID := "0x3e108c058e8066da635321dc3018294ca82ddedf_0x65", "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	err = suite.app.DelegationKeeper.UpdateDelegationState(suite.ctx, stakerID, assetID, map[string]*delegationtype.DelegationAmounts{
+		opAccAddr.String(): {CanUndelegationAmount: sdkmath.NewInt(10), WaitUndelegationAmount: sdkmath.ZeroInt()},
+	})
+	suite.NoError(err)
+	deregisterMsg := &delegationtype.MsgDeregisterOperator{FromAddress: opAccAddr.String()}
+	_, err = suite.app.DelegationKeeper.DeregisterOperator(suite.ctx, deregisterMsg)
+	suite.ErrorIs(err, delegationtype.ErrOperatorHasDelegations)
+
+	err = suite.app.DelegationKeeper.UpdateDelegationState(suite.ctx, stakerID, assetID, map[string]*delegationtype.DelegationAmounts{
+		opAccAddr.String(): {CanUndelegationAmount: sdkmath.NewInt(-10), WaitUndelegationAmount: sdkmath.ZeroInt()},
+	})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.DeregisterOperator(suite.ctx, deregisterMsg)
+	suite.NoError(err)
+	suite.False(suite.app.DelegationKeeper.IsOperator(suite.ctx, opAccAddr))
+	releaseHeight := uint64(suite.ctx.BlockHeight()) + 10
+	suite.Equal(&delegationtype.OperatorBond{Amount: bond, ReleaseHeight: releaseHeight}, suite.app.DelegationKeeper.GetOperatorBond(suite.ctx, opAccAddr))
+
+	// the operator can't register again before the bond is returned
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.ErrorIs(err, delegationtype.ErrOperatorDeregistering)
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(releaseHeight) - 1)
+	suite.app.DelegationKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	suite.Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, opAccAddr, utils.BaseDenom))
+
+	// the bond is returned even if the release height is skipped
+	suite.ctx = suite.ctx.WithBlockHeight(int64(releaseHeight) + 2)
+	suite.app.DelegationKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	suite.Equal(balance.Add(bond), suite.app.BankKeeper.GetBalance(suite.ctx, opAccAddr, utils.BaseDenom))
+	suite.Nil(suite.app.DelegationKeeper.GetOperatorBond(suite.ctx, opAccAddr))
+
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, registerReq)
+	suite.NoError(err)
 }
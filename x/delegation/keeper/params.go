@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetParams sets the parameters of the delegation module
+func (k Keeper) SetParams(ctx sdk.Context, params *delegationtype.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixParams)
+	bz := k.cdc.MustMarshal(params)
+	store.Set(delegationtype.ParamsKey, bz)
+	return nil
+}
+
+// GetParams returns the parameters of the delegation module
+func (k Keeper) GetParams(ctx sdk.Context) (*delegationtype.Params, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixParams)
+	value := store.Get(delegationtype.ParamsKey)
+	if value == nil {
+		return nil, delegationtype.ErrNoParamsKey
+	}
+
+	ret := &delegationtype.Params{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret, nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotKeptEpochs is the number of the latest epochs whose snapshots are kept, the older snapshots are pruned
+// when a new snapshot is taken.
+const SnapshotKeptEpochs = int64(10)
+
+// TakeOperatorSnapshots stores the total amounts of the assets delegated to each registered operator at the start
+// of the epoch. The delegation changes in the epoch don't affect the snapshots, so the AVSs see a stable stake of
+// the operators during the epoch.
+func (k Keeper) TakeOperatorSnapshots(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	operators, err := k.GetAllOperatorInfos(ctx)
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorSnapshot)
+	for _, operator := range operators {
+		snapshot, err := k.getOperatorStake(ctx, operator.OperatorAddr)
+		if err != nil {
+			return err
+		}
+		snapshot.EpochIdentifier = epochIdentifier
+		snapshot.EpochNumber = epochNumber
+		store.Set(delegationtype.GetOperatorSnapshotKey(epochIdentifier, epochNumber, operator.OperatorAddr), k.cdc.MustMarshal(snapshot))
+	}
+	latestStore := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLatestSnapshotEpoch)
+	latestStore.Set([]byte(epochIdentifier), sdk.Uint64ToBigEndian(uint64(epochNumber)))
+
+	k.pruneOperatorSnapshots(ctx, epochIdentifier, epochNumber-SnapshotKeptEpochs)
+	return nil
+}
+
+// getOperatorStake returns the current total amounts of the assets delegated to the operator, the amounts
+// waiting to be undelegated are included because they can still be slashed.
+func (k Keeper) getOperatorStake(ctx sdk.Context, operatorAddr string) (*delegationtype.OperatorSnapshot, error) {
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", operatorAddr))
+	}
+	assetsInfo, err := k.restakingStateKeeper.GetOperatorAssetInfos(ctx, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	assetIDs := make([]string, 0, len(assetsInfo))
+	for assetID := range assetsInfo {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
+
+	ret := &delegationtype.OperatorSnapshot{
+		OperatorAddr: operatorAddr,
+		Height:       ctx.BlockHeight(),
+		Assets:       make([]delegationtype.SnapshotAsset, 0, len(assetIDs)),
+	}
+	for _, assetID := range assetIDs {
+		amount := assetsInfo[assetID].TotalAmountOrWantChangeValue
+		if !amount.IsPositive() {
+			continue
+		}
+		ret.Assets = append(ret.Assets, delegationtype.SnapshotAsset{AssetID: assetID, Amount: amount})
+	}
+	return ret, nil
+}
+
+// pruneOperatorSnapshots removes the snapshots taken at or before the epoch.
+func (k Keeper) pruneOperatorSnapshots(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochNumber < 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(delegationtype.KeyPrefixOperatorSnapshot, delegationtype.GetSnapshotIdentifierPrefix(epochIdentifier)...))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(epochNumber)+1))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetLatestSnapshotEpoch returns the number of the latest epoch with the identifier at whose start the snapshots
+// are taken, it's zero if no snapshot has been taken.
+func (k Keeper) GetLatestSnapshotEpoch(ctx sdk.Context, epochIdentifier string) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixLatestSnapshotEpoch)
+	value := store.Get([]byte(epochIdentifier))
+	if value == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(value))
+}
+
+// GetOperatorSnapshot returns the stake snapshot of the operator taken at the start of the epoch, the snapshot
+// doesn't exist if the operator wasn't registered at that time or it has been pruned.
+func (k Keeper) GetOperatorSnapshot(ctx sdk.Context, epochIdentifier string, epochNumber int64, operatorAddr string) (*delegationtype.OperatorSnapshot, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), delegationtype.KeyPrefixOperatorSnapshot)
+	value := store.Get(delegationtype.GetOperatorSnapshotKey(epochIdentifier, epochNumber, operatorAddr))
+	if value == nil {
+		return nil, errorsmod.Wrap(delegationtype.ErrSnapshotNotFound, fmt.Sprintf("operator:%s,epochIdentifier:%s,epochNumber:%d", operatorAddr, epochIdentifier, epochNumber))
+	}
+	ret := &delegationtype.OperatorSnapshot{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret, nil
+}
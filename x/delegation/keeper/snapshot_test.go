@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ethereum/go-ethereum/common"
+	epochstypes "github.com/evmos/evmos/v14/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestOperatorSnapshot() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	_, usdtAssetID := types.GetStakeIDAndAssetID(clientChainLzID, nil, usdtAddress[:])
+	opAccAddr := suite.accAddress
+	hooks := suite.app.DelegationKeeper.Hooks()
+
+	_, err := suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams))
+
+	// the snapshots are only taken at the start of the epochs configured in the params
+	hooks.BeforeEpochStart(suite.ctx, epochstypes.WeekEpochID, 1)
+	_, err = suite.app.DelegationKeeper.QueryOperatorSnapshot(suite.ctx, &delegationtype.QueryOperatorSnapshotReq{OperatorAddr: opAccAddr.String()})
+	suite.ErrorIs(err, delegationtype.ErrSnapshotNotFound)
+
+	hooks.BeforeEpochStart(suite.ctx, delegationtype.DefaultEpochIdentifier, 1)
+	expectedSnapshot := &delegationtype.OperatorSnapshot{
+		OperatorAddr:    opAccAddr.String(),
+		EpochIdentifier: delegationtype.DefaultEpochIdentifier,
+		EpochNumber:     1,
+		Height:          suite.ctx.BlockHeight(),
+		Assets: []delegationtype.SnapshotAsset{
+			{AssetID: usdtAssetID, Amount: sdkmath.NewInt(50)},
+		},
+	}
+	snapshot, err := suite.app.DelegationKeeper.QueryOperatorSnapshot(suite.ctx, &delegationtype.QueryOperatorSnapshotReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(expectedSnapshot, snapshot)
+
+	// the delegation in the epoch doesn't change the snapshot of the epoch
+	delegationParams.LzNonce = 1
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams))
+	snapshot, err = suite.app.DelegationKeeper.QueryOperatorSnapshot(suite.ctx, &delegationtype.QueryOperatorSnapshotReq{OperatorAddr: opAccAddr.String(), EpochNumber: 1})
+	suite.NoError(err)
+	suite.Equal(expectedSnapshot, snapshot)
+
+	hooks.BeforeEpochStart(suite.ctx, delegationtype.DefaultEpochIdentifier, 2)
+	snapshot, err = suite.app.DelegationKeeper.QueryOperatorSnapshot(suite.ctx, &delegationtype.QueryOperatorSnapshotReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(int64(2), snapshot.EpochNumber)
+	suite.Equal([]delegationtype.SnapshotAsset{{AssetID: usdtAssetID, Amount: sdkmath.NewInt(100)}}, snapshot.Assets)
+
+	// the old snapshots are pruned
+	epochNumber := 2 + delegationkeeper.SnapshotKeptEpochs
+	hooks.BeforeEpochStart(suite.ctx, delegationtype.DefaultEpochIdentifier, epochNumber)
+	suite.Equal(epochNumber, suite.app.DelegationKeeper.GetLatestSnapshotEpoch(suite.ctx, delegationtype.DefaultEpochIdentifier))
+	_, err = suite.app.DelegationKeeper.GetOperatorSnapshot(suite.ctx, delegationtype.DefaultEpochIdentifier, 2, opAccAddr.String())
+	suite.ErrorIs(err, delegationtype.ErrSnapshotNotFound)
+	_, err = suite.app.DelegationKeeper.GetOperatorSnapshot(suite.ctx, delegationtype.DefaultEpochIdentifier, epochNumber, opAccAddr.String())
+	suite.NoError(err)
+}
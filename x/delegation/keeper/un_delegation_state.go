@@ -2,14 +2,12 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type GetUndelegationRecordType uint8
@@ -37,12 +35,30 @@ func (k Keeper) SetUndelegationRecords(ctx sdk.Context, records []*types.Undeleg
 		stakerKey := types.GetStakerUndelegationRecordKey(record.StakerID, record.AssetID, record.LzTxNonce)
 		stakerUndelegationStore.Set(stakerKey, singleRecKey)
 
-		waitCompleteKey := types.GetWaitCompleteRecordKey(record.CompleteBlockNumber, record.LzTxNonce)
+		waitCompleteKey := types.GetWaitCompleteRecordKey(record.CompleteBlockNumber, singleRecKey)
 		waitCompleteStore.Set(waitCompleteKey, singleRecKey)
 	}
 	return nil
 }
 
+// GetAllPendingUndelegationRecords returns all the undelegation records that haven't been completed,
+// it's used to export the genesis state.
+func (k Keeper) GetAllPendingUndelegationRecords(ctx sdk.Context) ([]types.UndelegationRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUndelegationInfo)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]types.UndelegationRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.UndelegationRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if record.IsPending {
+			ret = append(ret, record)
+		}
+	}
+	return ret, nil
+}
+
 func (k Keeper) SetSingleUndelegationRecord(ctx sdk.Context, record *types.UndelegationRecord) (recordKey []byte, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUndelegationInfo)
 	bz := k.cdc.MustMarshal(record)
@@ -92,7 +108,7 @@ func (k Keeper) SetStakerUndelegationInfo(ctx sdk.Context, stakerID, assetID str
 
 func (k Keeper) GetStakerUndelegationRecKeys(ctx sdk.Context, stakerID, assetID string) (recordKeyList []string, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerUndelegationInfo)
-	iterator := sdk.KVStorePrefixIterator(store, []byte(strings.Join([]string{stakerID, assetID}, "/")))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetStakerUndelegationIteratorPrefix(stakerID, assetID))
 	defer iterator.Close()
 
 	ret := make([]string, 0)
@@ -111,16 +127,30 @@ func (k Keeper) GetStakerUndelegationRecords(ctx sdk.Context, stakerID, assetID
 	return k.GetUndelegationRecords(ctx, recordKeys, getType)
 }
 
-func (k Keeper) SetWaitCompleteUndelegationInfo(ctx sdk.Context, height, lzNonce uint64, recordKey string) error {
+// GetStakerPendingUndelegations returns the undelegation records of the staker's asset that haven't been completed.
+func (k Keeper) GetStakerPendingUndelegations(ctx sdk.Context, stakerID, assetID string) ([]*types.UndelegationRecord, error) {
+	return k.GetStakerUndelegationRecords(ctx, stakerID, assetID, PendingRecords)
+}
+
+func (k Keeper) SetWaitCompleteUndelegationInfo(ctx sdk.Context, height uint64, recordKey string) error {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWaitCompleteUndelegations)
-	key := types.GetWaitCompleteRecordKey(height, lzNonce)
+	key := types.GetWaitCompleteRecordKey(height, []byte(recordKey))
 	store.Set(key, []byte(recordKey))
 	return nil
 }
 
+// DeleteWaitCompleteUndelegationInfo removes the record from the KeyPrefixWaitCompleteUndelegations store, it's called
+// when the record is completed or its complete height is reset.
+func (k Keeper) DeleteWaitCompleteUndelegationInfo(ctx sdk.Context, height uint64, recordKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWaitCompleteUndelegations)
+	store.Delete(types.GetWaitCompleteRecordKey(height, []byte(recordKey)))
+}
+
+// GetWaitCompleteUndelegationRecKeys returns the keys of the records whose complete height is at or before the height,
+// so the records scheduled at a height that has been skipped, e.g. the chain was halted, can still be completed.
 func (k Keeper) GetWaitCompleteUndelegationRecKeys(ctx sdk.Context, height uint64) (recordKeyList []string, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWaitCompleteUndelegations)
-	iterator := sdk.KVStorePrefixIterator(store, []byte(hexutil.EncodeUint64(height)))
+	iterator := store.Iterator(nil, types.GetHeightIteratorEnd(height))
 	defer iterator.Close()
 
 	ret := make([]string, 0)
@@ -130,6 +160,7 @@ func (k Keeper) GetWaitCompleteUndelegationRecKeys(ctx sdk.Context, height uint6
 	return ret, nil
 }
 
+// GetWaitCompleteUndelegationRecords returns the pending records whose complete height is at or before the height.
 func (k Keeper) GetWaitCompleteUndelegationRecords(ctx sdk.Context, height uint64) (records []*types.UndelegationRecord, err error) {
 	recordKeys, err := k.GetWaitCompleteUndelegationRecKeys(ctx, height)
 	if err != nil {
@@ -138,6 +169,26 @@ func (k Keeper) GetWaitCompleteUndelegationRecords(ctx sdk.Context, height uint6
 	if len(recordKeys) == 0 {
 		return nil, nil
 	}
-	// The states of records stored by WaitCompleteUndelegations kvStore should always be IsPending,so using AllRecords as getType here is ok.
-	return k.GetUndelegationRecords(ctx, recordKeys, AllRecords)
+	// The records are removed from the WaitCompleteUndelegations kvStore once completed, PendingRecords is used in case
+	// the index of a completed record is left.
+	return k.GetUndelegationRecords(ctx, recordKeys, PendingRecords)
+}
+
+// GetNextNativeUndelegationNonce returns a new nonce for the undelegation initiated from exoCore directly,
+// because there isn't a layerZero nonce in this case.
+func (k Keeper) GetNextNativeUndelegationNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nonce := types.NativeUndelegationNonceStart
+	value := store.Get(types.KeyNativeUndelegationNonce)
+	if value != nil {
+		nonce = sdk.BigEndianToUint64(value) + 1
+	}
+	store.Set(types.KeyNativeUndelegationNonce, sdk.Uint64ToBigEndian(nonce))
+	return nonce
+}
+
+// SetNativeUndelegationNonce sets the last nonce used by the undelegation initiated from exoCore directly
+func (k Keeper) SetNativeUndelegationNonce(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyNativeUndelegationNonce, sdk.Uint64ToBigEndian(nonce))
 }
@@ -0,0 +1,167 @@
+package v2
+
+import (
+	"bytes"
+	"strings"
+
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MigrateStore moves the delegation states from the store of the deposit module to the store of the delegation
+// module. The delegation module used to share the store with the deposit module, so all the entries except the
+// deposit params belong to the delegation module.
+// The indexes of the pending undelegations and the operator bonds waiting to be returned are re-keyed after the
+// move. The heights in the keys were hex strings, so the keys couldn't be iterated by the order of the height,
+// they are big-endian encoded now, and the wait-complete keys are the height followed by the full key of the
+// undelegation record. The indexes of the completed undelegations are removed because they were never deleted
+// before. The reverse index from the operators to their delegators is built from the moved delegation states.
+// The default params are set if they don't exist, and the epoch identifier is added to the existing params.
+func MigrateStore(ctx sdk.Context, depositStoreKey, delegationStoreKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	depositStore := ctx.KVStore(depositStoreKey)
+	delegationStore := ctx.KVStore(delegationStoreKey)
+	depositParamsKey := append(deposittype.KeyPrefixParams, depositkeeper.ParamsKey...)
+
+	iterator := depositStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.Equal(iterator.Key(), depositParamsKey) {
+			continue
+		}
+		keys = append(keys, iterator.Key())
+		delegationStore.Set(iterator.Key(), iterator.Value())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		depositStore.Delete(key)
+	}
+
+	if err := migrateWaitCompleteUndelegations(delegationStore, cdc); err != nil {
+		return err
+	}
+	if err := migrateOperatorBondReleases(delegationStore); err != nil {
+		return err
+	}
+	buildOperatorDelegators(delegationStore, cdc)
+	return migrateParams(delegationStore, cdc)
+}
+
+func migrateWaitCompleteUndelegations(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	waitCompleteStore := prefix.NewStore(store, delegationtype.KeyPrefixWaitCompleteUndelegations)
+	recordStore := prefix.NewStore(store, delegationtype.KeyPrefixUndelegationInfo)
+	iterator := waitCompleteStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		height, rest, ok := parseLegacyHeightKey(key)
+		if !ok {
+			// the key has been in the new format
+			continue
+		}
+		if _, err := hexutil.DecodeUint64(rest); err != nil {
+			return err
+		}
+		waitCompleteStore.Delete(key)
+
+		value := recordStore.Get(values[i])
+		if value == nil {
+			continue
+		}
+		var record delegationtype.UndelegationRecord
+		cdc.MustUnmarshal(value, &record)
+		if !record.IsPending {
+			continue
+		}
+		waitCompleteStore.Set(delegationtype.GetWaitCompleteRecordKey(height, values[i]), values[i])
+	}
+	return nil
+}
+
+func migrateOperatorBondReleases(store storetypes.KVStore) error {
+	releaseStore := prefix.NewStore(store, delegationtype.KeyPrefixOperatorBondRelease)
+	iterator := releaseStore.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height, operatorAddr, ok := parseLegacyHeightKey(key)
+		if !ok {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(operatorAddr); err != nil {
+			return err
+		}
+		releaseStore.Delete(key)
+		releaseStore.Set(delegationtype.GetOperatorBondReleaseKey(height, operatorAddr), []byte{})
+	}
+	return nil
+}
+
+func buildOperatorDelegators(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	stateStore := prefix.NewStore(store, delegationtype.KeyPrefixRestakerDelegationInfo)
+	delegatorStore := prefix.NewStore(store, delegationtype.KeyPrefixOperatorDelegators)
+	iterator := stateStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys, err := delegationtype.ParseStakerAssetIDAndOperatorAddrFromKey(iterator.Key())
+		if err != nil {
+			// the key of the staker's total delegation amount is skipped
+			continue
+		}
+		var amounts delegationtype.DelegationAmounts
+		cdc.MustUnmarshal(iterator.Value(), &amounts)
+		if amounts.CanUndelegationAmount.IsZero() && amounts.WaitUndelegationAmount.IsZero() {
+			continue
+		}
+		delegatorStore.Set(delegationtype.GetOperatorDelegatorKey(keys.OperatorAddr, keys.AssetID, keys.StakerID), []byte{})
+	}
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	paramsKey := append(delegationtype.KeyPrefixParams, delegationtype.ParamsKey...)
+	var params delegationtype.Params
+	value := store.Get(paramsKey)
+	if value == nil {
+		params = delegationtype.DefaultParams()
+	} else {
+		cdc.MustUnmarshal(value, &params)
+	}
+	if params.EpochIdentifier == "" {
+		params.EpochIdentifier = delegationtype.DefaultEpochIdentifier
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store.Set(paramsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+// parseLegacyHeightKey parses the keys in the format of hexHeight+'/'+rest, it returns false if the key isn't
+// in that format.
+func parseLegacyHeightKey(key []byte) (uint64, string, bool) {
+	stringList := strings.SplitN(string(key), "/", 2)
+	if len(stringList) != 2 {
+		return 0, "", false
+	}
+	height, err := hexutil.DecodeUint64(stringList[0])
+	if err != nil {
+		return 0, "", false
+	}
+	return height, stringList[1], true
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// ApprovalDomainName and ApprovalDomainVersion are used in the EIP-712 domain of the delegation approval
+	ApprovalDomainName    = "ExocoreDelegation"
+	ApprovalDomainVersion = "1"
+)
+
+var (
+	eip712DomainTypeHash       = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId)"))
+	delegationApprovalTypeHash = crypto.Keccak256([]byte("DelegationApproval(string stakerID,string operator,string assetID,uint256 amount,string salt,uint256 expiry)"))
+)
+
+// GetDelegationApprovalDigest returns the EIP-712 digest that should be signed by the ApproveAddr of the operator
+// to approve the delegation of the staker. The chainID is the EVM chain ID of exoCore, the approval only applies to
+// the specified asset and amount, and the expiry is the unix time in seconds after which it can't be used.
+func GetDelegationApprovalDigest(chainID *big.Int, stakerID, operatorAddr, assetID string, amount *big.Int, salt string, expiry uint64) []byte {
+	domainSeparator := crypto.Keccak256(
+		eip712DomainTypeHash,
+		crypto.Keccak256([]byte(ApprovalDomainName)),
+		crypto.Keccak256([]byte(ApprovalDomainVersion)),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+	)
+	structHash := crypto.Keccak256(
+		delegationApprovalTypeHash,
+		crypto.Keccak256([]byte(stakerID)),
+		crypto.Keccak256([]byte(operatorAddr)),
+		crypto.Keccak256([]byte(assetID)),
+		common.LeftPadBytes(amount.Bytes(), 32),
+		crypto.Keccak256([]byte(salt)),
+		common.LeftPadBytes(new(big.Int).SetUint64(expiry).Bytes(), 32),
+	)
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash)
+}
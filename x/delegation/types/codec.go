@@ -26,6 +26,12 @@ const (
 	registerOperator            = "exocore/RegisterOperatorReq"
 	delegateAssetToOperator     = "exocore/MsgDelegation"
 	UndelegateAssetFromOperator = "exocore/MsgUndelegation"
+	updateApprovedStakers       = "exocore/MsgUpdateApprovedStakers"
+	updateOperatorInfo          = "exocore/MsgUpdateOperatorInfo"
+	deregisterOperator          = "exocore/MsgDeregisterOperator"
+	optIntoChainValidation      = "exocore/MsgOptIntoChainValidation"
+	optOutOfChainValidation     = "exocore/MsgOptOutOfChainValidation"
+	updateParams                = "exocore/delegation/MsgUpdateParams"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -41,6 +47,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&RegisterOperatorReq{},
 		&MsgDelegation{},
 		&MsgUndelegation{},
+		&MsgUpdateApprovedStakers{},
+		&MsgUpdateOperatorInfo{},
+		&MsgDeregisterOperator{},
+		&MsgOptIntoChainValidation{},
+		&MsgOptOutOfChainValidation{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -52,4 +64,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RegisterOperatorReq{}, registerOperator, nil)
 	cdc.RegisterConcrete(&MsgDelegation{}, delegateAssetToOperator, nil)
 	cdc.RegisterConcrete(&MsgUndelegation{}, UndelegateAssetFromOperator, nil)
+	cdc.RegisterConcrete(&MsgUpdateApprovedStakers{}, updateApprovedStakers, nil)
+	cdc.RegisterConcrete(&MsgUpdateOperatorInfo{}, updateOperatorInfo, nil)
+	cdc.RegisterConcrete(&MsgDeregisterOperator{}, deregisterOperator, nil)
+	cdc.RegisterConcrete(&MsgOptIntoChainValidation{}, optIntoChainValidation, nil)
+	cdc.RegisterConcrete(&MsgOptOutOfChainValidation{}, optOutOfChainValidation, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
 }
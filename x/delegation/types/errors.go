@@ -25,4 +25,46 @@ var (
 	ErrCliCmdInputArg = errorsmod.Register(ModuleName, 10, "there is an error in the input client command args")
 
 	ErrDelegationAmountTooBig = errorsmod.Register(ModuleName, 11, "the delegation amount is bigger than the canWithdraw amount")
+
+	ErrNotLinkedExoCoreAddr = errorsmod.Register(ModuleName, 12, "the signer isn't the exoCore address linked to the staker")
+
+	ErrMismatchedClientChain = errorsmod.Register(ModuleName, 13, "the client chain of the staker and asset are mismatched")
+
+	ErrInvalidOpAmount = errorsmod.Register(ModuleName, 14, "the delegation or Undelegation amount is invalid")
+
+	ErrInvalidApproveSignature = errorsmod.Register(ModuleName, 15, "the operator approval signature is missing or invalid")
+
+	ErrUsedSalt = errorsmod.Register(ModuleName, 16, "the approval salt has been used")
+
+	ErrInvalidGenesisData = errorsmod.Register(ModuleName, 17, "the genesis data supplied is invalid")
+
+	ErrInconsistentAssetState = errorsmod.Register(ModuleName, 18, "the delegation states are inconsistent with the asset states")
+
+	ErrNoParamsKey = errorsmod.Register(ModuleName, 19, "there is no stored key for delegation module params")
+
+	ErrInvalidParams = errorsmod.Register(ModuleName, 20, "the delegation module params are invalid")
+
+	ErrInvalidOperatorInfo = errorsmod.Register(ModuleName, 21, "the operator info is invalid")
+
+	ErrOperatorAlreadyExist = errorsmod.Register(ModuleName, 22, "the operator has been registered")
+
+	ErrOperatorDeregistering = errorsmod.Register(ModuleName, 23, "the operator is deregistering and its bond hasn't been returned")
+
+	ErrOperatorHasDelegations = errorsmod.Register(ModuleName, 24, "the operator still has delegations")
+
+	ErrInvalidConsensusPubKey = errorsmod.Register(ModuleName, 25, "the consensus public key is invalid")
+
+	ErrConsensusPubKeyUsed = errorsmod.Register(ModuleName, 26, "the consensus public key has been used by another validator")
+
+	ErrAlreadyOptedIntoChainValidation = errorsmod.Register(ModuleName, 27, "the operator has opted in to the chain validation")
+
+	ErrNotOptedIntoChainValidation = errorsmod.Register(ModuleName, 28, "the operator hasn't opted in to the chain validation")
+
+	ErrInvalidSelfBond = errorsmod.Register(ModuleName, 29, "the self bond is invalid")
+
+	ErrSnapshotNotFound = errorsmod.Register(ModuleName, 30, "the stake snapshot of the operator isn't found")
+
+	ErrApprovalExpired = errorsmod.Register(ModuleName, 31, "the operator approval has expired")
+
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 32, "the signer isn't the authority of the module")
 )
@@ -3,32 +3,41 @@ package types
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var CanUndelegationDelayHeight = uint64(10)
 
 type ISlashKeeper interface {
 	IsOperatorFrozen(ctx sdk.Context, opAddr sdk.AccAddress) bool
-	OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec
 }
 
-// VirtualISlashKeeper todo: When the actual keeper functionality has not been implemented yet, temporarily use the virtual keeper.
-type VirtualISlashKeeper struct{}
-
-func (VirtualISlashKeeper) IsOperatorFrozen(sdk.Context, sdk.AccAddress) bool {
-	return false
+type OperatorOptedInMiddlewareKeeper interface {
+	GetOperatorCanUndelegateHeight(ctx sdk.Context, assetID string, opAddr sdk.AccAddress, startHeight uint64) uint64
 }
 
-func (VirtualISlashKeeper) OperatorAssetSlashedProportion(sdk.Context, sdk.AccAddress, string, uint64, uint64) sdkmath.LegacyDec {
-	return sdkmath.LegacyNewDec(0)
+// RewardKeeper is used to settle the rewards of the delegator before its delegated amount changes.
+type RewardKeeper interface {
+	UpdateDelegatorRewardShares(ctx sdk.Context, stakerID, assetID, operatorAddr string, shares sdkmath.Int) error
 }
 
-type OperatorOptedInMiddlewareKeeper interface {
-	GetOperatorCanUndelegateHeight(ctx sdk.Context, assetID string, opAddr sdk.AccAddress, startHeight uint64) uint64
+// BankKeeper is used to lock and return the operator bond.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
-type VirtualOperatorOptedInKeeper struct{}
+// PriceFeedKeeper is used to weight the operators opted in to the chain validation by the USD value
+// of their restaked assets.
+type PriceFeedKeeper interface {
+	GetAssetUSDValue(ctx sdk.Context, assetID string, amount sdkmath.Int) (sdkmath.Int, error)
+}
 
-func (VirtualOperatorOptedInKeeper) GetOperatorCanUndelegateHeight(_ sdk.Context, _ string, _ sdk.AccAddress, startHeight uint64) uint64 {
-	return startHeight + CanUndelegationDelayHeight
+// StakingKeeper is used to remove the validators of the staking module when the operators take over
+// the validator set and to restore them when the chain validation is disabled, and to avoid the consensus
+// keys used by them.
+type StakingKeeper interface {
+	GetLastValidators(ctx sdk.Context) []stakingtypes.Validator
+	PowerReduction(ctx sdk.Context) sdkmath.Int
+	ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) stakingtypes.ValidatorI
 }
@@ -6,6 +6,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -41,6 +42,26 @@ const (
 	prefixStakerUndelegationInfo
 
 	prefixWaitCompleteUndelegations
+
+	prefixNativeUndelegationNonce
+
+	prefixOperatorDelegators
+
+	prefixParams
+
+	prefixOperatorBond
+
+	prefixOperatorBondRelease
+
+	prefixChainValidator
+
+	prefixChainValidatorByConsAddr
+
+	prefixLastValidatorPower
+
+	prefixOperatorSnapshot
+
+	prefixLatestSnapshotEpoch
 )
 
 var (
@@ -53,9 +74,9 @@ var (
 	// reStakerId +'/'+assetID+'/'+operatorAddr -> delegationAmounts
 
 	KeyPrefixRestakerDelegationInfo = []byte{prefixRestakerDelegationInfo}
-	// KeyPrefixDelegationUsedSalt key->value: operatorApproveAddr->map[salt]{}
+	// KeyPrefixDelegationUsedSalt key->value: operatorApproveAddr+'/'+salt->struct{}
 	KeyPrefixDelegationUsedSalt = []byte{prefixDelegationUsedSalt}
-	// KeyPrefixOperatorApprovedInfo key-value: operatorApproveAddr->map[reStakerId]{}
+	// KeyPrefixOperatorApprovedInfo key-value: operatorAddr+'/'+reStakerId->struct{}
 	KeyPrefixOperatorApprovedInfo = []byte{prefixOperatorApprovedInfo}
 
 	// KeyPrefixUndelegationInfo singleRecordKey = lzNonce+'/'+txHash+'/'+operatorAddr
@@ -63,10 +84,50 @@ var (
 	KeyPrefixUndelegationInfo = []byte{prefixUndelegationInfo}
 	// KeyPrefixStakerUndelegationInfo reStakerId+'/'+assetID+'/'+lzNonce -> singleRecordKey
 	KeyPrefixStakerUndelegationInfo = []byte{prefixStakerUndelegationInfo}
-	// KeyPrefixWaitCompleteUndelegations completeHeight +'/'+lzNonce -> singleRecordKey
+	// KeyPrefixWaitCompleteUndelegations completeHeight+singleRecordKey -> singleRecordKey
+	// Both the height and nonce are big-endian encoded.
 	KeyPrefixWaitCompleteUndelegations = []byte{prefixWaitCompleteUndelegations}
+
+	// KeyNativeUndelegationNonce key-value: key->the last nonce used by the undelegation initiated from exoCore directly
+	KeyNativeUndelegationNonce = []byte{prefixNativeUndelegationNonce}
+
+	// KeyPrefixOperatorDelegators is the reverse index of the delegation states
+	// key-value: operatorAddr+'/'+assetID+'/'+reStakerId->struct{}
+	// It's only kept when the delegated amount isn't zero.
+	KeyPrefixOperatorDelegators = []byte{prefixOperatorDelegators}
+
+	// KeyPrefixParams key-value: ParamsKey->Params
+	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixOperatorBond key-value: operatorAddr->OperatorBond
+	KeyPrefixOperatorBond = []byte{prefixOperatorBond}
+	// KeyPrefixOperatorBondRelease is the index of the bonds waiting to be returned
+	// key-value: releaseHeight+operatorAddr->struct{}, the height is big-endian encoded
+	KeyPrefixOperatorBondRelease = []byte{prefixOperatorBondRelease}
+
+	// KeyPrefixChainValidator key-value: operatorAddr->ChainValidator
+	KeyPrefixChainValidator = []byte{prefixChainValidator}
+	// KeyPrefixChainValidatorByConsAddr is the index of the consensus keys, it's used to
+	// avoid the same key being registered by different operators.
+	// key-value: consAddr->operatorAddr
+	KeyPrefixChainValidatorByConsAddr = []byte{prefixChainValidatorByConsAddr}
+	// KeyPrefixLastValidatorPower is the validator set returned by the EndBlock of the last block
+	// key-value: operatorAddr->ValidatorPower
+	KeyPrefixLastValidatorPower = []byte{prefixLastValidatorPower}
+
+	// KeyPrefixOperatorSnapshot is the stake snapshots of the operators taken at the start of the epochs
+	// key-value: epochIdentifier+'/'+epochNumber+operatorAddr->OperatorSnapshot, the epoch number is big-endian encoded
+	KeyPrefixOperatorSnapshot = []byte{prefixOperatorSnapshot}
+	// KeyPrefixLatestSnapshotEpoch key-value: epochIdentifier->epochNumber
+	KeyPrefixLatestSnapshotEpoch = []byte{prefixLatestSnapshotEpoch}
 )
 
+// ParamsKey is the key of the params in the KeyPrefixParams store
+var ParamsKey = []byte("Params")
+
+// NativeUndelegationNonceStart The undelegation records initiated from exoCore directly don't have a layerZero nonce,
+// so they use a self-increasing nonce starting from here to avoid conflicting with the layerZero nonce in the record keys.
+const NativeUndelegationNonceStart = uint64(1) << 63
+
 func GetDelegationStateKey(stakerID, assetID, operatorAddr string) []byte {
 	return []byte(strings.Join([]string{stakerID, assetID, operatorAddr}, "/"))
 }
@@ -85,6 +146,16 @@ func ParseStakerAssetIDAndOperatorAddrFromKey(key []byte) (keys *SingleDelegatio
 	return &SingleDelegationInfoReq{StakerID: stringList[0], AssetID: stringList[1], OperatorAddr: stringList[2]}, nil
 }
 
+func GetOperatorDelegatorKey(operatorAddr, assetID, stakerID string) []byte {
+	return []byte(strings.Join([]string{operatorAddr, assetID, stakerID}, "/"))
+}
+
+func GetOperatorDelegatorsIteratorPrefix(operatorAddr, assetID string) []byte {
+	tmp := []byte(strings.Join([]string{operatorAddr, assetID}, "/"))
+	tmp = append(tmp, '/')
+	return tmp
+}
+
 func GetUndelegationRecordKey(lzNonce uint64, txHash string, operatorAddr string) []byte {
 	return []byte(strings.Join([]string{hexutil.EncodeUint64(lzNonce), txHash, operatorAddr}, "/"))
 }
@@ -93,6 +164,67 @@ func GetStakerUndelegationRecordKey(stakerID, assetID string, lzNonce uint64) []
 	return []byte(strings.Join([]string{stakerID, assetID, hexutil.EncodeUint64(lzNonce)}, "/"))
 }
 
-func GetWaitCompleteRecordKey(height, lzNonce uint64) []byte {
-	return []byte(strings.Join([]string{hexutil.EncodeUint64(height), hexutil.EncodeUint64(lzNonce)}, "/"))
+func GetStakerUndelegationIteratorPrefix(stakerID, assetID string) []byte {
+	tmp := []byte(strings.Join([]string{stakerID, assetID}, "/"))
+	tmp = append(tmp, '/')
+	return tmp
+}
+
+// GetWaitCompleteRecordKey returns the key of the KeyPrefixWaitCompleteUndelegations store. The height is
+// big-endian encoded, so the records can be iterated by the order of the complete height. The key of the
+// undelegation record is appended, because the lzNonces of different client chains can be the same.
+func GetWaitCompleteRecordKey(height uint64, recordKey []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(height), recordKey...)
+}
+
+// GetHeightIteratorEnd returns the exclusive end of the keys whose height is at or before the height, it's used
+// to iterate the stores indexed by the big-endian encoded height.
+func GetHeightIteratorEnd(height uint64) []byte {
+	return sdk.Uint64ToBigEndian(height + 1)
+}
+
+// ParseHeightFromWaitCompleteKey returns the complete height in the key of the KeyPrefixWaitCompleteUndelegations store.
+func ParseHeightFromWaitCompleteKey(key []byte) (uint64, error) {
+	if len(key) <= 8 {
+		return 0, errorsmod.Wrap(ErrParseDelegationKey, fmt.Sprintf("the length of the key should be greater than 8, got:%d", len(key)))
+	}
+	return sdk.BigEndianToUint64(key[:8]), nil
+}
+
+// GetOperatorBondReleaseKey returns the key of the KeyPrefixOperatorBondRelease store, the height is
+// big-endian encoded as the one in the KeyPrefixWaitCompleteUndelegations store.
+func GetOperatorBondReleaseKey(height uint64, operatorAddr string) []byte {
+	return append(sdk.Uint64ToBigEndian(height), []byte(operatorAddr)...)
+}
+
+// ParseOperatorBondReleaseKey returns the release height and the operator address in the key of the
+// KeyPrefixOperatorBondRelease store.
+func ParseOperatorBondReleaseKey(key []byte) (uint64, string, error) {
+	if len(key) <= 8 {
+		return 0, "", errorsmod.Wrap(ErrParseDelegationKey, fmt.Sprintf("the key is too short:%x", key))
+	}
+	return sdk.BigEndianToUint64(key[:8]), string(key[8:]), nil
+}
+
+// GetSnapshotEpochPrefix returns the prefix of the snapshots taken at the start of the epoch.
+func GetSnapshotEpochPrefix(epochIdentifier string, epochNumber int64) []byte {
+	return append(GetSnapshotIdentifierPrefix(epochIdentifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// GetSnapshotIdentifierPrefix returns the prefix of the snapshots taken at the start of the epochs with the identifier.
+func GetSnapshotIdentifierPrefix(epochIdentifier string) []byte {
+	return append([]byte(epochIdentifier), '/')
+}
+
+// GetOperatorSnapshotKey returns the key of the KeyPrefixOperatorSnapshot store.
+func GetOperatorSnapshotKey(epochIdentifier string, epochNumber int64, operatorAddr string) []byte {
+	return append(GetSnapshotEpochPrefix(epochIdentifier, epochNumber), []byte(operatorAddr)...)
+}
+
+func GetUsedSaltKey(approveAddr, salt string) []byte {
+	return []byte(strings.Join([]string{approveAddr, salt}, "/"))
+}
+
+func GetOperatorApprovedStakerKey(operatorAddr, stakerID string) []byte {
+	return []byte(strings.Join([]string{operatorAddr, stakerID}, "/"))
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +11,12 @@ var (
 	_ sdk.Msg = &RegisterOperatorReq{}
 	_ sdk.Msg = &MsgDelegation{}
 	_ sdk.Msg = &MsgUndelegation{}
+	_ sdk.Msg = &MsgUpdateApprovedStakers{}
+	_ sdk.Msg = &MsgUpdateOperatorInfo{}
+	_ sdk.Msg = &MsgDeregisterOperator{}
+	_ sdk.Msg = &MsgOptIntoChainValidation{}
+	_ sdk.Msg = &MsgOptOutOfChainValidation{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
@@ -22,7 +30,10 @@ func (m *RegisterOperatorReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
-	return nil
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidOperatorInfo, "the operator info is nil")
+	}
+	return m.Info.ValidateBasic()
 }
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -38,8 +49,17 @@ func (m *MsgDelegation) GetSigners() []sdk.AccAddress {
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgDelegation) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(m.BaseInfo.FromAddress); err != nil {
-		return errorsmod.Wrap(err, "invalid from address")
+	if err := validateDelegationIncOrDecInfo(m.BaseInfo); err != nil {
+		return err
+	}
+	// each approval should be used by the delegation to its operator
+	for operator, approvedInfo := range m.PerOperatorApprovedInfos {
+		if _, ok := m.BaseInfo.PerOperatorAmounts[operator]; !ok {
+			return errorsmod.Wrap(ErrCliCmdInputArg, fmt.Sprintf("the approved operator isn't delegated to:%s", operator))
+		}
+		if approvedInfo == nil {
+			return errorsmod.Wrap(ErrCliCmdInputArg, fmt.Sprintf("the approval of the operator is nil:%s", operator))
+		}
 	}
 	return nil
 }
@@ -57,13 +77,178 @@ func (m *MsgUndelegation) GetSigners() []sdk.AccAddress {
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUndelegation) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(m.BaseInfo.FromAddress); err != nil {
+	return validateDelegationIncOrDecInfo(m.BaseInfo)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUndelegation) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateApprovedStakers message.
+func (m *MsgUpdateApprovedStakers) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateApprovedStakers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
+	if len(m.ApprovedStakerIDs) == 0 && len(m.RevokedStakerIDs) == 0 {
+		return errorsmod.Wrap(ErrCliCmdInputArg, "both the approved and revoked stakerIDs are empty")
+	}
 	return nil
 }
 
 // GetSignBytes implements the LegacyMsg interface.
-func (m *MsgUndelegation) GetSignBytes() []byte {
+func (m *MsgUpdateApprovedStakers) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateOperatorInfo message.
+func (m *MsgUpdateOperatorInfo) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateOperatorInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return validateApproveAddr(m.ApproveAddr)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUpdateOperatorInfo) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgDeregisterOperator message.
+func (m *MsgDeregisterOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgDeregisterOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgDeregisterOperator) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgOptIntoChainValidation message.
+func (m *MsgOptIntoChainValidation) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data, the self bond can be zero if the
+// operator bond is enough.
+func (m *MsgOptIntoChainValidation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if err := ValidateConsensusPubKey(m.ConsensusPubKey); err != nil {
+		return err
+	}
+	if err := m.SelfBond.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSelfBond, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgOptIntoChainValidation) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgOptOutOfChainValidation message.
+func (m *MsgOptOutOfChainValidation) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgOptOutOfChainValidation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgOptOutOfChainValidation) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	return nil
+}
+
+// ValidateBasic checks the addresses in the operator info, the EarningsAddr is required while the
+// ApproveAddr is optional.
+func (info *OperatorInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(info.EarningsAddr); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperatorInfo, fmt.Sprintf("invalid earnings address:%s", info.EarningsAddr))
+	}
+	return validateApproveAddr(info.ApproveAddr)
+}
+
+func validateApproveAddr(approveAddr string) error {
+	if approveAddr == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(approveAddr); err != nil {
+		return errorsmod.Wrap(ErrInvalidOperatorInfo, fmt.Sprintf("invalid approve address:%s", approveAddr))
+	}
+	return nil
+}
+
+// validateDelegationIncOrDecInfo checks the delegation or undelegation info initiated from exoCore directly
+func validateDelegationIncOrDecInfo(info *DelegationIncOrDecInfo) error {
+	if info == nil {
+		return errorsmod.Wrap(ErrCliCmdInputArg, "the baseInfo is nil")
+	}
+	if _, err := sdk.AccAddressFromBech32(info.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if info.StakerID == "" || info.AssetID == "" {
+		return errorsmod.Wrap(ErrCliCmdInputArg, fmt.Sprintf("the stakerID or assetID is empty,stakerID:%s,assetID:%s", info.StakerID, info.AssetID))
+	}
+	if len(info.PerOperatorAmounts) == 0 {
+		return errorsmod.Wrap(ErrCliCmdInputArg, "the perOperatorAmounts is empty")
+	}
+	for operator, value := range info.PerOperatorAmounts {
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return errorsmod.Wrap(OperatorAddrIsNotAccAddr, fmt.Sprintf("the operator address is:%s", operator))
+		}
+		if value == nil || value.Amount.IsNil() || !value.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidOpAmount, fmt.Sprintf("the amount for operator %s isn't positive", operator))
+		}
+	}
 	return nil
 }
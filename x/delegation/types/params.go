@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/evmos/evmos/v14/x/epochs/types"
+)
+
+// DefaultDeregistrationDelayBlocks is the default number of blocks that the bond of a deregistered operator
+// waits before it's returned
+const DefaultDeregistrationDelayBlocks = uint64(100)
+
+// DefaultMaxValidators is the default max number of the operators in the validator set
+const DefaultMaxValidators = uint32(100)
+
+// DefaultEpochIdentifier is the default identifier of the epochs at whose start the stake snapshots are taken
+const DefaultEpochIdentifier = epochstypes.DayEpochID
+
+// NewParams creates a new Params instance
+func NewParams(
+	operatorBond sdk.Coin, deregistrationDelayBlocks uint64,
+	chainValidationEnabled bool, maxValidators uint32, minSelfBond sdkmath.Int,
+	epochIdentifier string,
+) Params {
+	return Params{
+		OperatorBond:              operatorBond,
+		DeregistrationDelayBlocks: deregistrationDelayBlocks,
+		ChainValidationEnabled:    chainValidationEnabled,
+		MaxValidators:             maxValidators,
+		MinSelfBond:               minSelfBond,
+		EpochIdentifier:           epochIdentifier,
+	}
+}
+
+// DefaultParams returns a default set of parameters, the operator bond and the chain validation
+// are disabled by default.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.NewCoin(utils.BaseDenom, sdkmath.ZeroInt()), DefaultDeregistrationDelayBlocks,
+		false, DefaultMaxValidators, sdkmath.ZeroInt(),
+		DefaultEpochIdentifier,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := p.OperatorBond.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("invalid operator bond:%s", err))
+	}
+	if p.MaxValidators == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "the max validators should be positive")
+	}
+	if p.MinSelfBond.IsNil() || p.MinSelfBond.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("invalid min self bond:%s", p.MinSelfBond))
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(p.EpochIdentifier); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("invalid epoch identifier:%s", err))
+	}
+	return nil
+}
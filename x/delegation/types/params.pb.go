@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/delegation/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the delegation module.
+type Params struct {
+	// operatorBond is the amount of the native token locked by the operator when it registers.
+	// A zero amount means no bond is required.
+	OperatorBond types.Coin `protobuf:"bytes,1,opt,name=operatorBond,proto3" json:"operatorBond"`
+	// deregistrationDelayBlocks is the number of blocks that the bond of a deregistered operator
+	// needs to wait before it's returned.
+	DeregistrationDelayBlocks uint64 `protobuf:"varint,2,opt,name=deregistrationDelayBlocks,proto3" json:"deregistrationDelayBlocks,omitempty"`
+	// chainValidationEnabled makes the operators opted in to the Exocore chain validation take over the
+	// validator set from the staking module, they are weighted by the USD value of their restaked assets.
+	// It's switched by the governance through MsgUpdateParams, the validator set is handed back to the
+	// staking module when it's switched off.
+	ChainValidationEnabled bool `protobuf:"varint,3,opt,name=chainValidationEnabled,proto3" json:"chainValidationEnabled,omitempty"`
+	// maxValidators is the max number of the operators in the validator set.
+	MaxValidators uint32 `protobuf:"varint,4,opt,name=maxValidators,proto3" json:"maxValidators,omitempty"`
+	// minSelfBond is the min amount of the operator bond required to be in the validator set, it's in the
+	// denom of the operator bond.
+	MinSelfBond cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=minSelfBond,proto3,customtype=cosmossdk.io/math.Int" json:"minSelfBond"`
+	// epochIdentifier is the identifier of the epochs in the x/epochs module at whose start the
+	// stake snapshots of the operators are taken.
+	EpochIdentifier string `protobuf:"bytes,6,opt,name=epochIdentifier,proto3" json:"epochIdentifier,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db40687ab9343fbe, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetOperatorBond() types.Coin {
+	if m != nil {
+		return m.OperatorBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDeregistrationDelayBlocks() uint64 {
+	if m != nil {
+		return m.DeregistrationDelayBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChainValidationEnabled() bool {
+	if m != nil {
+		return m.ChainValidationEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// OperatorBond is the bond locked by the operator.
+type OperatorBond struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// releaseHeight is the height at which the bond is returned to the operator, it's zero if
+	// the operator hasn't been deregistered.
+	ReleaseHeight uint64 `protobuf:"varint,2,opt,name=releaseHeight,proto3" json:"releaseHeight,omitempty"`
+}
+
+func (m *OperatorBond) Reset()         { *m = OperatorBond{} }
+func (m *OperatorBond) String() string { return proto.CompactTextString(m) }
+func (*OperatorBond) ProtoMessage()    {}
+func (*OperatorBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db40687ab9343fbe, []int{1}
+}
+func (m *OperatorBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorBond.Merge(m, src)
+}
+func (m *OperatorBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorBond proto.InternalMessageInfo
+
+func (m *OperatorBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *OperatorBond) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "exocore.delegation.v1.Params")
+	proto.RegisterType((*OperatorBond)(nil), "exocore.delegation.v1.OperatorBond")
+}
+
+func init() {
+	proto.RegisterFile("exocore/delegation/v1/params.proto", fileDescriptor_db40687ab9343fbe)
+}
+
+var fileDescriptor_db40687ab9343fbe = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xde, 0xa9, 0xeb, 0xa2, 0xd3, 0x16, 0x21, 0x58, 0xc9, 0xf6, 0x90, 0x86, 0xc5, 0x43, 0x40,
+	0x9c, 0x61, 0x55, 0xf4, 0xe2, 0x29, 0xb5, 0xe0, 0x1e, 0xac, 0x12, 0xc1, 0x83, 0x17, 0x99, 0x24,
+	0xaf, 0xc9, 0xb0, 0xc9, 0xbc, 0x30, 0x33, 0x5d, 0xb7, 0xff, 0xc2, 0x1f, 0xe3, 0x8f, 0xe8, 0xb1,
+	0x78, 0x12, 0x0f, 0x45, 0x76, 0xff, 0x88, 0x24, 0x19, 0x71, 0x57, 0x28, 0xf4, 0x36, 0xef, 0xfb,
+	0xbe, 0xf7, 0xde, 0x7c, 0x8f, 0x8f, 0x4e, 0x60, 0x89, 0x19, 0x6a, 0xe0, 0x39, 0x54, 0x50, 0x08,
+	0x2b, 0x51, 0xf1, 0xc5, 0x94, 0x37, 0x42, 0x8b, 0xda, 0xb0, 0x46, 0xa3, 0x45, 0xef, 0xc0, 0x69,
+	0xd8, 0x3f, 0x0d, 0x5b, 0x4c, 0x0f, 0x83, 0x0c, 0x4d, 0x8d, 0x86, 0xa7, 0xc2, 0x00, 0x5f, 0x4c,
+	0x53, 0xb0, 0x62, 0xca, 0x33, 0x94, 0xaa, 0x6f, 0x3b, 0x1c, 0xf7, 0xfc, 0x97, 0xae, 0xe2, 0x7d,
+	0xe1, 0xa8, 0x87, 0x05, 0x16, 0xd8, 0xe3, 0xed, 0xab, 0x47, 0x27, 0xab, 0x1d, 0x3a, 0xfa, 0xd0,
+	0x2d, 0xf6, 0x8e, 0xe9, 0x1e, 0x36, 0xa0, 0x85, 0x45, 0x1d, 0xa3, 0xca, 0x7d, 0x12, 0x92, 0x68,
+	0xf7, 0xd9, 0x98, 0xb9, 0x29, 0xed, 0x4a, 0xe6, 0x56, 0xb2, 0x63, 0x94, 0x2a, 0x1e, 0x5e, 0x5e,
+	0x1f, 0x0d, 0x92, 0xad, 0x26, 0xef, 0x35, 0x1d, 0xe7, 0xa0, 0xa1, 0x90, 0xc6, 0xea, 0xee, 0xd7,
+	0x6f, 0xa0, 0x12, 0x17, 0x71, 0x85, 0xd9, 0xdc, 0xf8, 0x3b, 0x21, 0x89, 0x86, 0xc9, 0xcd, 0x02,
+	0xef, 0x25, 0x7d, 0x94, 0x95, 0x42, 0xaa, 0x4f, 0xa2, 0x92, 0x79, 0xc7, 0x9e, 0x28, 0x91, 0x56,
+	0x90, 0xfb, 0x77, 0x42, 0x12, 0xdd, 0x4b, 0x6e, 0x60, 0xbd, 0xc7, 0x74, 0xbf, 0x16, 0x4b, 0x87,
+	0xa3, 0x36, 0xfe, 0x30, 0x24, 0xd1, 0x7e, 0xb2, 0x0d, 0x7a, 0xef, 0xe8, 0x6e, 0x2d, 0xd5, 0x47,
+	0xa8, 0xce, 0x3a, 0x7f, 0x77, 0x43, 0x12, 0xdd, 0x8f, 0x9f, 0xb4, 0x26, 0x7e, 0x5d, 0x1f, 0x1d,
+	0xf4, 0x36, 0x4d, 0x3e, 0x67, 0x12, 0x79, 0x2d, 0x6c, 0xc9, 0x66, 0xca, 0xfe, 0xf8, 0xfe, 0x94,
+	0x3a, 0xff, 0x33, 0x65, 0x93, 0xcd, 0x7e, 0x2f, 0xa2, 0x0f, 0xa0, 0xc1, 0xac, 0x9c, 0xe5, 0xa0,
+	0xac, 0x3c, 0x93, 0xa0, 0xfd, 0x51, 0x3b, 0x32, 0xf9, 0x1f, 0x9e, 0xd4, 0x74, 0xef, 0xfd, 0xe6,
+	0x91, 0x5e, 0xd1, 0x91, 0xa8, 0xf1, 0x5c, 0xd9, 0xdb, 0xde, 0xd8, 0xc9, 0x5b, 0x9f, 0x1a, 0x2a,
+	0x10, 0x06, 0xde, 0x82, 0x2c, 0x4a, 0xeb, 0x2e, 0xba, 0x0d, 0xc6, 0xa7, 0x97, 0xab, 0x80, 0x5c,
+	0xad, 0x02, 0xf2, 0x7b, 0x15, 0x90, 0x6f, 0xeb, 0x60, 0x70, 0xb5, 0x0e, 0x06, 0x3f, 0xd7, 0xc1,
+	0xe0, 0xf3, 0x8b, 0x42, 0xda, 0xf2, 0x3c, 0x65, 0x19, 0xd6, 0xfc, 0xa4, 0x0f, 0xd8, 0x29, 0xd8,
+	0xaf, 0xa8, 0xe7, 0xfc, 0x6f, 0x26, 0x97, 0x9b, 0xa9, 0xb4, 0x17, 0x0d, 0x98, 0x74, 0xd4, 0x45,
+	0xe5, 0xf9, 0x9f, 0x01, 0x00, 0x60, 0x68, 0xad, 0xf0, 0xb8, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.MinSelfBond.Size()
+		i -= size
+		if _, err := m.MinSelfBond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxValidators != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainValidationEnabled {
+		i--
+		if m.ChainValidationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeregistrationDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeregistrationDelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.OperatorBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OperatorBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DeregistrationDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DeregistrationDelayBlocks))
+	}
+	if m.ChainValidationEnabled {
+		n += 2
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovParams(uint64(m.MaxValidators))
+	}
+	l = m.MinSelfBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovParams(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregistrationDelayBlocks", wireType)
+			}
+			m.DeregistrationDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeregistrationDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainValidationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChainValidationEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfBond", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +32,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// UndelegationRecordType is used to filter the undelegation records in the queries.
+type UndelegationRecordType int32
+
+const (
+	// UNDELEGATION_RECORD_TYPE_ALL returns both the pending and completed records.
+	UndelegationRecordType_UNDELEGATION_RECORD_TYPE_ALL UndelegationRecordType = 0
+	// UNDELEGATION_RECORD_TYPE_PENDING only returns the pending records.
+	UndelegationRecordType_UNDELEGATION_RECORD_TYPE_PENDING UndelegationRecordType = 1
+	// UNDELEGATION_RECORD_TYPE_COMPLETED only returns the completed records.
+	UndelegationRecordType_UNDELEGATION_RECORD_TYPE_COMPLETED UndelegationRecordType = 2
+)
+
+var UndelegationRecordType_name = map[int32]string{
+	0: "UNDELEGATION_RECORD_TYPE_ALL",
+	1: "UNDELEGATION_RECORD_TYPE_PENDING",
+	2: "UNDELEGATION_RECORD_TYPE_COMPLETED",
+}
+
+var UndelegationRecordType_value = map[string]int32{
+	"UNDELEGATION_RECORD_TYPE_ALL":       0,
+	"UNDELEGATION_RECORD_TYPE_PENDING":   1,
+	"UNDELEGATION_RECORD_TYPE_COMPLETED": 2,
+}
+
+func (x UndelegationRecordType) String() string {
+	return proto.EnumName(UndelegationRecordType_name, int32(x))
+}
+
+func (UndelegationRecordType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{0}
+}
+
 type DelegationInfoReq struct {
 	StakerID string `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
 	AssetID  string `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
@@ -271,534 +303,2839 @@ func (m *QueryOperatorInfoReq) GetOperatorAddr() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*DelegationInfoReq)(nil), "exocore.delegation.v1.DelegationInfoReq")
-	proto.RegisterType((*DelegationAmounts)(nil), "exocore.delegation.v1.DelegationAmounts")
-	proto.RegisterType((*QueryDelegationInfoResponse)(nil), "exocore.delegation.v1.QueryDelegationInfoResponse")
-	proto.RegisterMapType((map[string]*DelegationAmounts)(nil), "exocore.delegation.v1.QueryDelegationInfoResponse.DelegationInfosEntry")
-	proto.RegisterType((*SingleDelegationInfoReq)(nil), "exocore.delegation.v1.SingleDelegationInfoReq")
-	proto.RegisterType((*QueryOperatorInfoReq)(nil), "exocore.delegation.v1.QueryOperatorInfoReq")
+type QueryStakerUndelegationsReq struct {
+	StakerID   string                 `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	AssetID    string                 `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	RecordType UndelegationRecordType `protobuf:"varint,3,opt,name=recordType,proto3,enum=exocore.delegation.v1.UndelegationRecordType" json:"recordType,omitempty"`
+	Pagination *query.PageRequest     `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("exocore/delegation/v1/query.proto", fileDescriptor_aab345e1cf20490c) }
-
-var fileDescriptor_aab345e1cf20490c = []byte{
-	// 630 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x5f, 0x6b, 0xd3, 0x50,
-	0x1c, 0xed, 0x4d, 0x9d, 0x7f, 0xee, 0x06, 0xba, 0x6b, 0xa7, 0x5d, 0x26, 0xd9, 0x8c, 0x30, 0xca,
-	0xa4, 0x89, 0xab, 0x0a, 0x22, 0x55, 0xd8, 0xec, 0x18, 0x79, 0xd9, 0x30, 0x9b, 0x08, 0xbe, 0x48,
-	0xd6, 0x5c, 0x63, 0x68, 0x7a, 0x6f, 0x9a, 0x7b, 0x5b, 0xdb, 0x57, 0x7d, 0xf1, 0x45, 0x10, 0xc4,
-	0x2f, 0xe1, 0x93, 0x0f, 0xfd, 0x10, 0x7b, 0x1c, 0xf3, 0x45, 0x7c, 0x18, 0xd2, 0x0a, 0xbe, 0xfa,
-	0x05, 0x04, 0x69, 0x92, 0x76, 0x69, 0x96, 0x6c, 0x0e, 0xf6, 0xd4, 0xdc, 0x9c, 0xd3, 0x73, 0x4e,
-	0xee, 0xef, 0xe4, 0x06, 0xde, 0xc4, 0x6d, 0x5a, 0xa5, 0x1e, 0x56, 0x4d, 0xec, 0x60, 0xcb, 0xe0,
-	0x36, 0x25, 0x6a, 0x6b, 0x59, 0x6d, 0x34, 0xb1, 0xd7, 0x51, 0x5c, 0x8f, 0x72, 0x8a, 0x66, 0x42,
-	0x8a, 0x72, 0x48, 0x51, 0x5a, 0xcb, 0x62, 0xce, 0xa2, 0x16, 0xf5, 0x19, 0xea, 0xe0, 0x2a, 0x20,
-	0x8b, 0x37, 0x2c, 0x4a, 0x2d, 0x07, 0xab, 0x86, 0x6b, 0xab, 0x06, 0x21, 0x94, 0xfb, 0x7c, 0x16,
-	0xa2, 0x73, 0x55, 0xca, 0xea, 0x94, 0x05, 0xf2, 0x31, 0x1f, 0x71, 0x36, 0x00, 0x5f, 0x06, 0x9a,
-	0xc1, 0x22, 0x84, 0xa4, 0xe4, 0x94, 0xbc, 0x1d, 0xe0, 0xb2, 0x06, 0xa7, 0x2b, 0x23, 0x44, 0x23,
-	0xaf, 0xa8, 0x8e, 0x1b, 0x48, 0x84, 0x17, 0x19, 0x37, 0x6a, 0xd8, 0xd3, 0x2a, 0x79, 0xb0, 0x00,
-	0x0a, 0x97, 0xf4, 0xd1, 0x1a, 0xe5, 0xe1, 0x05, 0x83, 0x31, 0xcc, 0xb5, 0x4a, 0x5e, 0xf0, 0xa1,
-	0xe1, 0x52, 0xfe, 0x0b, 0xa2, 0x5a, 0x2b, 0x75, 0xda, 0x24, 0x9c, 0x21, 0x0f, 0xce, 0x3c, 0x31,
-	0xc8, 0x33, 0x62, 0xc6, 0x90, 0x40, 0x78, 0xb5, 0xbc, 0x7b, 0x30, 0x9f, 0xf9, 0x71, 0x30, 0xbf,
-	0x68, 0xd9, 0xfc, 0x75, 0x73, 0x47, 0xa9, 0xd2, 0x7a, 0xf8, 0x00, 0xe1, 0x4f, 0x91, 0x99, 0x35,
-	0x95, 0x77, 0x5c, 0xcc, 0x14, 0x8d, 0xf0, 0xfd, 0x6e, 0x11, 0x86, 0xcf, 0xa7, 0x11, 0xae, 0x27,
-	0x4b, 0x23, 0x0e, 0xaf, 0x3d, 0x37, 0x6c, 0x9e, 0x60, 0x2a, 0x9c, 0x81, 0x69, 0x8a, 0xb6, 0xfc,
-	0x47, 0x80, 0x73, 0x4f, 0x07, 0x53, 0x89, 0x6f, 0x28, 0x73, 0x29, 0x61, 0x18, 0xb9, 0x30, 0xb7,
-	0x4d, 0xb9, 0xe1, 0x84, 0x30, 0x36, 0xcf, 0x70, 0x23, 0x12, 0x95, 0x51, 0x03, 0x5e, 0x36, 0xc7,
-	0xb2, 0xb0, 0xbc, 0xb0, 0x90, 0x2d, 0x4c, 0x96, 0xd6, 0x95, 0xc4, 0x66, 0x2a, 0xc7, 0xc4, 0x57,
-	0xc6, 0x6f, 0xb3, 0x35, 0xc2, 0xbd, 0x8e, 0x1e, 0xd7, 0x17, 0x1d, 0x98, 0x4b, 0x22, 0xa2, 0x2b,
-	0x30, 0x5b, 0xc3, 0x9d, 0xb0, 0x4d, 0x83, 0x4b, 0xf4, 0x18, 0x4e, 0xb4, 0x0c, 0xa7, 0x89, 0xfd,
-	0x99, 0x4c, 0x96, 0x0a, 0x29, 0x91, 0x8e, 0x34, 0x4a, 0x0f, 0xfe, 0xf6, 0x50, 0x78, 0x00, 0xe4,
-	0x0f, 0x00, 0x5e, 0xdf, 0xb2, 0x89, 0xe5, 0xe0, 0xd3, 0x95, 0xb8, 0x0c, 0xa7, 0xa8, 0x8b, 0x3d,
-	0x83, 0x53, 0x6f, 0xc5, 0x34, 0xbd, 0xb0, 0x16, 0xf9, 0xfd, 0x6e, 0x31, 0x17, 0x6e, 0xea, 0xe0,
-	0x36, 0x66, 0x6c, 0x8b, 0x7b, 0x36, 0xb1, 0xf4, 0x31, 0x76, 0xf4, 0x15, 0xc8, 0x8e, 0xbf, 0x02,
-	0xdb, 0x30, 0xe7, 0x6f, 0xe1, 0x66, 0x48, 0x1f, 0x66, 0x29, 0xc3, 0xa9, 0xcd, 0xa8, 0x1f, 0x38,
-	0xc9, 0x2f, 0xca, 0x2e, 0xbd, 0x3b, 0x07, 0x27, 0x7c, 0x59, 0xf4, 0x19, 0xc0, 0xe9, 0x23, 0x06,
-	0xe8, 0xf6, 0x71, 0xd3, 0x8c, 0x45, 0x11, 0x6f, 0xa5, 0x90, 0xa3, 0x3c, 0x59, 0x79, 0xfb, 0xed,
-	0xd7, 0x27, 0xa1, 0x80, 0x16, 0xd5, 0xe4, 0xe3, 0x63, 0x1d, 0xf3, 0xb1, 0x04, 0x5f, 0x00, 0xbc,
-	0x9a, 0xd0, 0x1d, 0x74, 0xf2, 0x50, 0x87, 0xb1, 0x4a, 0xa7, 0x6f, 0xa4, 0x7c, 0xff, 0xfd, 0xef,
-	0xaf, 0x4b, 0xc0, 0x8f, 0xba, 0x84, 0x0a, 0xe9, 0x51, 0x63, 0xa1, 0xba, 0x00, 0xce, 0xfa, 0xb2,
-	0x49, 0xcd, 0x41, 0x4a, 0x4a, 0x90, 0x94, 0x9a, 0x89, 0xff, 0xdd, 0x5b, 0xf9, 0xd1, 0x61, 0xdc,
-	0x12, 0xba, 0x93, 0x12, 0x37, 0x35, 0xd8, 0xea, 0xc6, 0x6e, 0x4f, 0x02, 0x7b, 0x3d, 0x09, 0xfc,
-	0xec, 0x49, 0xe0, 0x63, 0x5f, 0xca, 0xec, 0xf5, 0xa5, 0xcc, 0xf7, 0xbe, 0x94, 0x79, 0x71, 0x2f,
-	0x72, 0x64, 0xac, 0x05, 0xaa, 0x1b, 0x98, 0xbf, 0xa1, 0x5e, 0x6d, 0x64, 0xd2, 0x8e, 0xda, 0xf8,
-	0x87, 0xc8, 0xce, 0x79, 0xff, 0x03, 0x70, 0xf7, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0x51,
-	0x51, 0x30, 0xc8, 0x06, 0x00, 0x00,
+func (m *QueryStakerUndelegationsReq) Reset()         { *m = QueryStakerUndelegationsReq{} }
+func (m *QueryStakerUndelegationsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerUndelegationsReq) ProtoMessage()    {}
+func (*QueryStakerUndelegationsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{5}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	QueryOperatorInfo(ctx context.Context, in *QueryOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error)
-	// Balance queries the balance of a single coin for a single account.
-	QueryDelegationInfo(ctx context.Context, in *DelegationInfoReq, opts ...grpc.CallOption) (*QueryDelegationInfoResponse, error)
-	QuerySingleDelegationInfo(ctx context.Context, in *SingleDelegationInfoReq, opts ...grpc.CallOption) (*DelegationAmounts, error)
+func (m *QueryStakerUndelegationsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryStakerUndelegationsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerUndelegationsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryStakerUndelegationsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerUndelegationsReq.Merge(m, src)
+}
+func (m *QueryStakerUndelegationsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerUndelegationsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerUndelegationsReq.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryOperatorInfo(ctx context.Context, in *QueryOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error) {
-	out := new(OperatorInfo)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryOperatorInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStakerUndelegationsReq proto.InternalMessageInfo
+
+func (m *QueryStakerUndelegationsReq) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryDelegationInfo(ctx context.Context, in *DelegationInfoReq, opts ...grpc.CallOption) (*QueryDelegationInfoResponse, error) {
-	out := new(QueryDelegationInfoResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryDelegationInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakerUndelegationsReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QuerySingleDelegationInfo(ctx context.Context, in *SingleDelegationInfoReq, opts ...grpc.CallOption) (*DelegationAmounts, error) {
-	out := new(DelegationAmounts)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QuerySingleDelegationInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStakerUndelegationsReq) GetRecordType() UndelegationRecordType {
+	if m != nil {
+		return m.RecordType
 	}
-	return out, nil
+	return UndelegationRecordType_UNDELEGATION_RECORD_TYPE_ALL
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	QueryOperatorInfo(context.Context, *QueryOperatorInfoReq) (*OperatorInfo, error)
-	// Balance queries the balance of a single coin for a single account.
-	QueryDelegationInfo(context.Context, *DelegationInfoReq) (*QueryDelegationInfoResponse, error)
-	QuerySingleDelegationInfo(context.Context, *SingleDelegationInfoReq) (*DelegationAmounts, error)
+func (m *QueryStakerUndelegationsReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryUndelegationsResponse struct {
+	Undelegations []*UndelegationRecord `protobuf:"bytes,1,rep,name=undelegations,proto3" json:"undelegations,omitempty"`
+	Pagination    *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) QueryOperatorInfo(ctx context.Context, req *QueryOperatorInfoReq) (*OperatorInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorInfo not implemented")
+func (m *QueryUndelegationsResponse) Reset()         { *m = QueryUndelegationsResponse{} }
+func (m *QueryUndelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUndelegationsResponse) ProtoMessage()    {}
+func (*QueryUndelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{6}
 }
-func (*UnimplementedQueryServer) QueryDelegationInfo(ctx context.Context, req *DelegationInfoReq) (*QueryDelegationInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryDelegationInfo not implemented")
+func (m *QueryUndelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySingleDelegationInfo(ctx context.Context, req *SingleDelegationInfoReq) (*DelegationAmounts, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySingleDelegationInfo not implemented")
+func (m *QueryUndelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUndelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryUndelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUndelegationsResponse.Merge(m, src)
 }
-
-func _Query_QueryOperatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorInfoReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryOperatorInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Query/QueryOperatorInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryOperatorInfo(ctx, req.(*QueryOperatorInfoReq))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryUndelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUndelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUndelegationsResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryDelegationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelegationInfoReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryDelegationInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Query/QueryDelegationInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryDelegationInfo(ctx, req.(*DelegationInfoReq))
+var xxx_messageInfo_QueryUndelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryUndelegationsResponse) GetUndelegations() []*UndelegationRecord {
+	if m != nil {
+		return m.Undelegations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QuerySingleDelegationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SingleDelegationInfoReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySingleDelegationInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Query/QuerySingleDelegationInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySingleDelegationInfo(ctx, req.(*SingleDelegationInfoReq))
+func (m *QueryUndelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.delegation.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryOperatorInfo",
-			Handler:    _Query_QueryOperatorInfo_Handler,
-		},
-		{
-			MethodName: "QueryDelegationInfo",
-			Handler:    _Query_QueryDelegationInfo_Handler,
-		},
-		{
-			MethodName: "QuerySingleDelegationInfo",
-			Handler:    _Query_QuerySingleDelegationInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/delegation/v1/query.proto",
+type QueryWaitCompleteUndelegationsReq struct {
+	// height is the block height, the pending undelegations maturing at or before it will be returned.
+	Height     uint64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *DelegationInfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitCompleteUndelegationsReq) Reset()         { *m = QueryWaitCompleteUndelegationsReq{} }
+func (m *QueryWaitCompleteUndelegationsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryWaitCompleteUndelegationsReq) ProtoMessage()    {}
+func (*QueryWaitCompleteUndelegationsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{7}
+}
+func (m *QueryWaitCompleteUndelegationsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWaitCompleteUndelegationsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWaitCompleteUndelegationsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DelegationInfoReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWaitCompleteUndelegationsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWaitCompleteUndelegationsReq.Merge(m, src)
+}
+func (m *QueryWaitCompleteUndelegationsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWaitCompleteUndelegationsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWaitCompleteUndelegationsReq.DiscardUnknown(m)
 }
 
-func (m *DelegationInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryWaitCompleteUndelegationsReq proto.InternalMessageInfo
+
+func (m *QueryWaitCompleteUndelegationsReq) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DelegationAmounts) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWaitCompleteUndelegationsReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DelegationAmounts) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOperatorDelegatorsReq struct {
+	OperatorAddr string             `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string             `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *DelegationAmounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.WaitUndelegationAmount.Size()
-		i -= size
-		if _, err := m.WaitUndelegationAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.CanUndelegationAmount.Size()
-		i -= size
-		if _, err := m.CanUndelegationAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryOperatorDelegatorsReq) Reset()         { *m = QueryOperatorDelegatorsReq{} }
+func (m *QueryOperatorDelegatorsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegatorsReq) ProtoMessage()    {}
+func (*QueryOperatorDelegatorsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{8}
+}
+func (m *QueryOperatorDelegatorsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorDelegatorsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorDelegatorsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOperatorDelegatorsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegatorsReq.Merge(m, src)
+}
+func (m *QueryOperatorDelegatorsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorDelegatorsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegatorsReq.DiscardUnknown(m)
 }
 
-func (m *QueryDelegationInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOperatorDelegatorsReq proto.InternalMessageInfo
+
+func (m *QueryOperatorDelegatorsReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDelegationInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOperatorDelegatorsReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
 }
 
-func (m *QueryDelegationInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DelegationInfos) > 0 {
-		for k := range m.DelegationInfos {
-			v := m.DelegationInfos[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *QueryOperatorDelegatorsReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	{
-		size := m.TotalDelegatedAmount.Size()
-		i -= size
-		if _, err := m.TotalDelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+type DelegatorInfo struct {
+	StakerID string            `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	Amounts  DelegationAmounts `protobuf:"bytes,2,opt,name=amounts,proto3" json:"amounts"`
+}
+
+func (m *DelegatorInfo) Reset()         { *m = DelegatorInfo{} }
+func (m *DelegatorInfo) String() string { return proto.CompactTextString(m) }
+func (*DelegatorInfo) ProtoMessage()    {}
+func (*DelegatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{9}
+}
+func (m *DelegatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *DelegatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorInfo.Merge(m, src)
+}
+func (m *DelegatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorInfo.DiscardUnknown(m)
 }
 
-func (m *SingleDelegationInfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
+
+func (m *DelegatorInfo) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SingleDelegationInfoReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelegatorInfo) GetAmounts() DelegationAmounts {
+	if m != nil {
+		return m.Amounts
+	}
+	return DelegationAmounts{}
 }
 
-func (m *SingleDelegationInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x1a
+type QueryOperatorDelegatorsResponse struct {
+	Delegators []DelegatorInfo     `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorDelegatorsResponse) Reset()         { *m = QueryOperatorDelegatorsResponse{} }
+func (m *QueryOperatorDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorDelegatorsResponse) ProtoMessage()    {}
+func (*QueryOperatorDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{10}
+}
+func (m *QueryOperatorDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryOperatorDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryOperatorDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorDelegatorsResponse) GetDelegators() []DelegatorInfo {
+	if m != nil {
+		return m.Delegators
 	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryOperatorDelegatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryOperatorInfoReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type QueryChainValidatorsReq struct {
+}
+
+func (m *QueryChainValidatorsReq) Reset()         { *m = QueryChainValidatorsReq{} }
+func (m *QueryChainValidatorsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryChainValidatorsReq) ProtoMessage()    {}
+func (*QueryChainValidatorsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{11}
+}
+func (m *QueryChainValidatorsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainValidatorsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainValidatorsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryChainValidatorsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainValidatorsReq.Merge(m, src)
+}
+func (m *QueryChainValidatorsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainValidatorsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainValidatorsReq.DiscardUnknown(m)
 }
 
-func (m *QueryOperatorInfoReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryChainValidatorsReq proto.InternalMessageInfo
+
+type QueryChainValidatorsResponse struct {
+	Validators []ValidatorPower `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *QueryOperatorInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryChainValidatorsResponse) Reset()         { *m = QueryChainValidatorsResponse{} }
+func (m *QueryChainValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainValidatorsResponse) ProtoMessage()    {}
+func (*QueryChainValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{12}
+}
+func (m *QueryChainValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryChainValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainValidatorsResponse.Merge(m, src)
+}
+func (m *QueryChainValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainValidatorsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryChainValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryChainValidatorsResponse) GetValidators() []ValidatorPower {
+	if m != nil {
+		return m.Validators
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *DelegationInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryOperatorSnapshotReq is the request to query the stake snapshot of the operator.
+type QueryOperatorSnapshotReq struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	// epochNumber is the number of the epoch at whose start the snapshot is taken, the latest
+	// snapshot is returned if it's zero.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+}
+
+func (m *QueryOperatorSnapshotReq) Reset()         { *m = QueryOperatorSnapshotReq{} }
+func (m *QueryOperatorSnapshotReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorSnapshotReq) ProtoMessage()    {}
+func (*QueryOperatorSnapshotReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab345e1cf20490c, []int{13}
+}
+func (m *QueryOperatorSnapshotReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorSnapshotReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorSnapshotReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
+}
+func (m *QueryOperatorSnapshotReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorSnapshotReq.Merge(m, src)
+}
+func (m *QueryOperatorSnapshotReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorSnapshotReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorSnapshotReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorSnapshotReq proto.InternalMessageInfo
+
+func (m *QueryOperatorSnapshotReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *QueryOperatorSnapshotReq) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("exocore.delegation.v1.UndelegationRecordType", UndelegationRecordType_name, UndelegationRecordType_value)
+	proto.RegisterType((*DelegationInfoReq)(nil), "exocore.delegation.v1.DelegationInfoReq")
+	proto.RegisterType((*DelegationAmounts)(nil), "exocore.delegation.v1.DelegationAmounts")
+	proto.RegisterType((*QueryDelegationInfoResponse)(nil), "exocore.delegation.v1.QueryDelegationInfoResponse")
+	proto.RegisterMapType((map[string]*DelegationAmounts)(nil), "exocore.delegation.v1.QueryDelegationInfoResponse.DelegationInfosEntry")
+	proto.RegisterType((*SingleDelegationInfoReq)(nil), "exocore.delegation.v1.SingleDelegationInfoReq")
+	proto.RegisterType((*QueryOperatorInfoReq)(nil), "exocore.delegation.v1.QueryOperatorInfoReq")
+	proto.RegisterType((*QueryStakerUndelegationsReq)(nil), "exocore.delegation.v1.QueryStakerUndelegationsReq")
+	proto.RegisterType((*QueryUndelegationsResponse)(nil), "exocore.delegation.v1.QueryUndelegationsResponse")
+	proto.RegisterType((*QueryWaitCompleteUndelegationsReq)(nil), "exocore.delegation.v1.QueryWaitCompleteUndelegationsReq")
+	proto.RegisterType((*QueryOperatorDelegatorsReq)(nil), "exocore.delegation.v1.QueryOperatorDelegatorsReq")
+	proto.RegisterType((*DelegatorInfo)(nil), "exocore.delegation.v1.DelegatorInfo")
+	proto.RegisterType((*QueryOperatorDelegatorsResponse)(nil), "exocore.delegation.v1.QueryOperatorDelegatorsResponse")
+	proto.RegisterType((*QueryChainValidatorsReq)(nil), "exocore.delegation.v1.QueryChainValidatorsReq")
+	proto.RegisterType((*QueryChainValidatorsResponse)(nil), "exocore.delegation.v1.QueryChainValidatorsResponse")
+	proto.RegisterType((*QueryOperatorSnapshotReq)(nil), "exocore.delegation.v1.QueryOperatorSnapshotReq")
+}
+
+func init() { proto.RegisterFile("exocore/delegation/v1/query.proto", fileDescriptor_aab345e1cf20490c) }
+
+var fileDescriptor_aab345e1cf20490c = []byte{
+	// 1204 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xee, 0x4d, 0xf7, 0x03, 0x4e, 0x37, 0xe8, 0x2e, 0x59, 0x97, 0x7a, 0x55, 0xda, 0x99, 0xae,
+	0x2b, 0x1d, 0xb5, 0x97, 0x8c, 0x41, 0x81, 0x82, 0xd4, 0x36, 0x59, 0x09, 0x74, 0x49, 0x71, 0x33,
+	0x10, 0xbc, 0x14, 0xb7, 0xb9, 0x38, 0x51, 0x53, 0x5f, 0xd7, 0xbe, 0xe9, 0xda, 0x57, 0x78, 0xd9,
+	0x0b, 0x12, 0x12, 0xe2, 0x9f, 0x40, 0x3c, 0xf0, 0x50, 0x78, 0x42, 0x42, 0x48, 0x3c, 0xec, 0x71,
+	0x8c, 0x17, 0xc4, 0xc3, 0x84, 0x5a, 0x24, 0x24, 0x9e, 0xf8, 0x07, 0x90, 0x90, 0xed, 0xeb, 0xc4,
+	0x4e, 0xaf, 0xdd, 0xa6, 0xea, 0x53, 0x6b, 0xdf, 0xef, 0x9c, 0xf3, 0x9d, 0x73, 0xbf, 0x73, 0x8e,
+	0x03, 0xd7, 0xc8, 0x0e, 0x5d, 0xa7, 0x36, 0x51, 0x6b, 0xa4, 0x49, 0x0c, 0x9d, 0x35, 0xa8, 0xa9,
+	0x6e, 0xe7, 0xd4, 0xad, 0x16, 0xb1, 0x77, 0x15, 0xcb, 0xa6, 0x8c, 0xe2, 0xcb, 0x1c, 0xa2, 0x74,
+	0x20, 0xca, 0x76, 0x4e, 0x4a, 0x1b, 0xd4, 0xa0, 0x1e, 0x42, 0x75, 0xff, 0xf3, 0xc1, 0xd2, 0x88,
+	0x41, 0xa9, 0xd1, 0x24, 0xaa, 0x6e, 0x35, 0x54, 0xdd, 0x34, 0x29, 0xf3, 0xf0, 0x0e, 0x3f, 0xbd,
+	0xba, 0x4e, 0x9d, 0x4d, 0xea, 0xf8, 0xee, 0xbb, 0xe2, 0x48, 0xc3, 0xfe, 0xe1, 0xaa, 0xef, 0xd3,
+	0x7f, 0xe0, 0x47, 0x53, 0xdc, 0x6e, 0x4d, 0x77, 0x48, 0xdb, 0x78, 0x8d, 0x30, 0x3d, 0xa7, 0x5a,
+	0xba, 0xd1, 0x30, 0x7d, 0x52, 0x3e, 0x36, 0x2b, 0xce, 0x88, 0xed, 0xf0, 0xf3, 0x71, 0xf1, 0xb9,
+	0x63, 0xea, 0x96, 0x53, 0xa7, 0x8c, 0xa3, 0xae, 0x8b, 0x51, 0xdb, 0x7a, 0xb3, 0x51, 0xd3, 0x19,
+	0xb5, 0x7d, 0x98, 0x5c, 0x82, 0x4b, 0x85, 0x36, 0xa0, 0x64, 0x7e, 0x4a, 0x35, 0xb2, 0x85, 0x25,
+	0x78, 0xc6, 0x61, 0xfa, 0x06, 0xb1, 0x4b, 0x85, 0x0c, 0x1a, 0x43, 0x93, 0xcf, 0x6a, 0xed, 0x67,
+	0x9c, 0x81, 0xf3, 0xba, 0xe3, 0x10, 0x56, 0x2a, 0x64, 0x52, 0xde, 0x51, 0xf0, 0x28, 0xff, 0x87,
+	0xc2, 0xbe, 0xe6, 0x36, 0x69, 0xcb, 0x64, 0x0e, 0xb6, 0xe1, 0xf2, 0x82, 0x6e, 0xde, 0x37, 0x6b,
+	0x5d, 0x27, 0xbe, 0xe3, 0xf9, 0xd9, 0x47, 0x4f, 0x47, 0xfb, 0xfe, 0x78, 0x3a, 0x3a, 0x61, 0x34,
+	0x58, 0xbd, 0xb5, 0xa6, 0xac, 0xd3, 0x4d, 0x5e, 0x39, 0xfe, 0x67, 0xda, 0xa9, 0x6d, 0xa8, 0x6c,
+	0xd7, 0x22, 0x8e, 0x52, 0x32, 0xd9, 0x93, 0xbd, 0x69, 0xe0, 0x85, 0x2d, 0x99, 0x4c, 0x13, 0xbb,
+	0xc6, 0x0c, 0x86, 0x3e, 0xd4, 0x1b, 0x4c, 0x10, 0x34, 0x75, 0x0a, 0x41, 0x63, 0x7c, 0xcb, 0xff,
+	0xa6, 0xe0, 0xea, 0xfb, 0xee, 0xd5, 0x76, 0x17, 0xd4, 0xb1, 0xa8, 0xe9, 0x10, 0x6c, 0x41, 0xba,
+	0x4a, 0x99, 0xde, 0xe4, 0xc7, 0xa4, 0x76, 0x8a, 0x85, 0x10, 0x7a, 0xc6, 0x5b, 0xf0, 0x7c, 0x2d,
+	0xc2, 0xc5, 0xc9, 0xa4, 0xc6, 0xfa, 0x27, 0x07, 0xf2, 0x8b, 0x8a, 0xb0, 0x25, 0x94, 0x04, 0xfa,
+	0x4a, 0xf4, 0xb5, 0x53, 0x34, 0x99, 0xbd, 0xab, 0x75, 0xfb, 0x97, 0x9a, 0x90, 0x16, 0x01, 0xf1,
+	0x20, 0xf4, 0x6f, 0x90, 0x5d, 0xae, 0x26, 0xf7, 0x5f, 0xfc, 0x36, 0x9c, 0xdd, 0xd6, 0x9b, 0x2d,
+	0xe2, 0xdd, 0xc9, 0x40, 0x7e, 0x32, 0x86, 0xd2, 0x21, 0x45, 0x69, 0xbe, 0xd9, 0x1b, 0xa9, 0x19,
+	0x24, 0x7f, 0x81, 0xe0, 0xca, 0x4a, 0xc3, 0x34, 0x9a, 0xa4, 0x37, 0x11, 0xcf, 0xc2, 0x05, 0x6a,
+	0x11, 0xdb, 0xed, 0x83, 0xb9, 0x5a, 0xcd, 0xe6, 0xb2, 0xc8, 0x3c, 0xd9, 0x9b, 0x4e, 0xf3, 0xa2,
+	0xba, 0xaf, 0x89, 0xe3, 0xac, 0x30, 0xbb, 0x61, 0x1a, 0x5a, 0x04, 0x1d, 0x6e, 0x81, 0xfe, 0x68,
+	0x0b, 0x54, 0x21, 0xed, 0x95, 0xb0, 0xc2, 0xe1, 0x01, 0x97, 0x59, 0xb8, 0x50, 0x09, 0xc7, 0x43,
+	0x47, 0xc5, 0x0b, 0xa3, 0xe5, 0x7f, 0x10, 0x17, 0xd6, 0x8a, 0xc7, 0x3f, 0x2c, 0x3d, 0xe7, 0xc4,
+	0xed, 0x8a, 0xef, 0x01, 0xd8, 0x64, 0x9d, 0xda, 0xb5, 0xea, 0xae, 0x45, 0xbc, 0x44, 0x9e, 0xcb,
+	0x4f, 0xc7, 0x5c, 0x42, 0x38, 0xa4, 0xd6, 0x36, 0xd2, 0x42, 0x0e, 0xf0, 0x5d, 0x80, 0xce, 0x24,
+	0xcb, 0x9c, 0xf1, 0xee, 0x74, 0x42, 0xe1, 0xd9, 0xb9, 0x63, 0x4f, 0xf1, 0x47, 0x25, 0x1f, 0x7b,
+	0xca, 0xb2, 0x6e, 0x10, 0x8d, 0x6c, 0xb5, 0x88, 0xc3, 0xb4, 0x90, 0xa5, 0xfc, 0x03, 0x02, 0xc9,
+	0x4b, 0xb6, 0x2b, 0x4d, 0xde, 0x44, 0x15, 0xb8, 0xd8, 0x0a, 0x1f, 0x64, 0x90, 0x27, 0xe8, 0x97,
+	0x8e, 0x4d, 0x5c, 0x8b, 0xda, 0xe3, 0xc5, 0x08, 0x6f, 0x5f, 0x8b, 0x37, 0x8e, 0xe4, 0xed, 0xb3,
+	0x89, 0x10, 0xff, 0x1c, 0xc1, 0x35, 0x8f, 0xb8, 0x3b, 0x1e, 0x16, 0xe8, 0xa6, 0xd5, 0x24, 0x8c,
+	0x1c, 0xba, 0xab, 0x21, 0x38, 0x57, 0x27, 0x0d, 0xa3, 0xee, 0xb7, 0xfd, 0x19, 0x8d, 0x3f, 0xe1,
+	0xbb, 0x02, 0x1a, 0x27, 0x29, 0xdf, 0x8f, 0x41, 0xf9, 0x02, 0x05, 0xf1, 0xc6, 0xa0, 0xb6, 0xc3,
+	0x85, 0x48, 0x7b, 0x12, 0x62, 0x9c, 0xf0, 0xbb, 0xc4, 0x14, 0xa5, 0xdf, 0x7f, 0x62, 0xfa, 0x2d,
+	0xb8, 0xd8, 0x26, 0xec, 0x36, 0x4f, 0xa2, 0xb6, 0xdf, 0x81, 0xf3, 0xba, 0x3f, 0x13, 0x7a, 0x9d,
+	0x21, 0xf3, 0x67, 0xdc, 0x69, 0xab, 0x05, 0xe6, 0xae, 0xe8, 0x46, 0x63, 0xab, 0xc6, 0x95, 0xf7,
+	0x2e, 0x40, 0xad, 0xfd, 0x96, 0xcb, 0x6e, 0x3c, 0x39, 0xa0, 0x9f, 0x03, 0x0f, 0x16, 0xb2, 0x3e,
+	0x3d, 0xd1, 0x0d, 0xc3, 0x15, 0x8f, 0xf7, 0x42, 0x5d, 0x6f, 0x98, 0x1f, 0x04, 0xbb, 0xdd, 0xbd,
+	0x6a, 0x79, 0x03, 0x46, 0xc4, 0x47, 0x3c, 0x9f, 0xf7, 0x00, 0xda, 0x1f, 0x03, 0x41, 0x3e, 0xd7,
+	0x63, 0xf2, 0x69, 0x9b, 0x2f, 0xd3, 0x07, 0xc4, 0x0e, 0x12, 0xea, 0x98, 0xcb, 0x9f, 0x40, 0x26,
+	0x52, 0xbf, 0x15, 0xfe, 0x31, 0xe2, 0x6a, 0x4e, 0x16, 0x69, 0xae, 0x4b, 0x59, 0x63, 0x30, 0x40,
+	0x2c, 0xba, 0x5e, 0x2f, 0xb7, 0x36, 0xd7, 0x88, 0x3f, 0x8f, 0xfb, 0xb5, 0xf0, 0xab, 0xa9, 0x87,
+	0x08, 0x86, 0xc4, 0x63, 0x08, 0x8f, 0xc1, 0xc8, 0xfd, 0x72, 0xa1, 0xb8, 0x54, 0x5c, 0x9c, 0xab,
+	0x96, 0x2a, 0xe5, 0x55, 0xad, 0xb8, 0x50, 0xd1, 0x0a, 0xab, 0xd5, 0x8f, 0x96, 0x8b, 0xab, 0x73,
+	0x4b, 0x4b, 0x83, 0x7d, 0x78, 0x1c, 0xc6, 0x62, 0x11, 0xcb, 0xc5, 0x72, 0xa1, 0x54, 0x5e, 0x1c,
+	0x44, 0x78, 0x02, 0xe4, 0x58, 0xd4, 0x42, 0xe5, 0xde, 0xf2, 0x52, 0xb1, 0x5a, 0x2c, 0x0c, 0xa6,
+	0xf2, 0xbf, 0x0c, 0xc0, 0x59, 0x2f, 0x5b, 0xfc, 0x35, 0x82, 0x4b, 0x87, 0x06, 0x3e, 0xbe, 0x99,
+	0xb4, 0x5d, 0xbb, 0x56, 0x83, 0xf4, 0x62, 0x0c, 0x38, 0x8c, 0x93, 0x95, 0xcf, 0x7e, 0xfb, 0xeb,
+	0xab, 0xd4, 0x24, 0x9e, 0x50, 0xc5, 0x5f, 0x75, 0x8b, 0x84, 0x45, 0x18, 0x7c, 0x83, 0xe0, 0x05,
+	0xc1, 0x2e, 0xc7, 0x47, 0x37, 0x48, 0x40, 0x2b, 0xdf, 0xfb, 0x17, 0x82, 0x7c, 0xe7, 0xe1, 0xdf,
+	0xdf, 0x4d, 0x21, 0x8f, 0xea, 0x14, 0x9e, 0x8c, 0xa7, 0xda, 0x45, 0x6a, 0x0f, 0xc1, 0xb0, 0xbf,
+	0xde, 0x04, 0x9b, 0x1c, 0x2b, 0x31, 0x44, 0x62, 0xd6, 0xbe, 0x74, 0xec, 0x19, 0x20, 0xbf, 0xd5,
+	0xa1, 0x9b, 0xc7, 0xb7, 0x62, 0xe8, 0xc6, 0x13, 0xfb, 0x09, 0x41, 0x26, 0x6e, 0x2b, 0xe3, 0xc4,
+	0xf2, 0x89, 0xd7, 0xb8, 0x94, 0x4b, 0xb2, 0x11, 0x6e, 0x43, 0x79, 0xb6, 0x93, 0x42, 0x0e, 0xab,
+	0x89, 0x29, 0x08, 0x48, 0xfe, 0x8a, 0x20, 0x9b, 0xbc, 0xb1, 0xf0, 0x4c, 0x12, 0xa7, 0xa4, 0x45,
+	0x77, 0x92, 0x6c, 0xe6, 0x3b, 0xd9, 0xbc, 0x86, 0xef, 0x24, 0x65, 0x13, 0x4f, 0xf8, 0x67, 0xc4,
+	0x27, 0xe2, 0xe1, 0x49, 0x8e, 0x73, 0xc7, 0xe9, 0xcb, 0xc8, 0xbe, 0x94, 0x5e, 0xed, 0xd5, 0x84,
+	0xa7, 0xf2, 0x66, 0x27, 0x95, 0x5b, 0x58, 0x49, 0x4a, 0x45, 0xc0, 0xf3, 0x7b, 0x04, 0x69, 0xd1,
+	0xe8, 0x8e, 0xed, 0x85, 0x98, 0x15, 0x20, 0xdd, 0xee, 0x09, 0xcf, 0xa9, 0xcf, 0x74, 0xa8, 0x4f,
+	0xe3, 0x9b, 0x49, 0xd4, 0xbb, 0xe9, 0x7d, 0x8b, 0xe0, 0xb2, 0x70, 0x0b, 0x60, 0xf5, 0x38, 0x65,
+	0x0c, 0xed, 0x0c, 0xe9, 0xc6, 0x11, 0x53, 0x31, 0xc0, 0xca, 0xaf, 0x77, 0xd8, 0x2a, 0xf8, 0xe5,
+	0xe3, 0x14, 0x3a, 0x30, 0x9d, 0x2f, 0x3f, 0xda, 0xcf, 0xa2, 0xc7, 0xfb, 0x59, 0xf4, 0xe7, 0x7e,
+	0x16, 0x7d, 0x79, 0x90, 0xed, 0x7b, 0x7c, 0x90, 0xed, 0xfb, 0xfd, 0x20, 0xdb, 0xf7, 0xf1, 0x2b,
+	0xa1, 0xdf, 0x60, 0x45, 0xdf, 0x63, 0x99, 0xb0, 0x07, 0xd4, 0xde, 0x68, 0x07, 0xd8, 0x09, 0x87,
+	0xf0, 0x7e, 0x95, 0xad, 0x9d, 0xf3, 0x7e, 0x51, 0xdf, 0xfe, 0x7f, 0x00, 0x5c, 0xc5, 0x43, 0xce,
+	0x92, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	QueryOperatorInfo(ctx context.Context, in *QueryOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error)
+	// Balance queries the balance of a single coin for a single account.
+	QueryDelegationInfo(ctx context.Context, in *DelegationInfoReq, opts ...grpc.CallOption) (*QueryDelegationInfoResponse, error)
+	QuerySingleDelegationInfo(ctx context.Context, in *SingleDelegationInfoReq, opts ...grpc.CallOption) (*DelegationAmounts, error)
+	// QueryStakerUndelegations queries the undelegation records of the staker asset.
+	QueryStakerUndelegations(ctx context.Context, in *QueryStakerUndelegationsReq, opts ...grpc.CallOption) (*QueryUndelegationsResponse, error)
+	// QueryWaitCompleteUndelegations queries the pending undelegation records maturing at or before the height.
+	QueryWaitCompleteUndelegations(ctx context.Context, in *QueryWaitCompleteUndelegationsReq, opts ...grpc.CallOption) (*QueryUndelegationsResponse, error)
+	// QueryOperatorDelegators queries the stakers that have delegated the asset to the operator.
+	QueryOperatorDelegators(ctx context.Context, in *QueryOperatorDelegatorsReq, opts ...grpc.CallOption) (*QueryOperatorDelegatorsResponse, error)
+	// QueryChainValidators queries the operators in the validator set derived from the restaked assets.
+	QueryChainValidators(ctx context.Context, in *QueryChainValidatorsReq, opts ...grpc.CallOption) (*QueryChainValidatorsResponse, error)
+	// QueryOperatorSnapshot queries the stake snapshot of the operator taken at the start of an epoch.
+	QueryOperatorSnapshot(ctx context.Context, in *QueryOperatorSnapshotReq, opts ...grpc.CallOption) (*OperatorSnapshot, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryOperatorInfo(ctx context.Context, in *QueryOperatorInfoReq, opts ...grpc.CallOption) (*OperatorInfo, error) {
+	out := new(OperatorInfo)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryOperatorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryDelegationInfo(ctx context.Context, in *DelegationInfoReq, opts ...grpc.CallOption) (*QueryDelegationInfoResponse, error) {
+	out := new(QueryDelegationInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryDelegationInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySingleDelegationInfo(ctx context.Context, in *SingleDelegationInfoReq, opts ...grpc.CallOption) (*DelegationAmounts, error) {
+	out := new(DelegationAmounts)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QuerySingleDelegationInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryStakerUndelegations(ctx context.Context, in *QueryStakerUndelegationsReq, opts ...grpc.CallOption) (*QueryUndelegationsResponse, error) {
+	out := new(QueryUndelegationsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryStakerUndelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryWaitCompleteUndelegations(ctx context.Context, in *QueryWaitCompleteUndelegationsReq, opts ...grpc.CallOption) (*QueryUndelegationsResponse, error) {
+	out := new(QueryUndelegationsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryWaitCompleteUndelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOperatorDelegators(ctx context.Context, in *QueryOperatorDelegatorsReq, opts ...grpc.CallOption) (*QueryOperatorDelegatorsResponse, error) {
+	out := new(QueryOperatorDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryOperatorDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryChainValidators(ctx context.Context, in *QueryChainValidatorsReq, opts ...grpc.CallOption) (*QueryChainValidatorsResponse, error) {
+	out := new(QueryChainValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryChainValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryOperatorSnapshot(ctx context.Context, in *QueryOperatorSnapshotReq, opts ...grpc.CallOption) (*OperatorSnapshot, error) {
+	out := new(OperatorSnapshot)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Query/QueryOperatorSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	QueryOperatorInfo(context.Context, *QueryOperatorInfoReq) (*OperatorInfo, error)
+	// Balance queries the balance of a single coin for a single account.
+	QueryDelegationInfo(context.Context, *DelegationInfoReq) (*QueryDelegationInfoResponse, error)
+	QuerySingleDelegationInfo(context.Context, *SingleDelegationInfoReq) (*DelegationAmounts, error)
+	// QueryStakerUndelegations queries the undelegation records of the staker asset.
+	QueryStakerUndelegations(context.Context, *QueryStakerUndelegationsReq) (*QueryUndelegationsResponse, error)
+	// QueryWaitCompleteUndelegations queries the pending undelegation records maturing at or before the height.
+	QueryWaitCompleteUndelegations(context.Context, *QueryWaitCompleteUndelegationsReq) (*QueryUndelegationsResponse, error)
+	// QueryOperatorDelegators queries the stakers that have delegated the asset to the operator.
+	QueryOperatorDelegators(context.Context, *QueryOperatorDelegatorsReq) (*QueryOperatorDelegatorsResponse, error)
+	// QueryChainValidators queries the operators in the validator set derived from the restaked assets.
+	QueryChainValidators(context.Context, *QueryChainValidatorsReq) (*QueryChainValidatorsResponse, error)
+	// QueryOperatorSnapshot queries the stake snapshot of the operator taken at the start of an epoch.
+	QueryOperatorSnapshot(context.Context, *QueryOperatorSnapshotReq) (*OperatorSnapshot, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryOperatorInfo(ctx context.Context, req *QueryOperatorInfoReq) (*OperatorInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryDelegationInfo(ctx context.Context, req *DelegationInfoReq) (*QueryDelegationInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDelegationInfo not implemented")
+}
+func (*UnimplementedQueryServer) QuerySingleDelegationInfo(ctx context.Context, req *SingleDelegationInfoReq) (*DelegationAmounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySingleDelegationInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryStakerUndelegations(ctx context.Context, req *QueryStakerUndelegationsReq) (*QueryUndelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStakerUndelegations not implemented")
+}
+func (*UnimplementedQueryServer) QueryWaitCompleteUndelegations(ctx context.Context, req *QueryWaitCompleteUndelegationsReq) (*QueryUndelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWaitCompleteUndelegations not implemented")
+}
+func (*UnimplementedQueryServer) QueryOperatorDelegators(ctx context.Context, req *QueryOperatorDelegatorsReq) (*QueryOperatorDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorDelegators not implemented")
+}
+func (*UnimplementedQueryServer) QueryChainValidators(ctx context.Context, req *QueryChainValidatorsReq) (*QueryChainValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryChainValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryOperatorSnapshot(ctx context.Context, req *QueryOperatorSnapshotReq) (*OperatorSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOperatorSnapshot not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryOperatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOperatorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryOperatorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOperatorInfo(ctx, req.(*QueryOperatorInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryDelegationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDelegationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryDelegationInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDelegationInfo(ctx, req.(*DelegationInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySingleDelegationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SingleDelegationInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySingleDelegationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QuerySingleDelegationInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySingleDelegationInfo(ctx, req.(*SingleDelegationInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryStakerUndelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerUndelegationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryStakerUndelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryStakerUndelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryStakerUndelegations(ctx, req.(*QueryStakerUndelegationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryWaitCompleteUndelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWaitCompleteUndelegationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryWaitCompleteUndelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryWaitCompleteUndelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryWaitCompleteUndelegations(ctx, req.(*QueryWaitCompleteUndelegationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOperatorDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorDelegatorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOperatorDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryOperatorDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOperatorDelegators(ctx, req.(*QueryOperatorDelegatorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryChainValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainValidatorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryChainValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryChainValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryChainValidators(ctx, req.(*QueryChainValidatorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryOperatorSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryOperatorSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Query/QueryOperatorSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryOperatorSnapshot(ctx, req.(*QueryOperatorSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.delegation.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryOperatorInfo",
+			Handler:    _Query_QueryOperatorInfo_Handler,
+		},
+		{
+			MethodName: "QueryDelegationInfo",
+			Handler:    _Query_QueryDelegationInfo_Handler,
+		},
+		{
+			MethodName: "QuerySingleDelegationInfo",
+			Handler:    _Query_QuerySingleDelegationInfo_Handler,
+		},
+		{
+			MethodName: "QueryStakerUndelegations",
+			Handler:    _Query_QueryStakerUndelegations_Handler,
+		},
+		{
+			MethodName: "QueryWaitCompleteUndelegations",
+			Handler:    _Query_QueryWaitCompleteUndelegations_Handler,
+		},
+		{
+			MethodName: "QueryOperatorDelegators",
+			Handler:    _Query_QueryOperatorDelegators_Handler,
+		},
+		{
+			MethodName: "QueryChainValidators",
+			Handler:    _Query_QueryChainValidators_Handler,
+		},
+		{
+			MethodName: "QueryOperatorSnapshot",
+			Handler:    _Query_QueryOperatorSnapshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/delegation/v1/query.proto",
+}
+
+func (m *DelegationInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationAmounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationAmounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationAmounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WaitUndelegationAmount.Size()
+		i -= size
+		if _, err := m.WaitUndelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CanUndelegationAmount.Size()
+		i -= size
+		if _, err := m.CanUndelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationInfos) > 0 {
+		for k := range m.DelegationInfos {
+			v := m.DelegationInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalDelegatedAmount.Size()
+		i -= size
+		if _, err := m.TotalDelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SingleDelegationInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleDelegationInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleDelegationInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerUndelegationsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerUndelegationsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerUndelegationsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RecordType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUndelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUndelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUndelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Undelegations) > 0 {
+		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWaitCompleteUndelegationsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWaitCompleteUndelegationsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWaitCompleteUndelegationsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorDelegatorsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorDelegatorsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegatorsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amounts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainValidatorsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainValidatorsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainValidatorsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorSnapshotReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorSnapshotReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSnapshotReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegationInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationAmounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CanUndelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WaitUndelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDelegationInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalDelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegationInfos) > 0 {
+		for k, v := range m.DelegationInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SingleDelegationInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerUndelegationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecordType != 0 {
+		n += 1 + sovQuery(uint64(m.RecordType))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUndelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWaitCompleteUndelegationsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorDelegatorsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.StakerID)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	l = m.Amounts.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, e := range m.Delegators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainValidatorsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChainValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOperatorSnapshotReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationAmounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanUndelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CanUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WaitUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationInfos == nil {
+				m.DelegationInfos = make(map[string]*DelegationAmounts)
+			}
+			var mapkey string
+			var mapvalue *DelegationAmounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DelegationAmounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DelegationInfos[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleDelegationInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleDelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerUndelegationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerUndelegationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerUndelegationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordType", wireType)
+			}
+			m.RecordType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordType |= UndelegationRecordType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUndelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUndelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUndelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Undelegations = append(m.Undelegations, &UndelegationRecord{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DelegationAmounts) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.CanUndelegationAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.WaitUndelegationAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryDelegationInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalDelegatedAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.DelegationInfos) > 0 {
-		for k, v := range m.DelegationInfos {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
+func (m *QueryWaitCompleteUndelegationsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWaitCompleteUndelegationsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWaitCompleteUndelegationsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *SingleDelegationInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryOperatorInfoReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegatorsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -821,15 +3158,15 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegatorsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegatorsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -857,7 +3194,7 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -891,6 +3228,42 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -912,7 +3285,7 @@ func (m *DelegationInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -935,15 +3308,15 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationAmounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationAmounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanUndelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -971,15 +3344,13 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CanUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -989,23 +3360,22 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WaitUndelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amounts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1030,7 +3400,7 @@ func (m *DelegationAmounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorDelegatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1053,17 +3423,17 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorDelegatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1073,29 +3443,29 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegators = append(m.Delegators, DelegatorInfo{})
+			if err := m.Delegators[len(m.Delegators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1122,105 +3492,12 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationInfos == nil {
-				m.DelegationInfos = make(map[string]*DelegationAmounts)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var mapkey string
-			var mapvalue *DelegationAmounts
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DelegationAmounts{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DelegationInfos[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1243,7 +3520,7 @@ func (m *QueryDelegationInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryChainValidatorsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1266,81 +3543,67 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleDelegationInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChainValidatorsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleDelegationInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChainValidatorsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1350,23 +3613,25 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, ValidatorPower{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1389,7 +3654,7 @@ func (m *SingleDelegationInfoReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorInfoReq) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorSnapshotReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1412,10 +3677,10 @@ func (m *QueryOperatorInfoReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorInfoReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorSnapshotReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorSnapshotReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1450,6 +3715,25 @@ func (m *QueryOperatorInfoReq) Unmarshal(dAtA []byte) error {
 			}
 			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
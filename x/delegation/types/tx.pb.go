@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -327,6 +328,8 @@ var xxx_messageInfo_RegisterOperatorReq proto.InternalMessageInfo
 type DelegationApproveInfo struct {
 	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	Salt      string `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	// expiry is the unix time in seconds after which the approval can't be used
+	Expiry uint64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
 }
 
 func (m *DelegationApproveInfo) Reset()         { *m = DelegationApproveInfo{} }
@@ -376,6 +379,13 @@ func (m *DelegationApproveInfo) GetSalt() string {
 	return ""
 }
 
+func (m *DelegationApproveInfo) GetExpiry() uint64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
 type RegisterOperatorResponse struct {
 }
 
@@ -415,6 +425,10 @@ var xxx_messageInfo_RegisterOperatorResponse proto.InternalMessageInfo
 type DelegationIncOrDecInfo struct {
 	FromAddress        string                 `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
 	PerOperatorAmounts map[string]*ValueField `protobuf:"bytes,2,rep,name=perOperatorAmounts,proto3" json:"perOperatorAmounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// stakerID and assetID are only needed by the delegation and undelegation
+	// initiated from exoCore directly, the stakerID must be linked to the fromAddress.
+	StakerID string `protobuf:"bytes,3,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	AssetID  string `protobuf:"bytes,4,opt,name=assetID,proto3" json:"assetID,omitempty"`
 }
 
 func (m *DelegationIncOrDecInfo) Reset()         { *m = DelegationIncOrDecInfo{} }
@@ -451,8 +465,9 @@ func (m *DelegationIncOrDecInfo) XXX_DiscardUnknown() {
 var xxx_messageInfo_DelegationIncOrDecInfo proto.InternalMessageInfo
 
 type MsgDelegation struct {
-	BaseInfo     *DelegationIncOrDecInfo `protobuf:"bytes,1,opt,name=baseInfo,proto3" json:"baseInfo,omitempty"`
-	ApprovedInfo *DelegationApproveInfo  `protobuf:"bytes,2,opt,name=approvedInfo,proto3" json:"approvedInfo,omitempty"`
+	BaseInfo *DelegationIncOrDecInfo `protobuf:"bytes,1,opt,name=baseInfo,proto3" json:"baseInfo,omitempty"`
+	// perOperatorApprovedInfos is the approval of each operator requiring it, keyed by the operator address.
+	PerOperatorApprovedInfos map[string]*DelegationApproveInfo `protobuf:"bytes,3,rep,name=perOperatorApprovedInfos,proto3" json:"perOperatorApprovedInfos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *MsgDelegation) Reset()         { *m = MsgDelegation{} }
@@ -495,9 +510,9 @@ func (m *MsgDelegation) GetBaseInfo() *DelegationIncOrDecInfo {
 	return nil
 }
 
-func (m *MsgDelegation) GetApprovedInfo() *DelegationApproveInfo {
+func (m *MsgDelegation) GetPerOperatorApprovedInfos() map[string]*DelegationApproveInfo {
 	if m != nil {
-		return m.ApprovedInfo
+		return m.PerOperatorApprovedInfos
 	}
 	return nil
 }
@@ -764,874 +779,978 @@ func (m *UndelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UndelegationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ValueField)(nil), "exocore.delegation.v1.ValueField")
-	proto.RegisterType((*DelegatedSingleAssetInfo)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo")
-	proto.RegisterMapType((map[string]*ValueField)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo.PerOperatorAmountsEntry")
-	proto.RegisterType((*ClientChainEarningAddrList)(nil), "exocore.delegation.v1.clientChainEarningAddrList")
-	proto.RegisterType((*ClientChainEarningAddrInfo)(nil), "exocore.delegation.v1.clientChainEarningAddrInfo")
-	proto.RegisterType((*OperatorInfo)(nil), "exocore.delegation.v1.OperatorInfo")
-	proto.RegisterType((*RegisterOperatorReq)(nil), "exocore.delegation.v1.RegisterOperatorReq")
-	proto.RegisterType((*DelegationApproveInfo)(nil), "exocore.delegation.v1.DelegationApproveInfo")
-	proto.RegisterType((*RegisterOperatorResponse)(nil), "exocore.delegation.v1.RegisterOperatorResponse")
-	proto.RegisterType((*DelegationIncOrDecInfo)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo")
-	proto.RegisterMapType((map[string]*ValueField)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo.PerOperatorAmountsEntry")
-	proto.RegisterType((*MsgDelegation)(nil), "exocore.delegation.v1.MsgDelegation")
-	proto.RegisterType((*UndelegationRecord)(nil), "exocore.delegation.v1.UndelegationRecord")
-	proto.RegisterType((*UndelegationRecordKeyList)(nil), "exocore.delegation.v1.UndelegationRecordKeyList")
-	proto.RegisterType((*DelegationResponse)(nil), "exocore.delegation.v1.DelegationResponse")
-	proto.RegisterType((*MsgUndelegation)(nil), "exocore.delegation.v1.MsgUndelegation")
-	proto.RegisterType((*UndelegationResponse)(nil), "exocore.delegation.v1.UndelegationResponse")
+// MsgUpdateApprovedStakers is used by the operator to manage the stakers that can
+// delegate to it without the approval signature of the ApproveAddr.
+type MsgUpdateApprovedStakers struct {
+	FromAddress       string   `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	ApprovedStakerIDs []string `protobuf:"bytes,2,rep,name=approvedStakerIDs,proto3" json:"approvedStakerIDs,omitempty"`
+	RevokedStakerIDs  []string `protobuf:"bytes,3,rep,name=revokedStakerIDs,proto3" json:"revokedStakerIDs,omitempty"`
 }
 
-func init() { proto.RegisterFile("exocore/delegation/v1/tx.proto", fileDescriptor_16596a15a828f109) }
-
-var fileDescriptor_16596a15a828f109 = []byte{
-	// 1090 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xf6, 0xc4, 0xf9, 0x2d, 0x07, 0x25, 0xf4, 0xe6, 0x67, 0x76, 0x40, 0x4e, 0x18, 0x60, 0x15,
-	0x02, 0xb1, 0x49, 0x58, 0x58, 0x14, 0xed, 0x25, 0x7f, 0x0b, 0x16, 0x9b, 0x6c, 0x34, 0x1b, 0x38,
-	0x00, 0x12, 0x9a, 0x8c, 0x3b, 0x93, 0xc1, 0xe3, 0xee, 0xa1, 0xbb, 0x9d, 0xb5, 0xf7, 0x80, 0x10,
-	0x27, 0xe0, 0xc4, 0x23, 0xec, 0x03, 0x70, 0xc8, 0x61, 0x1f, 0x80, 0xe3, 0x1e, 0x57, 0x7b, 0x42,
-	0x48, 0xac, 0x50, 0x72, 0x08, 0x17, 0x1e, 0x00, 0x4e, 0x68, 0xba, 0xdb, 0x9e, 0x71, 0xec, 0x21,
-	0x44, 0x8a, 0xc4, 0x25, 0x99, 0xae, 0xaa, 0xfe, 0xbe, 0xea, 0xaa, 0xea, 0xaa, 0x36, 0x14, 0x71,
-	0x93, 0x7a, 0x94, 0xe1, 0x72, 0x15, 0x87, 0xd8, 0x77, 0x45, 0x40, 0x49, 0xf9, 0x68, 0xb9, 0x2c,
-	0x9a, 0xa5, 0x88, 0x51, 0x41, 0xd1, 0xb4, 0xd6, 0x97, 0x12, 0x7d, 0xe9, 0x68, 0xd9, 0x9a, 0xf5,
-	0x28, 0xaf, 0x53, 0x5e, 0xae, 0x73, 0x3f, 0x36, 0xaf, 0x73, 0x5f, 0xd9, 0x5b, 0xd7, 0x95, 0xe2,
-	0x0b, 0xb9, 0x2a, 0xab, 0x85, 0x56, 0x4d, 0xf9, 0xd4, 0xa7, 0x4a, 0x1e, 0x7f, 0x69, 0xe9, 0x8b,
-	0x6e, 0x3d, 0x20, 0xb4, 0x2c, 0xff, 0x2a, 0x91, 0xbd, 0x0f, 0xf0, 0x89, 0x1b, 0x36, 0xf0, 0x9d,
-	0x00, 0x87, 0x55, 0xb4, 0x07, 0xc3, 0x6b, 0x75, 0xda, 0x20, 0xc2, 0x34, 0xe6, 0x8d, 0x85, 0xb1,
-	0xf5, 0xdb, 0x4f, 0x9e, 0xcf, 0xe5, 0x7e, 0x7d, 0x3e, 0x77, 0xc3, 0x0f, 0xc4, 0x61, 0x63, 0xbf,
-	0xe4, 0xd1, 0xba, 0xe6, 0xd1, 0xff, 0x96, 0x78, 0xb5, 0x56, 0x16, 0xad, 0x08, 0xf3, 0x52, 0x85,
-	0x88, 0x67, 0x8f, 0x97, 0x40, 0xbb, 0x51, 0x21, 0xc2, 0xd1, 0x58, 0xf6, 0xf7, 0x79, 0x30, 0x37,
-	0xd5, 0x91, 0x70, 0xf5, 0x7e, 0x40, 0xfc, 0x10, 0xaf, 0x71, 0x8e, 0x45, 0x85, 0x1c, 0x50, 0x64,
-	0xc2, 0x88, 0x5a, 0x6c, 0x2a, 0x4e, 0xa7, 0xbd, 0x44, 0x11, 0x4c, 0xed, 0x51, 0xe1, 0x86, 0x9d,
-	0xad, 0xda, 0xb5, 0x81, 0x2b, 0x70, 0xad, 0x2f, 0x32, 0x7a, 0x00, 0x68, 0x17, 0xb3, 0x7b, 0x11,
-	0x66, 0xae, 0xa0, 0x4c, 0x09, 0xb9, 0x99, 0x9f, 0xcf, 0x2f, 0x14, 0x56, 0x3e, 0x28, 0xf5, 0xcd,
-	0x4e, 0x29, 0xeb, 0x60, 0xa5, 0x5e, 0xa4, 0x2d, 0x22, 0x58, 0xcb, 0xe9, 0x43, 0x61, 0x1d, 0xc2,
-	0x6c, 0x86, 0x39, 0x9a, 0x84, 0x7c, 0x0d, 0xb7, 0x74, 0x6c, 0xe2, 0x4f, 0x74, 0x0b, 0x86, 0x8e,
-	0xe2, 0x94, 0xc9, 0x40, 0x14, 0x56, 0x5e, 0xc9, 0x70, 0x2c, 0x49, 0xab, 0xa3, 0xec, 0x57, 0x07,
-	0xde, 0x37, 0xec, 0x16, 0x58, 0x5e, 0x18, 0x60, 0x22, 0x36, 0x0e, 0xdd, 0x80, 0x6c, 0xb9, 0x8c,
-	0x04, 0xc4, 0x5f, 0xab, 0x56, 0xd9, 0xdd, 0x80, 0x0b, 0xf4, 0x19, 0x4c, 0x68, 0x51, 0x7c, 0x84,
-	0x58, 0x64, 0x1a, 0xf2, 0xf4, 0xcb, 0x19, 0x24, 0xfd, 0xb1, 0xe2, 0xcd, 0xce, 0x79, 0x24, 0xfb,
-	0xeb, 0x2c, 0x6a, 0x59, 0x07, 0x0b, 0x30, 0x11, 0x3e, 0xdc, 0x48, 0xf4, 0xba, 0x1e, 0x06, 0x9d,
-	0xf3, 0x62, 0xf4, 0x1e, 0xcc, 0xf4, 0xc7, 0x51, 0x95, 0xe1, 0x64, 0x68, 0xed, 0x3f, 0x0d, 0x18,
-	0x6f, 0x87, 0x58, 0x52, 0xda, 0x30, 0xae, 0xf5, 0x5c, 0x6e, 0x57, 0x31, 0xee, 0x92, 0xa1, 0x79,
-	0x28, 0xac, 0x45, 0x11, 0xa3, 0x47, 0x38, 0xc5, 0x90, 0x16, 0xa1, 0x45, 0x98, 0x6c, 0xa3, 0x6e,
-	0x63, 0xe1, 0xc6, 0xc8, 0x66, 0x5e, 0x9a, 0xf5, 0xc8, 0x51, 0x0d, 0x66, 0x37, 0x7a, 0x9c, 0x53,
-	0xe4, 0x83, 0x32, 0x99, 0x97, 0x8b, 0x73, 0x1c, 0x56, 0x27, 0x0b, 0xd1, 0xfe, 0xd9, 0x80, 0x6b,
-	0x0e, 0xf6, 0x03, 0x2e, 0x92, 0xd2, 0x72, 0xf0, 0x57, 0x68, 0x15, 0x0a, 0x77, 0x18, 0xad, 0xc7,
-	0x36, 0x98, 0x73, 0x7d, 0xd3, 0xcd, 0x67, 0x8f, 0x97, 0xa6, 0xf4, 0x05, 0xd1, 0x9a, 0xfb, 0x82,
-	0x05, 0xc4, 0x77, 0xd2, 0xc6, 0xe8, 0x16, 0x0c, 0x06, 0xf1, 0x01, 0x55, 0xe9, 0xbd, 0x9a, 0xe1,
-	0x6d, 0x3a, 0xca, 0x8e, 0xdc, 0xb0, 0x7a, 0xf3, 0xbb, 0x47, 0x73, 0xb9, 0x3f, 0x1e, 0xcd, 0xe5,
-	0xbe, 0x3d, 0x3b, 0x5e, 0x4c, 0x43, 0xfe, 0x70, 0x76, 0xbc, 0x38, 0x9b, 0xba, 0xb1, 0xe9, 0xbd,
-	0x76, 0x05, 0xa6, 0x37, 0x3b, 0xc8, 0x3a, 0xe8, 0x32, 0x90, 0x2f, 0xc3, 0x18, 0x0f, 0x7c, 0xe2,
-	0x8a, 0x06, 0xc3, 0x3a, 0x6f, 0x89, 0x00, 0x21, 0x18, 0xe4, 0x6e, 0xa8, 0x3b, 0x85, 0x23, 0xbf,
-	0x6d, 0x0b, 0xcc, 0xde, 0x60, 0xf0, 0x88, 0x12, 0x8e, 0xed, 0xbf, 0x06, 0x60, 0x26, 0xe1, 0xa9,
-	0x10, 0xef, 0x1e, 0xdb, 0xc4, 0x9e, 0x24, 0x5a, 0x85, 0xc2, 0xc1, 0x65, 0x82, 0x95, 0x32, 0x46,
-	0x0d, 0x40, 0x51, 0x6f, 0x3b, 0x19, 0x90, 0x17, 0x6a, 0xeb, 0xdf, 0xdb, 0xc9, 0x39, 0x37, 0xb2,
-	0x9b, 0x49, 0xf4, 0x3f, 0x36, 0x93, 0xd5, 0xf5, 0xae, 0xa4, 0x1e, 0x74, 0x27, 0xf5, 0xf5, 0x54,
-	0x52, 0xb7, 0x79, 0x5c, 0xaf, 0xf2, 0x38, 0x0c, 0xbb, 0x1c, 0x27, 0xa7, 0xb4, 0x7f, 0x32, 0xe0,
-	0x85, 0x6d, 0xee, 0x27, 0x12, 0x54, 0x81, 0xd1, 0x7d, 0x97, 0xcb, 0x3c, 0x4b, 0x4f, 0x0b, 0x2b,
-	0x4b, 0x97, 0x0a, 0x96, 0xd3, 0xd9, 0x8e, 0x76, 0x61, 0xdc, 0x55, 0x55, 0x53, 0xad, 0x24, 0x65,
-	0xfb, 0xd6, 0x85, 0x70, 0xa9, 0x52, 0x73, 0xba, 0x10, 0xec, 0xbf, 0xf3, 0x80, 0x3e, 0x26, 0xc9,
-	0x3e, 0x07, 0x7b, 0x94, 0x55, 0x91, 0x05, 0xa3, 0x5c, 0xb8, 0x35, 0xcc, 0x3a, 0x63, 0xac, 0xb3,
-	0x8e, 0x27, 0x9c, 0xab, 0x27, 0x9c, 0x2a, 0xc8, 0xf6, 0x12, 0xdd, 0x4e, 0x1a, 0x92, 0xec, 0x01,
-	0xf9, 0x0b, 0xaa, 0xab, 0xcb, 0x1a, 0xcd, 0xc0, 0xb0, 0x68, 0x7e, 0xe8, 0xf2, 0x43, 0xd9, 0x3b,
-	0xc6, 0x1c, 0xbd, 0x8a, 0xef, 0x46, 0xc0, 0x77, 0x31, 0xa9, 0x06, 0xc4, 0x37, 0x87, 0xe6, 0x8d,
-	0x85, 0x51, 0x27, 0x11, 0xc4, 0x0d, 0x6d, 0x3d, 0xa4, 0x5e, 0x6d, 0xa7, 0x51, 0xdf, 0xc7, 0xcc,
-	0x1c, 0x96, 0x3d, 0x36, 0x2d, 0x42, 0x6f, 0xc3, 0xb5, 0x0d, 0x5a, 0x8f, 0x42, 0x2c, 0x70, 0xda,
-	0x72, 0x44, 0x5a, 0xf6, 0x53, 0xc5, 0x8c, 0x77, 0x1f, 0xee, 0x35, 0x77, 0x28, 0xf1, 0xb0, 0x39,
-	0x2a, 0xed, 0x12, 0x41, 0xfc, 0xa8, 0x70, 0xd5, 0xe4, 0x1e, 0xbb, 0x8a, 0x47, 0x85, 0xc2, 0x42,
-	0x0c, 0xa6, 0x5d, 0x4f, 0x34, 0xdc, 0xb0, 0xed, 0x50, 0xfb, 0x79, 0x00, 0x57, 0x40, 0xd2, 0x1f,
-	0xda, 0x7e, 0x17, 0xae, 0xf7, 0xe6, 0xfe, 0x23, 0xdc, 0x92, 0xb3, 0xd3, 0x84, 0x91, 0x9a, 0xfa,
-	0x94, 0x33, 0x73, 0xcc, 0x69, 0x2f, 0xed, 0x29, 0x40, 0x9b, 0xa9, 0x4d, 0xba, 0xe9, 0x7c, 0x0e,
-	0x13, 0xdb, 0xdc, 0x4f, 0xe3, 0x5d, 0x61, 0xe5, 0xdb, 0x33, 0x30, 0xd5, 0xed, 0xaa, 0x62, 0x5d,
-	0xf9, 0x6d, 0x00, 0xf2, 0xdb, 0xdc, 0x47, 0x14, 0x26, 0xcf, 0xb7, 0x43, 0xb4, 0x98, 0x41, 0xd6,
-	0x67, 0x88, 0x58, 0xe5, 0xff, 0x6c, 0xab, 0x88, 0xd1, 0x97, 0x30, 0xdb, 0x7e, 0x2a, 0xc9, 0x37,
-	0xd2, 0x1e, 0xed, 0xf0, 0xbe, 0x96, 0x81, 0xd5, 0xd5, 0x16, 0xac, 0x37, 0x2e, 0x0c, 0x45, 0x87,
-	0x8b, 0xc1, 0x4b, 0x9d, 0xc3, 0x2b, 0xb6, 0x78, 0xe0, 0x74, 0xf8, 0x6e, 0x64, 0xf3, 0xa5, 0x63,
-	0x66, 0xbd, 0x99, 0x61, 0xd7, 0x2f, 0xb0, 0xd6, 0xd0, 0x37, 0x67, 0xc7, 0x8b, 0xc6, 0xfa, 0xce,
-	0x93, 0x93, 0xa2, 0xf1, 0xf4, 0xa4, 0x68, 0xfc, 0x7e, 0x52, 0x34, 0x7e, 0x3c, 0x2d, 0xe6, 0x9e,
-	0x9e, 0x16, 0x73, 0xbf, 0x9c, 0x16, 0x73, 0x9f, 0xde, 0x4c, 0x55, 0xe2, 0x96, 0xc2, 0xdd, 0xc1,
-	0xe2, 0x01, 0x65, 0xb5, 0x72, 0xfb, 0x77, 0x41, 0x33, 0xfd, 0xcb, 0x40, 0xd6, 0xe6, 0xfe, 0xb0,
-	0x7c, 0xa6, 0xbf, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0xf9, 0x36, 0xba, 0x3c, 0x0c,
-	0x00, 0x00,
+func (m *MsgUpdateApprovedStakers) Reset()         { *m = MsgUpdateApprovedStakers{} }
+func (m *MsgUpdateApprovedStakers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateApprovedStakers) ProtoMessage()    {}
+func (*MsgUpdateApprovedStakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{15}
+}
+func (m *MsgUpdateApprovedStakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateApprovedStakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateApprovedStakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateApprovedStakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateApprovedStakers.Merge(m, src)
+}
+func (m *MsgUpdateApprovedStakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateApprovedStakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateApprovedStakers.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateApprovedStakers proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error)
-	DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error)
-	UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error)
+type UpdateApprovedStakersResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *UpdateApprovedStakersResponse) Reset()         { *m = UpdateApprovedStakersResponse{} }
+func (m *UpdateApprovedStakersResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateApprovedStakersResponse) ProtoMessage()    {}
+func (*UpdateApprovedStakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{16}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *UpdateApprovedStakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error) {
-	out := new(RegisterOperatorResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/RegisterOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateApprovedStakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateApprovedStakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error) {
-	out := new(DelegationResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/DelegateAssetToOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateApprovedStakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateApprovedStakersResponse.Merge(m, src)
 }
-
-func (c *msgClient) UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error) {
-	out := new(UndelegationResponse)
-	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateApprovedStakersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
-	RegisterOperator(context.Context, *RegisterOperatorReq) (*RegisterOperatorResponse, error)
-	DelegateAssetToOperator(context.Context, *MsgDelegation) (*DelegationResponse, error)
-	UndelegateAssetFromOperator(context.Context, *MsgUndelegation) (*UndelegationResponse, error)
+func (m *UpdateApprovedStakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateApprovedStakersResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_UpdateApprovedStakersResponse proto.InternalMessageInfo
+
+// MsgUpdateOperatorInfo is used by the operator to update its info. The EarningsAddr
+// can't be changed after the registration.
+type MsgUpdateOperatorInfo struct {
+	FromAddress             string                      `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	ApproveAddr             string                      `protobuf:"bytes,2,opt,name=approveAddr,proto3" json:"approveAddr,omitempty"`
+	OperatorMetaInfo        string                      `protobuf:"bytes,3,opt,name=operatorMetaInfo,proto3" json:"operatorMetaInfo,omitempty"`
+	ClientChainEarningsAddr *ClientChainEarningAddrList `protobuf:"bytes,4,opt,name=clientChainEarningsAddr,proto3" json:"clientChainEarningsAddr,omitempty"`
 }
 
-func (*UnimplementedMsgServer) RegisterOperator(ctx context.Context, req *RegisterOperatorReq) (*RegisterOperatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterOperator not implemented")
+func (m *MsgUpdateOperatorInfo) Reset()         { *m = MsgUpdateOperatorInfo{} }
+func (m *MsgUpdateOperatorInfo) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOperatorInfo) ProtoMessage()    {}
+func (*MsgUpdateOperatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{17}
 }
-func (*UnimplementedMsgServer) DelegateAssetToOperator(ctx context.Context, req *MsgDelegation) (*DelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelegateAssetToOperator not implemented")
+func (m *MsgUpdateOperatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UndelegateAssetFromOperator(ctx context.Context, req *MsgUndelegation) (*UndelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAssetFromOperator not implemented")
+func (m *MsgUpdateOperatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOperatorInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOperatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOperatorInfo.Merge(m, src)
+}
+func (m *MsgUpdateOperatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOperatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOperatorInfo.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateOperatorInfo proto.InternalMessageInfo
+
+type UpdateOperatorInfoResponse struct {
 }
 
-func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterOperatorReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Msg/RegisterOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
+func (m *UpdateOperatorInfoResponse) Reset()         { *m = UpdateOperatorInfoResponse{} }
+func (m *UpdateOperatorInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateOperatorInfoResponse) ProtoMessage()    {}
+func (*UpdateOperatorInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{18}
+}
+func (m *UpdateOperatorInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateOperatorInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateOperatorInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UpdateOperatorInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOperatorInfoResponse.Merge(m, src)
+}
+func (m *UpdateOperatorInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateOperatorInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOperatorInfoResponse.DiscardUnknown(m)
 }
 
-func _Msg_DelegateAssetToOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DelegateAssetToOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Msg/DelegateAssetToOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DelegateAssetToOperator(ctx, req.(*MsgDelegation))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_UpdateOperatorInfoResponse proto.InternalMessageInfo
+
+// MsgDeregisterOperator is used by the operator without any delegation to deregister
+// itself, the bond will be returned after the deregistration delay.
+type MsgDeregisterOperator struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
 }
 
-func _Msg_UndelegateAssetFromOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, req.(*MsgUndelegation))
+func (m *MsgDeregisterOperator) Reset()         { *m = MsgDeregisterOperator{} }
+func (m *MsgDeregisterOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterOperator) ProtoMessage()    {}
+func (*MsgDeregisterOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{19}
+}
+func (m *MsgDeregisterOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterOperator.Merge(m, src)
+}
+func (m *MsgDeregisterOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterOperator.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.delegation.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterOperator",
-			Handler:    _Msg_RegisterOperator_Handler,
-		},
-		{
-			MethodName: "DelegateAssetToOperator",
-			Handler:    _Msg_DelegateAssetToOperator_Handler,
-		},
-		{
-			MethodName: "UndelegateAssetFromOperator",
-			Handler:    _Msg_UndelegateAssetFromOperator_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/delegation/v1/tx.proto",
+var xxx_messageInfo_MsgDeregisterOperator proto.InternalMessageInfo
+
+type DeregisterOperatorResponse struct {
 }
 
-func (m *ValueField) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DeregisterOperatorResponse) Reset()         { *m = DeregisterOperatorResponse{} }
+func (m *DeregisterOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*DeregisterOperatorResponse) ProtoMessage()    {}
+func (*DeregisterOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{20}
+}
+func (m *DeregisterOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DeregisterOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterOperatorResponse.Merge(m, src)
+}
+func (m *DeregisterOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterOperatorResponse.DiscardUnknown(m)
 }
 
-func (m *ValueField) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DeregisterOperatorResponse proto.InternalMessageInfo
+
+// MsgOptIntoChainValidation is used by the operator to opt in to the Exocore chain validation with
+// its consensus key, the selfBond is added to the operator bond.
+type MsgOptIntoChainValidation struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	// consensusPubKey is the ed25519 public key used by the operator to sign the blocks
+	ConsensusPubKey []byte     `protobuf:"bytes,2,opt,name=consensusPubKey,proto3" json:"consensusPubKey,omitempty"`
+	SelfBond        types.Coin `protobuf:"bytes,3,opt,name=selfBond,proto3" json:"selfBond"`
 }
 
-func (m *ValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgOptIntoChainValidation) Reset()         { *m = MsgOptIntoChainValidation{} }
+func (m *MsgOptIntoChainValidation) String() string { return proto.CompactTextString(m) }
+func (*MsgOptIntoChainValidation) ProtoMessage()    {}
+func (*MsgOptIntoChainValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{21}
+}
+func (m *MsgOptIntoChainValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptIntoChainValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptIntoChainValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegatedSingleAssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgOptIntoChainValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptIntoChainValidation.Merge(m, src)
+}
+func (m *MsgOptIntoChainValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptIntoChainValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptIntoChainValidation.DiscardUnknown(m)
 }
 
-func (m *DelegatedSingleAssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgOptIntoChainValidation proto.InternalMessageInfo
+
+type OptIntoChainValidationResponse struct {
 }
 
-func (m *DelegatedSingleAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PerOperatorAmounts) > 0 {
-		for k := range m.PerOperatorAmounts {
-			v := m.PerOperatorAmounts[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintTx(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size := m.TotalDelegatedAmount.Size()
-		i -= size
-		if _, err := m.TotalDelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *OptIntoChainValidationResponse) Reset()         { *m = OptIntoChainValidationResponse{} }
+func (m *OptIntoChainValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*OptIntoChainValidationResponse) ProtoMessage()    {}
+func (*OptIntoChainValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{22}
+}
+func (m *OptIntoChainValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptIntoChainValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptIntoChainValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ClientChainEarningAddrList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OptIntoChainValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptIntoChainValidationResponse.Merge(m, src)
+}
+func (m *OptIntoChainValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptIntoChainValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptIntoChainValidationResponse.DiscardUnknown(m)
 }
 
-func (m *ClientChainEarningAddrList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OptIntoChainValidationResponse proto.InternalMessageInfo
+
+// MsgOptOutOfChainValidation is used by the operator to leave the Exocore chain validation,
+// it's removed from the validator set at the end of the block.
+type MsgOptOutOfChainValidation struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
 }
 
-func (m *ClientChainEarningAddrList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EarningInfoList) > 0 {
-		for iNdEx := len(m.EarningInfoList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EarningInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgOptOutOfChainValidation) Reset()         { *m = MsgOptOutOfChainValidation{} }
+func (m *MsgOptOutOfChainValidation) String() string { return proto.CompactTextString(m) }
+func (*MsgOptOutOfChainValidation) ProtoMessage()    {}
+func (*MsgOptOutOfChainValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{23}
+}
+func (m *MsgOptOutOfChainValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOptOutOfChainValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOptOutOfChainValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ClientChainEarningAddrInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgOptOutOfChainValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOptOutOfChainValidation.Merge(m, src)
 }
-
-func (m *ClientChainEarningAddrInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOptOutOfChainValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOptOutOfChainValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOptOutOfChainValidation.DiscardUnknown(m)
 }
 
-func (m *ClientChainEarningAddrInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientChainEarningAddr) > 0 {
-		i -= len(m.ClientChainEarningAddr)
-		copy(dAtA[i:], m.ClientChainEarningAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainEarningAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LzClientChainID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LzClientChainID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgOptOutOfChainValidation proto.InternalMessageInfo
+
+type OptOutOfChainValidationResponse struct {
 }
 
-func (m *OperatorInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OptOutOfChainValidationResponse) Reset()         { *m = OptOutOfChainValidationResponse{} }
+func (m *OptOutOfChainValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*OptOutOfChainValidationResponse) ProtoMessage()    {}
+func (*OptOutOfChainValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{24}
+}
+func (m *OptOutOfChainValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptOutOfChainValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptOutOfChainValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *OptOutOfChainValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptOutOfChainValidationResponse.Merge(m, src)
+}
+func (m *OptOutOfChainValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptOutOfChainValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptOutOfChainValidationResponse.DiscardUnknown(m)
 }
 
-func (m *OperatorInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OptOutOfChainValidationResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the params of the delegation module, it can only be sent by the governance.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the delegation parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *OperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClientChainEarningsAddr != nil {
-		{
-			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{25}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OperatorMetaInfo) > 0 {
-		i -= len(m.OperatorMetaInfo)
-		copy(dAtA[i:], m.OperatorMetaInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ApproveAddr) > 0 {
-		i -= len(m.ApproveAddr)
-		copy(dAtA[i:], m.ApproveAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.EarningsAddr) > 0 {
-		i -= len(m.EarningsAddr)
-		copy(dAtA[i:], m.EarningsAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EarningsAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *RegisterOperatorReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *RegisterOperatorReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type UpdateParamsResponse struct {
 }
 
-func (m *RegisterOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *UpdateParamsResponse) Reset()         { *m = UpdateParamsResponse{} }
+func (m *UpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsResponse) ProtoMessage()    {}
+func (*UpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16596a15a828f109, []int{26}
+}
+func (m *UpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DelegationApproveInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsResponse.Merge(m, src)
+}
+func (m *UpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *DelegationApproveInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValueField)(nil), "exocore.delegation.v1.ValueField")
+	proto.RegisterType((*DelegatedSingleAssetInfo)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo")
+	proto.RegisterMapType((map[string]*ValueField)(nil), "exocore.delegation.v1.DelegatedSingleAssetInfo.PerOperatorAmountsEntry")
+	proto.RegisterType((*ClientChainEarningAddrList)(nil), "exocore.delegation.v1.clientChainEarningAddrList")
+	proto.RegisterType((*ClientChainEarningAddrInfo)(nil), "exocore.delegation.v1.clientChainEarningAddrInfo")
+	proto.RegisterType((*OperatorInfo)(nil), "exocore.delegation.v1.OperatorInfo")
+	proto.RegisterType((*RegisterOperatorReq)(nil), "exocore.delegation.v1.RegisterOperatorReq")
+	proto.RegisterType((*DelegationApproveInfo)(nil), "exocore.delegation.v1.DelegationApproveInfo")
+	proto.RegisterType((*RegisterOperatorResponse)(nil), "exocore.delegation.v1.RegisterOperatorResponse")
+	proto.RegisterType((*DelegationIncOrDecInfo)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo")
+	proto.RegisterMapType((map[string]*ValueField)(nil), "exocore.delegation.v1.DelegationIncOrDecInfo.PerOperatorAmountsEntry")
+	proto.RegisterType((*MsgDelegation)(nil), "exocore.delegation.v1.MsgDelegation")
+	proto.RegisterMapType((map[string]*DelegationApproveInfo)(nil), "exocore.delegation.v1.MsgDelegation.PerOperatorApprovedInfosEntry")
+	proto.RegisterType((*UndelegationRecord)(nil), "exocore.delegation.v1.UndelegationRecord")
+	proto.RegisterType((*UndelegationRecordKeyList)(nil), "exocore.delegation.v1.UndelegationRecordKeyList")
+	proto.RegisterType((*DelegationResponse)(nil), "exocore.delegation.v1.DelegationResponse")
+	proto.RegisterType((*MsgUndelegation)(nil), "exocore.delegation.v1.MsgUndelegation")
+	proto.RegisterType((*UndelegationResponse)(nil), "exocore.delegation.v1.UndelegationResponse")
+	proto.RegisterType((*MsgUpdateApprovedStakers)(nil), "exocore.delegation.v1.MsgUpdateApprovedStakers")
+	proto.RegisterType((*UpdateApprovedStakersResponse)(nil), "exocore.delegation.v1.UpdateApprovedStakersResponse")
+	proto.RegisterType((*MsgUpdateOperatorInfo)(nil), "exocore.delegation.v1.MsgUpdateOperatorInfo")
+	proto.RegisterType((*UpdateOperatorInfoResponse)(nil), "exocore.delegation.v1.UpdateOperatorInfoResponse")
+	proto.RegisterType((*MsgDeregisterOperator)(nil), "exocore.delegation.v1.MsgDeregisterOperator")
+	proto.RegisterType((*DeregisterOperatorResponse)(nil), "exocore.delegation.v1.DeregisterOperatorResponse")
+	proto.RegisterType((*MsgOptIntoChainValidation)(nil), "exocore.delegation.v1.MsgOptIntoChainValidation")
+	proto.RegisterType((*OptIntoChainValidationResponse)(nil), "exocore.delegation.v1.OptIntoChainValidationResponse")
+	proto.RegisterType((*MsgOptOutOfChainValidation)(nil), "exocore.delegation.v1.MsgOptOutOfChainValidation")
+	proto.RegisterType((*OptOutOfChainValidationResponse)(nil), "exocore.delegation.v1.OptOutOfChainValidationResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.delegation.v1.MsgUpdateParams")
+	proto.RegisterType((*UpdateParamsResponse)(nil), "exocore.delegation.v1.UpdateParamsResponse")
 }
 
-func (m *DelegationApproveInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Salt) > 0 {
-		i -= len(m.Salt)
-		copy(dAtA[i:], m.Salt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("exocore/delegation/v1/tx.proto", fileDescriptor_16596a15a828f109) }
+
+var fileDescriptor_16596a15a828f109 = []byte{
+	// 1604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xc4, 0x6e, 0x9a, 0x9c, 0xe4, 0x29, 0xe9, 0x6d, 0x3e, 0x9c, 0x79, 0x8d, 0xe3, 0xce,
+	0x7b, 0xaf, 0xca, 0xcb, 0x4b, 0xec, 0x26, 0xaf, 0x1f, 0xc8, 0x2d, 0xa0, 0x38, 0x4e, 0xc1, 0xb4,
+	0xf9, 0xd0, 0x34, 0x74, 0x01, 0x48, 0x68, 0x62, 0xdf, 0x4c, 0x06, 0xdb, 0x73, 0xcd, 0xdc, 0xeb,
+	0x34, 0xee, 0xa2, 0x02, 0xc4, 0x02, 0xd8, 0xc0, 0x96, 0x5d, 0xff, 0x03, 0xba, 0xe8, 0x8e, 0x0d,
+	0x1b, 0xa4, 0x8a, 0x55, 0xd5, 0x15, 0x02, 0xa9, 0x42, 0xed, 0xa2, 0x6c, 0x10, 0x7b, 0x16, 0x08,
+	0xcd, 0x9d, 0xeb, 0xf9, 0xb0, 0xe7, 0x3a, 0xad, 0x12, 0xb1, 0x49, 0x7c, 0xcf, 0xd7, 0xef, 0xcc,
+	0xb9, 0xe7, 0xfe, 0xee, 0x99, 0x81, 0x34, 0x3e, 0x20, 0x65, 0xe2, 0xe0, 0x5c, 0x05, 0xd7, 0xb0,
+	0x69, 0x30, 0x8b, 0xd8, 0xb9, 0xfd, 0xa5, 0x1c, 0x3b, 0xc8, 0x36, 0x1c, 0xc2, 0x08, 0x9a, 0x10,
+	0xfa, 0x6c, 0xa0, 0xcf, 0xee, 0x2f, 0xa9, 0xe9, 0x32, 0xa1, 0x75, 0x42, 0x73, 0x3b, 0x06, 0xc5,
+	0xb9, 0xfd, 0xa5, 0x1d, 0xcc, 0x8c, 0xa5, 0x5c, 0x99, 0x58, 0xb6, 0xe7, 0xa6, 0x4e, 0x09, 0x7d,
+	0x9d, 0x9a, 0x6e, 0xb8, 0x3a, 0x35, 0x85, 0x62, 0xda, 0x53, 0xbc, 0xcf, 0x57, 0x39, 0x6f, 0x21,
+	0x54, 0xe3, 0x26, 0x31, 0x89, 0x27, 0x77, 0x7f, 0x09, 0xe9, 0x29, 0xa3, 0x6e, 0xd9, 0x24, 0xc7,
+	0xff, 0x0a, 0x91, 0x16, 0x9f, 0x73, 0xc3, 0x70, 0x8c, 0xba, 0x08, 0xa6, 0xed, 0x00, 0xdc, 0x32,
+	0x6a, 0x4d, 0x7c, 0xcd, 0xc2, 0xb5, 0x0a, 0xda, 0x86, 0x81, 0x95, 0x3a, 0x69, 0xda, 0x2c, 0xa5,
+	0x64, 0x94, 0xb9, 0xa1, 0xc2, 0xd5, 0x87, 0x4f, 0x66, 0xfb, 0x7e, 0x7a, 0x32, 0x7b, 0xce, 0xb4,
+	0xd8, 0x5e, 0x73, 0x27, 0x5b, 0x26, 0x75, 0x91, 0x8b, 0xf8, 0xb7, 0x48, 0x2b, 0xd5, 0x1c, 0x6b,
+	0x35, 0x30, 0xcd, 0x96, 0x6c, 0xf6, 0xf8, 0xc1, 0x22, 0x88, 0x54, 0x4b, 0x36, 0xd3, 0x45, 0x2c,
+	0xed, 0xf3, 0x04, 0xa4, 0x8a, 0x5e, 0x0a, 0xb8, 0x72, 0xd3, 0xb2, 0xcd, 0x1a, 0x5e, 0xa1, 0x14,
+	0xb3, 0x92, 0xbd, 0x4b, 0x50, 0x0a, 0x4e, 0x7a, 0x8b, 0xa2, 0x87, 0xa9, 0xb7, 0x97, 0xa8, 0x01,
+	0xe3, 0xdb, 0x84, 0x19, 0x35, 0xdf, 0x55, 0xa4, 0xd6, 0x7f, 0x0c, 0xa9, 0xc5, 0x46, 0x46, 0xb7,
+	0x01, 0x6d, 0x61, 0x67, 0xb3, 0x81, 0x1d, 0x83, 0x11, 0xc7, 0x13, 0xd2, 0x54, 0x22, 0x93, 0x98,
+	0x1b, 0x5e, 0x7e, 0x23, 0x1b, 0xbb, 0xc3, 0x59, 0xd9, 0x83, 0x65, 0xbb, 0x23, 0xad, 0xd9, 0xcc,
+	0x69, 0xe9, 0x31, 0x10, 0xea, 0x1e, 0x4c, 0x49, 0xcc, 0xd1, 0x18, 0x24, 0xaa, 0xb8, 0x25, 0x6a,
+	0xe3, 0xfe, 0x44, 0x97, 0xe1, 0xc4, 0xbe, 0xbb, 0x65, 0xbc, 0x10, 0xc3, 0xcb, 0x67, 0x25, 0x89,
+	0x05, 0xdb, 0xaa, 0x7b, 0xf6, 0xf9, 0xfe, 0x57, 0x14, 0xad, 0x05, 0x6a, 0xb9, 0x66, 0x61, 0x9b,
+	0xad, 0xee, 0x19, 0x96, 0xbd, 0x66, 0x38, 0xb6, 0x65, 0x9b, 0x2b, 0x95, 0x8a, 0x73, 0xc3, 0xa2,
+	0x0c, 0xbd, 0x0b, 0xa3, 0x42, 0xe4, 0x3e, 0x82, 0x2b, 0x4a, 0x29, 0xfc, 0xe9, 0x97, 0x24, 0x20,
+	0xf1, 0xb1, 0x5c, 0x67, 0xbd, 0x33, 0x92, 0x76, 0x57, 0x06, 0xcd, 0xfb, 0x60, 0x0e, 0x46, 0x6b,
+	0x77, 0x56, 0x03, 0xbd, 0xe8, 0x87, 0xa4, 0xde, 0x29, 0x46, 0x97, 0x60, 0x32, 0x3e, 0x8e, 0xd7,
+	0x19, 0xba, 0x44, 0xab, 0xfd, 0xa6, 0xc0, 0x48, 0xbb, 0xc4, 0x1c, 0x52, 0x83, 0x11, 0xa1, 0xa7,
+	0xdc, 0xdd, 0xab, 0x71, 0x44, 0x86, 0x32, 0x30, 0xbc, 0xd2, 0x68, 0x38, 0x64, 0x1f, 0x87, 0x10,
+	0xc2, 0x22, 0x34, 0x0f, 0x63, 0xed, 0xa8, 0xeb, 0x98, 0x19, 0x6e, 0xe4, 0x54, 0x82, 0x9b, 0x75,
+	0xc9, 0x51, 0x15, 0xa6, 0x56, 0xbb, 0x92, 0xf3, 0xc0, 0x93, 0x19, 0xe5, 0xa5, 0xeb, 0xec, 0x96,
+	0x55, 0x97, 0x45, 0xd4, 0xbe, 0x53, 0xe0, 0xb4, 0x8e, 0x4d, 0x8b, 0xb2, 0xa0, 0xb5, 0x74, 0xfc,
+	0x21, 0xca, 0xc3, 0xf0, 0x35, 0x87, 0xd4, 0x5d, 0x1b, 0x4c, 0xa9, 0x38, 0xe9, 0xa9, 0xc7, 0x0f,
+	0x16, 0xc7, 0xc5, 0x01, 0x11, 0x9a, 0x9b, 0xcc, 0xb1, 0x6c, 0x53, 0x0f, 0x1b, 0xa3, 0xcb, 0x90,
+	0xb4, 0xdc, 0x07, 0xf4, 0x5a, 0xef, 0x5f, 0x92, 0x6c, 0xc3, 0x55, 0xd6, 0xb9, 0x43, 0xfe, 0xc2,
+	0x67, 0xf7, 0x66, 0xfb, 0x7e, 0xbd, 0x37, 0xdb, 0xf7, 0xc9, 0xf3, 0xfb, 0xf3, 0xe1, 0x90, 0x5f,
+	0x3c, 0xbf, 0x3f, 0x3f, 0x15, 0x3a, 0xb1, 0x61, 0x5f, 0xcd, 0x80, 0x89, 0xa2, 0x1f, 0x59, 0x14,
+	0x9d, 0x17, 0xf2, 0x0c, 0x0c, 0x51, 0xcb, 0xb4, 0x0d, 0xd6, 0x74, 0xb0, 0xd8, 0xb7, 0x40, 0x80,
+	0x10, 0x24, 0xa9, 0x51, 0x13, 0x4c, 0xa1, 0xf3, 0xdf, 0x68, 0x12, 0x06, 0xf0, 0x41, 0xc3, 0x72,
+	0x5a, 0x7c, 0x73, 0x92, 0xba, 0x58, 0x69, 0x2a, 0xa4, 0xba, 0x8b, 0x44, 0x1b, 0xc4, 0xa6, 0x58,
+	0xfb, 0x26, 0x01, 0x93, 0x01, 0x7e, 0xc9, 0x2e, 0x6f, 0x3a, 0x45, 0x5c, 0xe6, 0x09, 0xe4, 0x61,
+	0x78, 0xf7, 0x65, 0x8a, 0x18, 0x32, 0x46, 0x4d, 0x40, 0x8d, 0x6e, 0x9a, 0xe9, 0xe7, 0x07, 0x6d,
+	0xad, 0x37, 0xcd, 0x74, 0xa4, 0x21, 0x27, 0x99, 0x6e, 0x00, 0xa4, 0xc2, 0x20, 0x65, 0x46, 0x15,
+	0x3b, 0xa5, 0xa2, 0x68, 0x50, 0x7f, 0xed, 0xb2, 0xb0, 0x21, 0x58, 0x38, 0xe9, 0xb1, 0xb0, 0x58,
+	0xfe, 0x7d, 0xd4, 0x94, 0x2f, 0x44, 0x5a, 0x64, 0x37, 0xda, 0x22, 0xff, 0x09, 0xb5, 0xc8, 0x3a,
+	0x75, 0xbb, 0x9f, 0x17, 0xc1, 0xc1, 0x06, 0xc5, 0x41, 0x6d, 0xb4, 0x9f, 0xfb, 0xe1, 0x1f, 0xeb,
+	0xd4, 0x0c, 0x24, 0xa8, 0x04, 0x83, 0xee, 0xe5, 0xcb, 0x8f, 0xa5, 0xc2, 0xb3, 0x5a, 0x7c, 0xa9,
+	0x12, 0xeb, 0xbe, 0x3b, 0xba, 0x0b, 0xa9, 0x70, 0x59, 0xbd, 0x76, 0xac, 0xb8, 0xaa, 0xf6, 0x25,
+	0x51, 0x90, 0x84, 0x8e, 0xa4, 0x94, 0xdd, 0x92, 0x04, 0xf1, 0xb6, 0x4e, 0x8a, 0xa1, 0xb6, 0x60,
+	0xa6, 0xa7, 0x6b, 0xcc, 0x86, 0x14, 0xa2, 0x1b, 0xb2, 0x70, 0xe8, 0xa3, 0x87, 0x0e, 0x59, 0x68,
+	0x6f, 0xde, 0x4a, 0x0e, 0xf6, 0x8f, 0x25, 0xb4, 0x3f, 0x12, 0x80, 0xde, 0xb6, 0x03, 0x57, 0x1d,
+	0x97, 0x89, 0x53, 0x89, 0x34, 0x96, 0x22, 0x6f, 0xac, 0xfe, 0x48, 0x63, 0xa1, 0xab, 0x01, 0x1b,
+	0x73, 0x02, 0x4c, 0x1c, 0x72, 0x84, 0x22, 0xd6, 0xee, 0x71, 0x66, 0x07, 0x6f, 0x1a, 0x74, 0x4f,
+	0xf4, 0xab, 0x58, 0xb9, 0xc4, 0x60, 0xd1, 0x2d, 0x6c, 0x57, 0x2c, 0xdb, 0x4c, 0x9d, 0xc8, 0x28,
+	0x73, 0x83, 0x7a, 0x20, 0x70, 0xd9, 0xbc, 0x50, 0x23, 0xe5, 0xea, 0x46, 0xb3, 0xbe, 0x83, 0x9d,
+	0xd4, 0x00, 0x67, 0x82, 0xb0, 0x08, 0x9d, 0x87, 0xd3, 0xab, 0xa4, 0xde, 0xa8, 0x61, 0x86, 0xc3,
+	0x96, 0x27, 0xb9, 0x65, 0x9c, 0xca, 0x45, 0xbc, 0x71, 0x67, 0xfb, 0x60, 0x83, 0xd8, 0x65, 0x9c,
+	0x1a, 0xe4, 0x76, 0x81, 0xc0, 0x9d, 0xa8, 0x0c, 0x6f, 0x6c, 0x19, 0x3a, 0x8e, 0x89, 0xca, 0x8b,
+	0x85, 0x1c, 0x98, 0x30, 0xca, 0xac, 0x69, 0xd4, 0xda, 0x09, 0xb5, 0x67, 0x23, 0x38, 0x06, 0x90,
+	0xf8, 0xd0, 0xda, 0x45, 0x98, 0xee, 0xde, 0xfb, 0xeb, 0xb8, 0xc5, 0x07, 0x87, 0x14, 0x9c, 0xac,
+	0xe2, 0x96, 0x3f, 0x30, 0x0c, 0xe9, 0xed, 0xa5, 0x36, 0x0e, 0xa8, 0x18, 0x72, 0x12, 0xcc, 0xfa,
+	0x1e, 0x8c, 0xae, 0x53, 0x33, 0x1c, 0xef, 0x18, 0x0f, 0xaa, 0x36, 0x09, 0xe3, 0xd1, 0x54, 0x05,
+	0xea, 0xef, 0x0a, 0xa4, 0x5c, 0xd8, 0x46, 0xc5, 0x60, 0xb8, 0x7d, 0x7e, 0x6e, 0xf2, 0x56, 0xa5,
+	0x47, 0x62, 0xf4, 0x05, 0x38, 0x65, 0x44, 0xc2, 0x95, 0x8a, 0x1e, 0xa1, 0x0f, 0xe9, 0xdd, 0x0a,
+	0x77, 0x62, 0x70, 0xf0, 0x3e, 0xa9, 0x86, 0x8d, 0x13, 0xdc, 0xb8, 0x4b, 0x9e, 0x7f, 0xb5, 0x17,
+	0x29, 0x66, 0xda, 0x03, 0xbe, 0xec, 0xa1, 0xb4, 0x59, 0x98, 0x89, 0x55, 0xf8, 0x25, 0xf9, 0xa1,
+	0x1f, 0x26, 0x7c, 0xef, 0xc8, 0x74, 0x74, 0x94, 0x7a, 0x64, 0x60, 0xd8, 0xe8, 0x9e, 0x9a, 0x8c,
+	0xe8, 0xd4, 0x44, 0x24, 0x53, 0x13, 0x89, 0x99, 0x9a, 0xca, 0xc7, 0x3e, 0x35, 0x49, 0x22, 0xe6,
+	0xf3, 0xbd, 0x0a, 0x3e, 0xd3, 0x55, 0xf0, 0xc8, 0xb8, 0x72, 0x06, 0xd4, 0x6e, 0xa9, 0x5f, 0xea,
+	0x2f, 0x15, 0x5e, 0xea, 0x22, 0x76, 0x3a, 0xe6, 0x8d, 0xa3, 0x94, 0xfa, 0xc5, 0xf3, 0xed, 0xc6,
+	0x75, 0xf3, 0xed, 0x96, 0xfa, 0xf9, 0xfe, 0xa9, 0xc0, 0xf4, 0x3a, 0x35, 0x37, 0x1b, 0xac, 0x64,
+	0x33, 0xc2, 0x2b, 0x75, 0xcb, 0xa8, 0x59, 0x15, 0xef, 0xb8, 0x1e, 0xa5, 0x3d, 0xe6, 0x60, 0xb4,
+	0xec, 0x42, 0xd8, 0xb4, 0x49, 0xb7, 0x9a, 0x3b, 0xd7, 0x71, 0x8b, 0xb7, 0xc8, 0x88, 0xde, 0x29,
+	0x46, 0x57, 0x60, 0x90, 0xe2, 0xda, 0x6e, 0x81, 0xd8, 0x15, 0xde, 0x1e, 0xc3, 0xcb, 0xd3, 0x59,
+	0x11, 0xdf, 0x3d, 0xed, 0x59, 0xf1, 0x4a, 0x9d, 0x5d, 0x25, 0x96, 0x5d, 0x48, 0xba, 0xb4, 0xa7,
+	0xfb, 0x0e, 0xf9, 0xd7, 0x7a, 0x95, 0xe6, 0x6c, 0xa8, 0x34, 0xf1, 0x8f, 0xa8, 0x65, 0x20, 0x1d,
+	0xaf, 0xf1, 0x4b, 0xf4, 0xb5, 0x02, 0xaa, 0xe7, 0xbf, 0xd9, 0x64, 0x9b, 0xbb, 0xc7, 0x58, 0xa3,
+	0xfc, 0xeb, 0xbd, 0x92, 0xd7, 0xa2, 0xc9, 0xc7, 0x81, 0x6b, 0x67, 0x61, 0x56, 0xa2, 0xf2, 0xd3,
+	0xff, 0x5e, 0xf1, 0x68, 0x98, 0xf7, 0xec, 0x16, 0xff, 0x2c, 0x80, 0x2e, 0xc1, 0x90, 0xd1, 0x64,
+	0x7b, 0xc4, 0xb1, 0x58, 0xeb, 0xd0, 0x8c, 0x03, 0x53, 0x74, 0x05, 0x06, 0xbc, 0x0f, 0x0b, 0x62,
+	0xd4, 0x98, 0x91, 0x9c, 0x49, 0x0f, 0x46, 0xec, 0x95, 0x70, 0x89, 0xb2, 0x5c, 0x10, 0x34, 0xf2,
+	0xa8, 0x41, 0x98, 0x5c, 0x47, 0xce, 0x9c, 0xef, 0x43, 0xeb, 0xf6, 0xf3, 0x2d, 0x7f, 0x3b, 0x08,
+	0x89, 0x75, 0x6a, 0x22, 0x02, 0x63, 0x9d, 0x33, 0x3e, 0x9a, 0x97, 0xe4, 0x17, 0xf3, 0xc6, 0xa4,
+	0xe6, 0x5e, 0xd8, 0xd6, 0x03, 0x46, 0x1f, 0xc0, 0x54, 0xfb, 0xbb, 0x00, 0xff, 0x20, 0xb0, 0x4d,
+	0x7c, 0xdc, 0x7f, 0xbf, 0xc8, 0x88, 0xa8, 0xfe, 0xf7, 0xd0, 0xab, 0xcf, 0xc7, 0x72, 0xe0, 0x9f,
+	0xfe, 0x65, 0xe7, 0xa1, 0xb9, 0x6f, 0x57, 0x3e, 0xde, 0x39, 0x39, 0x5e, 0xf8, 0x8e, 0x54, 0xff,
+	0x27, 0xb1, 0x8b, 0xbb, 0x48, 0xd1, 0x5d, 0x98, 0x88, 0xbf, 0x44, 0x73, 0x3d, 0xd0, 0xe2, 0x1c,
+	0xd4, 0x0b, 0x32, 0xd8, 0x5e, 0xb7, 0x96, 0xfb, 0x06, 0x15, 0x73, 0x63, 0x2d, 0x1c, 0x06, 0x1e,
+	0xb6, 0x56, 0x97, 0x7a, 0x22, 0xc7, 0x31, 0xb8, 0x0b, 0x1b, 0xc3, 0xde, 0x0b, 0xbd, 0x76, 0xb4,
+	0xd3, 0x5a, 0x0a, 0x2b, 0x27, 0x62, 0xf4, 0xb1, 0x02, 0x93, 0x12, 0x16, 0x3e, 0x2f, 0xc7, 0x8e,
+	0xf7, 0x50, 0x2f, 0x4a, 0xdf, 0xd9, 0x7b, 0x31, 0x1d, 0xfa, 0x54, 0x81, 0x29, 0x19, 0xcd, 0x2d,
+	0xf5, 0x4c, 0x22, 0xce, 0x45, 0xbd, 0x24, 0xcf, 0xa2, 0x17, 0x63, 0xa1, 0x32, 0x8c, 0x44, 0xd8,
+	0xea, 0xdc, 0x61, 0x5b, 0xee, 0xd9, 0xc9, 0xbb, 0x3b, 0x86, 0x36, 0xd4, 0x13, 0x1f, 0x3d, 0xbf,
+	0x3f, 0xaf, 0x14, 0x36, 0x1e, 0x3e, 0x4d, 0x2b, 0x8f, 0x9e, 0xa6, 0x95, 0x5f, 0x9e, 0xa6, 0x95,
+	0xaf, 0x9e, 0xa5, 0xfb, 0x1e, 0x3d, 0x4b, 0xf7, 0xfd, 0xf8, 0x2c, 0xdd, 0xf7, 0xce, 0x85, 0xd0,
+	0x5c, 0xbd, 0xe6, 0xc5, 0xdd, 0xc0, 0xec, 0x36, 0x71, 0xaa, 0xb9, 0x36, 0x5b, 0x1d, 0x84, 0xf9,
+	0x8a, 0x4f, 0xda, 0x3b, 0x03, 0xfc, 0x8b, 0xeb, 0xff, 0xff, 0x1a, 0x00, 0x7c, 0x58, 0x9b, 0xfc,
+	0x4b, 0x16, 0x00, 0x00,
 }
 
-func (m *RegisterOperatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error)
+	DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error)
+	UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error)
+	UpdateApprovedStakers(ctx context.Context, in *MsgUpdateApprovedStakers, opts ...grpc.CallOption) (*UpdateApprovedStakersResponse, error)
+	UpdateOperatorInfo(ctx context.Context, in *MsgUpdateOperatorInfo, opts ...grpc.CallOption) (*UpdateOperatorInfoResponse, error)
+	DeregisterOperator(ctx context.Context, in *MsgDeregisterOperator, opts ...grpc.CallOption) (*DeregisterOperatorResponse, error)
+	OptIntoChainValidation(ctx context.Context, in *MsgOptIntoChainValidation, opts ...grpc.CallOption) (*OptIntoChainValidationResponse, error)
+	OptOutOfChainValidation(ctx context.Context, in *MsgOptOutOfChainValidation, opts ...grpc.CallOption) (*OptOutOfChainValidationResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
 }
 
-func (m *RegisterOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *RegisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *DelegationIncOrDecInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterOperator(ctx context.Context, in *RegisterOperatorReq, opts ...grpc.CallOption) (*RegisterOperatorResponse, error) {
+	out := new(RegisterOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/RegisterOperator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DelegationIncOrDecInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DelegateAssetToOperator(ctx context.Context, in *MsgDelegation, opts ...grpc.CallOption) (*DelegationResponse, error) {
+	out := new(DelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/DelegateAssetToOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DelegationIncOrDecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PerOperatorAmounts) > 0 {
-		for k := range m.PerOperatorAmounts {
-			v := m.PerOperatorAmounts[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintTx(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UndelegateAssetFromOperator(ctx context.Context, in *MsgUndelegation, opts ...grpc.CallOption) (*UndelegationResponse, error) {
+	out := new(UndelegationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateApprovedStakers(ctx context.Context, in *MsgUpdateApprovedStakers, opts ...grpc.CallOption) (*UpdateApprovedStakersResponse, error) {
+	out := new(UpdateApprovedStakersResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UpdateApprovedStakers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateOperatorInfo(ctx context.Context, in *MsgUpdateOperatorInfo, opts ...grpc.CallOption) (*UpdateOperatorInfoResponse, error) {
+	out := new(UpdateOperatorInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UpdateOperatorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ApprovedInfo != nil {
-		{
-			size, err := m.ApprovedInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) DeregisterOperator(ctx context.Context, in *MsgDeregisterOperator, opts ...grpc.CallOption) (*DeregisterOperatorResponse, error) {
+	out := new(DeregisterOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/DeregisterOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.BaseInfo != nil {
-		{
-			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) OptIntoChainValidation(ctx context.Context, in *MsgOptIntoChainValidation, opts ...grpc.CallOption) (*OptIntoChainValidationResponse, error) {
+	out := new(OptIntoChainValidationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/OptIntoChainValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UndelegationRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) OptOutOfChainValidation(ctx context.Context, in *MsgOptOutOfChainValidation, opts ...grpc.CallOption) (*OptOutOfChainValidationResponse, error) {
+	out := new(OptOutOfChainValidationResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/OptOutOfChainValidation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UndelegationRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
+	out := new(UpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.delegation.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UndelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ActualCompletedAmount.Size()
-		i -= size
-		if _, err := m.ActualCompletedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if m.LzTxNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
-		i--
-		dAtA[i] = 0x40
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateClawbackVestingAccount creats a vesting account that is subject to clawback.
+	RegisterOperator(context.Context, *RegisterOperatorReq) (*RegisterOperatorResponse, error)
+	DelegateAssetToOperator(context.Context, *MsgDelegation) (*DelegationResponse, error)
+	UndelegateAssetFromOperator(context.Context, *MsgUndelegation) (*UndelegationResponse, error)
+	UpdateApprovedStakers(context.Context, *MsgUpdateApprovedStakers) (*UpdateApprovedStakersResponse, error)
+	UpdateOperatorInfo(context.Context, *MsgUpdateOperatorInfo) (*UpdateOperatorInfoResponse, error)
+	DeregisterOperator(context.Context, *MsgDeregisterOperator) (*DeregisterOperatorResponse, error)
+	OptIntoChainValidation(context.Context, *MsgOptIntoChainValidation) (*OptIntoChainValidationResponse, error)
+	OptOutOfChainValidation(context.Context, *MsgOptOutOfChainValidation) (*OptOutOfChainValidationResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*UpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterOperator(ctx context.Context, req *RegisterOperatorReq) (*RegisterOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterOperator not implemented")
+}
+func (*UnimplementedMsgServer) DelegateAssetToOperator(ctx context.Context, req *MsgDelegation) (*DelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateAssetToOperator not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateAssetFromOperator(ctx context.Context, req *MsgUndelegation) (*UndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateAssetFromOperator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateApprovedStakers(ctx context.Context, req *MsgUpdateApprovedStakers) (*UpdateApprovedStakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApprovedStakers not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOperatorInfo(ctx context.Context, req *MsgUpdateOperatorInfo) (*UpdateOperatorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOperatorInfo not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterOperator(ctx context.Context, req *MsgDeregisterOperator) (*DeregisterOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterOperator not implemented")
+}
+func (*UnimplementedMsgServer) OptIntoChainValidation(ctx context.Context, req *MsgOptIntoChainValidation) (*OptIntoChainValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptIntoChainValidation not implemented")
+}
+func (*UnimplementedMsgServer) OptOutOfChainValidation(ctx context.Context, req *MsgOptOutOfChainValidation) (*OptOutOfChainValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptOutOfChainValidation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*UpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterOperatorReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CompleteBlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterOperator(ctx, in)
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/RegisterOperator",
 	}
-	if m.IsPending {
-		i--
-		if m.IsPending {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterOperator(ctx, req.(*RegisterOperatorReq))
 	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateAssetToOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateAssetToOperator(ctx, in)
 	}
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/DelegateAssetToOperator",
 	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateAssetToOperator(ctx, req.(*MsgDelegation))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UndelegationRecordKeyList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UndelegateAssetFromOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UndelegationRecordKeyList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/UndelegateAssetFromOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateAssetFromOperator(ctx, req.(*MsgUndelegation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UndelegationRecordKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyList) > 0 {
-		for iNdEx := len(m.KeyList) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.KeyList[iNdEx])
-			copy(dAtA[i:], m.KeyList[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyList[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Msg_UpdateApprovedStakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApprovedStakers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApprovedStakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/UpdateApprovedStakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApprovedStakers(ctx, req.(*MsgUpdateApprovedStakers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateOperatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOperatorInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOperatorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/UpdateOperatorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOperatorInfo(ctx, req.(*MsgUpdateOperatorInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeregisterOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/DeregisterOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterOperator(ctx, req.(*MsgDeregisterOperator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_OptIntoChainValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptIntoChainValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OptIntoChainValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/OptIntoChainValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptIntoChainValidation(ctx, req.(*MsgOptIntoChainValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_OptOutOfChainValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOptOutOfChainValidation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).OptOutOfChainValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/OptOutOfChainValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OptOutOfChainValidation(ctx, req.(*MsgOptOutOfChainValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.delegation.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BaseInfo != nil {
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.delegation.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
-			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+			MethodName: "RegisterOperator",
+			Handler:    _Msg_RegisterOperator_Handler,
+		},
+		{
+			MethodName: "DelegateAssetToOperator",
+			Handler:    _Msg_DelegateAssetToOperator_Handler,
+		},
+		{
+			MethodName: "UndelegateAssetFromOperator",
+			Handler:    _Msg_UndelegateAssetFromOperator_Handler,
+		},
+		{
+			MethodName: "UpdateApprovedStakers",
+			Handler:    _Msg_UpdateApprovedStakers_Handler,
+		},
+		{
+			MethodName: "UpdateOperatorInfo",
+			Handler:    _Msg_UpdateOperatorInfo_Handler,
+		},
+		{
+			MethodName: "DeregisterOperator",
+			Handler:    _Msg_DeregisterOperator_Handler,
+		},
+		{
+			MethodName: "OptIntoChainValidation",
+			Handler:    _Msg_OptIntoChainValidation_Handler,
+		},
+		{
+			MethodName: "OptOutOfChainValidation",
+			Handler:    _Msg_OptOutOfChainValidation_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/delegation/v1/tx.proto",
 }
 
-func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValueField) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1641,305 +1760,2343 @@ func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueField) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegatedSingleAssetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValueField) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+func (m *DelegatedSingleAssetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatedSingleAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DelegatedSingleAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.TotalDelegatedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
 	if len(m.PerOperatorAmounts) > 0 {
-		for k, v := range m.PerOperatorAmounts {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.PerOperatorAmounts {
+			v := m.PerOperatorAmounts[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	{
+		size := m.TotalDelegatedAmount.Size()
+		i -= size
+		if _, err := m.TotalDelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClientChainEarningAddrList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClientChainEarningAddrList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientChainEarningAddrList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientChainEarningAddrList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.EarningInfoList) > 0 {
-		for _, e := range m.EarningInfoList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.EarningInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarningInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClientChainEarningAddrInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LzClientChainID != 0 {
-		n += 1 + sovTx(uint64(m.LzClientChainID))
-	}
-	l = len(m.ClientChainEarningAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *ClientChainEarningAddrInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OperatorInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EarningsAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ApproveAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorMetaInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ClientChainEarningsAddr != nil {
-		l = m.ClientChainEarningsAddr.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *ClientChainEarningAddrInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterOperatorReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ClientChainEarningAddrInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClientChainEarningAddr) > 0 {
+		i -= len(m.ClientChainEarningAddr)
+		copy(dAtA[i:], m.ClientChainEarningAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainEarningAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.LzClientChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzClientChainID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationApproveInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ClientChainEarningsAddr != nil {
+		{
+			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Salt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorMetaInfo) > 0 {
+		i -= len(m.OperatorMetaInfo)
+		copy(dAtA[i:], m.OperatorMetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ApproveAddr) > 0 {
+		i -= len(m.ApproveAddr)
+		copy(dAtA[i:], m.ApproveAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EarningsAddr) > 0 {
+		i -= len(m.EarningsAddr)
+		copy(dAtA[i:], m.EarningsAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EarningsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterOperatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisterOperatorReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DelegationIncOrDecInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RegisterOperatorReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterOperatorReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.PerOperatorAmounts) > 0 {
-		for k, v := range m.PerOperatorAmounts {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationApproveInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationApproveInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationApproveInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Expiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.ApprovedInfo != nil {
-		l = m.ApprovedInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisterOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.IsPending {
-		n += 2
-	}
-	if m.BlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.BlockNumber))
-	}
-	if m.CompleteBlockNumber != 0 {
-		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
-	}
-	if m.LzTxNonce != 0 {
-		n += 1 + sovTx(uint64(m.LzTxNonce))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ActualCompletedAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UndelegationRecordKeyList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegationIncOrDecInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationIncOrDecInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationIncOrDecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.KeyList) > 0 {
-		for _, s := range m.KeyList {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PerOperatorAmounts) > 0 {
+		for k := range m.PerOperatorAmounts {
+			v := m.PerOperatorAmounts[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PerOperatorApprovedInfos) > 0 {
+		for k := range m.PerOperatorApprovedInfos {
+			v := m.PerOperatorApprovedInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ActualCompletedAmount.Size()
+		i -= size
+		if _, err := m.ActualCompletedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.LzTxNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LzTxNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompleteBlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompleteBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsPending {
+		i--
+		if m.IsPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationRecordKeyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationRecordKeyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationRecordKeyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyList) > 0 {
+		for iNdEx := len(m.KeyList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyList[iNdEx])
+			copy(dAtA[i:], m.KeyList[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.KeyList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		{
+			size, err := m.BaseInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateApprovedStakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApprovedStakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApprovedStakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedStakerIDs) > 0 {
+		for iNdEx := len(m.RevokedStakerIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedStakerIDs[iNdEx])
+			copy(dAtA[i:], m.RevokedStakerIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RevokedStakerIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ApprovedStakerIDs) > 0 {
+		for iNdEx := len(m.ApprovedStakerIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedStakerIDs[iNdEx])
+			copy(dAtA[i:], m.ApprovedStakerIDs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ApprovedStakerIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateApprovedStakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateApprovedStakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateApprovedStakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOperatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOperatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOperatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientChainEarningsAddr != nil {
+		{
+			size, err := m.ClientChainEarningsAddr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorMetaInfo) > 0 {
+		i -= len(m.OperatorMetaInfo)
+		copy(dAtA[i:], m.OperatorMetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorMetaInfo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ApproveAddr) > 0 {
+		i -= len(m.ApproveAddr)
+		copy(dAtA[i:], m.ApproveAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApproveAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateOperatorInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateOperatorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateOperatorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptIntoChainValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptIntoChainValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptIntoChainValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SelfBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConsensusPubKey) > 0 {
+		i -= len(m.ConsensusPubKey)
+		copy(dAtA[i:], m.ConsensusPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsensusPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptIntoChainValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptIntoChainValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptIntoChainValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOptOutOfChainValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOptOutOfChainValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOptOutOfChainValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OptOutOfChainValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptOutOfChainValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptOutOfChainValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValueField) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *DelegatedSingleAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalDelegatedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.PerOperatorAmounts) > 0 {
+		for k, v := range m.PerOperatorAmounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ClientChainEarningAddrList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EarningInfoList) > 0 {
+		for _, e := range m.EarningInfoList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientChainEarningAddrInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LzClientChainID != 0 {
+		n += 1 + sovTx(uint64(m.LzClientChainID))
+	}
+	l = len(m.ClientChainEarningAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EarningsAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApproveAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorMetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainEarningsAddr != nil {
+		l = m.ClientChainEarningsAddr.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterOperatorReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationApproveInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovTx(uint64(m.Expiry))
+	}
+	return n
+}
+
+func (m *RegisterOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DelegationIncOrDecInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PerOperatorAmounts) > 0 {
+		for k, v := range m.PerOperatorAmounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PerOperatorApprovedInfos) > 0 {
+		for k, v := range m.PerOperatorApprovedInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *UndelegationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsPending {
+		n += 2
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	if m.CompleteBlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.CompleteBlockNumber))
+	}
+	if m.LzTxNonce != 0 {
+		n += 1 + sovTx(uint64(m.LzTxNonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ActualCompletedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *UndelegationRecordKeyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyList) > 0 {
+		for _, s := range m.KeyList {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseInfo != nil {
+		l = m.BaseInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateApprovedStakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ApprovedStakerIDs) > 0 {
+		for _, s := range m.ApprovedStakerIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RevokedStakerIDs) > 0 {
+		for _, s := range m.RevokedStakerIDs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateApprovedStakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateOperatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApproveAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorMetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainEarningsAddr != nil {
+		l = m.ClientChainEarningsAddr.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateOperatorInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOptIntoChainValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsensusPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SelfBond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OptIntoChainValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOptOutOfChainValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OptOutOfChainValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *UpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValueField) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueField: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedSingleAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedSingleAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerOperatorAmounts == nil {
+				m.PerOperatorAmounts = make(map[string]*ValueField)
+			}
+			var mapkey string
+			var mapvalue *ValueField
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ValueField{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PerOperatorAmounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: clientChainEarningAddrList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: clientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
+			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: clientChainEarningAddrInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: clientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			}
+			m.LzClientChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzClientChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientChainEarningsAddr == nil {
+				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
+			}
+			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUndelegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseInfo != nil {
-		l = m.BaseInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterOperatorReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &OperatorInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValueField) Unmarshal(dAtA []byte) error {
+func (m *DelegationApproveInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1962,15 +4119,15 @@ func (m *ValueField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueField: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationApproveInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationApproveInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1998,10 +4155,109 @@ func (m *ValueField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2023,7 +4279,7 @@ func (m *ValueField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2046,15 +4302,15 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatedSingleAssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationIncOrDecInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatedSingleAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationIncOrDecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2082,43 +4338,9 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalDelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
 			}
@@ -2247,6 +4469,70 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.PerOperatorAmounts[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2268,7 +4554,7 @@ func (m *DelegatedSingleAssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2291,15 +4577,51 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: clientChainEarningAddrList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: clientChainEarningAddrList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarningInfoList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseInfo == nil {
+				m.BaseInfo = &DelegationIncOrDecInfo{}
+			}
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorApprovedInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2316,20 +4638,115 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EarningInfoList = append(m.EarningInfoList, &ClientChainEarningAddrInfo{})
-			if err := m.EarningInfoList[len(m.EarningInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PerOperatorApprovedInfos == nil {
+				m.PerOperatorApprovedInfos = make(map[string]*DelegationApproveInfo)
+			}
+			var mapkey string
+			var mapvalue *DelegationApproveInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DelegationApproveInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PerOperatorApprovedInfos[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2352,7 +4769,7 @@ func (m *ClientChainEarningAddrList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
+func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2375,17 +4792,17 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: clientChainEarningAddrInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: clientChainEarningAddrInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LzClientChainID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
-			m.LzClientChainID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2395,14 +4812,27 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LzClientChainID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2430,61 +4860,11 @@ func (m *ClientChainEarningAddrInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientChainEarningAddr = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EarningsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2512,11 +4892,11 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EarningsAddr = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2544,11 +4924,88 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPending = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
+			}
+			m.CompleteBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
+			}
+			m.LzTxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LzTxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2576,13 +5033,15 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualCompletedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2592,25 +5051,23 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientChainEarningsAddr == nil {
-				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
-			}
-			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActualCompletedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2635,7 +5092,7 @@ func (m *OperatorInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
+func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2658,15 +5115,15 @@ func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterOperatorReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationRecordKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterOperatorReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationRecordKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2694,44 +5151,58 @@ func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.KeyList = append(m.KeyList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &OperatorInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2753,7 +5224,7 @@ func (m *RegisterOperatorReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationApproveInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2776,17 +5247,17 @@ func (m *DelegationApproveInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationApproveInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationApproveInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2796,55 +5267,27 @@ func (m *DelegationApproveInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if m.BaseInfo == nil {
+				m.BaseInfo = &DelegationIncOrDecInfo{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2867,7 +5310,7 @@ func (m *DelegationApproveInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterOperatorResponse) Unmarshal(dAtA []byte) error {
+func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2890,10 +5333,10 @@ func (m *RegisterOperatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterOperatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2917,7 +5360,7 @@ func (m *RegisterOperatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateApprovedStakers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2940,10 +5383,10 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationIncOrDecInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateApprovedStakers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationIncOrDecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateApprovedStakers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2980,9 +5423,9 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerOperatorAmounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedStakerIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2992,121 +5435,106 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PerOperatorAmounts == nil {
-				m.PerOperatorAmounts = make(map[string]*ValueField)
+			m.ApprovedStakerIDs = append(m.ApprovedStakerIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedStakerIDs", wireType)
 			}
-			var mapkey string
-			var mapvalue *ValueField
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ValueField{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedStakerIDs = append(m.RevokedStakerIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateApprovedStakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.PerOperatorAmounts[mapkey] = mapvalue
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateApprovedStakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateApprovedStakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3128,7 +5556,7 @@ func (m *DelegationIncOrDecInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateOperatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3151,17 +5579,17 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateOperatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateOperatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3171,31 +5599,91 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseInfo == nil {
-				m.BaseInfo = &DelegationIncOrDecInfo{}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproveAddr", wireType)
 			}
-			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApproveAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorMetaInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorMetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainEarningsAddr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3222,10 +5710,10 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedInfo == nil {
-				m.ApprovedInfo = &DelegationApproveInfo{}
+			if m.ClientChainEarningsAddr == nil {
+				m.ClientChainEarningsAddr = &ClientChainEarningAddrList{}
 			}
-			if err := m.ApprovedInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClientChainEarningsAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3250,7 +5738,7 @@ func (m *MsgDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
+func (m *UpdateOperatorInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3273,15 +5761,65 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateOperatorInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateOperatorInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterOperator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3309,43 +5847,111 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptIntoChainValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptIntoChainValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptIntoChainValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3373,13 +5979,13 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3389,106 +5995,31 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPending", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsPending = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteBlockNumber", wireType)
-			}
-			m.CompleteBlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompleteBlockNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LzTxNonce", wireType)
-			}
-			m.LzTxNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LzTxNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ConsensusPubKey = append(m.ConsensusPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusPubKey == nil {
+				m.ConsensusPubKey = []byte{}
 			}
-		case 9:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBond", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3498,60 +6029,75 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualCompletedAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OptIntoChainValidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActualCompletedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptIntoChainValidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptIntoChainValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3573,7 +6119,7 @@ func (m *UndelegationRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
+func (m *MsgOptOutOfChainValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3596,15 +6142,15 @@ func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationRecordKeyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOptOutOfChainValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationRecordKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOptOutOfChainValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3632,7 +6178,7 @@ func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyList = append(m.KeyList, string(dAtA[iNdEx:postIndex]))
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3655,7 +6201,7 @@ func (m *UndelegationRecordKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *OptOutOfChainValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3678,10 +6224,10 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OptOutOfChainValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OptOutOfChainValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3705,7 +6251,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3728,15 +6274,47 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3763,10 +6341,7 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseInfo == nil {
-				m.BaseInfo = &DelegationIncOrDecInfo{}
-			}
-			if err := m.BaseInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3791,7 +6366,7 @@ func (m *MsgUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3814,10 +6389,10 @@ func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
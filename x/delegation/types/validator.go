@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateConsensusPubKey checks the consensus key registered by the operator, only the ed25519 key
+// is supported by the default consensus params.
+func ValidateConsensusPubKey(pubKey []byte) error {
+	if len(pubKey) != ed25519.PubKeySize {
+		return errorsmod.Wrap(ErrInvalidConsensusPubKey, fmt.Sprintf("the length of the ed25519 key should be %d, got:%d", ed25519.PubKeySize, len(pubKey)))
+	}
+	return nil
+}
+
+// GetConsAddr returns the consensus address of the ed25519 consensus key
+func GetConsAddr(pubKey []byte) sdk.ConsAddress {
+	return sdk.ConsAddress((&ed25519.PubKey{Key: pubKey}).Address())
+}
+
+// ABCIValidatorUpdate returns the validator update of the power, a zero power removes the validator
+// from the validator set.
+func ABCIValidatorUpdate(pubKey []byte, power int64) abci.ValidatorUpdate {
+	return abci.ValidatorUpdate{
+		PubKey: tmprotocrypto.PublicKey{
+			Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: pubKey},
+		},
+		Power: power,
+	}
+}
+
+// USDValuePowerReduction converts the USD value of the restaked assets to the voting power, the value
+// has 18 decimals and one voting power is worth one USD.
+var USDValuePowerReduction = sdkmath.NewIntWithDecimal(1, 18)
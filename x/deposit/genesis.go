@@ -0,0 +1,76 @@
+package deposit
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params deposittype.Params) *deposittype.GenesisState {
+	return &deposittype.GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state, the params are empty
+// because the exoCore LayerZero app isn't deployed at the genesis.
+func DefaultGenesisState() *deposittype.GenesisState {
+	return NewGenesisState(deposittype.Params{})
+}
+
+// GetGenesisStateFromAppState returns x/deposit GenesisState given raw application
+// genesis state.
+func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) deposittype.GenesisState {
+	var genesisState deposittype.GenesisState
+
+	if appState[deposittype.ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[deposittype.ModuleName], &genesisState)
+	}
+
+	return genesisState
+}
+
+// ValidateGenesis performs basic validation of deposit genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data deposittype.GenesisState) error {
+	if isEmptyParams(data.Params) {
+		return nil
+	}
+	return data.Params.Validate()
+}
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data deposittype.GenesisState,
+) {
+	if isEmptyParams(data.Params) {
+		return
+	}
+	err := k.SetParams(ctx, &data.Params)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *deposittype.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if errorsmod.IsOf(err, deposittype.ErrNoParamsKey) {
+			return DefaultGenesisState()
+		}
+		panic(err)
+	}
+	return NewGenesisState(*params)
+}
+
+func isEmptyParams(params deposittype.Params) bool {
+	return params.ExoCoreLzAppAddress == "" && params.ExoCoreLzAppEventTopic == ""
+}
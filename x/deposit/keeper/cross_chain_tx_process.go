@@ -12,6 +12,7 @@ import (
 
 type DepositParams struct {
 	ClientChainLzID uint64
+	LzNonce         uint64
 	// The action field might need to be removed,it will be used when called from event hook.
 	Action        types.CrossChainOpType
 	AssetsAddress []byte
@@ -130,15 +131,23 @@ func (k Keeper) Deposit(ctx sdk.Context, params *DepositParams) error {
 	}
 	stakeID, assetID := types.GetStakeIDAndAssetID(params.ClientChainLzID, params.StakerAddress, params.AssetsAddress)
 	// check if asset exist
-	if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
+	assetInfo, err := k.restakingStateKeeper.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
 		return errorsmod.Wrap(despoittypes.ErrDepositAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
 	}
+	// the deprecated asset can't be deposited anymore
+	if assetInfo.Deprecated {
+		return errorsmod.Wrap(despoittypes.ErrDepositAssetDeprecated, fmt.Sprintf("the assetID is:%s", assetID))
+	}
+	if err = k.restakingStateKeeper.CheckAssetNotPaused(ctx, assetID); err != nil {
+		return err
+	}
 	changeAmount := types.StakerSingleAssetOrChangeInfo{
 		TotalDepositAmountOrWantChangeValue: params.OpAmount,
 		CanWithdrawAmountOrWantChangeValue:  params.OpAmount,
 	}
 	// update asset state of the specified staker
-	err := k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
 	if err != nil {
 		return err
 	}
@@ -12,12 +12,8 @@ import (
 var ParamsKey = []byte("Params")
 
 func (k Keeper) SetParams(ctx sdk.Context, params *deposittype.Params) error {
-	// check if addr is evm address
-	if !common.IsHexAddress(params.ExoCoreLzAppAddress) {
-		return deposittype.ErrInvalidEvmAddressFormat
-	}
-	if len(common.FromHex(params.ExoCoreLzAppEventTopic)) != common.HashLength {
-		return deposittype.ErrInvalidLzUaTopicIDLength
+	if err := params.Validate(); err != nil {
+		return err
 	}
 	params.ExoCoreLzAppAddress = strings.ToLower(params.ExoCoreLzAppAddress)
 	params.ExoCoreLzAppEventTopic = strings.ToLower(params.ExoCoreLzAppEventTopic)
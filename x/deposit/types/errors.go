@@ -11,4 +11,5 @@ var (
 	ErrNoParamsKey              = errorsmod.Register(ModuleName, 2, "there is no stored key for deposit module params")
 	ErrDepositAmountIsNegative  = errorsmod.Register(ModuleName, 3, "the deposit amount is negative")
 	ErrDepositAssetNotExist     = errorsmod.Register(ModuleName, 4, "the deposit asset doesn't exist")
+	ErrDepositAssetDeprecated   = errorsmod.Register(ModuleName, 5, "the deposit asset has been deprecated")
 )
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate checks the exoCore LayerZero app address and the topic of its event
+func (m *Params) Validate() error {
+	// check if addr is evm address
+	if !common.IsHexAddress(m.ExoCoreLzAppAddress) {
+		return ErrInvalidEvmAddressFormat
+	}
+	if len(common.FromHex(m.ExoCoreLzAppEventTopic)) != common.HashLength {
+		return ErrInvalidLzUaTopicIDLength
+	}
+	return nil
+}
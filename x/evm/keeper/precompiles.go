@@ -9,11 +9,15 @@ import (
 
 	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
 	depositKeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	nativeTokenKeeper "github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	priceFeedKeeper "github.com/ExocoreNetwork/exocore/x/price_feed/keeper"
 
 	"golang.org/x/exp/maps"
 
 	delegationprecompile "github.com/ExocoreNetwork/exocore/precompiles/delegation"
 	depositprecompile "github.com/ExocoreNetwork/exocore/precompiles/deposit"
+	gatewayPrecompile "github.com/ExocoreNetwork/exocore/precompiles/gateway"
+	priceFeedPrecompile "github.com/ExocoreNetwork/exocore/precompiles/pricefeed"
 	rewardPrecompile "github.com/ExocoreNetwork/exocore/precompiles/reward"
 	slashPrecompile "github.com/ExocoreNetwork/exocore/precompiles/slash"
 	withdrawPrecompile "github.com/ExocoreNetwork/exocore/precompiles/withdraw"
@@ -47,6 +51,8 @@ func AvailablePrecompiles(
 	withdrawKeeper withdrawKeeper.Keeper,
 	slashKeeper exoslashKeeper.Keeper,
 	rewardKeeper rewardKeeper.Keeper,
+	nativeTokenKeeper nativeTokenKeeper.Keeper,
+	priceFeedKeeper priceFeedKeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -92,11 +98,21 @@ func AvailablePrecompiles(
 	if err != nil {
 		panic(fmt.Errorf("failed to load  reward precompile: %w", err))
 	}
+	gatewayPrecompile, err := gatewayPrecompile.NewPrecompile(stakingStateKeeper, depositKeeper, delegationKeeper, withdrawKeeper, rewardKeeper, slashKeeper, nativeTokenKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load  gateway precompile: %w", err))
+	}
+	priceFeedPrecompile, err := priceFeedPrecompile.NewPrecompile(stakingStateKeeper, priceFeedKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load  price feed precompile: %w", err))
+	}
 	precompiles[slashPrecompile.Address()] = slashPrecompile
 	precompiles[rewardPrecompile.Address()] = rewardPrecompile
 	precompiles[withdrawPrecompile.Address()] = withdrawPrecompile
 	precompiles[depositPrecompile.Address()] = depositPrecompile
 	precompiles[delegationPrecompile.Address()] = delegationPrecompile
+	precompiles[gatewayPrecompile.Address()] = gatewayPrecompile
+	precompiles[priceFeedPrecompile.Address()] = priceFeedPrecompile
 
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
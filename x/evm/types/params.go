@@ -16,6 +16,8 @@ var ExocoreAvailableEVMExtensions = []string{
 	"0x0000000000000000000000000000000000000806", // reward precompile
 	"0x0000000000000000000000000000000000000807", // slash precompile
 	"0x0000000000000000000000000000000000000808", // withdraw precompile
+	"0x0000000000000000000000000000000000000809", // gateway precompile
+	"0x000000000000000000000000000000000000080A", // price feed precompile
 }
 
 // ExocoreEvmDefaultParams returns default evm parameters
@@ -0,0 +1,106 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ZeroHashes are the roots of the SSZ subtrees whose leaves are all zero, ZeroHashes[i] is the root of
+// the subtree with the depth i. They are used to pad the trees that aren't full.
+var ZeroHashes [MaxTreeDepth + 1]common.Hash
+
+func init() {
+	for i := 1; i <= MaxTreeDepth; i++ {
+		ZeroHashes[i] = HashPair(ZeroHashes[i-1], ZeroHashes[i-1])
+	}
+}
+
+// HashPair returns the SSZ hash of two sibling nodes
+func HashPair(left, right common.Hash) common.Hash {
+	h := sha256.New()
+	h.Write(left[:])
+	h.Write(right[:])
+	return common.BytesToHash(h.Sum(nil))
+}
+
+// GIndexDepth returns the depth of the generalized index, it's the length of its proof.
+func GIndexDepth(gIndex uint64) int {
+	return bits.Len64(gIndex) - 1
+}
+
+// ConcatGIndices concatenates the generalized indices along a path from the root to the leaf
+func ConcatGIndices(gIndices ...uint64) uint64 {
+	ret := uint64(1)
+	for _, gIndex := range gIndices {
+		depth := GIndexDepth(gIndex)
+		ret = ret<<depth | (gIndex ^ 1<<depth)
+	}
+	return ret
+}
+
+// VerifyMerkleProof checks the proof of the leaf at the generalized index against the root,
+// the proof is ordered from the sibling of the leaf to the child of the root.
+func VerifyMerkleProof(root, leaf common.Hash, proof []common.Hash, gIndex uint64) bool {
+	if gIndex == 0 || len(proof) != GIndexDepth(gIndex) {
+		return false
+	}
+	node := leaf
+	for i, sibling := range proof {
+		if gIndex>>i&1 == 1 {
+			node = HashPair(sibling, node)
+		} else {
+			node = HashPair(node, sibling)
+		}
+	}
+	return node == root
+}
+
+// MerkleizeChunks returns the root of the chunks padded to a full tree with the depth
+func MerkleizeChunks(chunks []common.Hash, depth int) common.Hash {
+	layer := make([]common.Hash, len(chunks))
+	copy(layer, chunks)
+	for i := 0; i < depth; i++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, ZeroHashes[i])
+		}
+		next := make([]common.Hash, len(layer)/2)
+		for j := range next {
+			next[j] = HashPair(layer[2*j], layer[2*j+1])
+		}
+		layer = next
+	}
+	if len(layer) == 0 {
+		return ZeroHashes[depth]
+	}
+	return layer[0]
+}
+
+// Uint64Leaf returns the SSZ leaf of the uint64, it's little-endian and padded to 32 bytes.
+func Uint64Leaf(value uint64) common.Hash {
+	var leaf common.Hash
+	binary.LittleEndian.PutUint64(leaf[:8], value)
+	return leaf
+}
+
+// LeafToUint64 returns the uint64 of the SSZ leaf
+func LeafToUint64(leaf common.Hash) uint64 {
+	return binary.LittleEndian.Uint64(leaf[:8])
+}
+
+// AddressLeaf returns the SSZ leaf of the execution address, it's padded to 32 bytes on the right.
+func AddressLeaf(addr common.Address) common.Hash {
+	var leaf common.Hash
+	copy(leaf[:], addr.Bytes())
+	return leaf
+}
+
+// PubkeyRoot returns the hash tree root of the 48-byte BLS public key of the validator
+func PubkeyRoot(pubkey []byte) common.Hash {
+	var chunks [2]common.Hash
+	copy(chunks[0][:], pubkey[:32])
+	copy(chunks[1][:], pubkey[32:])
+	return HashPair(chunks[0], chunks[1])
+}
@@ -0,0 +1,221 @@
+// Package beacon verifies the SSZ Merkle proofs of the beacon chain states against the beacon block root.
+// The generalized indices follow the containers of the fork active at the slot of the beacon block, the
+// forks since Deneb are supported.
+package beacon
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// MaxTreeDepth is the max depth of the trees that need to be padded, it's the depth of the validator list.
+	MaxTreeDepth = ValidatorListTreeDepth
+
+	SlotsPerEpoch = 32
+
+	// BeaconBlockHeaderTreeDepth the header has 5 fields
+	BeaconBlockHeaderTreeDepth = 3
+	StateRootIndexInHeader     = 3
+	BodyRootIndexInHeader      = 4
+
+	ValidatorListIndex      = 11
+	BalanceListIndex        = 12
+	ValidatorListTreeDepth  = 40
+	BalanceListTreeDepth    = 38
+	BalancesPerLeaf         = 4
+	ValidatorFieldsCount    = 8
+	ValidatorFieldTreeDepth = 3
+
+	// BeaconBlockBodyTreeDepth the block body has 12 fields in Deneb and 13 fields since Electra
+	BeaconBlockBodyTreeDepth   = 4
+	ExecutionPayloadIndex      = 9
+	ExecutionPayloadTreeDepth  = 5
+	WithdrawalListIndex        = 14
+	WithdrawalListTreeDepth    = 4
+	WithdrawalFieldsCount      = 4
+	WithdrawalFieldTreeDepth   = 2
+	withdrawalCredentialPrefix = 0x01
+)
+
+// the epochs of the forks on the Ethereum mainnet whose containers change the proofs
+const (
+	MainnetDenebForkEpoch   = 269568
+	MainnetElectraForkEpoch = 364032
+)
+
+// Fork is the layout of the beacon chain containers that the proofs depend on
+type Fork struct {
+	Name string
+	// BeaconStateTreeDepth is the depth of the beacon state container
+	BeaconStateTreeDepth int
+}
+
+var (
+	// Deneb the beacon state has 28 fields
+	Deneb = Fork{Name: "deneb", BeaconStateTreeDepth: 5}
+	// Electra the beacon state has 37 fields, Fulu adds a field to the state and keeps the layout of Electra
+	Electra = Fork{Name: "electra", BeaconStateTreeDepth: 6}
+)
+
+// ForkAtSlot returns the fork of the beacon block at the slot on the Ethereum mainnet, the proofs of the
+// slots before Deneb aren't supported.
+func ForkAtSlot(slot uint64) (Fork, error) {
+	epoch := EpochAtSlot(slot)
+	switch {
+	case epoch >= MainnetElectraForkEpoch:
+		return Electra, nil
+	case epoch >= MainnetDenebForkEpoch:
+		return Deneb, nil
+	default:
+		return Fork{}, errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("the slot before the Deneb fork isn't supported:%d", slot))
+	}
+}
+
+// the indices of the validator fields
+const (
+	ValidatorPubkeyIndex = iota
+	ValidatorWithdrawalCredentialsIndex
+	ValidatorEffectiveBalanceIndex
+	ValidatorSlashedIndex
+	ValidatorActivationEligibilityEpochIndex
+	ValidatorActivationEpochIndex
+	ValidatorExitEpochIndex
+	ValidatorWithdrawableEpochIndex
+)
+
+// the indices of the withdrawal fields
+const (
+	WithdrawalIndexIndex = iota
+	WithdrawalValidatorIndexIndex
+	WithdrawalAddressIndex
+	WithdrawalAmountIndex
+)
+
+// StateRootProof proves the state root of the beacon block
+type StateRootProof struct {
+	StateRoot common.Hash
+	Proof     []common.Hash
+}
+
+// ValidatorProof proves the fields of the validator in the validator list of the beacon state
+type ValidatorProof struct {
+	ValidatorIndex uint64
+	Fields         []common.Hash
+	Proof          []common.Hash
+}
+
+// BalanceProof proves the leaf that packs the balance of the validator in the balance list of the beacon state
+type BalanceProof struct {
+	BalanceRoot common.Hash
+	Proof       []common.Hash
+}
+
+// WithdrawalProof proves the withdrawal in the execution payload of the beacon block
+type WithdrawalProof struct {
+	WithdrawalIndex uint64
+	Fields          []common.Hash
+	Proof           []common.Hash
+}
+
+// StateRootGIndex returns the generalized index of the state root in the beacon block
+func StateRootGIndex() uint64 {
+	return 1<<BeaconBlockHeaderTreeDepth | StateRootIndexInHeader
+}
+
+// ValidatorGIndex returns the generalized index of the validator in the beacon state, the length
+// of the list is mixed in the list root, so the validators are in the left subtree.
+func (f Fork) ValidatorGIndex(validatorIndex uint64) uint64 {
+	return ConcatGIndices(
+		1<<f.BeaconStateTreeDepth|ValidatorListIndex,
+		2,
+		1<<ValidatorListTreeDepth|validatorIndex,
+	)
+}
+
+// BalanceGIndex returns the generalized index of the leaf that packs the balance of the validator in the beacon state
+func (f Fork) BalanceGIndex(validatorIndex uint64) uint64 {
+	return ConcatGIndices(
+		1<<f.BeaconStateTreeDepth|BalanceListIndex,
+		2,
+		1<<BalanceListTreeDepth|validatorIndex/BalancesPerLeaf,
+	)
+}
+
+// WithdrawalGIndex returns the generalized index of the withdrawal in the beacon block
+func WithdrawalGIndex(withdrawalIndex uint64) uint64 {
+	return ConcatGIndices(
+		1<<BeaconBlockHeaderTreeDepth|BodyRootIndexInHeader,
+		1<<BeaconBlockBodyTreeDepth|ExecutionPayloadIndex,
+		1<<ExecutionPayloadTreeDepth|WithdrawalListIndex,
+		2,
+		1<<WithdrawalListTreeDepth|withdrawalIndex,
+	)
+}
+
+// WithdrawalCredentials returns the withdrawal credentials of the validator whose withdrawals are sent to the pod
+func WithdrawalCredentials(podAddress common.Address) common.Hash {
+	var credentials common.Hash
+	credentials[0] = withdrawalCredentialPrefix
+	copy(credentials[common.HashLength-common.AddressLength:], podAddress.Bytes())
+	return credentials
+}
+
+// EpochAtSlot returns the epoch of the slot
+func EpochAtSlot(slot uint64) uint64 {
+	return slot / SlotsPerEpoch
+}
+
+// VerifyStateRoot verifies the state root against the beacon block root
+func VerifyStateRoot(blockRoot common.Hash, proof *StateRootProof) error {
+	if !VerifyMerkleProof(blockRoot, proof.StateRoot, proof.Proof, StateRootGIndex()) {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid state root proof, blockRoot:%s", blockRoot))
+	}
+	return nil
+}
+
+// VerifyValidatorFields verifies the validator fields against the state root
+func (f Fork) VerifyValidatorFields(stateRoot common.Hash, proof *ValidatorProof) error {
+	if len(proof.Fields) != ValidatorFieldsCount {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid validator fields count:%d", len(proof.Fields)))
+	}
+	if proof.ValidatorIndex >= 1<<ValidatorListTreeDepth {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid validator index:%d", proof.ValidatorIndex))
+	}
+	validatorRoot := MerkleizeChunks(proof.Fields, ValidatorFieldTreeDepth)
+	if !VerifyMerkleProof(stateRoot, validatorRoot, proof.Proof, f.ValidatorGIndex(proof.ValidatorIndex)) {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid validator proof, validatorIndex:%d", proof.ValidatorIndex))
+	}
+	return nil
+}
+
+// VerifyValidatorBalance verifies the balance leaf against the state root and returns the balance of the validator in gwei
+func (f Fork) VerifyValidatorBalance(stateRoot common.Hash, validatorIndex uint64, proof *BalanceProof) (uint64, error) {
+	if validatorIndex >= 1<<ValidatorListTreeDepth {
+		return 0, errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid validator index:%d", validatorIndex))
+	}
+	if !VerifyMerkleProof(stateRoot, proof.BalanceRoot, proof.Proof, f.BalanceGIndex(validatorIndex)) {
+		return 0, errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid balance proof, validatorIndex:%d", validatorIndex))
+	}
+	offset := validatorIndex % BalancesPerLeaf * 8
+	return binary.LittleEndian.Uint64(proof.BalanceRoot[offset : offset+8]), nil
+}
+
+// VerifyWithdrawal verifies the withdrawal fields against the beacon block root
+func VerifyWithdrawal(blockRoot common.Hash, proof *WithdrawalProof) error {
+	if len(proof.Fields) != WithdrawalFieldsCount {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid withdrawal fields count:%d", len(proof.Fields)))
+	}
+	if proof.WithdrawalIndex >= 1<<WithdrawalListTreeDepth {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid withdrawal index:%d", proof.WithdrawalIndex))
+	}
+	withdrawalRoot := MerkleizeChunks(proof.Fields, WithdrawalFieldTreeDepth)
+	if !VerifyMerkleProof(blockRoot, withdrawalRoot, proof.Proof, WithdrawalGIndex(proof.WithdrawalIndex)) {
+		return errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid withdrawal proof, withdrawalIndex:%d", proof.WithdrawalIndex))
+	}
+	return nil
+}
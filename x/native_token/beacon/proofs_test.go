@@ -0,0 +1,233 @@
+package beacon_test
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ExocoreNetwork/exocore/testutil"
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	update = flag.Bool("update", false, "regenerate the proof fixtures")
+	// blocks is the directory of the beacon blocks in the JSON format of the beacon API, it's the beacon/types/testdata
+	// of go-ethereum, the fixtures of the real blocks are regenerated from it with the -update flag.
+	blocks = flag.String("blocks", "", "the directory of the real beacon blocks to regenerate the block fixtures")
+)
+
+const (
+	mainnetNetwork = "mainnet"
+	testnetNetwork = "testnet"
+	// denebSlot and electraSlot are the slots of the mainnet in the Deneb and Electra forks
+	denebSlot   = 8667136
+	electraSlot = 12000000
+)
+
+// proofFixture is the proofs of a validator in a beacon block, the fixtures in the testdata are generated by
+// buildSpecFixture and buildBlockFixture with the -update flag and verified offline. The fixtures of the real
+// blocks don't include the proofs against the beacon state.
+type proofFixture struct {
+	Description     string                 `json:"description"`
+	Network         string                 `json:"network"`
+	Fork            string                 `json:"fork"`
+	Slot            uint64                 `json:"slot"`
+	BlockRoot       common.Hash            `json:"blockRoot"`
+	BalanceGwei     uint64                 `json:"balanceGwei,omitempty"`
+	StateRootProof  beacon.StateRootProof  `json:"stateRootProof"`
+	ValidatorProof  *beacon.ValidatorProof `json:"validatorProof,omitempty"`
+	BalanceProof    *beacon.BalanceProof   `json:"balanceProof,omitempty"`
+	WithdrawalProof beacon.WithdrawalProof `json:"withdrawalProof"`
+	Withdrawal      *withdrawalFixture     `json:"withdrawal,omitempty"`
+}
+
+// withdrawalFixture is the withdrawal proved by the fixture, it's decoded from the block independently of the proof
+type withdrawalFixture struct {
+	ValidatorIndex uint64         `json:"validatorIndex"`
+	Address        common.Address `json:"address"`
+	AmountGwei     uint64         `json:"amountGwei"`
+}
+
+// forks are the supported forks by the name
+var forks = map[string]beacon.Fork{
+	beacon.Deneb.Name:   beacon.Deneb,
+	beacon.Electra.Name: beacon.Electra,
+}
+
+var (
+	podAddress      = common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	validatorPubkey = common.FromHex("0xa1d1ad0714035353258038e964ae9675dc0252ee22cea896825c01458e1807bfad2f9969338798548d9858a571f7425c")
+)
+
+func buildProofs(slot, validatorIndex, balanceGwei, withdrawableEpoch uint64) *testutil.BeaconBlockProofs {
+	return testutil.NewBeaconBlockBuilder(slot).
+		AddValidator(validatorIndex, validatorPubkey, podAddress, balanceGwei, withdrawableEpoch).
+		AddValidator(validatorIndex+1, make([]byte, types.ValidatorPubkeyLength), common.Address{}, 32e9, testutil.FarFutureEpoch).
+		AddWithdrawal(validatorIndex+1, common.Address{}, 1e6).
+		AddWithdrawal(validatorIndex, podAddress, balanceGwei).
+		Build()
+}
+
+func TestZeroHashes(t *testing.T) {
+	// the root of the two zero chunks is the well-known sha256 of 64 zero bytes
+	require.Equal(t, common.HexToHash("0xf5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a92759fb4b"), beacon.ZeroHashes[1])
+	require.Equal(t, beacon.ZeroHashes[3], beacon.MerkleizeChunks(nil, 3))
+}
+
+func TestForkAtSlot(t *testing.T) {
+	_, err := beacon.ForkAtSlot(beacon.MainnetDenebForkEpoch*32 - 1)
+	require.ErrorIs(t, err, types.ErrInvalidBeaconProof)
+	for slot, expected := range map[uint64]beacon.Fork{
+		beacon.MainnetDenebForkEpoch * 32:     beacon.Deneb,
+		beacon.MainnetElectraForkEpoch*32 - 1: beacon.Deneb,
+		beacon.MainnetElectraForkEpoch * 32:   beacon.Electra,
+		electraSlot:                           beacon.Electra,
+	} {
+		fork, err := beacon.ForkAtSlot(slot)
+		require.NoError(t, err)
+		require.Equal(t, expected, fork, slot)
+	}
+}
+
+func TestGIndices(t *testing.T) {
+	require.Equal(t, uint64(11), beacon.StateRootGIndex())
+	require.Equal(t, 17, beacon.GIndexDepth(beacon.WithdrawalGIndex(0)))
+	require.Equal(t, beacon.ConcatGIndices(12, 25, 46, 2, 16+3), beacon.WithdrawalGIndex(3))
+
+	require.Equal(t, 46, beacon.GIndexDepth(beacon.Deneb.ValidatorGIndex(0)))
+	require.Equal(t, 44, beacon.GIndexDepth(beacon.Deneb.BalanceGIndex(0)))
+	require.Equal(t, uint64(86)<<40|5, beacon.Deneb.ValidatorGIndex(5))
+	require.Equal(t, uint64(88)<<38|1, beacon.Deneb.BalanceGIndex(5))
+
+	// the beacon state has one more level since Electra
+	require.Equal(t, 47, beacon.GIndexDepth(beacon.Electra.ValidatorGIndex(0)))
+	require.Equal(t, 45, beacon.GIndexDepth(beacon.Electra.BalanceGIndex(0)))
+	require.Equal(t, uint64(150)<<40|5, beacon.Electra.ValidatorGIndex(5))
+	require.Equal(t, uint64(152)<<38|1, beacon.Electra.BalanceGIndex(5))
+}
+
+func TestVerifyProofs(t *testing.T) {
+	for _, slot := range []uint64{denebSlot, electraSlot} {
+		fork, err := beacon.ForkAtSlot(slot)
+		require.NoError(t, err)
+		testVerifyProofs(t, slot, fork)
+	}
+}
+
+func testVerifyProofs(t *testing.T, slot uint64, fork beacon.Fork) {
+	proofs := buildProofs(slot, 1234, 32000000001, beacon.EpochAtSlot(slot))
+	stateRootProof := proofs.StateRootProof()
+	require.NoError(t, beacon.VerifyStateRoot(proofs.BlockRoot, &stateRootProof))
+
+	validatorProof := proofs.ValidatorProof(1234)
+	require.NoError(t, fork.VerifyValidatorFields(stateRootProof.StateRoot, &validatorProof))
+	require.Equal(t, beacon.PubkeyRoot(validatorPubkey), validatorProof.Fields[beacon.ValidatorPubkeyIndex])
+	require.Equal(t, beacon.WithdrawalCredentials(podAddress), validatorProof.Fields[beacon.ValidatorWithdrawalCredentialsIndex])
+
+	balanceProof := proofs.BalanceProof(1234)
+	balance, err := fork.VerifyValidatorBalance(stateRootProof.StateRoot, 1234, &balanceProof)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32000000001), balance)
+	// the neighbour validator packed in the same leaf has another balance
+	balance, err = fork.VerifyValidatorBalance(stateRootProof.StateRoot, 1235, &balanceProof)
+	require.NoError(t, err)
+	require.Equal(t, uint64(32e9), balance)
+
+	withdrawalProof := proofs.WithdrawalProof(1)
+	require.NoError(t, beacon.VerifyWithdrawal(proofs.BlockRoot, &withdrawalProof))
+	require.Equal(t, uint64(1234), beacon.LeafToUint64(withdrawalProof.Fields[beacon.WithdrawalValidatorIndexIndex]))
+
+	// the proofs against the state of another fork are rejected
+	otherFork := beacon.Deneb
+	if fork == beacon.Deneb {
+		otherFork = beacon.Electra
+	}
+	require.ErrorIs(t, otherFork.VerifyValidatorFields(stateRootProof.StateRoot, &validatorProof), types.ErrInvalidBeaconProof)
+	_, err = otherFork.VerifyValidatorBalance(stateRootProof.StateRoot, 1234, &balanceProof)
+	require.ErrorIs(t, err, types.ErrInvalidBeaconProof)
+
+	// the tampered proofs are rejected
+	tamperedStateRoot := stateRootProof
+	tamperedStateRoot.StateRoot = common.HexToHash("0x01")
+	require.ErrorIs(t, beacon.VerifyStateRoot(proofs.BlockRoot, &tamperedStateRoot), types.ErrInvalidBeaconProof)
+
+	tamperedValidator := proofs.ValidatorProof(1234)
+	tamperedValidator.ValidatorIndex = 1235
+	require.ErrorIs(t, fork.VerifyValidatorFields(stateRootProof.StateRoot, &tamperedValidator), types.ErrInvalidBeaconProof)
+	tamperedValidator = proofs.ValidatorProof(1234)
+	tamperedValidator.Fields = append([]common.Hash{}, tamperedValidator.Fields...)
+	tamperedValidator.Fields[beacon.ValidatorWithdrawableEpochIndex] = beacon.Uint64Leaf(0)
+	require.ErrorIs(t, fork.VerifyValidatorFields(stateRootProof.StateRoot, &tamperedValidator), types.ErrInvalidBeaconProof)
+	tamperedValidator.Fields = tamperedValidator.Fields[:7]
+	require.ErrorIs(t, fork.VerifyValidatorFields(stateRootProof.StateRoot, &tamperedValidator), types.ErrInvalidBeaconProof)
+
+	_, err = fork.VerifyValidatorBalance(stateRootProof.StateRoot, 1238, &balanceProof)
+	require.ErrorIs(t, err, types.ErrInvalidBeaconProof)
+	tamperedBalance := proofs.BalanceProof(1234)
+	tamperedBalance.Proof = tamperedBalance.Proof[1:]
+	_, err = fork.VerifyValidatorBalance(stateRootProof.StateRoot, 1234, &tamperedBalance)
+	require.ErrorIs(t, err, types.ErrInvalidBeaconProof)
+
+	tamperedWithdrawal := proofs.WithdrawalProof(1)
+	tamperedWithdrawal.WithdrawalIndex = 0
+	require.ErrorIs(t, beacon.VerifyWithdrawal(proofs.BlockRoot, &tamperedWithdrawal), types.ErrInvalidBeaconProof)
+}
+
+func writeFixture(t *testing.T, name string, fixture proofFixture) {
+	bz, err := json.MarshalIndent(fixture, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join("testdata", name), bz, 0o600))
+}
+
+func TestProofFixtures(t *testing.T) {
+	if *update {
+		writeFixture(t, "deneb_withdrawn_validator.json", buildSpecFixture(t, denebSlot, 512345, 32001234567))
+		writeFixture(t, "electra_withdrawn_validator.json", buildSpecFixture(t, electraSlot, 1912345, 32001234567))
+		if *blocks != "" {
+			writeFixture(t, "mainnet_deneb_block_8631513.json",
+				buildBlockFixture(t, filepath.Join(*blocks, "block_deneb.json"), mainnetNetwork, beacon.Deneb, 15))
+			writeFixture(t, "testnet_electra_block_151717.json",
+				buildBlockFixture(t, filepath.Join(*blocks, "block_electra_consolidations.json"), testnetNetwork, beacon.Electra, 15))
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		bz, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var fixture proofFixture
+		require.NoError(t, json.Unmarshal(bz, &fixture), file)
+		fork, ok := forks[fixture.Fork]
+		require.True(t, ok, file)
+		if fixture.Network == mainnetNetwork {
+			// the fork of the mainnet is chosen from the slot as the keeper does
+			slotFork, err := beacon.ForkAtSlot(fixture.Slot)
+			require.NoError(t, err, file)
+			require.Equal(t, fork, slotFork, file)
+		}
+
+		require.NoError(t, beacon.VerifyStateRoot(fixture.BlockRoot, &fixture.StateRootProof), file)
+		require.NoError(t, beacon.VerifyWithdrawal(fixture.BlockRoot, &fixture.WithdrawalProof), file)
+		if fixture.Withdrawal != nil {
+			fields := fixture.WithdrawalProof.Fields
+			require.Equal(t, fixture.Withdrawal.ValidatorIndex, beacon.LeafToUint64(fields[beacon.WithdrawalValidatorIndexIndex]), file)
+			require.Equal(t, beacon.AddressLeaf(fixture.Withdrawal.Address), fields[beacon.WithdrawalAddressIndex], file)
+			require.Equal(t, fixture.Withdrawal.AmountGwei, beacon.LeafToUint64(fields[beacon.WithdrawalAmountIndex]), file)
+		}
+		if fixture.ValidatorProof == nil {
+			continue
+		}
+		stateRoot := fixture.StateRootProof.StateRoot
+		require.NoError(t, fork.VerifyValidatorFields(stateRoot, fixture.ValidatorProof), file)
+		balance, err := fork.VerifyValidatorBalance(stateRoot, fixture.ValidatorProof.ValidatorIndex, fixture.BalanceProof)
+		require.NoError(t, err, file)
+		require.Equal(t, fixture.BalanceGwei, balance, file)
+	}
+}
@@ -0,0 +1,277 @@
+package beacon_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/protolambda/zrnt/eth2/beacon/altair"
+	zcommon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/zrnt/eth2/beacon/deneb"
+	"github.com/protolambda/zrnt/eth2/beacon/electra"
+	"github.com/protolambda/zrnt/eth2/beacon/phase0"
+	"github.com/protolambda/zrnt/eth2/configs"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/tree"
+	"github.com/protolambda/ztyp/view"
+	"github.com/stretchr/testify/require"
+)
+
+// specState is the beacon state of zrnt, the states of Deneb and Electra share the methods used by the tests
+type specState interface {
+	AddValidator(spec *zcommon.Spec, pub zcommon.BLSPubkey, withdrawalCreds zcommon.Root, balance zcommon.Gwei) error
+	SetBalances(balances []zcommon.Gwei) error
+	Validators() (zcommon.ValidatorRegistry, error)
+	SetSlot(slot zcommon.Slot) error
+	HashTreeRoot(h tree.HashFn) tree.Root
+	Backing() tree.Node
+}
+
+// specBlock is the beacon block of zrnt
+type specBlock interface {
+	Serialize(spec *zcommon.Spec, w *codec.EncodingWriter) error
+	HashTreeRoot(spec *zcommon.Spec, hFn tree.HashFn) zcommon.Root
+	Header(spec *zcommon.Spec) *zcommon.BeaconBlockHeader
+}
+
+// specProof collects the sibling nodes from the leaf at the generalized index up to the root of the backing tree.
+func specProof(t *testing.T, root tree.Node, gIndex uint64) []common.Hash {
+	hFn := tree.GetHashFn()
+	proof := make([]common.Hash, 0, beacon.GIndexDepth(gIndex))
+	for ; gIndex > 1; gIndex /= 2 {
+		node, err := root.Getter(tree.Gindex64(gIndex ^ 1))
+		require.NoError(t, err)
+		proof = append(proof, common.Hash(node.MerkleRoot(hFn)))
+	}
+	return proof
+}
+
+// specLeaves returns the leaves of the container at the generalized index, the container has 2^depth chunks.
+func specLeaves(t *testing.T, root tree.Node, gIndex uint64, count, depth int) []common.Hash {
+	leaves := make([]common.Hash, 0, count)
+	for i := 0; i < count; i++ {
+		node, err := root.Getter(tree.Gindex64(gIndex<<depth | uint64(i)))
+		require.NoError(t, err)
+		leaves = append(leaves, common.Hash(node.MerkleRoot(tree.GetHashFn())))
+	}
+	return leaves
+}
+
+// specBlockTree returns the backing tree and the root of the block, the block is decoded by the container type
+// of the fork, so the tree is built by zrnt instead of the verifier.
+func specBlockTree(t *testing.T, fork beacon.Fork, block specBlock) (tree.Node, common.Hash) {
+	spec := configs.Mainnet
+	hFn := tree.GetHashFn()
+	var buf bytes.Buffer
+	require.NoError(t, block.Serialize(spec, codec.NewEncodingWriter(&buf)))
+	reader := codec.NewDecodingReader(bytes.NewReader(buf.Bytes()), uint64(buf.Len()))
+	var blockView view.View
+	var err error
+	switch fork {
+	case beacon.Deneb:
+		blockView, err = deneb.BeaconBlockType(spec).Deserialize(reader)
+	case beacon.Electra:
+		blockView, err = electraBeaconBlockType(spec).Deserialize(reader)
+	default:
+		t.Fatalf("unsupported fork:%s", fork.Name)
+	}
+	require.NoError(t, err)
+	blockRoot := common.Hash(block.HashTreeRoot(spec, hFn))
+	require.Equal(t, blockRoot, common.Hash(blockView.HashTreeRoot(hFn)))
+	// the block root is the root of the block header
+	require.Equal(t, blockRoot, common.Hash(block.Header(spec).HashTreeRoot(hFn)))
+	return blockView.Backing(), blockRoot
+}
+
+// electraBeaconBlockType is the beacon block type of Electra, the attester slashings of the block body type of zrnt
+// are limited by MAX_ATTESTER_SLASHINGS instead of MAX_ATTESTER_SLASHINGS_ELECTRA, so the list is replaced to build
+// the same tree as the block containers and the consensus specs.
+func electraBeaconBlockType(spec *zcommon.Spec) *view.ContainerTypeDef {
+	bodyFields := append([]view.FieldDef{}, electra.BeaconBlockBodyType(spec).Fields...)
+	for i := range bodyFields {
+		if bodyFields[i].Name == "attester_slashings" {
+			bodyFields[i].Type = view.ListType(electra.AttesterSlashingType(spec), uint64(spec.MAX_ATTESTER_SLASHINGS_ELECTRA))
+		}
+	}
+	blockFields := append([]view.FieldDef{}, electra.BeaconBlockType(spec).Fields...)
+	blockFields[len(blockFields)-1].Type = view.ContainerType("BeaconBlockBody", bodyFields)
+	return view.ContainerType("BeaconBlock", blockFields)
+}
+
+// specBlockProofs collects the state root proof and the withdrawal proof from the tree of the block
+func specBlockProofs(t *testing.T, blockTree tree.Node, stateRoot common.Hash, withdrawalIndex uint64) (beacon.StateRootProof, beacon.WithdrawalProof) {
+	withdrawalGIndex := beacon.WithdrawalGIndex(withdrawalIndex)
+	return beacon.StateRootProof{
+		StateRoot: stateRoot,
+		Proof:     specProof(t, blockTree, beacon.StateRootGIndex()),
+	}, beacon.WithdrawalProof{
+		WithdrawalIndex: withdrawalIndex,
+		Fields:          specLeaves(t, blockTree, withdrawalGIndex, beacon.WithdrawalFieldsCount, beacon.WithdrawalFieldTreeDepth),
+		Proof:           specProof(t, blockTree, withdrawalGIndex),
+	}
+}
+
+// buildSpecFixture builds the beacon block and state of the fork at the slot with zrnt, an independent implementation
+// of the consensus specs, with the mainnet preset, and collects the proofs of the fully withdrawn validator from their
+// backing trees.
+func buildSpecFixture(t *testing.T, slot, validatorIndex, balanceGwei uint64) proofFixture {
+	spec := configs.Mainnet
+	hFn := tree.GetHashFn()
+	fork, err := beacon.ForkAtSlot(slot)
+	require.NoError(t, err)
+
+	var state specState
+	switch fork {
+	case beacon.Deneb:
+		state = deneb.NewBeaconStateView(spec)
+	case beacon.Electra:
+		state = electra.NewBeaconStateView(spec)
+	default:
+		t.Fatalf("unsupported fork:%s", fork.Name)
+	}
+	for i := uint64(0); i <= validatorIndex+1; i++ {
+		pubkey, credentials := zcommon.BLSPubkey{}, zcommon.Root{}
+		if i == validatorIndex {
+			copy(pubkey[:], validatorPubkey)
+			credentials = zcommon.Root(beacon.WithdrawalCredentials(podAddress))
+		}
+		require.NoError(t, state.AddValidator(spec, pubkey, credentials, zcommon.Gwei(32e9)))
+	}
+	balances := make([]zcommon.Gwei, validatorIndex+2)
+	for i := range balances {
+		balances[i] = zcommon.Gwei(32e9)
+	}
+	balances[validatorIndex] = zcommon.Gwei(balanceGwei)
+	require.NoError(t, state.SetBalances(balances))
+	validators, err := state.Validators()
+	require.NoError(t, err)
+	validator, err := validators.Validator(zcommon.ValidatorIndex(validatorIndex))
+	require.NoError(t, err)
+	// the validator is fully withdrawn at the epoch of the block
+	withdrawableEpoch := zcommon.Epoch(beacon.EpochAtSlot(slot))
+	require.NoError(t, validator.(*phase0.ValidatorView).SetExitEpoch(withdrawableEpoch))
+	require.NoError(t, validator.(*phase0.ValidatorView).SetWithdrawableEpoch(withdrawableEpoch))
+	require.NoError(t, state.SetSlot(zcommon.Slot(slot)))
+	stateRoot := common.Hash(state.HashTreeRoot(hFn))
+
+	withdrawals := zcommon.Withdrawals{
+		{Index: 100, ValidatorIndex: zcommon.ValidatorIndex(validatorIndex + 1), Amount: 1e6},
+		{Index: 101, ValidatorIndex: zcommon.ValidatorIndex(validatorIndex), Address: zcommon.Eth1Address(podAddress), Amount: zcommon.Gwei(balanceGwei)},
+	}
+	var block specBlock
+	switch fork {
+	case beacon.Deneb:
+		denebBlock := &deneb.BeaconBlock{Slot: zcommon.Slot(slot), ProposerIndex: zcommon.ValidatorIndex(validatorIndex + 1), StateRoot: zcommon.Root(stateRoot)}
+		denebBlock.Body.SyncAggregate.SyncCommitteeBits = make(altair.SyncCommitteeBits, spec.SYNC_COMMITTEE_SIZE/8)
+		denebBlock.Body.ExecutionPayload.Withdrawals = withdrawals
+		block = denebBlock
+	case beacon.Electra:
+		electraBlock := &electra.BeaconBlock{Slot: zcommon.Slot(slot), ProposerIndex: zcommon.ValidatorIndex(validatorIndex + 1), StateRoot: zcommon.Root(stateRoot)}
+		electraBlock.Body.SyncAggregate.SyncCommitteeBits = make(altair.SyncCommitteeBits, spec.SYNC_COMMITTEE_SIZE/8)
+		electraBlock.Body.ExecutionPayload.Withdrawals = withdrawals
+		block = electraBlock
+	}
+	blockTree, blockRoot := specBlockTree(t, fork, block)
+	stateRootProof, withdrawalProof := specBlockProofs(t, blockTree, stateRoot, 1)
+
+	validatorGIndex := fork.ValidatorGIndex(validatorIndex)
+	balanceLeaf, err := state.Backing().Getter(tree.Gindex64(fork.BalanceGIndex(validatorIndex)))
+	require.NoError(t, err)
+	return proofFixture{
+		Description:    fork.Name + " beacon block and state merkleized by zrnt with the mainnet preset, the validator is fully withdrawn",
+		Network:        mainnetNetwork,
+		Fork:           fork.Name,
+		Slot:           slot,
+		BlockRoot:      blockRoot,
+		BalanceGwei:    balanceGwei,
+		StateRootProof: stateRootProof,
+		ValidatorProof: &beacon.ValidatorProof{
+			ValidatorIndex: validatorIndex,
+			Fields:         specLeaves(t, state.Backing(), validatorGIndex, beacon.ValidatorFieldsCount, beacon.ValidatorFieldTreeDepth),
+			Proof:          specProof(t, state.Backing(), validatorGIndex),
+		},
+		BalanceProof: &beacon.BalanceProof{
+			BalanceRoot: common.Hash(balanceLeaf.MerkleRoot(hFn)),
+			Proof:       specProof(t, state.Backing(), fork.BalanceGIndex(validatorIndex)),
+		},
+		WithdrawalProof: withdrawalProof,
+	}
+}
+
+// buildBlockFixture decodes the beacon block in the JSON format of the beacon API with zrnt, and collects the
+// proofs of its state root and withdrawal. The states of the real blocks are too large to be kept, so the
+// fixtures built from them don't include the validator and balance proofs.
+func buildBlockFixture(t *testing.T, file, network string, fork beacon.Fork, withdrawalIndex uint64) proofFixture {
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	var block specBlock
+	var withdrawals zcommon.Withdrawals
+	switch fork {
+	case beacon.Deneb:
+		denebBlock := &deneb.BeaconBlock{}
+		require.NoError(t, json.Unmarshal(bz, denebBlock))
+		withdrawals = denebBlock.Body.ExecutionPayload.Withdrawals
+		block = denebBlock
+	case beacon.Electra:
+		electraBlock := &electra.BeaconBlock{}
+		require.NoError(t, json.Unmarshal(bz, electraBlock))
+		withdrawals = electraBlock.Body.ExecutionPayload.Withdrawals
+		block = electraBlock
+	default:
+		t.Fatalf("unsupported fork:%s", fork.Name)
+	}
+	require.Less(t, withdrawalIndex, uint64(len(withdrawals)))
+	header := block.Header(configs.Mainnet)
+	blockTree, blockRoot := specBlockTree(t, fork, block)
+	stateRootProof, withdrawalProof := specBlockProofs(t, blockTree, common.Hash(header.StateRoot), withdrawalIndex)
+	withdrawal := withdrawals[withdrawalIndex]
+	return proofFixture{
+		Description: fork.Name + " beacon block of the " + network + " decoded by zrnt, " + filepath.Base(file) +
+			" of the go-ethereum beacon/types test data",
+		Network:         network,
+		Fork:            fork.Name,
+		Slot:            uint64(header.Slot),
+		BlockRoot:       blockRoot,
+		StateRootProof:  stateRootProof,
+		WithdrawalProof: withdrawalProof,
+		Withdrawal: &withdrawalFixture{
+			ValidatorIndex: uint64(withdrawal.ValidatorIndex),
+			Address:        common.Address(withdrawal.Address),
+			AmountGwei:     uint64(withdrawal.Amount),
+		},
+	}
+}
+
+// TestVerifySpecProofs checks the generalized indices and the tree depths of the containers of each fork against
+// the trees built by zrnt instead of trusting the test builder.
+func TestVerifySpecProofs(t *testing.T) {
+	for _, slot := range []uint64{denebSlot, electraSlot} {
+		validatorIndex, balanceGwei := uint64(1234), uint64(32001234567)
+		fixture := buildSpecFixture(t, slot, validatorIndex, balanceGwei)
+		fork, err := beacon.ForkAtSlot(slot)
+		require.NoError(t, err)
+
+		require.NoError(t, beacon.VerifyStateRoot(fixture.BlockRoot, &fixture.StateRootProof))
+		stateRoot := fixture.StateRootProof.StateRoot
+		require.NoError(t, fork.VerifyValidatorFields(stateRoot, fixture.ValidatorProof), fork.Name)
+		fields := fixture.ValidatorProof.Fields
+		require.Equal(t, beacon.PubkeyRoot(validatorPubkey), fields[beacon.ValidatorPubkeyIndex])
+		require.Equal(t, beacon.WithdrawalCredentials(podAddress), fields[beacon.ValidatorWithdrawalCredentialsIndex])
+		require.Equal(t, beacon.Uint64Leaf(32e9), fields[beacon.ValidatorEffectiveBalanceIndex])
+		require.Equal(t, beacon.Uint64Leaf(beacon.EpochAtSlot(slot)), fields[beacon.ValidatorWithdrawableEpochIndex])
+
+		balance, err := fork.VerifyValidatorBalance(stateRoot, validatorIndex, fixture.BalanceProof)
+		require.NoError(t, err, fork.Name)
+		require.Equal(t, balanceGwei, balance)
+
+		require.NoError(t, beacon.VerifyWithdrawal(fixture.BlockRoot, &fixture.WithdrawalProof))
+		fields = fixture.WithdrawalProof.Fields
+		require.Equal(t, validatorIndex, beacon.LeafToUint64(fields[beacon.WithdrawalValidatorIndexIndex]))
+		require.Equal(t, beacon.AddressLeaf(podAddress), fields[beacon.WithdrawalAddressIndex])
+		require.Equal(t, balanceGwei, beacon.LeafToUint64(fields[beacon.WithdrawalAmountIndex]))
+	}
+}
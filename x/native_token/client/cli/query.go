@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the parent command for all native_token CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the native_token module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryStakerInfo(),
+		QueryPodOwner(),
+		QueryBeaconBlockRoot(),
+	)
+	return cmd
+}
+
+// QueryStakerInfo queries the native token restaking info of the staker
+func QueryStakerInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryStakerInfo clientChainLzID stakerAddr",
+		Short: "Get the pod and validators of the staker",
+		Long:  "Get the pod and validators of the staker",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, err.Error())
+			}
+			stakerID, _ := restakingtype.GetStakeIDAndAssetIDFromStr(clientChainLzID, args[1], "")
+			req := &types.QueryStakerInfoReq{
+				StakerID: stakerID,
+			}
+			res, err := queryClient.QueStakerInfo(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryPodOwner queries the staker that registered the pod
+func QueryPodOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryPodOwner clientChainLzID podAddr",
+		Short: "Get the staker that registered the pod",
+		Long:  "Get the staker that registered the pod",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, err.Error())
+			}
+			req := &types.QueryPodOwnerReq{
+				ClientChainLzID: clientChainLzID,
+				PodAddress:      args[1],
+			}
+			res, err := queryClient.QuePodOwner(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryBeaconBlockRoot queries the beacon block root of the slot
+func QueryBeaconBlockRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryBeaconBlockRoot slot",
+		Short: "Get the beacon block root of the slot submitted by the oracle",
+		Long:  "Get the beacon block root of the slot submitted by the oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			slot, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, err.Error())
+			}
+			req := &types.QueryBeaconBlockRootReq{
+				Slot: slot,
+			}
+			res, err := queryClient.QueBeaconBlockRoot(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+// NewTxCmd returns a root CLI command handler for native_token commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "native_token subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		SubmitBeaconBlockRoot(),
+	)
+	return txCmd
+}
+
+// SubmitBeaconBlockRoot submits the beacon block root of the slot, the sender must be a beacon oracle
+func SubmitBeaconBlockRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SubmitBeaconBlockRoot slot blockRoot",
+		Short: "submit the beacon block root of the slot, the sender must be a beacon oracle",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			slot, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, err.Error())
+			}
+			msg := &types.MsgSubmitBeaconBlockRoot{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				Slot:        slot,
+				BlockRoot:   args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,45 @@
+package native_token
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// InitGenesis imports the native token restaking states, the restaking_assets_manage module
+// should be initialized before this module to check the consistency of the staker balances.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, &data.Params); err != nil {
+		panic(err)
+	}
+	for _, root := range data.BeaconBlockRoots {
+		if err := k.SetBeaconBlockRoot(ctx, root.Slot, common.HexToHash(root.BlockRoot)); err != nil {
+			panic(err)
+		}
+	}
+	for i := range data.Stakers {
+		k.SetStakerInfo(ctx, data.Stakers[i].StakerID, &data.Stakers[i].Info)
+	}
+	if err := k.CheckStakerBalancesConsistency(ctx); err != nil {
+		panic(errorsmod.Wrap(types.ErrInvalidGenesisData, err.Error()))
+	}
+}
+
+// ExportGenesis exports the native token restaking states
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	roots, err := k.GetAllBeaconBlockRoots(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Stakers:          k.GetAllStakerInfos(ctx),
+		Params:           *params,
+		BeaconBlockRoots: roots,
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SetBeaconBlockRoot stores the beacon block root of the slot. The root of a slot can't be changed once
+// it's submitted, so the verified balances can't be affected by a later submission.
+// todo: the roots of the old slots should be pruned in the future.
+func (k Keeper) SetBeaconBlockRoot(ctx sdk.Context, slot uint64, blockRoot common.Hash) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBeaconBlockRoot)
+	key := types.GetBeaconBlockRootKey(slot)
+	if value := store.Get(key); value != nil {
+		if common.BytesToHash(value) != blockRoot {
+			return errorsmod.Wrap(types.ErrBeaconBlockRootConflict, fmt.Sprintf("slot:%d,recorded:%s,input:%s", slot, common.BytesToHash(value), blockRoot))
+		}
+		return nil
+	}
+	store.Set(key, blockRoot.Bytes())
+	return nil
+}
+
+// GetBeaconBlockRoot returns the beacon block root of the slot
+func (k Keeper) GetBeaconBlockRoot(ctx sdk.Context, slot uint64) (common.Hash, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBeaconBlockRoot)
+	value := store.Get(types.GetBeaconBlockRootKey(slot))
+	if value == nil {
+		return common.Hash{}, errorsmod.Wrap(types.ErrBeaconBlockRootNotFound, fmt.Sprintf("slot:%d", slot))
+	}
+	return common.BytesToHash(value), nil
+}
+
+// GetAllBeaconBlockRoots returns all the beacon block roots, it's used to export the genesis state.
+func (k Keeper) GetAllBeaconBlockRoots(ctx sdk.Context) ([]types.BeaconBlockRoot, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBeaconBlockRoot)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]types.BeaconBlockRoot, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		slot, err := hexutil.DecodeUint64(string(iterator.Key()))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, types.BeaconBlockRoot{
+			Slot:      slot,
+			BlockRoot: hexutil.Encode(iterator.Value()),
+		})
+	}
+	return ret, nil
+}
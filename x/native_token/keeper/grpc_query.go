@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// QueStakerInfo queries the native token restaking info of the staker
+func (k Keeper) QueStakerInfo(ctx context.Context, req *types.QueryStakerInfoReq) (*types.NativeTokenStakerInfo, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	info := k.GetStakerInfo(c, req.StakerID)
+	if info == nil {
+		return nil, errorsmod.Wrap(types.ErrPodNotRegistered, fmt.Sprintf("stakerID:%s", req.StakerID))
+	}
+	return info, nil
+}
+
+// QuePodOwner queries the staker that registered the pod address
+func (k Keeper) QuePodOwner(ctx context.Context, req *types.QueryPodOwnerReq) (*types.QueryPodOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	podKey, _ := restakingtype.GetStakeIDAndAssetIDFromStr(req.ClientChainLzID, req.PodAddress, "")
+	owner := k.GetPodOwner(c, podKey)
+	if owner == "" {
+		return nil, errorsmod.Wrap(types.ErrPodNotRegistered, fmt.Sprintf("pod:%s", podKey))
+	}
+	return &types.QueryPodOwnerResponse{StakerID: owner}, nil
+}
+
+// QueBeaconBlockRoot queries the beacon block root of the slot submitted by the oracle
+func (k Keeper) QueBeaconBlockRoot(ctx context.Context, req *types.QueryBeaconBlockRootReq) (*types.BeaconBlockRoot, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	blockRoot, err := k.GetBeaconBlockRoot(c, req.Slot)
+	if err != nil {
+		return nil, err
+	}
+	return &types.BeaconBlockRoot{Slot: req.Slot, BlockRoot: blockRoot.Hex()}, nil
+}
@@ -1,3 +1,47 @@
 package keeper
 
-type Keeper struct{}
+import (
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper manages the native token restaking through the beacon chain validators. The validator
+// balances of the staker are mirrored to its restaking asset state of the virtual native ETH asset,
+// so the native ETH can be delegated through the delegation module like the other assets.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of updating the params, it should be the gov module account.
+	authority string
+
+	restakingStateKeeper keeper.Keeper
+	slashKeeper          types.SlashKeeper
+}
+
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	restakingStateKeeper keeper.Keeper,
+	slashKeeper types.SlashKeeper,
+	authority string,
+) Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+	return Keeper{
+		storeKey:             storeKey,
+		cdc:                  cdc,
+		authority:            authority,
+		restakingStateKeeper: restakingStateKeeper,
+		slashKeeper:          slashKeeper,
+	}
+}
+
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	context "context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// SubmitBeaconBlockRoot stores the beacon block root submitted by the beacon oracle, the proofs of the
+// validator balances and withdrawals are verified against it.
+func (k Keeper) SubmitBeaconBlockRoot(ctx context.Context, req *types.MsgSubmitBeaconBlockRoot) (*types.SubmitBeaconBlockRootResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := types.ValidateBeaconBlockRoot(req.BlockRoot); err != nil {
+		return nil, err
+	}
+	params, err := k.GetParams(c)
+	if err != nil {
+		return nil, err
+	}
+	if !params.IsBeaconOracle(req.FromAddress) {
+		return nil, errorsmod.Wrap(types.ErrNotBeaconOracle, fmt.Sprintf("sender:%s", req.FromAddress))
+	}
+	if err = k.SetBeaconBlockRoot(c, req.Slot, common.HexToHash(req.BlockRoot)); err != nil {
+		return nil, err
+	}
+	return &types.SubmitBeaconBlockRootResponse{}, nil
+}
+
+// UpdateParams updates the params of the native_token module, it can only be called by the governance.
+// The beacon oracles are set by it on the chains upgraded with the default params.
+func (k Keeper) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.UpdateParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, req.Authority))
+	}
+	if err := k.SetParams(c, &req.Params); err != nil {
+		return nil, err
+	}
+	return &types.UpdateParamsResponse{}, nil
+}
@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RegisterPod registers the pod address of the staker, the staker can only register one pod on a client
+// chain and a pod can't be registered by different stakers.
+func (k Keeper) RegisterPod(ctx sdk.Context, clientChainLzID uint64, stakerAddr, podAddr []byte) error {
+	stakerID, _ := restakingtype.GetStakeIDAndAssetID(clientChainLzID, stakerAddr, nil)
+	podKey, _ := restakingtype.GetStakeIDAndAssetID(clientChainLzID, podAddr, nil)
+	if k.GetStakerInfo(ctx, stakerID) != nil {
+		return errorsmod.Wrap(types.ErrPodAlreadyRegistered, fmt.Sprintf("the staker has registered a pod, stakerID:%s", stakerID))
+	}
+	if owner := k.GetPodOwner(ctx, podKey); owner != "" {
+		return errorsmod.Wrap(types.ErrPodAlreadyRegistered, fmt.Sprintf("the pod has been registered by:%s", owner))
+	}
+	info := types.NewNativeTokenStakerInfo(hexutil.Encode(podAddr))
+	k.SetStakerInfo(ctx, stakerID, &info)
+	return nil
+}
+
+// BalanceUpdateParams is the balance proof of the validator relayed from the client chain
+type BalanceUpdateParams struct {
+	ClientChainLzID uint64
+	StakerAddress   []byte
+	ValidatorPubkey []byte
+	// Slot is the slot of the beacon block whose root has been submitted by the beacon oracle
+	Slot           uint64
+	StateRootProof beacon.StateRootProof
+	ValidatorProof beacon.ValidatorProof
+	BalanceProof   beacon.BalanceProof
+}
+
+// WithdrawalParams is the full withdrawal proof of the validator relayed from the client chain
+type WithdrawalParams struct {
+	ClientChainLzID uint64
+	StakerAddress   []byte
+	ValidatorPubkey []byte
+	// Slot is the slot of the beacon block that includes the withdrawal
+	Slot            uint64
+	StateRootProof  beacon.StateRootProof
+	ValidatorProof  beacon.ValidatorProof
+	WithdrawalProof beacon.WithdrawalProof
+}
+
+// UpdateValidatorBalance records the balance of the validator proven against the beacon block root. The validator
+// is added to the staker if it's new, and the change of the balance is applied to the restaking asset state of the
+// native ETH asset, so the TotalValidatorBalances is always equal to the total deposit amount of the staker.
+func (k Keeper) UpdateValidatorBalance(ctx sdk.Context, params *BalanceUpdateParams) error {
+	stakerID, assetID := restakingtype.GetStakeIDAndAssetID(params.ClientChainLzID, params.StakerAddress, types.NativeETHAssetAddr.Bytes())
+	info, err := k.getStakerInfoWithNativeAsset(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+	fork, err := beacon.ForkAtSlot(params.Slot)
+	if err != nil {
+		return err
+	}
+	stateRoot, err := k.verifyValidator(ctx, info, params.ValidatorPubkey, params.Slot, fork, &params.StateRootProof, &params.ValidatorProof)
+	if err != nil {
+		return err
+	}
+	validatorIndex := params.ValidatorProof.ValidatorIndex
+	balance, err := fork.VerifyValidatorBalance(stateRoot, validatorIndex, &params.BalanceProof)
+	if err != nil {
+		return err
+	}
+	balanceGwei := sdkmath.NewIntFromUint64(balance)
+
+	validatorKey := types.GetValidatorKey(params.ValidatorPubkey)
+	validator, ok := info.ValidatorsInfo[validatorKey]
+	if !ok {
+		validator = &types.ValidatorInfo{
+			ValidatorIndex:    validatorIndex,
+			StakedBalanceGwei: sdkmath.NewInt(0),
+		}
+		info.ValidatorsInfo[validatorKey] = validator
+	} else if params.Slot <= validator.MostRecentBalanceUpdateSlot {
+		return errorsmod.Wrap(types.ErrStaleBalanceProof, fmt.Sprintf("validator:%s,recorded:%d,input:%d", validatorKey, validator.MostRecentBalanceUpdateSlot, params.Slot))
+	}
+	if validator.Status == types.ValidatorInfo_WITHDRAWN {
+		return errorsmod.Wrap(types.ErrValidatorWithdrawn, fmt.Sprintf("validator:%s", validatorKey))
+	}
+	if validator.ValidatorIndex != validatorIndex {
+		return errorsmod.Wrap(types.ErrMismatchedValidatorIndex, fmt.Sprintf("validator:%s,recorded:%d,input:%d", validatorKey, validator.ValidatorIndex, validatorIndex))
+	}
+
+	changeAmount := balanceGwei.Sub(validator.StakedBalanceGwei).Mul(types.GweiToWei)
+	validator.StakedBalanceGwei = balanceGwei
+	validator.MostRecentBalanceUpdateBlockNumber = uint64(ctx.BlockHeight())
+	validator.MostRecentBalanceUpdateSlot = params.Slot
+	if balanceGwei.IsZero() {
+		validator.Status = types.ValidatorInfo_INACTIVE
+	} else {
+		validator.Status = types.ValidatorInfo_ACTIVE
+	}
+	info.TotalValidatorBalances = info.TotalValidatorBalances.Add(changeAmount)
+
+	if changeAmount.IsNegative() {
+		err = k.decreaseNativeAssetState(ctx, stakerID, assetID, changeAmount.Neg())
+	} else {
+		err = k.updateNativeAssetState(ctx, stakerID, assetID, changeAmount)
+	}
+	if err != nil {
+		return err
+	}
+	k.SetStakerInfo(ctx, stakerID, info)
+	return nil
+}
+
+// WithdrawValidator removes the fully withdrawn validator from the staker. The validator should be withdrawable
+// at the slot and the withdrawal to the pod should be included in the beacon block. Its recorded balance is removed
+// from the restaking asset state, so the withdrawal is rejected if the balance has been delegated.
+// The withdrawn amount is accumulated to the UnStakedValueFromPOS, it can be claimed from the pod on the client chain
+// after the withdrawal is acknowledged.
+func (k Keeper) WithdrawValidator(ctx sdk.Context, params *WithdrawalParams) error {
+	stakerID, assetID := restakingtype.GetStakeIDAndAssetID(params.ClientChainLzID, params.StakerAddress, types.NativeETHAssetAddr.Bytes())
+	info, err := k.getStakerInfoWithNativeAsset(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+
+	validatorKey := types.GetValidatorKey(params.ValidatorPubkey)
+	validator, ok := info.ValidatorsInfo[validatorKey]
+	if !ok {
+		return errorsmod.Wrap(types.ErrValidatorNotExist, fmt.Sprintf("stakerID:%s,validator:%s", stakerID, validatorKey))
+	}
+	if validator.Status == types.ValidatorInfo_WITHDRAWN {
+		return errorsmod.Wrap(types.ErrValidatorWithdrawn, fmt.Sprintf("validator:%s", validatorKey))
+	}
+	withdrawnAmountGwei, err := k.verifyFullWithdrawal(ctx, info, validator, params)
+	if err != nil {
+		return err
+	}
+
+	removedAmount := validator.StakedBalanceGwei.Mul(types.GweiToWei)
+	if err = k.restakingStateKeeper.CheckAndRecordWithdrawalOutflow(ctx, assetID, removedAmount); err != nil {
+		return err
+	}
+	if err = k.updateNativeAssetState(ctx, stakerID, assetID, removedAmount.Neg()); err != nil {
+		return err
+	}
+
+	validator.Status = types.ValidatorInfo_WITHDRAWN
+	validator.StakedBalanceGwei = sdkmath.NewInt(0)
+	validator.MostRecentBalanceUpdateBlockNumber = uint64(ctx.BlockHeight())
+	validator.MostRecentBalanceUpdateSlot = params.Slot
+	info.TotalValidatorBalances = info.TotalValidatorBalances.Sub(removedAmount)
+	info.UnStakedValueFromPOS = info.UnStakedValueFromPOS.Add(withdrawnAmountGwei.Mul(types.GweiToWei))
+	k.SetStakerInfo(ctx, stakerID, info)
+	return nil
+}
+
+// verifyValidator verifies the validator fields against the beacon block root of the slot with the layout of
+// the fork, and checks the validator is the one of the pubkey whose withdrawal credentials point to the pod of
+// the staker. It returns the verified state root.
+func (k Keeper) verifyValidator(
+	ctx sdk.Context,
+	info *types.NativeTokenStakerInfo,
+	validatorPubkey []byte,
+	slot uint64,
+	fork beacon.Fork,
+	stateRootProof *beacon.StateRootProof,
+	validatorProof *beacon.ValidatorProof,
+) (common.Hash, error) {
+	if len(validatorPubkey) != types.ValidatorPubkeyLength {
+		return common.Hash{}, errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("invalid validator pubkey:%s", hexutil.Encode(validatorPubkey)))
+	}
+	blockRoot, err := k.GetBeaconBlockRoot(ctx, slot)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err = beacon.VerifyStateRoot(blockRoot, stateRootProof); err != nil {
+		return common.Hash{}, err
+	}
+	if err = fork.VerifyValidatorFields(stateRootProof.StateRoot, validatorProof); err != nil {
+		return common.Hash{}, err
+	}
+	if validatorProof.Fields[beacon.ValidatorPubkeyIndex] != beacon.PubkeyRoot(validatorPubkey) {
+		return common.Hash{}, errorsmod.Wrap(types.ErrInvalidBeaconProof, fmt.Sprintf("the validator fields don't belong to the pubkey:%s", hexutil.Encode(validatorPubkey)))
+	}
+	podAddress := common.HexToAddress(info.PodAddress)
+	if validatorProof.Fields[beacon.ValidatorWithdrawalCredentialsIndex] != beacon.WithdrawalCredentials(podAddress) {
+		return common.Hash{}, errorsmod.Wrap(types.ErrMismatchedCredentials, fmt.Sprintf("validator:%s,pod:%s", hexutil.Encode(validatorPubkey), info.PodAddress))
+	}
+	return stateRootProof.StateRoot, nil
+}
+
+// verifyFullWithdrawal verifies the validator is withdrawable at the slot and its withdrawal to the pod is included
+// in the beacon block of the slot. It returns the withdrawn amount in gwei.
+func (k Keeper) verifyFullWithdrawal(ctx sdk.Context, info *types.NativeTokenStakerInfo, validator *types.ValidatorInfo, params *WithdrawalParams) (sdkmath.Int, error) {
+	fork, err := beacon.ForkAtSlot(params.Slot)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if _, err = k.verifyValidator(ctx, info, params.ValidatorPubkey, params.Slot, fork, &params.StateRootProof, &params.ValidatorProof); err != nil {
+		return sdkmath.Int{}, err
+	}
+	validatorIndex := params.ValidatorProof.ValidatorIndex
+	if validator.ValidatorIndex != validatorIndex {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrMismatchedValidatorIndex, fmt.Sprintf("recorded:%d,input:%d", validator.ValidatorIndex, validatorIndex))
+	}
+	withdrawableEpoch := beacon.LeafToUint64(params.ValidatorProof.Fields[beacon.ValidatorWithdrawableEpochIndex])
+	if withdrawableEpoch > beacon.EpochAtSlot(params.Slot) {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrValidatorNotWithdrawable, fmt.Sprintf("withdrawableEpoch:%d,slot:%d", withdrawableEpoch, params.Slot))
+	}
+
+	blockRoot, err := k.GetBeaconBlockRoot(ctx, params.Slot)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if err = beacon.VerifyWithdrawal(blockRoot, &params.WithdrawalProof); err != nil {
+		return sdkmath.Int{}, err
+	}
+	fields := params.WithdrawalProof.Fields
+	if beacon.LeafToUint64(fields[beacon.WithdrawalValidatorIndexIndex]) != validatorIndex ||
+		fields[beacon.WithdrawalAddressIndex] != beacon.AddressLeaf(common.HexToAddress(info.PodAddress)) {
+		return sdkmath.Int{}, errorsmod.Wrap(types.ErrInvalidWithdrawal, fmt.Sprintf("validatorIndex:%d,withdrawalIndex:%d", validatorIndex, params.WithdrawalProof.WithdrawalIndex))
+	}
+	return sdkmath.NewIntFromUint64(beacon.LeafToUint64(fields[beacon.WithdrawalAmountIndex])), nil
+}
+
+// getStakerInfoWithNativeAsset returns the info of the staker after checking the native ETH asset can be restaked
+func (k Keeper) getStakerInfoWithNativeAsset(ctx sdk.Context, stakerID, assetID string) (*types.NativeTokenStakerInfo, error) {
+	info := k.GetStakerInfo(ctx, stakerID)
+	if info == nil {
+		return nil, errorsmod.Wrap(types.ErrPodNotRegistered, fmt.Sprintf("stakerID:%s", stakerID))
+	}
+	if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
+		return nil, errorsmod.Wrap(types.ErrNativeAssetNotRegistered, fmt.Sprintf("assetID:%s", assetID))
+	}
+	if err := k.restakingStateKeeper.CheckAssetNotPaused(ctx, assetID); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// updateNativeAssetState applies the change of the validator balances to the deposit and withdrawable amount of the
+// staker, and the total staking amount of the native ETH asset.
+func (k Keeper) updateNativeAssetState(ctx sdk.Context, stakerID, assetID string, changeAmount sdkmath.Int) error {
+	if changeAmount.IsZero() {
+		return nil
+	}
+	err := k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, restakingtype.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: changeAmount,
+		CanWithdrawAmountOrWantChangeValue:  changeAmount,
+	})
+	if err != nil {
+		return err
+	}
+	return k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, changeAmount)
+}
+
+// decreaseNativeAssetState applies the decrease of the validator balances, e.g. caused by the beacon chain slashing.
+// The decrease is taken from the withdrawable amount of the staker first, the rest is slashed from the delegated
+// amounts because the staker's validator balances back its delegations.
+func (k Keeper) decreaseNativeAssetState(ctx sdk.Context, stakerID, assetID string, decreaseAmount sdkmath.Int) error {
+	assetInfo, err := k.restakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+	withdrawableAmount := sdkmath.MinInt(decreaseAmount, assetInfo.CanWithdrawAmountOrWantChangeValue)
+	if err = k.updateNativeAssetState(ctx, stakerID, assetID, withdrawableAmount.Neg()); err != nil {
+		return err
+	}
+	return k.slashKeeper.SlashStakerAsset(ctx, stakerID, assetID, decreaseAmount.Sub(withdrawableAmount))
+}
+
+// SetStakerInfo stores the info of the staker and indexes its pod address
+func (k Keeper) SetStakerInfo(ctx sdk.Context, stakerID string, info *types.NativeTokenStakerInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerInfo)
+	store.Set([]byte(stakerID), k.cdc.MustMarshal(info))
+
+	_, clientChainLzID, _ := restakingtype.ParseID(stakerID)
+	podKey, _ := restakingtype.GetStakeIDAndAssetIDFromStr(clientChainLzID, info.PodAddress, "")
+	podStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPodOwner)
+	podStore.Set([]byte(podKey), []byte(stakerID))
+}
+
+// GetStakerInfo returns the info of the staker, it's nil if the staker hasn't registered a pod.
+func (k Keeper) GetStakerInfo(ctx sdk.Context, stakerID string) *types.NativeTokenStakerInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerInfo)
+	value := store.Get([]byte(stakerID))
+	if value == nil {
+		return nil
+	}
+	ret := &types.NativeTokenStakerInfo{}
+	k.cdc.MustUnmarshal(value, ret)
+	if ret.ValidatorsInfo == nil {
+		ret.ValidatorsInfo = make(map[string]*types.ValidatorInfo)
+	}
+	return ret
+}
+
+// GetPodOwner returns the stakerID that registered the pod, the podKey is podAddr+'_'+clientChainLzID.
+func (k Keeper) GetPodOwner(ctx sdk.Context, podKey string) string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPodOwner)
+	return string(store.Get([]byte(podKey)))
+}
+
+// GetAllStakerInfos returns the infos of all stakers, it's used to export the genesis state.
+func (k Keeper) GetAllStakerInfos(ctx sdk.Context) []types.StakerInfo {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixStakerInfo)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]types.StakerInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.NativeTokenStakerInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		ret = append(ret, types.StakerInfo{
+			StakerID: string(iterator.Key()),
+			Info:     info,
+		})
+	}
+	return ret
+}
+
+// CheckStakerBalancesConsistency checks the total validator balances of every staker is equal to its
+// deposit amount of the native ETH asset recorded in the restaking_assets_manage module.
+func (k Keeper) CheckStakerBalancesConsistency(ctx sdk.Context) error {
+	for _, staker := range k.GetAllStakerInfos(ctx) {
+		_, clientChainLzID, err := restakingtype.ParseID(staker.StakerID)
+		if err != nil {
+			return err
+		}
+		_, assetID := restakingtype.GetStakeIDAndAssetID(clientChainLzID, nil, types.NativeETHAssetAddr.Bytes())
+		depositAmount := sdkmath.NewInt(0)
+		assetInfo, err := k.restakingStateKeeper.GetStakerSpecifiedAssetInfo(ctx, staker.StakerID, assetID)
+		if err == nil {
+			depositAmount = assetInfo.TotalDepositAmountOrWantChangeValue
+		} else if !staker.Info.TotalValidatorBalances.IsZero() {
+			return err
+		}
+		if !depositAmount.Equal(staker.Info.TotalValidatorBalances) {
+			return errorsmod.Wrap(types.ErrInvalidBalance, fmt.Sprintf("stakerID:%s,totalValidatorBalances:%s,depositAmount:%s", staker.StakerID, staker.Info.TotalValidatorBalances, depositAmount))
+		}
+	}
+	return nil
+}
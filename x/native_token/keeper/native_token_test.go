@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/testutil"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/native_token/beacon"
+	"github.com/ExocoreNetwork/exocore/x/native_token/keeper"
+	"github.com/ExocoreNetwork/exocore/x/native_token/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// denebSlot and electraSlot are the slots of the mainnet in the Deneb and Electra forks, the proofs are verified
+	// against the beacon state layout of the fork at the slot
+	denebSlot   = beacon.MainnetDenebForkEpoch*32 + 1000
+	electraSlot = beacon.MainnetElectraForkEpoch * 32
+)
+
+func (suite *KeeperTestSuite) registerNativeETHAsset() string {
+	err := suite.app.StakingAssetsManageKeeper.SetStakingAssetInfo(suite.ctx, &restakingtype.StakingAssetInfo{
+		AssetBasicInfo: &restakingtype.AssetInfo{
+			Name:             "native ETH",
+			Symbol:           "ETH",
+			Address:          types.NativeETHAssetAddr.String(),
+			Decimals:         18,
+			TotalSupply:      sdkmath.NewInt(1e18),
+			LayerZeroChainID: 101,
+		},
+		StakingTotalAmount: sdkmath.NewInt(0),
+	})
+	suite.NoError(err)
+	_, assetID := restakingtype.GetStakeIDAndAssetID(101, nil, types.NativeETHAssetAddr.Bytes())
+	return assetID
+}
+
+func (suite *KeeperTestSuite) TestRegisterPod() {
+	podAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf")
+	stakerID, _ := restakingtype.GetStakeIDAndAssetID(101, suite.address.Bytes(), nil)
+
+	err := suite.app.NativeTokenKeeper.RegisterPod(suite.ctx, 101, suite.address.Bytes(), podAddr.Bytes())
+	suite.NoError(err)
+	info := suite.app.NativeTokenKeeper.GetStakerInfo(suite.ctx, stakerID)
+	suite.NotNil(info)
+	suite.Equal(podAddr.Hex(), common.HexToAddress(info.PodAddress).Hex())
+	suite.Equal(sdkmath.NewInt(0), info.TotalValidatorBalances)
+	podKey, _ := restakingtype.GetStakeIDAndAssetID(101, podAddr.Bytes(), nil)
+	suite.Equal(stakerID, suite.app.NativeTokenKeeper.GetPodOwner(suite.ctx, podKey))
+
+	// the staker can't register another pod and the pod can't be registered by another staker
+	err = suite.app.NativeTokenKeeper.RegisterPod(suite.ctx, 101, suite.address.Bytes(), common.HexToAddress("0x01").Bytes())
+	suite.ErrorContains(err, types.ErrPodAlreadyRegistered.Error())
+	err = suite.app.NativeTokenKeeper.RegisterPod(suite.ctx, 101, common.HexToAddress("0x02").Bytes(), podAddr.Bytes())
+	suite.ErrorContains(err, types.ErrPodAlreadyRegistered.Error())
+}
+
+// submitBeaconBlock builds the beacon block with the validator of the staker's pod and submits its root by the oracle
+func (suite *KeeperTestSuite) submitBeaconBlock(slot, validatorIndex uint64, validatorPubkey []byte, podAddr common.Address, balanceGwei, withdrawableEpoch uint64, withdrawals ...uint64) *testutil.BeaconBlockProofs {
+	builder := testutil.NewBeaconBlockBuilder(slot).AddValidator(validatorIndex, validatorPubkey, podAddr, balanceGwei, withdrawableEpoch)
+	for _, amount := range withdrawals {
+		builder.AddWithdrawal(validatorIndex, podAddr, amount)
+	}
+	proofs := builder.Build()
+	_, err := suite.app.NativeTokenKeeper.SubmitBeaconBlockRoot(suite.ctx, &types.MsgSubmitBeaconBlockRoot{
+		FromAddress: suite.accAddress.String(),
+		Slot:        slot,
+		BlockRoot:   proofs.BlockRoot.Hex(),
+	})
+	suite.NoError(err)
+	return proofs
+}
+
+func (suite *KeeperTestSuite) TestSubmitBeaconBlockRoot() {
+	msg := &types.MsgSubmitBeaconBlockRoot{
+		FromAddress: suite.accAddress.String(),
+		Slot:        100,
+		BlockRoot:   common.HexToHash("0x01").Hex(),
+	}
+	_, err := suite.app.NativeTokenKeeper.SubmitBeaconBlockRoot(suite.ctx, msg)
+	suite.ErrorContains(err, types.ErrNotBeaconOracle.Error())
+
+	// the beacon oracles are set by the governance
+	updateMsg := &types.MsgUpdateParams{
+		Authority: suite.accAddress.String(),
+		Params:    types.NewParams([]string{suite.accAddress.String()}),
+	}
+	suite.NoError(updateMsg.ValidateBasic())
+	_, err = suite.app.NativeTokenKeeper.UpdateParams(suite.ctx, updateMsg)
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+	updateMsg.Authority = suite.app.NativeTokenKeeper.GetAuthority()
+	_, err = suite.app.NativeTokenKeeper.UpdateParams(suite.ctx, updateMsg)
+	suite.NoError(err)
+	params, err := suite.app.NativeTokenKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	suite.True(params.IsBeaconOracle(suite.accAddress.String()))
+	_, err = suite.app.NativeTokenKeeper.SubmitBeaconBlockRoot(suite.ctx, msg)
+	suite.NoError(err)
+	root, err := suite.app.NativeTokenKeeper.GetBeaconBlockRoot(suite.ctx, 100)
+	suite.NoError(err)
+	suite.Equal(common.HexToHash("0x01"), root)
+
+	// the submitted root can't be changed
+	_, err = suite.app.NativeTokenKeeper.SubmitBeaconBlockRoot(suite.ctx, msg)
+	suite.NoError(err)
+	msg.BlockRoot = common.HexToHash("0x02").Hex()
+	_, err = suite.app.NativeTokenKeeper.SubmitBeaconBlockRoot(suite.ctx, msg)
+	suite.ErrorContains(err, types.ErrBeaconBlockRootConflict.Error())
+	_, err = suite.app.NativeTokenKeeper.GetBeaconBlockRoot(suite.ctx, 101)
+	suite.ErrorContains(err, types.ErrBeaconBlockRootNotFound.Error())
+}
+
+func (suite *KeeperTestSuite) TestValidatorBalances() {
+	validatorPubkey := make([]byte, types.ValidatorPubkeyLength)
+	validatorPubkey[0] = 1
+	podAddr := common.HexToAddress("0x03")
+	stakerAddr := suite.address.Bytes()
+	stakerID, assetID := restakingtype.GetStakeIDAndAssetID(101, stakerAddr, types.NativeETHAssetAddr.Bytes())
+	err := suite.app.NativeTokenKeeper.SetParams(suite.ctx, &types.Params{BeaconOracles: []string{suite.accAddress.String()}})
+	suite.NoError(err)
+
+	balanceUpdate := func(proofs *testutil.BeaconBlockProofs, slot, validatorIndex uint64) *keeper.BalanceUpdateParams {
+		return &keeper.BalanceUpdateParams{
+			ClientChainLzID: 101,
+			StakerAddress:   stakerAddr,
+			ValidatorPubkey: validatorPubkey,
+			Slot:            slot,
+			StateRootProof:  proofs.StateRootProof(),
+			ValidatorProof:  proofs.ValidatorProof(validatorIndex),
+			BalanceProof:    proofs.BalanceProof(validatorIndex),
+		}
+	}
+	withdrawal := func(proofs *testutil.BeaconBlockProofs, slot uint64) *keeper.WithdrawalParams {
+		return &keeper.WithdrawalParams{
+			ClientChainLzID: 101,
+			StakerAddress:   stakerAddr,
+			ValidatorPubkey: validatorPubkey,
+			Slot:            slot,
+			StateRootProof:  proofs.StateRootProof(),
+			ValidatorProof:  proofs.ValidatorProof(10),
+			WithdrawalProof: proofs.WithdrawalProof(0),
+		}
+	}
+	checkBalances := func(expected sdkmath.Int) {
+		info := suite.app.NativeTokenKeeper.GetStakerInfo(suite.ctx, stakerID)
+		suite.Equal(expected, info.TotalValidatorBalances)
+		assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+		suite.NoError(err)
+		suite.Equal(expected, assetInfo.TotalDepositAmountOrWantChangeValue)
+		suite.Equal(expected, assetInfo.CanWithdrawAmountOrWantChangeValue)
+		stakingAssetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+		suite.NoError(err)
+		suite.Equal(expected, stakingAssetInfo.StakingTotalAmount)
+		suite.NoError(suite.app.NativeTokenKeeper.CheckStakerBalancesConsistency(suite.ctx))
+	}
+
+	proofs := suite.submitBeaconBlock(denebSlot, 10, validatorPubkey, podAddr, 32e9, testutil.FarFutureEpoch)
+	// the pod and the native ETH asset should be registered first
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot, 10))
+	suite.ErrorContains(err, types.ErrPodNotRegistered.Error())
+	err = suite.app.NativeTokenKeeper.RegisterPod(suite.ctx, 101, stakerAddr, podAddr.Bytes())
+	suite.NoError(err)
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot, 10))
+	suite.ErrorContains(err, types.ErrNativeAssetNotRegistered.Error())
+	suite.registerNativeETHAsset()
+
+	// the proof should be verified against the submitted block root
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot-1, 10))
+	suite.ErrorContains(err, types.ErrBeaconBlockRootNotFound.Error())
+	invalidParams := balanceUpdate(proofs, denebSlot, 10)
+	invalidParams.BalanceProof = proofs.BalanceProof(20)
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, invalidParams)
+	suite.ErrorContains(err, types.ErrInvalidBeaconProof.Error())
+
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot, 10))
+	suite.NoError(err)
+	checkBalances(sdkmath.NewInt(32e9).Mul(types.GweiToWei))
+
+	// the stale proof is rejected
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot, 10))
+	suite.ErrorContains(err, types.ErrStaleBalanceProof.Error())
+
+	// the validator whose withdrawal credentials don't point to the pod can't be restaked
+	otherPubkey := make([]byte, types.ValidatorPubkeyLength)
+	otherProofs := suite.submitBeaconBlock(denebSlot+1, 11, otherPubkey, common.HexToAddress("0x04"), 32e9, testutil.FarFutureEpoch)
+	otherParams := balanceUpdate(otherProofs, denebSlot+1, 11)
+	otherParams.ValidatorPubkey = otherPubkey
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, otherParams)
+	suite.ErrorContains(err, types.ErrMismatchedCredentials.Error())
+
+	// the validator index can't be changed
+	proofs = suite.submitBeaconBlock(denebSlot+2, 11, validatorPubkey, podAddr, 33e9, testutil.FarFutureEpoch)
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, denebSlot+2, 11))
+	suite.ErrorContains(err, types.ErrMismatchedValidatorIndex.Error())
+
+	proofs = suite.submitBeaconBlock(electraSlot+3, 10, validatorPubkey, podAddr, 31e9, testutil.FarFutureEpoch)
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, electraSlot+3, 10))
+	suite.NoError(err)
+	checkBalances(sdkmath.NewInt(31e9).Mul(types.GweiToWei))
+	info := suite.app.NativeTokenKeeper.GetStakerInfo(suite.ctx, stakerID)
+	validator := info.ValidatorsInfo[types.GetValidatorKey(validatorPubkey)]
+	suite.Equal(types.ValidatorInfo_ACTIVE, validator.Status)
+	suite.Equal(uint64(suite.ctx.BlockHeight()), validator.MostRecentBalanceUpdateBlockNumber)
+	suite.Equal(uint64(electraSlot+3), validator.MostRecentBalanceUpdateSlot)
+
+	// the validator can't be withdrawn before its withdrawable epoch
+	proofs = suite.submitBeaconBlock(electraSlot+4, 10, validatorPubkey, podAddr, 31e9, (electraSlot+4)/32+1, 31e9)
+	err = suite.app.NativeTokenKeeper.WithdrawValidator(suite.ctx, withdrawal(proofs, electraSlot+4))
+	suite.ErrorContains(err, types.ErrValidatorNotWithdrawable.Error())
+
+	// the withdrawn validator is removed from the restaking balance
+	proofs = suite.submitBeaconBlock(electraSlot+64, 10, validatorPubkey, podAddr, 0, (electraSlot+64)/32, 31e9)
+	err = suite.app.NativeTokenKeeper.WithdrawValidator(suite.ctx, withdrawal(proofs, electraSlot+64))
+	suite.NoError(err)
+	checkBalances(sdkmath.NewInt(0))
+	info = suite.app.NativeTokenKeeper.GetStakerInfo(suite.ctx, stakerID)
+	suite.Equal(types.ValidatorInfo_WITHDRAWN, info.ValidatorsInfo[types.GetValidatorKey(validatorPubkey)].Status)
+	suite.Equal(sdkmath.NewInt(31e9).Mul(types.GweiToWei), info.UnStakedValueFromPOS)
+
+	proofs = suite.submitBeaconBlock(electraSlot+100, 10, validatorPubkey, podAddr, 32e9, (electraSlot+64)/32, 1e9)
+	err = suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, balanceUpdate(proofs, electraSlot+100, 10))
+	suite.ErrorContains(err, types.ErrValidatorWithdrawn.Error())
+	err = suite.app.NativeTokenKeeper.WithdrawValidator(suite.ctx, withdrawal(proofs, electraSlot+100))
+	suite.ErrorContains(err, types.ErrValidatorWithdrawn.Error())
+}
+
+func (suite *KeeperTestSuite) TestValidatorBalanceSlashing() {
+	validatorPubkey := make([]byte, types.ValidatorPubkeyLength)
+	validatorPubkey[0] = 1
+	podAddr := common.HexToAddress("0x03")
+	stakerAddr := suite.address.Bytes()
+	stakerID, assetID := restakingtype.GetStakeIDAndAssetID(101, stakerAddr, types.NativeETHAssetAddr.Bytes())
+	opAccAddr := suite.accAddress
+	ether := func(amount int64) sdkmath.Int {
+		return sdkmath.NewInt(amount * 1e9).Mul(types.GweiToWei)
+	}
+	err := suite.app.NativeTokenKeeper.SetParams(suite.ctx, &types.Params{BeaconOracles: []string{suite.accAddress.String()}})
+	suite.NoError(err)
+	suite.registerNativeETHAsset()
+	err = suite.app.NativeTokenKeeper.RegisterPod(suite.ctx, 101, stakerAddr, podAddr.Bytes())
+	suite.NoError(err)
+	balanceUpdate := func(balanceGwei, slot uint64) error {
+		proofs := suite.submitBeaconBlock(slot, 10, validatorPubkey, podAddr, balanceGwei, testutil.FarFutureEpoch)
+		return suite.app.NativeTokenKeeper.UpdateValidatorBalance(suite.ctx, &keeper.BalanceUpdateParams{
+			ClientChainLzID: 101,
+			StakerAddress:   stakerAddr,
+			ValidatorPubkey: validatorPubkey,
+			Slot:            slot,
+			StateRootProof:  proofs.StateRootProof(),
+			ValidatorProof:  proofs.ValidatorProof(10),
+			BalanceProof:    proofs.BalanceProof(10),
+		})
+	}
+	suite.NoError(balanceUpdate(32e9, denebSlot))
+
+	// 20 ETH is delegated and 8 ETH of it is being undelegated
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	delegationParams := &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: 101,
+		Action:          restakingtype.DelegateTo,
+		AssetsAddress:   types.NativeETHAssetAddr.Bytes(),
+		OperatorAddress: opAccAddr,
+		StakerAddress:   stakerAddr,
+		OpAmount:        ether(20),
+	}
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationParams))
+	delegationParams.OpAmount = ether(8)
+	delegationParams.LzNonce = 1
+	suite.NoError(suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationParams))
+
+	// the decrease of 28 ETH is taken from the withdrawable 12 ETH, the delegated 12 ETH and 4 ETH of the undelegation
+	suite.NoError(balanceUpdate(4e9, electraSlot+1))
+	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(ether(4), assetInfo.TotalDepositAmountOrWantChangeValue)
+	suite.True(assetInfo.CanWithdrawAmountOrWantChangeValue.IsZero())
+	suite.Equal(ether(4), assetInfo.WaitUndelegationAmountOrWantChangeValue)
+	delegationInfo, err := suite.app.DelegationKeeper.GetSingleDelegationInfo(suite.ctx, stakerID, assetID, opAccAddr.String())
+	suite.NoError(err)
+	suite.True(delegationInfo.CanUndelegationAmount.IsZero())
+	suite.Equal(ether(4), delegationInfo.WaitUndelegationAmount)
+	operatorInfo, err := suite.app.StakingAssetsManageKeeper.GetOperatorSpecifiedAssetInfo(suite.ctx, opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(ether(4), operatorInfo.TotalAmountOrWantChangeValue)
+	stakingAssetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(ether(4), stakingAssetInfo.StakingTotalAmount)
+	suite.NoError(suite.app.NativeTokenKeeper.CheckStakerBalancesConsistency(suite.ctx))
+	suite.NoError(suite.app.DelegationKeeper.CheckAssetStatesConsistency(suite.ctx))
+
+	// the reduced undelegation returns the remaining amount when it's completed
+	records, err := suite.app.DelegationKeeper.GetStakerPendingUndelegations(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Len(records, 1)
+	suite.Equal(ether(4), records[0].Amount)
+	ctx := suite.ctx.WithBlockHeight(int64(records[0].CompleteBlockNumber))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(ctx))
+	assetInfo, err = suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(ether(4), assetInfo.CanWithdrawAmountOrWantChangeValue)
+	suite.NoError(suite.app.DelegationKeeper.CheckAssetStatesConsistency(ctx))
+}
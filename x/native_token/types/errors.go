@@ -0,0 +1,27 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// errors
+var (
+	ErrPodAlreadyRegistered     = errorsmod.Register(ModuleName, 2, "the staker or pod address has been registered")
+	ErrPodNotRegistered         = errorsmod.Register(ModuleName, 3, "the staker hasn't registered a pod address")
+	ErrNativeAssetNotRegistered = errorsmod.Register(ModuleName, 4, "the native token asset hasn't been registered on the client chain")
+	ErrValidatorWithdrawn       = errorsmod.Register(ModuleName, 5, "the validator has been withdrawn")
+	ErrValidatorNotExist        = errorsmod.Register(ModuleName, 6, "the validator doesn't belong to the staker")
+	ErrMismatchedValidatorIndex = errorsmod.Register(ModuleName, 7, "the validator index is mismatched with the recorded one")
+	ErrInvalidBalance           = errorsmod.Register(ModuleName, 8, "the validator balance is invalid")
+	ErrNativeAssetInvalidOp     = errorsmod.Register(ModuleName, 9, "the native token asset can only be deposited or withdrawn through the validators")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 10, "the genesis data supplied is invalid")
+	ErrInvalidBeaconProof       = errorsmod.Register(ModuleName, 11, "the beacon chain proof is invalid")
+	ErrBeaconBlockRootNotFound  = errorsmod.Register(ModuleName, 12, "the beacon block root of the slot hasn't been submitted")
+	ErrBeaconBlockRootConflict  = errorsmod.Register(ModuleName, 13, "the beacon block root of the slot has been submitted with a different value")
+	ErrNotBeaconOracle          = errorsmod.Register(ModuleName, 14, "the sender isn't a beacon oracle")
+	ErrInvalidParams            = errorsmod.Register(ModuleName, 15, "the params is invalid")
+	ErrStaleBalanceProof        = errorsmod.Register(ModuleName, 16, "the balance proof isn't newer than the recorded one")
+	ErrMismatchedCredentials    = errorsmod.Register(ModuleName, 17, "the withdrawal credentials of the validator don't point to the pod")
+	ErrValidatorNotWithdrawable = errorsmod.Register(ModuleName, 18, "the validator isn't fully withdrawable")
+	ErrInvalidWithdrawal        = errorsmod.Register(ModuleName, 19, "the withdrawal doesn't belong to the validator")
+	ErrNoParamsKey              = errorsmod.Register(ModuleName, 20, "there is no stored key for native_token module params")
+	ErrInvalidAuthority         = errorsmod.Register(ModuleName, 21, "the signer isn't the authority of the module")
+)
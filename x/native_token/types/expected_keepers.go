@@ -1 +1,11 @@
 package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashKeeper defines the expected slash keeper used to apply the beacon chain slashing to the delegated amounts
+type SlashKeeper interface {
+	SlashStakerAsset(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error
+}
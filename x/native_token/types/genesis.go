@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+// The total validator balances of the staker should be equal to the sum of its validator balances.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	slots := make(map[uint64]struct{}, len(gs.BeaconBlockRoots))
+	for _, root := range gs.BeaconBlockRoots {
+		if _, ok := slots[root.Slot]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated beacon block root, slot:%d", root.Slot))
+		}
+		slots[root.Slot] = struct{}{}
+		if err := ValidateBeaconBlockRoot(root.BlockRoot); err != nil {
+			return err
+		}
+	}
+
+	stakers := make(map[string]struct{}, len(gs.Stakers))
+	pods := make(map[string]struct{}, len(gs.Stakers))
+	for _, staker := range gs.Stakers {
+		_, clientChainLzID, err := restakingtype.ParseID(staker.StakerID)
+		if err != nil {
+			return err
+		}
+		if _, ok := stakers[staker.StakerID]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated staker:%s", staker.StakerID))
+		}
+		stakers[staker.StakerID] = struct{}{}
+
+		info := staker.Info
+		if info.PodAddress == "" {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the pod address is empty, staker:%s", staker.StakerID))
+		}
+		podKey, _ := restakingtype.GetStakeIDAndAssetIDFromStr(clientChainLzID, info.PodAddress, "")
+		if _, ok := pods[podKey]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated pod address:%s", podKey))
+		}
+		pods[podKey] = struct{}{}
+
+		if info.TotalValidatorBalances.IsNil() || info.UnStakedValueFromPOS.IsNil() || info.UnStakedValueFromPOS.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the balances are invalid, staker:%s", staker.StakerID))
+		}
+		totalBalances := sdkmath.NewInt(0)
+		for pubkey, validator := range info.ValidatorsInfo {
+			if validator == nil || validator.StakedBalanceGwei.IsNil() || validator.StakedBalanceGwei.IsNegative() {
+				return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the validator balance is invalid, staker:%s,validator:%s", staker.StakerID, pubkey))
+			}
+			if validator.Status == ValidatorInfo_WITHDRAWN && !validator.StakedBalanceGwei.IsZero() {
+				return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the withdrawn validator has balance, staker:%s,validator:%s", staker.StakerID, pubkey))
+			}
+			totalBalances = totalBalances.Add(validator.StakedBalanceGwei.Mul(GweiToWei))
+		}
+		if !totalBalances.Equal(info.TotalValidatorBalances) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the total validator balances don't match the validators, staker:%s", staker.StakerID))
+		}
+	}
+	return nil
+}
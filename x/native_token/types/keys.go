@@ -3,6 +3,7 @@ package types
 import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // constants
@@ -25,9 +26,31 @@ func init() {
 }
 
 const (
-	prefixReStakerExocoreAddr = iota + 1
+	prefixStakerInfo = iota + 1
+
+	prefixPodOwner
+
+	prefixParams
+
+	prefixBeaconBlockRoot
 )
 
-// KeyPrefixReStakerExoCoreAddr reStakerId = clientChainAddr+'_'+ExoCoreChainIndex
-// KeyPrefixReStakerExoCoreAddr key-value: reStakerId->exoCoreAddr
-var KeyPrefixReStakerExoCoreAddr = []byte{prefixReStakerExocoreAddr}
+var (
+	// KeyPrefixStakerInfo key-value: stakerID->NativeTokenStakerInfo
+	KeyPrefixStakerInfo = []byte{prefixStakerInfo}
+	// KeyPrefixPodOwner is the reverse index of the pod address
+	// key-value: podAddr+'_'+clientChainLzID->stakerID
+	KeyPrefixPodOwner = []byte{prefixPodOwner}
+	// KeyPrefixParams key-value: ParamsKey->Params
+	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixBeaconBlockRoot key-value: slot->beaconBlockRoot
+	KeyPrefixBeaconBlockRoot = []byte{prefixBeaconBlockRoot}
+)
+
+// ParamsKey is the key of the params in the KeyPrefixParams store
+var ParamsKey = []byte("Params")
+
+// GetBeaconBlockRootKey returns the key of the beacon block root, the slot is hex-encoded like the heights in the other modules.
+func GetBeaconBlockRootKey(slot uint64) []byte {
+	return []byte(hexutil.EncodeUint64(slot))
+}
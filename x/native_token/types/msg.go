@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	_ sdk.Msg = &MsgSubmitBeaconBlockRoot{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// GetSigners returns the expected signers for a MsgSubmitBeaconBlockRoot message.
+func (m *MsgSubmitBeaconBlockRoot) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubmitBeaconBlockRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return ValidateBeaconBlockRoot(m.BlockRoot)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgSubmitBeaconBlockRoot) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	return nil
+}
+
+// ValidateBeaconBlockRoot checks the block root is a 32-byte hex string
+func ValidateBeaconBlockRoot(blockRoot string) error {
+	root, err := hexutil.Decode(blockRoot)
+	if err != nil || len(root) != BeaconBlockRootLength {
+		return errorsmod.Wrap(ErrInvalidBeaconProof, fmt.Sprintf("invalid beacon block root:%s", blockRoot))
+	}
+	return nil
+}
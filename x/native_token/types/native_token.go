@@ -0,0 +1,45 @@
+package types
+
+import (
+	"bytes"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// ValidatorPubkeyLength is the length of the BLS public key of the beacon chain validator
+	ValidatorPubkeyLength = 48
+	// BeaconBlockRootLength is the length of the beacon block root
+	BeaconBlockRootLength = 32
+)
+
+var (
+	// NativeETHAssetAddr is the virtual asset address of the native ETH restaked through the beacon chain
+	// validators, the asset should be registered on the client chain before the pods are registered.
+	NativeETHAssetAddr = common.HexToAddress("0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee")
+
+	// GweiToWei is used to convert the validator balance to the amount of the native ETH asset
+	GweiToWei = sdkmath.NewInt(1e9)
+)
+
+// IsNativeETHAsset returns true if the asset address is the virtual native ETH asset
+func IsNativeETHAsset(assetAddr []byte) bool {
+	return bytes.Equal(assetAddr, NativeETHAssetAddr.Bytes())
+}
+
+// GetValidatorKey returns the key of the validator in the ValidatorsInfo of the staker
+func GetValidatorKey(validatorPubkey []byte) string {
+	return hexutil.Encode(validatorPubkey)
+}
+
+// NewNativeTokenStakerInfo creates the info of the staker that has just registered the pod address
+func NewNativeTokenStakerInfo(podAddress string) NativeTokenStakerInfo {
+	return NativeTokenStakerInfo{
+		TotalValidatorBalances: sdkmath.NewInt(0),
+		UnStakedValueFromPOS:   sdkmath.NewInt(0),
+		PodAddress:             podAddress,
+		ValidatorsInfo:         make(map[string]*ValidatorInfo),
+	}
+}
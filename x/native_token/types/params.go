@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance
+func NewParams(beaconOracles []string) Params {
+	return Params{
+		BeaconOracles: beaconOracles,
+	}
+}
+
+// DefaultParams returns a default set of parameters, there isn't any beacon oracle by default.
+func DefaultParams() Params {
+	return NewParams(nil)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	oracles := make(map[string]struct{}, len(p.BeaconOracles))
+	for _, oracle := range p.BeaconOracles {
+		if _, err := sdk.AccAddressFromBech32(oracle); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("invalid beacon oracle:%s", oracle))
+		}
+		if _, ok := oracles[oracle]; ok {
+			return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("duplicated beacon oracle:%s", oracle))
+		}
+		oracles[oracle] = struct{}{}
+	}
+	return nil
+}
+
+// IsBeaconOracle returns true if the address is a beacon oracle
+func (p Params) IsBeaconOracle(addr string) bool {
+	for _, oracle := range p.BeaconOracles {
+		if oracle == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1029 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/native_token/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryStakerInfoReq struct {
+	StakerID string `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+}
+
+func (m *QueryStakerInfoReq) Reset()         { *m = QueryStakerInfoReq{} }
+func (m *QueryStakerInfoReq) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerInfoReq) ProtoMessage()    {}
+func (*QueryStakerInfoReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7626bfce118caa1, []int{0}
+}
+func (m *QueryStakerInfoReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerInfoReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerInfoReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerInfoReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerInfoReq.Merge(m, src)
+}
+func (m *QueryStakerInfoReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerInfoReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerInfoReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerInfoReq proto.InternalMessageInfo
+
+func (m *QueryStakerInfoReq) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+type QueryPodOwnerReq struct {
+	ClientChainLzID uint64 `protobuf:"varint,1,opt,name=clientChainLzID,proto3" json:"clientChainLzID,omitempty"`
+	PodAddress      string `protobuf:"bytes,2,opt,name=podAddress,proto3" json:"podAddress,omitempty"`
+}
+
+func (m *QueryPodOwnerReq) Reset()         { *m = QueryPodOwnerReq{} }
+func (m *QueryPodOwnerReq) String() string { return proto.CompactTextString(m) }
+func (*QueryPodOwnerReq) ProtoMessage()    {}
+func (*QueryPodOwnerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7626bfce118caa1, []int{1}
+}
+func (m *QueryPodOwnerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPodOwnerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPodOwnerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPodOwnerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPodOwnerReq.Merge(m, src)
+}
+func (m *QueryPodOwnerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPodOwnerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPodOwnerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPodOwnerReq proto.InternalMessageInfo
+
+func (m *QueryPodOwnerReq) GetClientChainLzID() uint64 {
+	if m != nil {
+		return m.ClientChainLzID
+	}
+	return 0
+}
+
+func (m *QueryPodOwnerReq) GetPodAddress() string {
+	if m != nil {
+		return m.PodAddress
+	}
+	return ""
+}
+
+type QueryPodOwnerResponse struct {
+	StakerID string `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+}
+
+func (m *QueryPodOwnerResponse) Reset()         { *m = QueryPodOwnerResponse{} }
+func (m *QueryPodOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPodOwnerResponse) ProtoMessage()    {}
+func (*QueryPodOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7626bfce118caa1, []int{2}
+}
+func (m *QueryPodOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPodOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPodOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPodOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPodOwnerResponse.Merge(m, src)
+}
+func (m *QueryPodOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPodOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPodOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPodOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryPodOwnerResponse) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+type QueryBeaconBlockRootReq struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (m *QueryBeaconBlockRootReq) Reset()         { *m = QueryBeaconBlockRootReq{} }
+func (m *QueryBeaconBlockRootReq) String() string { return proto.CompactTextString(m) }
+func (*QueryBeaconBlockRootReq) ProtoMessage()    {}
+func (*QueryBeaconBlockRootReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7626bfce118caa1, []int{3}
+}
+func (m *QueryBeaconBlockRootReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeaconBlockRootReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeaconBlockRootReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeaconBlockRootReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeaconBlockRootReq.Merge(m, src)
+}
+func (m *QueryBeaconBlockRootReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeaconBlockRootReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeaconBlockRootReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBeaconBlockRootReq proto.InternalMessageInfo
+
+func (m *QueryBeaconBlockRootReq) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryStakerInfoReq)(nil), "exocore.native_token.v1.QueryStakerInfoReq")
+	proto.RegisterType((*QueryPodOwnerReq)(nil), "exocore.native_token.v1.QueryPodOwnerReq")
+	proto.RegisterType((*QueryPodOwnerResponse)(nil), "exocore.native_token.v1.QueryPodOwnerResponse")
+	proto.RegisterType((*QueryBeaconBlockRootReq)(nil), "exocore.native_token.v1.QueryBeaconBlockRootReq")
+}
+
+func init() {
+	proto.RegisterFile("exocore/native_token/v1/query.proto", fileDescriptor_e7626bfce118caa1)
+}
+
+var fileDescriptor_e7626bfce118caa1 = []byte{
+	// 457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6b, 0xd4, 0x40,
+	0x14, 0xc6, 0x77, 0x6a, 0x15, 0x1d, 0x11, 0x65, 0x40, 0x5a, 0xa2, 0x84, 0x12, 0x4b, 0x59, 0x2d,
+	0x9d, 0x74, 0x5d, 0x10, 0xaf, 0xae, 0x7a, 0x10, 0xa4, 0x76, 0x57, 0x4f, 0x22, 0xc8, 0x34, 0xfb,
+	0xdc, 0x86, 0x64, 0xe7, 0xa5, 0x33, 0x93, 0xed, 0xd6, 0xa3, 0x27, 0x8f, 0x82, 0x47, 0x4f, 0x5e,
+	0x3d, 0xf9, 0x67, 0x78, 0x2c, 0x78, 0xf1, 0x28, 0xbb, 0x82, 0xff, 0x81, 0x67, 0xc9, 0x64, 0xbb,
+	0xd9, 0xa6, 0x24, 0xf4, 0x96, 0xf7, 0xf8, 0xbe, 0xef, 0xfd, 0xf2, 0x5e, 0x42, 0xef, 0xc0, 0x18,
+	0x03, 0x54, 0xe0, 0x4b, 0x61, 0xc2, 0x11, 0xbc, 0x35, 0x18, 0x81, 0xf4, 0x47, 0x2d, 0xff, 0x20,
+	0x05, 0x75, 0xc4, 0x13, 0x85, 0x06, 0xd9, 0xca, 0x4c, 0xc4, 0x17, 0x45, 0x7c, 0xd4, 0x72, 0x6e,
+	0x0f, 0x10, 0x07, 0x31, 0xf8, 0x22, 0x09, 0x7d, 0x21, 0x25, 0x1a, 0x61, 0x42, 0x94, 0x3a, 0xb7,
+	0x39, 0xb7, 0x02, 0xd4, 0x43, 0xd4, 0x79, 0x54, 0x29, 0xd3, 0x59, 0xaf, 0x1a, 0x9c, 0x08, 0x25,
+	0x86, 0x27, 0x11, 0x6b, 0x55, 0x2a, 0x33, 0xce, 0x15, 0xde, 0x36, 0x65, 0xdd, 0x2c, 0xf6, 0xa5,
+	0x11, 0x11, 0xa8, 0x67, 0xf2, 0x1d, 0xf6, 0xe0, 0x80, 0x39, 0xf4, 0xb2, 0xce, 0x1b, 0x4f, 0x56,
+	0xc9, 0x1a, 0x69, 0x5e, 0xe9, 0xcd, 0x6b, 0xef, 0x0d, 0xbd, 0x61, 0x1d, 0xbb, 0xd8, 0x7f, 0x71,
+	0x28, 0x41, 0x65, 0xfa, 0x26, 0xbd, 0x1e, 0xc4, 0x21, 0x48, 0xf3, 0x78, 0x5f, 0x84, 0xf2, 0xf9,
+	0xfb, 0x99, 0x6d, 0xb9, 0x57, 0x6e, 0x33, 0x97, 0xd2, 0x04, 0xfb, 0x8f, 0xfa, 0x7d, 0x05, 0x5a,
+	0xaf, 0x2e, 0xd9, 0xec, 0x85, 0x8e, 0xd7, 0xa6, 0x37, 0x4b, 0xe9, 0x3a, 0x41, 0xa9, 0xa1, 0x16,
+	0x69, 0x8b, 0xae, 0x58, 0x53, 0x07, 0x44, 0x80, 0xb2, 0x13, 0x63, 0x10, 0xf5, 0x10, 0x4d, 0x46,
+	0xc6, 0xe8, 0xb2, 0x8e, 0xd1, 0xcc, 0x70, 0xec, 0xf3, 0xfd, 0x7f, 0x17, 0xe8, 0x45, 0xab, 0x67,
+	0x5f, 0x09, 0xbd, 0xd6, 0x4d, 0xa1, 0x78, 0x79, 0xb6, 0xc9, 0x2b, 0x8e, 0xc5, 0xcf, 0xae, 0xc9,
+	0xe1, 0x95, 0xe2, 0x1d, 0x5b, 0xbf, 0xca, 0xca, 0xc2, 0xe2, 0xb5, 0x3f, 0xfe, 0xfd, 0x7e, 0x8f,
+	0x7c, 0xf8, 0xf9, 0xe7, 0xf3, 0x52, 0x93, 0x6d, 0xf8, 0x55, 0xc7, 0x39, 0x4d, 0xf4, 0x85, 0xd0,
+	0xab, 0xdd, 0x14, 0x4e, 0x16, 0xc2, 0xee, 0xd6, 0x13, 0x2e, 0x9c, 0xc5, 0xe1, 0xe7, 0x95, 0xe6,
+	0x3b, 0xf6, 0x5a, 0x05, 0xdf, 0x06, 0x5b, 0xaf, 0xe3, 0x9b, 0xd3, 0x7c, 0x23, 0xf6, 0x03, 0x2a,
+	0x6d, 0x9e, 0x6d, 0xd7, 0x4f, 0x3e, 0x7b, 0x28, 0xa7, 0x59, 0xe9, 0x28, 0x89, 0xbd, 0x87, 0x05,
+	0xe5, 0x16, 0xdb, 0xac, 0xa3, 0x2c, 0x39, 0x3b, 0xbb, 0x3f, 0x26, 0x2e, 0x39, 0x9e, 0xb8, 0xe4,
+	0xf7, 0xc4, 0x25, 0x9f, 0xa6, 0x6e, 0xe3, 0x78, 0xea, 0x36, 0x7e, 0x4d, 0xdd, 0xc6, 0xeb, 0x07,
+	0x83, 0xd0, 0xec, 0xa7, 0x7b, 0x3c, 0xc0, 0xa1, 0xff, 0x34, 0x0f, 0xdc, 0x01, 0x73, 0x88, 0x2a,
+	0x9a, 0xe7, 0x8f, 0x4f, 0x4f, 0x30, 0x47, 0x09, 0xe8, 0xbd, 0x4b, 0xf6, 0x2f, 0x6a, 0xff, 0x1f,
+	0x00, 0x19, 0x7d, 0xf9, 0xc1, 0x08, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueStakerInfo queries the native token restaking info of the staker.
+	QueStakerInfo(ctx context.Context, in *QueryStakerInfoReq, opts ...grpc.CallOption) (*NativeTokenStakerInfo, error)
+	// QuePodOwner queries the staker that registered the pod address.
+	QuePodOwner(ctx context.Context, in *QueryPodOwnerReq, opts ...grpc.CallOption) (*QueryPodOwnerResponse, error)
+	// QueBeaconBlockRoot queries the beacon block root of the slot submitted by the oracle.
+	QueBeaconBlockRoot(ctx context.Context, in *QueryBeaconBlockRootReq, opts ...grpc.CallOption) (*BeaconBlockRoot, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueStakerInfo(ctx context.Context, in *QueryStakerInfoReq, opts ...grpc.CallOption) (*NativeTokenStakerInfo, error) {
+	out := new(NativeTokenStakerInfo)
+	err := c.cc.Invoke(ctx, "/exocore.native_token.v1.Query/QueStakerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuePodOwner(ctx context.Context, in *QueryPodOwnerReq, opts ...grpc.CallOption) (*QueryPodOwnerResponse, error) {
+	out := new(QueryPodOwnerResponse)
+	err := c.cc.Invoke(ctx, "/exocore.native_token.v1.Query/QuePodOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueBeaconBlockRoot(ctx context.Context, in *QueryBeaconBlockRootReq, opts ...grpc.CallOption) (*BeaconBlockRoot, error) {
+	out := new(BeaconBlockRoot)
+	err := c.cc.Invoke(ctx, "/exocore.native_token.v1.Query/QueBeaconBlockRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueStakerInfo queries the native token restaking info of the staker.
+	QueStakerInfo(context.Context, *QueryStakerInfoReq) (*NativeTokenStakerInfo, error)
+	// QuePodOwner queries the staker that registered the pod address.
+	QuePodOwner(context.Context, *QueryPodOwnerReq) (*QueryPodOwnerResponse, error)
+	// QueBeaconBlockRoot queries the beacon block root of the slot submitted by the oracle.
+	QueBeaconBlockRoot(context.Context, *QueryBeaconBlockRootReq) (*BeaconBlockRoot, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueStakerInfo(ctx context.Context, req *QueryStakerInfoReq) (*NativeTokenStakerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakerInfo not implemented")
+}
+func (*UnimplementedQueryServer) QuePodOwner(ctx context.Context, req *QueryPodOwnerReq) (*QueryPodOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuePodOwner not implemented")
+}
+func (*UnimplementedQueryServer) QueBeaconBlockRoot(ctx context.Context, req *QueryBeaconBlockRootReq) (*BeaconBlockRoot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueBeaconBlockRoot not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueStakerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.native_token.v1.Query/QueStakerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakerInfo(ctx, req.(*QueryStakerInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuePodOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPodOwnerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuePodOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.native_token.v1.Query/QuePodOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuePodOwner(ctx, req.(*QueryPodOwnerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueBeaconBlockRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeaconBlockRootReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueBeaconBlockRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.native_token.v1.Query/QueBeaconBlockRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueBeaconBlockRoot(ctx, req.(*QueryBeaconBlockRootReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.native_token.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueStakerInfo",
+			Handler:    _Query_QueStakerInfo_Handler,
+		},
+		{
+			MethodName: "QuePodOwner",
+			Handler:    _Query_QuePodOwner_Handler,
+		},
+		{
+			MethodName: "QueBeaconBlockRoot",
+			Handler:    _Query_QueBeaconBlockRoot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/native_token/v1/query.proto",
+}
+
+func (m *QueryStakerInfoReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerInfoReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerInfoReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPodOwnerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPodOwnerReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPodOwnerReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodAddress) > 0 {
+		i -= len(m.PodAddress)
+		copy(dAtA[i:], m.PodAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PodAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClientChainLzID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClientChainLzID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPodOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPodOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPodOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeaconBlockRootReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeaconBlockRootReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeaconBlockRootReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStakerInfoReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPodOwnerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientChainLzID != 0 {
+		n += 1 + sovQuery(uint64(m.ClientChainLzID))
+	}
+	l = len(m.PodAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPodOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeaconBlockRootReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovQuery(uint64(m.Slot))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStakerInfoReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerInfoReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerInfoReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPodOwnerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPodOwnerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPodOwnerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainLzID", wireType)
+			}
+			m.ClientChainLzID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientChainLzID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPodOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPodOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPodOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeaconBlockRootReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeaconBlockRootReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeaconBlockRootReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
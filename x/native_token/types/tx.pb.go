@@ -4,12 +4,18 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -59,6 +65,9 @@ type ValidatorInfo struct {
 	ValidatorIndex                     uint64                                 `protobuf:"varint,2,opt,name=ValidatorIndex,proto3" json:"ValidatorIndex,omitempty"`
 	StakedBalanceGwei                  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=StakedBalanceGwei,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"StakedBalanceGwei"`
 	MostRecentBalanceUpdateBlockNumber uint64                                 `protobuf:"varint,4,opt,name=MostRecentBalanceUpdateBlockNumber,proto3" json:"MostRecentBalanceUpdateBlockNumber,omitempty"`
+	// MostRecentBalanceUpdateSlot is the beacon chain slot of the latest proven balance, the proof
+	// of an older slot is rejected.
+	MostRecentBalanceUpdateSlot uint64 `protobuf:"varint,5,opt,name=MostRecentBalanceUpdateSlot,proto3" json:"MostRecentBalanceUpdateSlot,omitempty"`
 }
 
 func (m *ValidatorInfo) Reset()         { *m = ValidatorInfo{} }
@@ -115,6 +124,13 @@ func (m *ValidatorInfo) GetMostRecentBalanceUpdateBlockNumber() uint64 {
 	return 0
 }
 
+func (m *ValidatorInfo) GetMostRecentBalanceUpdateSlot() uint64 {
+	if m != nil {
+		return m.MostRecentBalanceUpdateSlot
+	}
+	return 0
+}
+
 type NativeTokenStakerInfo struct {
 	TotalValidatorBalances github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=TotalValidatorBalances,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"TotalValidatorBalances"`
 	UnStakedValueFromPOS   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=UnStakedValueFromPOS,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"UnStakedValueFromPOS"`
@@ -169,51 +185,344 @@ func (m *NativeTokenStakerInfo) GetValidatorsInfo() map[string]*ValidatorInfo {
 	return nil
 }
 
+// MsgSubmitBeaconBlockRoot is used by the beacon oracle to submit the block root of the beacon chain,
+// the proofs of the validator balances and withdrawals are verified against it.
+type MsgSubmitBeaconBlockRoot struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	Slot        uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// blockRoot is the hex string of the beacon block root
+	BlockRoot string `protobuf:"bytes,3,opt,name=blockRoot,proto3" json:"blockRoot,omitempty"`
+}
+
+func (m *MsgSubmitBeaconBlockRoot) Reset()         { *m = MsgSubmitBeaconBlockRoot{} }
+func (m *MsgSubmitBeaconBlockRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBeaconBlockRoot) ProtoMessage()    {}
+func (*MsgSubmitBeaconBlockRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_769c53c072051eb9, []int{2}
+}
+func (m *MsgSubmitBeaconBlockRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBeaconBlockRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBeaconBlockRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitBeaconBlockRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBeaconBlockRoot.Merge(m, src)
+}
+func (m *MsgSubmitBeaconBlockRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBeaconBlockRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBeaconBlockRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitBeaconBlockRoot proto.InternalMessageInfo
+
+type SubmitBeaconBlockRootResponse struct {
+}
+
+func (m *SubmitBeaconBlockRootResponse) Reset()         { *m = SubmitBeaconBlockRootResponse{} }
+func (m *SubmitBeaconBlockRootResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitBeaconBlockRootResponse) ProtoMessage()    {}
+func (*SubmitBeaconBlockRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_769c53c072051eb9, []int{3}
+}
+func (m *SubmitBeaconBlockRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitBeaconBlockRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitBeaconBlockRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitBeaconBlockRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitBeaconBlockRootResponse.Merge(m, src)
+}
+func (m *SubmitBeaconBlockRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitBeaconBlockRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitBeaconBlockRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitBeaconBlockRootResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the params of the native_token module, it can only be sent by the governance.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the native_token parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_769c53c072051eb9, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type UpdateParamsResponse struct {
+}
+
+func (m *UpdateParamsResponse) Reset()         { *m = UpdateParamsResponse{} }
+func (m *UpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateParamsResponse) ProtoMessage()    {}
+func (*UpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_769c53c072051eb9, []int{5}
+}
+func (m *UpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsResponse.Merge(m, src)
+}
+func (m *UpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("exocore.native_token.v1.ValidatorInfo_ValidatorStatus", ValidatorInfo_ValidatorStatus_name, ValidatorInfo_ValidatorStatus_value)
 	proto.RegisterType((*ValidatorInfo)(nil), "exocore.native_token.v1.ValidatorInfo")
 	proto.RegisterType((*NativeTokenStakerInfo)(nil), "exocore.native_token.v1.NativeTokenStakerInfo")
 	proto.RegisterMapType((map[string]*ValidatorInfo)(nil), "exocore.native_token.v1.NativeTokenStakerInfo.ValidatorsInfoEntry")
+	proto.RegisterType((*MsgSubmitBeaconBlockRoot)(nil), "exocore.native_token.v1.MsgSubmitBeaconBlockRoot")
+	proto.RegisterType((*SubmitBeaconBlockRootResponse)(nil), "exocore.native_token.v1.SubmitBeaconBlockRootResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.native_token.v1.MsgUpdateParams")
+	proto.RegisterType((*UpdateParamsResponse)(nil), "exocore.native_token.v1.UpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("exocore/native_token/v1/tx.proto", fileDescriptor_769c53c072051eb9) }
 
 var fileDescriptor_769c53c072051eb9 = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x12, 0xd1, 0x29, 0x2d, 0x61, 0x29, 0x34, 0xe4, 0xe0, 0x46, 0x39, 0x44, 0xb9,
-	0xd4, 0x56, 0x83, 0x54, 0xa1, 0xaa, 0x97, 0x18, 0x02, 0xf8, 0x80, 0x89, 0x9c, 0x34, 0x95, 0xb8,
-	0x54, 0x1b, 0x7b, 0x31, 0xae, 0x7f, 0x36, 0xf2, 0x6e, 0xd2, 0xe4, 0x2d, 0x78, 0x18, 0x1e, 0xa2,
-	0xc7, 0x8a, 0x13, 0xe2, 0x50, 0xa1, 0xe4, 0x1d, 0xb8, 0x82, 0xec, 0x35, 0xe0, 0x84, 0x44, 0xaa,
-	0xd4, 0xd3, 0xee, 0xfc, 0x7c, 0xdf, 0xce, 0x7c, 0xb3, 0xbb, 0x50, 0x25, 0x13, 0x6a, 0xd1, 0x88,
-	0xa8, 0x21, 0xe6, 0xee, 0x98, 0x9c, 0x73, 0xea, 0x91, 0x50, 0x1d, 0x1f, 0xaa, 0x7c, 0xa2, 0x0c,
-	0x23, 0xca, 0x29, 0xda, 0x4b, 0x33, 0x94, 0x6c, 0x86, 0x32, 0x3e, 0xac, 0xec, 0x59, 0x94, 0x05,
-	0x94, 0xa9, 0x01, 0x73, 0x62, 0x40, 0xc0, 0x1c, 0x81, 0xa8, 0x3c, 0x13, 0x81, 0xf3, 0xc4, 0x52,
-	0x85, 0x91, 0x86, 0x76, 0x1d, 0xea, 0x50, 0xe1, 0x8f, 0x77, 0xc2, 0x5b, 0xfb, 0x95, 0x87, 0xed,
-	0x3e, 0xf6, 0x5d, 0x1b, 0x73, 0x1a, 0xe9, 0xe1, 0x47, 0x8a, 0x0c, 0x28, 0x76, 0x39, 0xe6, 0x23,
-	0x56, 0x96, 0xaa, 0x52, 0x63, 0xa7, 0x79, 0xa4, 0xac, 0xa9, 0x42, 0x59, 0xc0, 0xfd, 0xb3, 0x04,
-	0xda, 0x4c, 0x59, 0x50, 0x1d, 0x76, 0x32, 0x89, 0x36, 0x99, 0x94, 0xf3, 0x55, 0xa9, 0xb1, 0x61,
-	0x2e, 0x79, 0xd1, 0x05, 0x3c, 0xea, 0x72, 0xec, 0x11, 0x5b, 0xc3, 0x3e, 0x0e, 0x2d, 0xf2, 0xe6,
-	0x92, 0xb8, 0xe5, 0x42, 0x55, 0x6a, 0x6c, 0x6a, 0x27, 0x57, 0x37, 0xfb, 0xb9, 0xef, 0x37, 0xfb,
-	0x75, 0xc7, 0xe5, 0x9f, 0x46, 0x03, 0xc5, 0xa2, 0x41, 0xda, 0x5b, 0xba, 0x1c, 0x30, 0xdb, 0x53,
-	0xf9, 0x74, 0x48, 0x98, 0xa2, 0x87, 0xfc, 0xeb, 0x97, 0x03, 0x48, 0x5b, 0xd7, 0x43, 0x6e, 0xfe,
-	0x4f, 0x8b, 0x0c, 0xa8, 0xbd, 0xa3, 0x8c, 0x9b, 0xc4, 0x22, 0x21, 0x4f, 0x03, 0xa7, 0x43, 0x1b,
-	0x73, 0xa2, 0xf9, 0xd4, 0xf2, 0x8c, 0x51, 0x30, 0x20, 0x51, 0x79, 0x23, 0xa9, 0xf3, 0x16, 0x99,
-	0xb5, 0x63, 0x78, 0xb8, 0xd4, 0x3e, 0x02, 0x28, 0xb6, 0x5e, 0xf6, 0xf4, 0x7e, 0xbb, 0x94, 0x43,
-	0x0f, 0xe0, 0xbe, 0x6e, 0xa4, 0x96, 0x84, 0xb6, 0x61, 0xf3, 0x4c, 0xef, 0xbd, 0x7d, 0x65, 0xb6,
-	0xce, 0x8c, 0x52, 0xbe, 0xf6, 0xb3, 0x00, 0x4f, 0x8c, 0x44, 0xd9, 0x5e, 0x2c, 0x6c, 0x52, 0xac,
-	0x98, 0x04, 0x87, 0xa7, 0x3d, 0xca, 0xb1, 0xff, 0x97, 0x3a, 0x3d, 0x5f, 0x4c, 0xe6, 0xae, 0xb2,
-	0xac, 0xe1, 0x46, 0x43, 0xd8, 0x3d, 0x15, 0x55, 0xd8, 0x7d, 0xec, 0x8f, 0xc8, 0xeb, 0x88, 0x06,
-	0x9d, 0xf7, 0xdd, 0x64, 0x6a, 0x77, 0x3d, 0x73, 0x25, 0x33, 0x92, 0x01, 0x3a, 0xd4, 0x6e, 0xd9,
-	0x76, 0x44, 0x18, 0x13, 0x23, 0x37, 0x33, 0x1e, 0x74, 0x91, 0xb9, 0x41, 0x2c, 0x56, 0xa6, 0xbc,
-	0x51, 0x2d, 0x34, 0xb6, 0x9a, 0xda, 0xda, 0x9b, 0xb9, 0x52, 0x4f, 0x65, 0x91, 0xa4, 0x1d, 0xf2,
-	0x68, 0x6a, 0x2e, 0x31, 0x57, 0x5c, 0x78, 0xbc, 0x22, 0x0d, 0x95, 0xa0, 0xe0, 0x91, 0xa9, 0xd0,
-	0xdd, 0x8c, 0xb7, 0xe8, 0x04, 0xee, 0x8d, 0xe3, 0x26, 0x12, 0x5d, 0xb6, 0x9a, 0xf5, 0xdb, 0xbd,
-	0x12, 0x53, 0x80, 0x8e, 0xf3, 0x2f, 0x24, 0xad, 0x73, 0x35, 0x93, 0xa5, 0xeb, 0x99, 0x2c, 0xfd,
-	0x98, 0xc9, 0xd2, 0xe7, 0xb9, 0x9c, 0xbb, 0x9e, 0xcb, 0xb9, 0x6f, 0x73, 0x39, 0xf7, 0xe1, 0x28,
-	0x23, 0x6e, 0x5b, 0xd0, 0x1a, 0x84, 0x5f, 0xd2, 0xc8, 0x53, 0xff, 0xfc, 0x19, 0x93, 0xc5, 0x5f,
-	0x23, 0x11, 0x7c, 0x50, 0x4c, 0xde, 0xf4, 0xf3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x17, 0x55,
-	0x62, 0x2e, 0x5a, 0x04, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0x40, 0x50, 0x79, 0xd9, 0x3f, 0xd9, 0x29, 0xbb, 0xeb, 0xd2, 0x16, 0x90, 0x55, 0x45,
+	0x28, 0x12, 0xb6, 0x42, 0xa5, 0xa8, 0x42, 0xbb, 0x6a, 0x43, 0x9b, 0xb6, 0x1c, 0xa0, 0xc8, 0xb0,
+	0x59, 0xa9, 0x97, 0xc8, 0xe0, 0x89, 0xe3, 0x80, 0x3d, 0xc8, 0x33, 0x10, 0xb8, 0x55, 0x39, 0x55,
+	0x3d, 0xf5, 0x23, 0xe4, 0x23, 0xe4, 0x90, 0x2f, 0xd0, 0x53, 0xd3, 0x5b, 0x94, 0x53, 0xd5, 0x43,
+	0x54, 0x25, 0x87, 0xf4, 0x13, 0xf4, 0xd0, 0x53, 0x65, 0xcf, 0x10, 0x0c, 0xc5, 0x69, 0xa4, 0xf6,
+	0x02, 0x33, 0x6f, 0x7e, 0xef, 0x37, 0xef, 0xfd, 0xde, 0x9b, 0x67, 0x28, 0xe0, 0x31, 0xe9, 0x12,
+	0x0f, 0x6b, 0xae, 0xc1, 0xec, 0x11, 0xde, 0x63, 0xa4, 0x87, 0x5d, 0x6d, 0xb4, 0xa9, 0xb1, 0xb1,
+	0x3a, 0xf0, 0x08, 0x23, 0xe8, 0xa5, 0x40, 0xa8, 0x61, 0x84, 0x3a, 0xda, 0xcc, 0xbe, 0xec, 0x12,
+	0xea, 0x10, 0xaa, 0x39, 0xd4, 0xf2, 0x1d, 0x1c, 0x6a, 0x71, 0x8f, 0xec, 0x7b, 0xfc, 0x60, 0x2f,
+	0xd8, 0x69, 0x7c, 0x23, 0x8e, 0x32, 0x16, 0xb1, 0x08, 0xb7, 0xfb, 0x2b, 0x61, 0x7d, 0x66, 0x38,
+	0xb6, 0x4b, 0xb4, 0xe0, 0x57, 0x98, 0x3e, 0x8a, 0x8a, 0x6b, 0x60, 0x78, 0x86, 0x23, 0xe8, 0x94,
+	0x9f, 0x12, 0xf0, 0x78, 0xd7, 0xe8, 0xdb, 0xa6, 0xc1, 0x88, 0x57, 0x73, 0xf7, 0x09, 0x6a, 0x40,
+	0xaa, 0xc5, 0x0c, 0x36, 0xa4, 0xb2, 0x54, 0x90, 0x8a, 0x4f, 0xca, 0x5b, 0x6a, 0x44, 0xf8, 0xea,
+	0x9c, 0xdf, 0x6c, 0xc7, 0xbd, 0x75, 0xc1, 0x82, 0xd6, 0xe1, 0x49, 0x08, 0x68, 0xe2, 0xb1, 0x1c,
+	0x2f, 0x48, 0xc5, 0xa4, 0xbe, 0x60, 0x45, 0x87, 0xf0, 0xac, 0xc5, 0x8c, 0x1e, 0x36, 0xab, 0x46,
+	0xdf, 0x70, 0xbb, 0xf8, 0xab, 0x23, 0x6c, 0xcb, 0x89, 0x82, 0x54, 0x4c, 0x57, 0x5f, 0x9d, 0x5f,
+	0xe5, 0x63, 0xbf, 0x5d, 0xe5, 0xd7, 0x2d, 0x9b, 0x1d, 0x0c, 0x3b, 0x6a, 0x97, 0x38, 0x42, 0x14,
+	0xf1, 0x57, 0xa2, 0x66, 0x4f, 0x63, 0x93, 0x01, 0xa6, 0x6a, 0xcd, 0x65, 0x97, 0x67, 0x25, 0x10,
+	0x9a, 0xd5, 0x5c, 0xa6, 0xff, 0x93, 0x16, 0x35, 0x40, 0xa9, 0x13, 0xca, 0x74, 0xdc, 0xc5, 0x2e,
+	0x13, 0x07, 0x6f, 0x06, 0xa6, 0xc1, 0x70, 0xb5, 0x4f, 0xba, 0xbd, 0xc6, 0xd0, 0xe9, 0x60, 0x4f,
+	0x4e, 0x06, 0x71, 0x3e, 0x00, 0x89, 0x3e, 0x83, 0xf7, 0x23, 0x50, 0xad, 0x3e, 0x61, 0xf2, 0x4a,
+	0x40, 0x74, 0x1f, 0x44, 0xa9, 0xc0, 0xd3, 0x05, 0x01, 0x11, 0x40, 0x6a, 0xfb, 0xf3, 0x76, 0x6d,
+	0x77, 0x67, 0x2d, 0x86, 0x1e, 0xc1, 0x3b, 0xb5, 0x86, 0xd8, 0x49, 0xe8, 0x31, 0xa4, 0xdf, 0xd6,
+	0xda, 0x5f, 0x7f, 0xa1, 0x6f, 0xbf, 0x6d, 0xac, 0xc5, 0x95, 0x3f, 0x13, 0xf0, 0xbc, 0x11, 0xd4,
+	0xa6, 0xed, 0x97, 0x26, 0x48, 0x97, 0xd7, 0x92, 0xc1, 0x8b, 0x36, 0x61, 0x46, 0xff, 0x8e, 0x5a,
+	0x5c, 0xcc, 0x6b, 0xfb, 0x5f, 0x85, 0x8d, 0xe0, 0x46, 0x03, 0xc8, 0xbc, 0xe1, 0x51, 0x98, 0xbb,
+	0x46, 0x7f, 0x88, 0xbf, 0xf4, 0x88, 0xd3, 0xfc, 0xa6, 0x25, 0xc7, 0xff, 0x87, 0x3b, 0x97, 0x32,
+	0xa3, 0x1c, 0x40, 0x93, 0x98, 0xdb, 0xa6, 0xe9, 0x61, 0x4a, 0x79, 0xd3, 0xe8, 0x21, 0x0b, 0x3a,
+	0x0c, 0xf5, 0x20, 0xf5, 0x95, 0x91, 0x93, 0x85, 0x44, 0x71, 0xb5, 0x5c, 0x8d, 0xec, 0xed, 0xa5,
+	0x7a, 0xaa, 0xf3, 0x24, 0x3b, 0x2e, 0xf3, 0x26, 0xfa, 0x02, 0x73, 0xd6, 0x86, 0x77, 0x97, 0xc0,
+	0xd0, 0x1a, 0x24, 0x7a, 0x78, 0xc2, 0x75, 0xd7, 0xfd, 0x25, 0x7a, 0x05, 0x2b, 0x23, 0x3f, 0x89,
+	0x40, 0x97, 0xd5, 0xf2, 0xfa, 0xc3, 0xde, 0x99, 0xce, 0x9d, 0x2a, 0xf1, 0x4f, 0x24, 0xe5, 0x67,
+	0x09, 0xe4, 0x3a, 0xb5, 0x5a, 0xc3, 0x8e, 0x63, 0xb3, 0x2a, 0x36, 0xba, 0xc4, 0x0d, 0xba, 0x52,
+	0x27, 0x84, 0xa1, 0x0a, 0xac, 0xee, 0x7b, 0xc4, 0x99, 0x8a, 0xc2, 0x0b, 0x2e, 0x5f, 0x9e, 0x95,
+	0x32, 0x42, 0x4e, 0x71, 0xd2, 0x62, 0x9e, 0xed, 0x5a, 0x7a, 0x18, 0x8c, 0x10, 0x24, 0xa9, 0xdf,
+	0xb8, 0xfc, 0xa5, 0x06, 0x6b, 0xf4, 0x01, 0xa4, 0x3b, 0x53, 0x72, 0x21, 0xf1, 0xcc, 0x50, 0x79,
+	0xfd, 0xfd, 0x49, 0x3e, 0xf6, 0xc7, 0x49, 0x3e, 0x76, 0x7c, 0x7b, 0xba, 0x11, 0xe6, 0xfa, 0xe1,
+	0xf6, 0x74, 0xe3, 0x6e, 0x44, 0x46, 0x05, 0xab, 0xe4, 0xe1, 0xc3, 0xa5, 0x07, 0x3a, 0xa6, 0x03,
+	0xe2, 0x52, 0xac, 0xfc, 0x22, 0xc1, 0xd3, 0x3a, 0xb5, 0xf8, 0x8b, 0x69, 0x06, 0x13, 0x0c, 0x6d,
+	0x41, 0xda, 0x18, 0xb2, 0x03, 0xe2, 0xd9, 0x6c, 0xf2, 0xaf, 0xf9, 0xcd, 0xa0, 0xe8, 0x35, 0xa4,
+	0xf8, 0x0c, 0x14, 0xca, 0xe7, 0x23, 0x95, 0xe7, 0x17, 0x55, 0x93, 0x7e, 0xcb, 0xea, 0xc2, 0xa9,
+	0xf2, 0x69, 0x38, 0xd5, 0x19, 0xad, 0x9f, 0xe8, 0xf2, 0x99, 0xbb, 0x10, 0xb7, 0xf2, 0x02, 0x32,
+	0xe1, 0xfd, 0x34, 0xc7, 0xf2, 0x5f, 0x12, 0x24, 0xea, 0xd4, 0x42, 0xc7, 0x12, 0x3c, 0x5f, 0x5e,
+	0xd3, 0xcd, 0xc8, 0x48, 0xa3, 0x94, 0xcd, 0x46, 0x8f, 0xef, 0x7b, 0x05, 0x47, 0x16, 0x3c, 0x9a,
+	0x13, 0xbb, 0x78, 0xdf, 0xd5, 0x61, 0x64, 0xb6, 0x14, 0x89, 0x5c, 0x96, 0x75, 0x76, 0xe5, 0xbb,
+	0xdb, 0xd3, 0x0d, 0xa9, 0xda, 0x3c, 0xbf, 0xce, 0x49, 0x17, 0xd7, 0x39, 0xe9, 0xf7, 0xeb, 0x9c,
+	0xf4, 0xe3, 0x4d, 0x2e, 0x76, 0x71, 0x93, 0x8b, 0xfd, 0x7a, 0x93, 0x8b, 0x7d, 0xbb, 0x15, 0x1a,
+	0x14, 0x3b, 0x9c, 0xb9, 0x81, 0xd9, 0x11, 0xf1, 0x7a, 0xda, 0x54, 0xee, 0xf1, 0xbc, 0xe0, 0xc1,
+	0xf0, 0xe8, 0xa4, 0x82, 0x2f, 0xdc, 0xc7, 0x7f, 0x0f, 0x00, 0xbd, 0x65, 0x70, 0x28, 0xa1, 0x07,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SubmitBeaconBlockRoot(ctx context.Context, in *MsgSubmitBeaconBlockRoot, opts ...grpc.CallOption) (*SubmitBeaconBlockRootResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*UpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitBeaconBlockRoot(ctx context.Context, in *MsgSubmitBeaconBlockRoot, opts ...grpc.CallOption) (*SubmitBeaconBlockRootResponse, error) {
+	out := new(SubmitBeaconBlockRootResponse)
+	err := c.cc.Invoke(ctx, "/exocore.native_token.v1.Msg/SubmitBeaconBlockRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*UpdateParamsResponse, error) {
+	out := new(UpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.native_token.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SubmitBeaconBlockRoot(context.Context, *MsgSubmitBeaconBlockRoot) (*SubmitBeaconBlockRootResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*UpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitBeaconBlockRoot(ctx context.Context, req *MsgSubmitBeaconBlockRoot) (*SubmitBeaconBlockRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBeaconBlockRoot not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*UpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitBeaconBlockRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBeaconBlockRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBeaconBlockRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.native_token.v1.Msg/SubmitBeaconBlockRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBeaconBlockRoot(ctx, req.(*MsgSubmitBeaconBlockRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.native_token.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.native_token.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBeaconBlockRoot",
+			Handler:    _Msg_SubmitBeaconBlockRoot_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/native_token/v1/tx.proto",
 }
 
 func (m *ValidatorInfo) Marshal() (dAtA []byte, err error) {
@@ -236,6 +545,11 @@ func (m *ValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MostRecentBalanceUpdateSlot != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MostRecentBalanceUpdateSlot))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MostRecentBalanceUpdateBlockNumber != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.MostRecentBalanceUpdateBlockNumber))
 		i--
@@ -340,6 +654,134 @@ func (m *NativeTokenStakerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitBeaconBlockRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBeaconBlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBeaconBlockRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockRoot) > 0 {
+		i -= len(m.BlockRoot)
+		copy(dAtA[i:], m.BlockRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Slot != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitBeaconBlockRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitBeaconBlockRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitBeaconBlockRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -368,6 +810,9 @@ func (m *ValidatorInfo) Size() (n int) {
 	if m.MostRecentBalanceUpdateBlockNumber != 0 {
 		n += 1 + sovTx(uint64(m.MostRecentBalanceUpdateBlockNumber))
 	}
+	if m.MostRecentBalanceUpdateSlot != 0 {
+		n += 1 + sovTx(uint64(m.MostRecentBalanceUpdateSlot))
+	}
 	return n
 }
 
@@ -401,14 +846,67 @@ func (m *NativeTokenStakerInfo) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSubmitBeaconBlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovTx(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *SubmitBeaconBlockRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *UpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -527,6 +1025,25 @@ func (m *ValidatorInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MostRecentBalanceUpdateSlot", wireType)
+			}
+			m.MostRecentBalanceUpdateSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MostRecentBalanceUpdateSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -827,6 +1344,354 @@ func (m *NativeTokenStakerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitBeaconBlockRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBeaconBlockRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBeaconBlockRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitBeaconBlockRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitBeaconBlockRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitBeaconBlockRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
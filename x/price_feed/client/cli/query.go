@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the parent command for all price_feed CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the price_feed module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		QueryParams(),
+		QueryPrice(),
+		QueryOperatorValue(),
+		QueryOperatorAVSValue(),
+		QueryAVSOperatorValues(),
+	)
+	return cmd
+}
+
+// QueryParams queries the params of the price_feed module
+func QueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryParams",
+		Short: "Get the price oracles and the max price age",
+		Long:  "Get the price oracles and the max price age",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueParams(context.Background(), &types.QueryParamsReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryPrice queries the latest price of the asset
+func QueryPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryPrice assetID",
+		Short: "Get the latest USD price of the asset",
+		Long:  "Get the latest USD price of the asset, the price is returned even if it's stale",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPriceReq{
+				AssetID: args[0],
+			}
+			res, err := queryClient.QuePrice(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryOperatorValue queries the USD value of all the assets restaked to the operator
+func QueryOperatorValue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryOperatorValue operatorAddr",
+		Short: "Get the USD value of all the assets restaked to the operator",
+		Long:  "Get the USD value of all the assets restaked to the operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOperatorValueReq{
+				OperatorAddr: args[0],
+			}
+			res, err := queryClient.QueOperatorValue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryOperatorAVSValue queries the USD value of the assets restaked to the operator that secure the AVS
+func QueryOperatorAVSValue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryOperatorAVSValue operatorAddr avsAddress",
+		Short: "Get the USD value of the assets restaked to the operator that secure the AVS",
+		Long:  "Get the USD value of the assets restaked to the operator that secure the AVS, it's zero if the operator hasn't opted into the AVS",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOperatorAVSValueReq{
+				OperatorAddr: args[0],
+				AvsAddress:   args[1],
+			}
+			res, err := queryClient.QueOperatorAVSValue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryAVSOperatorValues queries the values of all the operators opted into the AVS
+func QueryAVSOperatorValues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueryAVSOperatorValues avsAddress",
+		Short: "Get the USD values of all the operators opted into the AVS",
+		Long:  "Get the USD values of all the operators opted into the AVS and the sum of them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAVSOperatorValuesReq{
+				AvsAddress: args[0],
+			}
+			res, err := queryClient.QueAVSOperatorValues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
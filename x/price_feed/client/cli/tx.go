@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+// NewTxCmd returns a root CLI command handler for price_feed commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "price_feed subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		SubmitPrice(),
+	)
+	return txCmd
+}
+
+// SubmitPrice submits the USD price of the asset, the sender must be a price oracle or the governance
+func SubmitPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SubmitPrice assetID price decimal",
+		Short: "submit the USD price of the asset, the price of a whole asset is price/10^decimal",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			price, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, "invalid price")
+			}
+			decimal, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, err.Error())
+			}
+			msg := &types.MsgSubmitPrices{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				Prices: []types.AssetPrice{
+					{
+						AssetID: args[0],
+						Price:   price,
+						Decimal: uint32(decimal),
+					},
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
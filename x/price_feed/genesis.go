@@ -0,0 +1,32 @@
+package price_feed
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/price_feed/keeper"
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis imports the params and prices, the restaking_assets_manage module should be
+// initialized before this module to check the assets of the prices have been registered.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, &data.Params); err != nil {
+		panic(err)
+	}
+	for i := range data.Prices {
+		if err := k.SetPrice(ctx, &data.Prices[i]); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis exports the params and prices
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Params: *params,
+		Prices: k.GetAllPrices(ctx),
+	}
+}
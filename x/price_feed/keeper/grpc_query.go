@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// QueParams queries the params of the price_feed module
+func (k Keeper) QueParams(ctx context.Context, _ *types.QueryParamsReq) (*types.Params, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	return k.GetParams(c)
+}
+
+// QuePrice queries the latest price of the asset
+func (k Keeper) QuePrice(ctx context.Context, req *types.QueryPriceReq) (*types.AssetPrice, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	return k.GetPrice(c, req.AssetID)
+}
+
+// QueOperatorValue queries the USD value of all the assets restaked to the operator
+func (k Keeper) QueOperatorValue(ctx context.Context, req *types.QueryOperatorValueReq) (*types.OperatorValue, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	value, err := k.GetOperatorTotalValue(c, opAccAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &types.OperatorValue{OperatorAddr: req.OperatorAddr, Value: value}, nil
+}
+
+// QueOperatorAVSValue queries the USD value of the assets restaked to the operator that secure the AVS
+func (k Keeper) QueOperatorAVSValue(ctx context.Context, req *types.QueryOperatorAVSValueReq) (*types.OperatorValue, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	opAccAddr, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	value, err := k.GetOperatorAVSValue(c, opAccAddr, req.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.OperatorValue{OperatorAddr: req.OperatorAddr, Value: value}, nil
+}
+
+// QueAVSOperatorValues queries the values of all the operators opted into the AVS
+func (k Keeper) QueAVSOperatorValues(ctx context.Context, req *types.QueryAVSOperatorValuesReq) (*types.QueryAVSOperatorValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	values, total, err := k.GetAVSOperatorValues(c, req.AvsAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAVSOperatorValuesResponse{OperatorValues: values, TotalValue: total}, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper stores the USD prices of the restaking assets, they are used to convert the raw amounts
+// restaked to the operators into comparable values, so the AVSs can weight the operators with a
+// single number whatever assets they hold.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of updating the params and submitting the prices, it should be the gov module account.
+	authority string
+
+	// other keepers
+	restakingStateKeeper keeper.Keeper
+	avsKeeper            types.AVSKeeper
+}
+
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	restakingStateKeeper keeper.Keeper,
+	avsKeeper types.AVSKeeper,
+	authority string,
+) Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+	return Keeper{
+		storeKey:             storeKey,
+		cdc:                  cdc,
+		authority:            authority,
+		restakingStateKeeper: restakingStateKeeper,
+		avsKeeper:            avsKeeper,
+	}
+}
+
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	context "context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// SubmitPrices stores the prices submitted by the price oracle or the governance, the update time
+// and height of the prices are set to the current block.
+func (k Keeper) SubmitPrices(ctx context.Context, req *types.MsgSubmitPrices) (*types.SubmitPricesResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if req.FromAddress != k.authority {
+		params, err := k.GetParams(c)
+		if err != nil {
+			return nil, err
+		}
+		if !params.IsPriceOracle(req.FromAddress) {
+			return nil, errorsmod.Wrap(types.ErrNotPriceOracle, fmt.Sprintf("sender:%s", req.FromAddress))
+		}
+	}
+	for i := range req.Prices {
+		price := req.Prices[i]
+		price.UpdateTime = c.BlockTime().Unix()
+		price.UpdateHeight = c.BlockHeight()
+		if err := k.SetPrice(c, &price); err != nil {
+			return nil, err
+		}
+	}
+	return &types.SubmitPricesResponse{}, nil
+}
+
+// UpdateParams updates the params of the price_feed module, it can only be called by the governance.
+func (k Keeper) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.UpdateParamsResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, req.Authority))
+	}
+	if err := k.SetParams(c, &req.Params); err != nil {
+		return nil, err
+	}
+	return &types.UpdateParamsResponse{}, nil
+}
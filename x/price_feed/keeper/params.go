@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetParams sets the parameters of the price_feed module
+func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	bz := k.cdc.MustMarshal(params)
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetParams returns the parameters of the price_feed module
+func (k Keeper) GetParams(ctx sdk.Context) (*types.Params, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	value := store.Get(types.ParamsKey)
+	if value == nil {
+		return nil, types.ErrNoParamsKey
+	}
+
+	ret := &types.Params{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret, nil
+}
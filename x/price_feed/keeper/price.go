@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPrice stores the price of the asset, the asset should have been registered. The previous price
+// of the asset is overwritten.
+func (k Keeper) SetPrice(ctx sdk.Context, price *types.AssetPrice) error {
+	if err := price.ValidateBasic(); err != nil {
+		return err
+	}
+	if !k.restakingStateKeeper.IsStakingAsset(ctx, price.AssetID) {
+		return errorsmod.Wrap(restakingtype.ErrNoClientChainAssetKey, fmt.Sprintf("the assetID is:%s", price.AssetID))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	bz := k.cdc.MustMarshal(price)
+	store.Set([]byte(price.AssetID), bz)
+	return nil
+}
+
+// GetPrice returns the latest price of the asset whether it's stale or not
+func (k Keeper) GetPrice(ctx sdk.Context, assetID string) (*types.AssetPrice, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return nil, errorsmod.Wrap(types.ErrPriceNotFound, fmt.Sprintf("the assetID is:%s", assetID))
+	}
+	ret := &types.AssetPrice{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret, nil
+}
+
+// GetFreshPrice returns the price of the asset, it returns ErrStalePrice if the price is older than
+// the max price age in the params.
+func (k Keeper) GetFreshPrice(ctx sdk.Context, assetID string) (*types.AssetPrice, error) {
+	price, err := k.GetPrice(ctx, assetID)
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if price.IsStale(ctx.BlockTime().Unix(), params.MaxPriceAge) {
+		return nil, errorsmod.Wrap(types.ErrStalePrice, fmt.Sprintf("assetID:%s,updateTime:%d,maxPriceAge:%d", assetID, price.UpdateTime, params.MaxPriceAge))
+	}
+	return price, nil
+}
+
+// GetAllPrices returns the prices ordered by the assetID
+func (k Keeper) GetAllPrices(ctx sdk.Context) []types.AssetPrice {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAssetPrice)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]types.AssetPrice, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var price types.AssetPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		ret = append(ret, price)
+	}
+	return ret
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	pricefeedtype "github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestSubmitPrices() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	_, assetID := types.GetStakeIDAndAssetID(101, nil, usdtAddress[:])
+	authority := suite.app.PriceFeedKeeper.GetAuthority()
+	price := pricefeedtype.AssetPrice{AssetID: assetID, Price: sdkmath.NewInt(99990000), Decimal: 8}
+
+	// only the oracles and the governance can submit the prices
+	submitMsg := &pricefeedtype.MsgSubmitPrices{
+		FromAddress: suite.accAddress.String(),
+		Prices:      []pricefeedtype.AssetPrice{price},
+	}
+	suite.NoError(submitMsg.ValidateBasic())
+	_, err := suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, submitMsg)
+	suite.ErrorIs(err, pricefeedtype.ErrNotPriceOracle)
+
+	// only the governance can update the params
+	updateMsg := &pricefeedtype.MsgUpdateParams{
+		Authority: suite.accAddress.String(),
+		Params:    pricefeedtype.NewParams([]string{suite.accAddress.String()}, 60),
+	}
+	_, err = suite.app.PriceFeedKeeper.UpdateParams(suite.ctx, updateMsg)
+	suite.ErrorIs(err, pricefeedtype.ErrInvalidAuthority)
+	updateMsg.Authority = authority
+	_, err = suite.app.PriceFeedKeeper.UpdateParams(suite.ctx, updateMsg)
+	suite.NoError(err)
+	params, err := suite.app.PriceFeedKeeper.QueParams(suite.ctx, &pricefeedtype.QueryParamsReq{})
+	suite.NoError(err)
+	suite.Equal(uint64(60), params.MaxPriceAge)
+
+	_, err = suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, submitMsg)
+	suite.NoError(err)
+	stored, err := suite.app.PriceFeedKeeper.QuePrice(suite.ctx, &pricefeedtype.QueryPriceReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(price.Price, stored.Price)
+	suite.Equal(suite.ctx.BlockTime().Unix(), stored.UpdateTime)
+	suite.Equal(suite.ctx.BlockHeight(), stored.UpdateHeight)
+
+	// the governance can submit the prices as well
+	submitMsg.FromAddress = authority
+	_, err = suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, submitMsg)
+	suite.NoError(err)
+
+	// the asset should have been registered
+	_, unknownAssetID := types.GetStakeIDAndAssetID(101, nil, common.HexToAddress("0x1").Bytes())
+	submitMsg.Prices = []pricefeedtype.AssetPrice{{AssetID: unknownAssetID, Price: sdkmath.NewInt(1), Decimal: 8}}
+	_, err = suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, submitMsg)
+	suite.ErrorIs(err, types.ErrNoClientChainAssetKey)
+
+	// the price should be positive
+	submitMsg.Prices = []pricefeedtype.AssetPrice{{AssetID: assetID, Price: sdkmath.NewInt(0), Decimal: 8}}
+	suite.ErrorIs(submitMsg.ValidateBasic(), pricefeedtype.ErrInvalidPrice)
+
+	// the price becomes stale after the max price age
+	_, err = suite.app.PriceFeedKeeper.GetFreshPrice(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(60 * time.Second))
+	_, err = suite.app.PriceFeedKeeper.GetFreshPrice(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	_, err = suite.app.PriceFeedKeeper.GetFreshPrice(suite.ctx, assetID)
+	suite.ErrorIs(err, pricefeedtype.ErrStalePrice)
+	// the stale price can still be queried
+	_, err = suite.app.PriceFeedKeeper.QuePrice(suite.ctx, &pricefeedtype.QueryPriceReq{AssetID: assetID})
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestOperatorValues() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	wethAddress := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	clientChainLzID := uint64(101)
+	_, usdtAssetID := types.GetStakeIDAndAssetID(clientChainLzID, nil, usdtAddress[:])
+	_, wethAssetID := types.GetStakeIDAndAssetID(clientChainLzID, nil, wethAddress[:])
+	avsAddress := "0x3e108c058e8066da635321dc3018294ca82ddedf"
+	authority := suite.app.PriceFeedKeeper.GetAuthority()
+
+	err := suite.app.StakingAssetsManageKeeper.SetStakingAssetInfo(suite.ctx, &types.StakingAssetInfo{
+		AssetBasicInfo: &types.AssetInfo{
+			Name:             "Wrapped Ether",
+			Symbol:           "WETH",
+			Address:          wethAddress.String(),
+			Decimals:         18,
+			LayerZeroChainID: clientChainLzID,
+		},
+		StakingTotalAmount: sdkmath.NewInt(0),
+	})
+	suite.NoError(err)
+
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+
+	// the operator holds 600 USDT and 2 WETH
+	amounts := map[common.Address]sdkmath.Int{
+		usdtAddress: sdkmath.NewInt(600e6),
+		wethAddress: sdkmath.NewInt(2e18),
+	}
+	for assetAddr, amount := range amounts {
+		assetAddr := assetAddr
+		err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.Deposit,
+			StakerAddress:   suite.address[:],
+			AssetsAddress:   assetAddr[:],
+			OpAmount:        amount,
+		})
+		suite.NoError(err)
+		err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.DelegateTo,
+			AssetsAddress:   assetAddr[:],
+			OperatorAddress: opAccAddr,
+			StakerAddress:   suite.address[:],
+			OpAmount:        amount,
+		})
+		suite.NoError(err)
+	}
+
+	// the AVS only accepts USDT
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, &avstype.MsgRegisterAVS{
+		FromAddress: suite.accAddress.String(),
+		Info: &avstype.AVSInfo{
+			Name:                      "avs",
+			MiddlewareContractAddress: avsAddress,
+			AssetIDs:                  []string{usdtAssetID},
+			UnbondingPeriod:           100,
+		},
+	})
+	suite.NoError(err)
+
+	// the value can't be computed without the prices
+	_, err = suite.app.PriceFeedKeeper.GetOperatorTotalValue(suite.ctx, opAccAddr)
+	suite.ErrorIs(err, pricefeedtype.ErrPriceNotFound)
+
+	_, err = suite.app.PriceFeedKeeper.SubmitPrices(suite.ctx, &pricefeedtype.MsgSubmitPrices{
+		FromAddress: authority,
+		Prices: []pricefeedtype.AssetPrice{
+			{AssetID: usdtAssetID, Price: sdkmath.NewInt(99990000), Decimal: 8},
+			{AssetID: wethAssetID, Price: sdkmath.NewInt(3000_000000), Decimal: 6},
+		},
+	})
+	suite.NoError(err)
+
+	// 600 USDT * 0.9999 + 2 WETH * 3000 = 6599.94 USD
+	usdValue := func(s string) sdkmath.Int {
+		value, ok := sdkmath.NewIntFromString(s)
+		suite.True(ok)
+		return value
+	}
+	totalValue, err := suite.app.PriceFeedKeeper.QueOperatorValue(suite.ctx, &pricefeedtype.QueryOperatorValueReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(usdValue("6599940000000000000000"), totalValue.Value)
+
+	// the value in the AVS is zero before the operator opts in
+	avsValue, err := suite.app.PriceFeedKeeper.GetOperatorAVSValue(suite.ctx, opAccAddr, avsAddress)
+	suite.NoError(err)
+	suite.True(avsValue.IsZero())
+	_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, &avstype.MsgOptIntoAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.NoError(err)
+
+	// only the USDT secures the AVS
+	avsValue, err = suite.app.PriceFeedKeeper.GetOperatorAVSValue(suite.ctx, opAccAddr, avsAddress)
+	suite.NoError(err)
+	suite.Equal(usdValue("599940000000000000000"), avsValue)
+	operatorValues, err := suite.app.PriceFeedKeeper.QueAVSOperatorValues(suite.ctx, &pricefeedtype.QueryAVSOperatorValuesReq{AvsAddress: avsAddress})
+	suite.NoError(err)
+	suite.Len(operatorValues.OperatorValues, 1)
+	suite.Equal(opAccAddr.String(), operatorValues.OperatorValues[0].OperatorAddr)
+	suite.Equal(avsValue, operatorValues.TotalValue)
+
+	// the stale price can't be used
+	params, err := suite.app.PriceFeedKeeper.GetParams(suite.ctx)
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(params.MaxPriceAge+1) * time.Second))
+	_, err = suite.app.PriceFeedKeeper.GetOperatorAVSValue(suite.ctx, opAccAddr, avsAddress)
+	suite.ErrorIs(err, pricefeedtype.ErrStalePrice)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	"github.com/ExocoreNetwork/exocore/x/price_feed/types"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetAssetUSDValue returns the USD value of the raw amount of the asset, it has types.USDValueDecimal decimals.
+// The zero amount is always worth zero, otherwise the price of the asset should be fresh.
+func (k Keeper) GetAssetUSDValue(ctx sdk.Context, assetID string, amount sdkmath.Int) (sdkmath.Int, error) {
+	if amount.IsZero() {
+		return sdkmath.ZeroInt(), nil
+	}
+	assetInfo, err := k.restakingStateKeeper.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	price, err := k.GetFreshPrice(ctx, assetID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return types.CalculateUSDValue(amount, assetInfo.AssetBasicInfo.Decimals, *price), nil
+}
+
+// GetOperatorTotalValue returns the USD value of all the assets restaked to the operator, the amounts
+// waiting for the undelegation aren't counted.
+func (k Keeper) GetOperatorTotalValue(ctx sdk.Context, operatorAddr sdk.AccAddress) (sdkmath.Int, error) {
+	assetsInfo, err := k.restakingStateKeeper.GetOperatorAssetInfos(ctx, operatorAddr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	// sort the assets to return the same error on all the nodes if there are several stale prices
+	assetIDs := make([]string, 0, len(assetsInfo))
+	for assetID := range assetsInfo {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
+
+	total := sdkmath.ZeroInt()
+	for _, assetID := range assetIDs {
+		value, err := k.GetAssetUSDValue(ctx, assetID, assetsInfo[assetID].TotalAmountOrWantChangeValue)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		total = total.Add(value)
+	}
+	return total, nil
+}
+
+// GetOperatorAVSValue returns the USD value of the assets restaked to the operator that are accepted by the AVS,
+// it's the weight of the operator in the AVS. The value is zero if the operator hasn't opted into the AVS.
+func (k Keeper) GetOperatorAVSValue(ctx sdk.Context, operatorAddr sdk.AccAddress, avsAddress string) (sdkmath.Int, error) {
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, avsAddress)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	return k.getOperatorAVSValue(ctx, operatorAddr, avsInfo)
+}
+
+// GetAVSOperatorValues returns the values of all the operators opted into the AVS and the sum of them.
+func (k Keeper) GetAVSOperatorValues(ctx sdk.Context, avsAddress string) ([]types.OperatorValue, sdkmath.Int, error) {
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, avsAddress)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+	operators, err := k.avsKeeper.GetAVSOperatorList(ctx, avsAddress)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	ret := make([]types.OperatorValue, 0, len(operators))
+	total := sdkmath.ZeroInt()
+	for _, operator := range operators {
+		opAccAddr, err := sdk.AccAddressFromBech32(operator)
+		if err != nil {
+			return nil, sdkmath.Int{}, errorsmod.Wrap(err, fmt.Sprintf("invalid operator address:%s", operator))
+		}
+		value, err := k.getOperatorAVSValue(ctx, opAccAddr, avsInfo)
+		if err != nil {
+			return nil, sdkmath.Int{}, err
+		}
+		ret = append(ret, types.OperatorValue{OperatorAddr: operator, Value: value})
+		total = total.Add(value)
+	}
+	return ret, total, nil
+}
+
+func (k Keeper) getOperatorAVSValue(ctx sdk.Context, operatorAddr sdk.AccAddress, avsInfo *avstypes.AVSInfo) (sdkmath.Int, error) {
+	total := sdkmath.ZeroInt()
+	if !k.avsKeeper.IsOptedIn(ctx, operatorAddr.String(), avsInfo.MiddlewareContractAddress) {
+		return total, nil
+	}
+	for _, assetID := range avsInfo.AssetIDs {
+		info, err := k.restakingStateKeeper.GetOperatorSpecifiedAssetInfo(ctx, operatorAddr, assetID)
+		if err != nil {
+			if errorsmod.IsOf(err, restakingtype.ErrNoOperatorAssetKey) {
+				continue
+			}
+			return sdkmath.Int{}, err
+		}
+		value, err := k.GetAssetUSDValue(ctx, assetID, info.TotalAmountOrWantChangeValue)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		total = total.Add(value)
+	}
+	return total, nil
+}
@@ -0,0 +1,15 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// errors
+var (
+	ErrInvalidParams      = errorsmod.Register(ModuleName, 2, "the params is invalid")
+	ErrNoParamsKey        = errorsmod.Register(ModuleName, 3, "there is no stored key for price_feed module params")
+	ErrInvalidPrice       = errorsmod.Register(ModuleName, 4, "the asset price is invalid")
+	ErrPriceNotFound      = errorsmod.Register(ModuleName, 5, "the price of the asset hasn't been submitted")
+	ErrStalePrice         = errorsmod.Register(ModuleName, 6, "the price of the asset is older than the max price age")
+	ErrNotPriceOracle     = errorsmod.Register(ModuleName, 7, "the sender is neither a price oracle nor the governance")
+	ErrInvalidAuthority   = errorsmod.Register(ModuleName, 8, "the sender isn't the governance")
+	ErrInvalidGenesisData = errorsmod.Register(ModuleName, 9, "the genesis data supplied is invalid")
+)
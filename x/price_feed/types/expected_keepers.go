@@ -0,0 +1,13 @@
+package types
+
+import (
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AVSKeeper defines the expected avs keeper used to compute the values of the operators securing the AVS
+type AVSKeeper interface {
+	GetAVSInfo(ctx sdk.Context, avsAddress string) (*avstypes.AVSInfo, error)
+	IsOptedIn(ctx sdk.Context, operatorAddr, avsAddress string) bool
+	GetAVSOperatorList(ctx sdk.Context, avsAddress string) ([]string, error)
+}
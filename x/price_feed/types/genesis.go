@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	assets := make(map[string]struct{}, len(gs.Prices))
+	for _, price := range gs.Prices {
+		if err := price.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := assets[price.AssetID]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated price, assetID:%s", price.AssetID))
+		}
+		assets[price.AssetID] = struct{}{}
+	}
+	return nil
+}
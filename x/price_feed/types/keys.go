@@ -0,0 +1,29 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "price_feed"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+
+	prefixAssetPrice
+)
+
+var (
+	// KeyPrefixParams key-value: ParamsKey->Params
+	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixAssetPrice key-value: assetID->AssetPrice
+	KeyPrefixAssetPrice = []byte{prefixAssetPrice}
+)
+
+// ParamsKey is the key of the params in the KeyPrefixParams store
+var ParamsKey = []byte("Params")
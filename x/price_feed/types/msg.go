@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgSubmitPrices{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// GetSigners returns the expected signers for a MsgSubmitPrices message.
+func (m *MsgSubmitPrices) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubmitPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if len(m.Prices) == 0 {
+		return errorsmod.Wrap(ErrInvalidPrice, "there isn't any price")
+	}
+	assets := make(map[string]struct{}, len(m.Prices))
+	for _, price := range m.Prices {
+		if err := price.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := assets[price.AssetID]; ok {
+			return errorsmod.Wrap(ErrInvalidPrice, fmt.Sprintf("duplicated assetID:%s", price.AssetID))
+		}
+		assets[price.AssetID] = struct{}{}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgSubmitPrices) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUpdateParams) GetSignBytes() []byte {
+	return nil
+}
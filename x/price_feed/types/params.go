@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxPriceAge is the default max price age, the prices should be submitted at least hourly.
+const DefaultMaxPriceAge = 3600
+
+// NewParams creates a new Params instance
+func NewParams(oracles []string, maxPriceAge uint64) Params {
+	return Params{
+		Oracles:     oracles,
+		MaxPriceAge: maxPriceAge,
+	}
+}
+
+// DefaultParams returns a default set of parameters, there isn't any price oracle by default,
+// so the prices can only be submitted by the governance.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultMaxPriceAge)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if p.MaxPriceAge == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "the max price age should be positive")
+	}
+	oracles := make(map[string]struct{}, len(p.Oracles))
+	for _, oracle := range p.Oracles {
+		if _, err := sdk.AccAddressFromBech32(oracle); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("invalid price oracle:%s", oracle))
+		}
+		if _, ok := oracles[oracle]; ok {
+			return errorsmod.Wrap(ErrInvalidParams, fmt.Sprintf("duplicated price oracle:%s", oracle))
+		}
+		oracles[oracle] = struct{}{}
+	}
+	return nil
+}
+
+// IsPriceOracle returns true if the address is a price oracle
+func (p Params) IsPriceOracle(addr string) bool {
+	for _, oracle := range p.Oracles {
+		if oracle == addr {
+			return true
+		}
+	}
+	return false
+}
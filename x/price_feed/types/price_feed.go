@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// USDValueDecimal is the decimal of the USD values computed by the module, so the values of
+	// the assets with different decimals can be compared and summed up.
+	USDValueDecimal = 18
+
+	// MaxPriceDecimal is the maximum decimal of the submitted prices
+	MaxPriceDecimal = 18
+)
+
+// ValidateBasic checks the price is positive and the decimal isn't too large
+func (p AssetPrice) ValidateBasic() error {
+	if p.AssetID == "" {
+		return errorsmod.Wrap(ErrInvalidPrice, "the assetID is empty")
+	}
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPrice, fmt.Sprintf("the price should be positive, assetID:%s", p.AssetID))
+	}
+	if p.Decimal > MaxPriceDecimal {
+		return errorsmod.Wrap(ErrInvalidPrice, fmt.Sprintf("the decimal is larger than %d, assetID:%s,decimal:%d", MaxPriceDecimal, p.AssetID, p.Decimal))
+	}
+	return nil
+}
+
+// IsStale returns true if the price is older than maxPriceAge seconds at the blockTime.
+func (p AssetPrice) IsStale(blockTime int64, maxPriceAge uint64) bool {
+	return blockTime > p.UpdateTime && uint64(blockTime-p.UpdateTime) > maxPriceAge
+}
+
+// CalculateUSDValue converts the raw amount of the asset that has assetDecimal decimals to its USD value
+// with USDValueDecimal decimals, the remainder is truncated.
+func CalculateUSDValue(amount sdkmath.Int, assetDecimal uint32, price AssetPrice) sdkmath.Int {
+	// value = amount * price * 10^USDValueDecimal / 10^(assetDecimal+priceDecimal)
+	value := new(big.Int).Mul(amount.BigInt(), price.Price.BigInt())
+	value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(USDValueDecimal), nil))
+	value.Quo(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(assetDecimal)+int64(price.Decimal)), nil))
+	return sdkmath.NewIntFromBigInt(value)
+}
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/price_feed/v1/price_feed.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AssetPrice is the USD price of the asset, the price of a whole asset is price/10^decimal.
+type AssetPrice struct {
+	AssetID string                                 `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Price   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"price"`
+	Decimal uint32                                 `protobuf:"varint,3,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	// updateTime is the unix time in seconds of the block in which the price is submitted
+	UpdateTime   int64 `protobuf:"varint,4,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	UpdateHeight int64 `protobuf:"varint,5,opt,name=updateHeight,proto3" json:"updateHeight,omitempty"`
+}
+
+func (m *AssetPrice) Reset()         { *m = AssetPrice{} }
+func (m *AssetPrice) String() string { return proto.CompactTextString(m) }
+func (*AssetPrice) ProtoMessage()    {}
+func (*AssetPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d07aed626c84b15, []int{0}
+}
+func (m *AssetPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetPrice.Merge(m, src)
+}
+func (m *AssetPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetPrice proto.InternalMessageInfo
+
+func (m *AssetPrice) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *AssetPrice) GetDecimal() uint32 {
+	if m != nil {
+		return m.Decimal
+	}
+	return 0
+}
+
+func (m *AssetPrice) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+func (m *AssetPrice) GetUpdateHeight() int64 {
+	if m != nil {
+		return m.UpdateHeight
+	}
+	return 0
+}
+
+// OperatorValue is the USD value restaked to the operator, it has USDValueDecimal decimals.
+type OperatorValue struct {
+	OperatorAddr string                                 `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Value        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *OperatorValue) Reset()         { *m = OperatorValue{} }
+func (m *OperatorValue) String() string { return proto.CompactTextString(m) }
+func (*OperatorValue) ProtoMessage()    {}
+func (*OperatorValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d07aed626c84b15, []int{1}
+}
+func (m *OperatorValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorValue.Merge(m, src)
+}
+func (m *OperatorValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorValue proto.InternalMessageInfo
+
+func (m *OperatorValue) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AssetPrice)(nil), "exocore.price_feed.v1.AssetPrice")
+	proto.RegisterType((*OperatorValue)(nil), "exocore.price_feed.v1.OperatorValue")
+}
+
+func init() {
+	proto.RegisterFile("exocore/price_feed/v1/price_feed.proto", fileDescriptor_7d07aed626c84b15)
+}
+
+var fileDescriptor_7d07aed626c84b15 = []byte{
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x4e, 0xfa, 0x50,
+	0x14, 0xc6, 0x7b, 0xff, 0xfc, 0xd1, 0x78, 0x23, 0x4b, 0xa3, 0x49, 0x65, 0xb8, 0x10, 0x06, 0xc2,
+	0x42, 0x1b, 0xa2, 0xa3, 0x0b, 0x44, 0x13, 0x59, 0xd0, 0x34, 0xc6, 0xc1, 0x85, 0x94, 0xf6, 0x58,
+	0x1a, 0x28, 0xa7, 0xb9, 0xbd, 0x20, 0x3e, 0x81, 0xab, 0x0f, 0xe3, 0x43, 0x30, 0x12, 0x27, 0xe2,
+	0x40, 0x0c, 0xbc, 0x88, 0xe9, 0xbd, 0xb7, 0x49, 0xd9, 0x9d, 0xda, 0xef, 0xd7, 0xef, 0x9c, 0xaf,
+	0x27, 0xe7, 0xd0, 0x26, 0x2c, 0xd1, 0x47, 0x0e, 0x4e, 0xc2, 0x23, 0x1f, 0x86, 0x2f, 0x00, 0x81,
+	0xb3, 0xe8, 0x14, 0x94, 0x9d, 0x70, 0x14, 0x68, 0x9e, 0x6b, 0x9f, 0x5d, 0xf8, 0xb2, 0xe8, 0x54,
+	0x2f, 0x7c, 0x4c, 0x63, 0x4c, 0x87, 0xd2, 0xe4, 0x28, 0xa1, 0x2a, 0xaa, 0x67, 0x21, 0x86, 0xa8,
+	0x78, 0xf6, 0xa6, 0x68, 0x63, 0x43, 0x28, 0xed, 0xa6, 0x29, 0x88, 0x87, 0xac, 0x8f, 0x69, 0xd1,
+	0x63, 0x2f, 0x53, 0xfd, 0x1b, 0x8b, 0xd4, 0x49, 0xeb, 0xc4, 0xcd, 0xa5, 0xe9, 0xd2, 0xb2, 0x8c,
+	0xb2, 0xfe, 0x65, 0xbc, 0x77, 0xbd, 0xda, 0xd6, 0x8c, 0xef, 0x6d, 0xad, 0x19, 0x46, 0x62, 0x3c,
+	0x1f, 0xd9, 0x3e, 0xc6, 0x3a, 0x4e, 0x3f, 0xda, 0x69, 0x30, 0x71, 0xc4, 0x5b, 0x02, 0xa9, 0xdd,
+	0x9f, 0x89, 0xaf, 0xcf, 0x36, 0xd5, 0x7f, 0xd3, 0x9f, 0x09, 0xb7, 0x9c, 0xe4, 0x69, 0x01, 0xf8,
+	0x51, 0xec, 0x4d, 0xad, 0x52, 0x9d, 0xb4, 0x2a, 0x6e, 0x2e, 0x4d, 0x46, 0xe9, 0x3c, 0x09, 0x3c,
+	0x01, 0x8f, 0x51, 0x0c, 0xd6, 0xff, 0x3a, 0x69, 0x95, 0xdc, 0x02, 0x31, 0x1b, 0xf4, 0x54, 0xa9,
+	0x3b, 0x88, 0xc2, 0xb1, 0xb0, 0xca, 0xd2, 0x71, 0xc0, 0x1a, 0xef, 0x84, 0x56, 0xee, 0x13, 0xe0,
+	0x9e, 0x40, 0xfe, 0xe4, 0x4d, 0xe7, 0xb2, 0x0a, 0x35, 0xe8, 0x06, 0x01, 0xd7, 0x23, 0x1e, 0xb0,
+	0x6c, 0xce, 0x45, 0x66, 0xfe, 0x9b, 0x39, 0x65, 0xab, 0xde, 0x60, 0xb5, 0x63, 0x64, 0xbd, 0x63,
+	0xe4, 0x67, 0xc7, 0xc8, 0xc7, 0x9e, 0x19, 0xeb, 0x3d, 0x33, 0x36, 0x7b, 0x66, 0x3c, 0x5f, 0x15,
+	0xda, 0xde, 0xaa, 0x8d, 0x0e, 0x40, 0xbc, 0x22, 0x9f, 0x38, 0xf9, 0x21, 0x2c, 0x8b, 0xa7, 0x20,
+	0x83, 0x46, 0x47, 0x72, 0x77, 0x97, 0xbf, 0x03, 0x00, 0x73, 0x54, 0x69, 0xbe, 0x2d, 0x02, 0x00,
+	0x00,
+}
+
+func (m *AssetPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateHeight != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.UpdateHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdateTime != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.UpdateTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimal != 0 {
+		i = encodeVarintPriceFeed(dAtA, i, uint64(m.Decimal))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPriceFeed(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintPriceFeed(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceFeed(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceFeed(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AssetPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovPriceFeed(uint64(l))
+	if m.Decimal != 0 {
+		n += 1 + sovPriceFeed(uint64(m.Decimal))
+	}
+	if m.UpdateTime != 0 {
+		n += 1 + sovPriceFeed(uint64(m.UpdateTime))
+	}
+	if m.UpdateHeight != 0 {
+		n += 1 + sovPriceFeed(uint64(m.UpdateHeight))
+	}
+	return n
+}
+
+func (m *OperatorValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovPriceFeed(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovPriceFeed(uint64(l))
+	return n
+}
+
+func sovPriceFeed(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceFeed(x uint64) (n int) {
+	return sovPriceFeed(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AssetPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimal", wireType)
+			}
+			m.Decimal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			m.UpdateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			}
+			m.UpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceFeed(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceFeed
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceFeed(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceFeed
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceFeed
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceFeed
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceFeed
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceFeed
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceFeed        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceFeed          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceFeed = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/price_feed/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParamsReq struct {
+}
+
+func (m *QueryParamsReq) Reset()         { *m = QueryParamsReq{} }
+func (m *QueryParamsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsReq) ProtoMessage()    {}
+func (*QueryParamsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{0}
+}
+func (m *QueryParamsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsReq.Merge(m, src)
+}
+func (m *QueryParamsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsReq proto.InternalMessageInfo
+
+type QueryPriceReq struct {
+	AssetID string `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
+}
+
+func (m *QueryPriceReq) Reset()         { *m = QueryPriceReq{} }
+func (m *QueryPriceReq) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceReq) ProtoMessage()    {}
+func (*QueryPriceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{1}
+}
+func (m *QueryPriceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceReq.Merge(m, src)
+}
+func (m *QueryPriceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceReq proto.InternalMessageInfo
+
+func (m *QueryPriceReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+type QueryOperatorValueReq struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+}
+
+func (m *QueryOperatorValueReq) Reset()         { *m = QueryOperatorValueReq{} }
+func (m *QueryOperatorValueReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorValueReq) ProtoMessage()    {}
+func (*QueryOperatorValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{2}
+}
+func (m *QueryOperatorValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorValueReq.Merge(m, src)
+}
+func (m *QueryOperatorValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorValueReq proto.InternalMessageInfo
+
+func (m *QueryOperatorValueReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+type QueryOperatorAVSValueReq struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AvsAddress   string `protobuf:"bytes,2,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *QueryOperatorAVSValueReq) Reset()         { *m = QueryOperatorAVSValueReq{} }
+func (m *QueryOperatorAVSValueReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAVSValueReq) ProtoMessage()    {}
+func (*QueryOperatorAVSValueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{3}
+}
+func (m *QueryOperatorAVSValueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorAVSValueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorAVSValueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorAVSValueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAVSValueReq.Merge(m, src)
+}
+func (m *QueryOperatorAVSValueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorAVSValueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAVSValueReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorAVSValueReq proto.InternalMessageInfo
+
+func (m *QueryOperatorAVSValueReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *QueryOperatorAVSValueReq) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+type QueryAVSOperatorValuesReq struct {
+	AvsAddress string `protobuf:"bytes,1,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+}
+
+func (m *QueryAVSOperatorValuesReq) Reset()         { *m = QueryAVSOperatorValuesReq{} }
+func (m *QueryAVSOperatorValuesReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSOperatorValuesReq) ProtoMessage()    {}
+func (*QueryAVSOperatorValuesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{4}
+}
+func (m *QueryAVSOperatorValuesReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSOperatorValuesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSOperatorValuesReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSOperatorValuesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSOperatorValuesReq.Merge(m, src)
+}
+func (m *QueryAVSOperatorValuesReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSOperatorValuesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSOperatorValuesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSOperatorValuesReq proto.InternalMessageInfo
+
+func (m *QueryAVSOperatorValuesReq) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+// QueryAVSOperatorValuesResponse contains the values of the operators opted into the AVS,
+// the totalValue is the sum of them.
+type QueryAVSOperatorValuesResponse struct {
+	OperatorValues []OperatorValue                        `protobuf:"bytes,1,rep,name=operatorValues,proto3" json:"operatorValues"`
+	TotalValue     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=totalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalValue"`
+}
+
+func (m *QueryAVSOperatorValuesResponse) Reset()         { *m = QueryAVSOperatorValuesResponse{} }
+func (m *QueryAVSOperatorValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAVSOperatorValuesResponse) ProtoMessage()    {}
+func (*QueryAVSOperatorValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4ce8ea95644e76, []int{5}
+}
+func (m *QueryAVSOperatorValuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAVSOperatorValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAVSOperatorValuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAVSOperatorValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAVSOperatorValuesResponse.Merge(m, src)
+}
+func (m *QueryAVSOperatorValuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAVSOperatorValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAVSOperatorValuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAVSOperatorValuesResponse proto.InternalMessageInfo
+
+func (m *QueryAVSOperatorValuesResponse) GetOperatorValues() []OperatorValue {
+	if m != nil {
+		return m.OperatorValues
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsReq)(nil), "exocore.price_feed.v1.QueryParamsReq")
+	proto.RegisterType((*QueryPriceReq)(nil), "exocore.price_feed.v1.QueryPriceReq")
+	proto.RegisterType((*QueryOperatorValueReq)(nil), "exocore.price_feed.v1.QueryOperatorValueReq")
+	proto.RegisterType((*QueryOperatorAVSValueReq)(nil), "exocore.price_feed.v1.QueryOperatorAVSValueReq")
+	proto.RegisterType((*QueryAVSOperatorValuesReq)(nil), "exocore.price_feed.v1.QueryAVSOperatorValuesReq")
+	proto.RegisterType((*QueryAVSOperatorValuesResponse)(nil), "exocore.price_feed.v1.QueryAVSOperatorValuesResponse")
+}
+
+func init() { proto.RegisterFile("exocore/price_feed/v1/query.proto", fileDescriptor_9d4ce8ea95644e76) }
+
+var fileDescriptor_9d4ce8ea95644e76 = []byte{
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x73, 0xfd, 0xfd, 0x28, 0xf4, 0x01, 0x55, 0x75, 0xb4, 0x52, 0x6a, 0xc0, 0x49, 0x4e,
+	0xa5, 0xb4, 0x25, 0xb1, 0x69, 0x29, 0x02, 0xa9, 0x2c, 0x89, 0x60, 0xe8, 0x52, 0x88, 0x2b, 0x65,
+	0x40, 0x88, 0xca, 0x4d, 0x8e, 0x10, 0x35, 0xc9, 0x39, 0xf6, 0x39, 0xb4, 0x1b, 0x30, 0x31, 0x82,
+	0x58, 0x59, 0xd9, 0x19, 0x58, 0xf8, 0x0f, 0x3a, 0x56, 0x65, 0x41, 0x0c, 0x15, 0x4a, 0x90, 0xf8,
+	0x37, 0x90, 0xef, 0x9c, 0xda, 0x4e, 0xe2, 0x90, 0x4e, 0xf1, 0x7b, 0xfe, 0x7e, 0xbf, 0xf7, 0xb1,
+	0xee, 0xbd, 0x40, 0x86, 0xee, 0xb3, 0x32, 0xb3, 0xa9, 0x6e, 0xd9, 0xb5, 0x32, 0xdd, 0x79, 0x41,
+	0x69, 0x45, 0x6f, 0xaf, 0xea, 0x2d, 0x97, 0xda, 0x07, 0x9a, 0x65, 0x33, 0xce, 0xf0, 0x9c, 0x2f,
+	0xd1, 0x02, 0x89, 0xd6, 0x5e, 0x55, 0xe6, 0xcb, 0xcc, 0x69, 0x30, 0x67, 0x47, 0x88, 0x74, 0x59,
+	0x48, 0x87, 0x32, 0x5b, 0x65, 0x55, 0x26, 0xfb, 0xde, 0x93, 0xdf, 0xbd, 0x56, 0x65, 0xac, 0x5a,
+	0xa7, 0xba, 0x69, 0xd5, 0x74, 0xb3, 0xd9, 0x64, 0xdc, 0xe4, 0x35, 0xd6, 0xec, 0x79, 0xae, 0xca,
+	0x04, 0x79, 0x72, 0x1f, 0x82, 0x42, 0x86, 0x53, 0x5a, 0xa6, 0x6d, 0x36, 0x7a, 0x01, 0x8b, 0x31,
+	0x9a, 0x00, 0x5a, 0xe8, 0xc8, 0x0c, 0x4c, 0x17, 0xbd, 0xe8, 0x27, 0xc2, 0x6c, 0xd0, 0x16, 0x59,
+	0x86, 0xcb, 0xb2, 0xe3, 0x49, 0x0d, 0xda, 0xc2, 0x49, 0x38, 0x6f, 0x3a, 0x0e, 0xe5, 0x9b, 0x0f,
+	0x93, 0x28, 0x8d, 0x96, 0xa6, 0x8c, 0x5e, 0x49, 0x36, 0x60, 0x4e, 0x48, 0x1f, 0x5b, 0xd4, 0x36,
+	0x39, 0xb3, 0x4b, 0x66, 0xdd, 0x15, 0x16, 0x02, 0x97, 0x98, 0xdf, 0xcb, 0x57, 0x2a, 0xb6, 0xef,
+	0x8b, 0xf4, 0xc8, 0x73, 0x48, 0x46, 0xcc, 0xf9, 0xd2, 0xf6, 0x59, 0xfc, 0x58, 0x05, 0x30, 0xdb,
+	0x8e, 0xf7, 0x48, 0x1d, 0x27, 0x39, 0x21, 0x14, 0xa1, 0x0e, 0xd9, 0x80, 0x79, 0x91, 0x9f, 0x2f,
+	0x6d, 0x47, 0xf8, 0xbc, 0x8f, 0xec, 0x33, 0xa3, 0x01, 0xf3, 0x31, 0x02, 0x35, 0xce, 0xed, 0x58,
+	0xac, 0xe9, 0x50, 0x6c, 0xc0, 0x34, 0x8b, 0xbc, 0x49, 0xa2, 0xf4, 0x7f, 0x4b, 0x17, 0xd7, 0x16,
+	0xb4, 0xa1, 0x13, 0xa2, 0x45, 0x62, 0x0a, 0xff, 0x1f, 0x9e, 0xa4, 0x12, 0x46, 0x5f, 0x02, 0x7e,
+	0x06, 0xc0, 0x19, 0x37, 0xeb, 0xa2, 0x94, 0xdf, 0x54, 0x78, 0xe0, 0x29, 0x7f, 0x9e, 0xa4, 0x16,
+	0xab, 0x35, 0xfe, 0xd2, 0xdd, 0xd5, 0xca, 0xac, 0xe1, 0xcf, 0x97, 0xff, 0x93, 0x73, 0x2a, 0x7b,
+	0x3a, 0x3f, 0xb0, 0xa8, 0xa3, 0x6d, 0x36, 0xf9, 0xf1, 0xd7, 0x1c, 0xc8, 0xbe, 0x57, 0x19, 0xa1,
+	0xbc, 0xb5, 0x0f, 0x93, 0x70, 0x4e, 0x7c, 0x14, 0x7e, 0x8d, 0x60, 0xaa, 0xe8, 0x52, 0x79, 0xe9,
+	0xf8, 0x46, 0x0c, 0x71, 0x74, 0x30, 0x94, 0xeb, 0x31, 0x32, 0xa9, 0x20, 0xb9, 0x77, 0x7f, 0xbe,
+	0xac, 0xa0, 0xb7, 0xdf, 0x7f, 0x7f, 0x9c, 0x20, 0x38, 0xad, 0x0f, 0x9f, 0xbf, 0xe0, 0xd0, 0x37,
+	0x08, 0x2e, 0x78, 0x95, 0xf7, 0x1a, 0x2f, 0x8c, 0x24, 0xf0, 0x07, 0x51, 0xc9, 0xc4, 0xa8, 0xf2,
+	0xde, 0x38, 0x0a, 0x15, 0xc9, 0x06, 0x10, 0x19, 0x9c, 0x1a, 0x01, 0x21, 0x8e, 0xfd, 0x84, 0x60,
+	0xa6, 0xe8, 0xd2, 0xc8, 0xcd, 0xe0, 0xec, 0x28, 0x96, 0xfe, 0x49, 0x57, 0xc6, 0xba, 0x6d, 0xb2,
+	0x1e, 0x60, 0x2d, 0xe3, 0x9b, 0xf1, 0x58, 0x51, 0x92, 0xcf, 0x08, 0xae, 0x84, 0x9a, 0xbd, 0x05,
+	0xc1, 0xfa, 0x38, 0x84, 0xa1, 0x75, 0x1a, 0x13, 0xf2, 0x5e, 0x00, 0x99, 0xc5, 0x2b, 0xff, 0x86,
+	0x3c, 0xe5, 0xf9, 0x86, 0x60, 0xb6, 0xe8, 0xd2, 0x81, 0x55, 0xc1, 0xb7, 0x47, 0x81, 0x0e, 0xdb,
+	0x4b, 0xe5, 0xee, 0x19, 0x1d, 0x72, 0x17, 0xc9, 0xfd, 0x00, 0x3d, 0x87, 0x6f, 0xc5, 0xa3, 0x0f,
+	0x24, 0x14, 0xb6, 0x0e, 0x3b, 0x2a, 0x3a, 0xea, 0xa8, 0xe8, 0x57, 0x47, 0x45, 0xef, 0xbb, 0x6a,
+	0xe2, 0xa8, 0xab, 0x26, 0x7e, 0x74, 0xd5, 0xc4, 0xd3, 0xf5, 0xd0, 0xbe, 0x3d, 0x92, 0x81, 0x5b,
+	0x94, 0xbf, 0x62, 0xf6, 0xde, 0x69, 0xfe, 0x7e, 0xf8, 0x04, 0xb1, 0x81, 0xbb, 0x93, 0xe2, 0x6f,
+	0xf5, 0xce, 0xdf, 0x01, 0x00, 0x05, 0xa3, 0x9a, 0xec, 0x4a, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueParams queries the params of the price_feed module.
+	QueParams(ctx context.Context, in *QueryParamsReq, opts ...grpc.CallOption) (*Params, error)
+	// QuePrice queries the latest price of the asset, the stale price is returned as well.
+	QuePrice(ctx context.Context, in *QueryPriceReq, opts ...grpc.CallOption) (*AssetPrice, error)
+	// QueOperatorValue queries the USD value of all the assets restaked to the operator.
+	QueOperatorValue(ctx context.Context, in *QueryOperatorValueReq, opts ...grpc.CallOption) (*OperatorValue, error)
+	// QueOperatorAVSValue queries the USD value of the assets restaked to the operator that secure the AVS.
+	QueOperatorAVSValue(ctx context.Context, in *QueryOperatorAVSValueReq, opts ...grpc.CallOption) (*OperatorValue, error)
+	// QueAVSOperatorValues queries the values of all the operators opted into the AVS.
+	QueAVSOperatorValues(ctx context.Context, in *QueryAVSOperatorValuesReq, opts ...grpc.CallOption) (*QueryAVSOperatorValuesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueParams(ctx context.Context, in *QueryParamsReq, opts ...grpc.CallOption) (*Params, error) {
+	out := new(Params)
+	err := c.cc.Invoke(ctx, "/exocore.price_feed.v1.Query/QueParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuePrice(ctx context.Context, in *QueryPriceReq, opts ...grpc.CallOption) (*AssetPrice, error) {
+	out := new(AssetPrice)
+	err := c.cc.Invoke(ctx, "/exocore.price_feed.v1.Query/QuePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueOperatorValue(ctx context.Context, in *QueryOperatorValueReq, opts ...grpc.CallOption) (*OperatorValue, error) {
+	out := new(OperatorValue)
+	err := c.cc.Invoke(ctx, "/exocore.price_feed.v1.Query/QueOperatorValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueOperatorAVSValue(ctx context.Context, in *QueryOperatorAVSValueReq, opts ...grpc.CallOption) (*OperatorValue, error) {
+	out := new(OperatorValue)
+	err := c.cc.Invoke(ctx, "/exocore.price_feed.v1.Query/QueOperatorAVSValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueAVSOperatorValues(ctx context.Context, in *QueryAVSOperatorValuesReq, opts ...grpc.CallOption) (*QueryAVSOperatorValuesResponse, error) {
+	out := new(QueryAVSOperatorValuesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.price_feed.v1.Query/QueAVSOperatorValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueParams queries the params of the price_feed module.
+	QueParams(context.Context, *QueryParamsReq) (*Params, error)
+	// QuePrice queries the latest price of the asset, the stale price is returned as well.
+	QuePrice(context.Context, *QueryPriceReq) (*AssetPrice, error)
+	// QueOperatorValue queries the USD value of all the assets restaked to the operator.
+	QueOperatorValue(context.Context, *QueryOperatorValueReq) (*OperatorValue, error)
+	// QueOperatorAVSValue queries the USD value of the assets restaked to the operator that secure the AVS.
+	QueOperatorAVSValue(context.Context, *QueryOperatorAVSValueReq) (*OperatorValue, error)
+	// QueAVSOperatorValues queries the values of all the operators opted into the AVS.
+	QueAVSOperatorValues(context.Context, *QueryAVSOperatorValuesReq) (*QueryAVSOperatorValuesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueParams(ctx context.Context, req *QueryParamsReq) (*Params, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueParams not implemented")
+}
+func (*UnimplementedQueryServer) QuePrice(ctx context.Context, req *QueryPriceReq) (*AssetPrice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuePrice not implemented")
+}
+func (*UnimplementedQueryServer) QueOperatorValue(ctx context.Context, req *QueryOperatorValueReq) (*OperatorValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorValue not implemented")
+}
+func (*UnimplementedQueryServer) QueOperatorAVSValue(ctx context.Context, req *QueryOperatorAVSValueReq) (*OperatorValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorAVSValue not implemented")
+}
+func (*UnimplementedQueryServer) QueAVSOperatorValues(ctx context.Context, req *QueryAVSOperatorValuesReq) (*QueryAVSOperatorValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueAVSOperatorValues not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.price_feed.v1.Query/QueParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueParams(ctx, req.(*QueryParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.price_feed.v1.Query/QuePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuePrice(ctx, req.(*QueryPriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueOperatorValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueOperatorValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.price_feed.v1.Query/QueOperatorValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueOperatorValue(ctx, req.(*QueryOperatorValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueOperatorAVSValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAVSValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueOperatorAVSValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.price_feed.v1.Query/QueOperatorAVSValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueOperatorAVSValue(ctx, req.(*QueryOperatorAVSValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueAVSOperatorValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAVSOperatorValuesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueAVSOperatorValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.price_feed.v1.Query/QueAVSOperatorValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueAVSOperatorValues(ctx, req.(*QueryAVSOperatorValuesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.price_feed.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueParams",
+			Handler:    _Query_QueParams_Handler,
+		},
+		{
+			MethodName: "QuePrice",
+			Handler:    _Query_QuePrice_Handler,
+		},
+		{
+			MethodName: "QueOperatorValue",
+			Handler:    _Query_QueOperatorValue_Handler,
+		},
+		{
+			MethodName: "QueOperatorAVSValue",
+			Handler:    _Query_QueOperatorAVSValue_Handler,
+		},
+		{
+			MethodName: "QueAVSOperatorValues",
+			Handler:    _Query_QueAVSOperatorValues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/price_feed/v1/query.proto",
+}
+
+func (m *QueryParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAVSValueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAVSValueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAVSValueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAVSOperatorValuesReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSOperatorValuesReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSOperatorValuesReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAVSOperatorValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAVSOperatorValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAVSOperatorValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalValue.Size()
+		i -= size
+		if _, err := m.TotalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorValues) > 0 {
+		for iNdEx := len(m.OperatorValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAVSValueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAVSOperatorValuesReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAVSOperatorValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperatorValues) > 0 {
+		for _, e := range m.OperatorValues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorAVSValueReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorAVSValueReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorAVSValueReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSOperatorValuesReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSOperatorValuesReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSOperatorValuesReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAVSOperatorValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAVSOperatorValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAVSOperatorValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorValues = append(m.OperatorValues, OperatorValue{})
+			if err := m.OperatorValues[len(m.OperatorValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -32,7 +32,17 @@ func GetQueryCmd() *cobra.Command {
 		QueStakerSpecifiedAssetAmount(),
 		QueOperatorAssetInfos(),
 		QueOperatorSpecifiedAssetAmount(),
-		// QueStakerExoCoreAddr(),
+		QueClientChainInfoList(),
+		QueStakingAssetInfoList(),
+		QueStakerAssetList(),
+		QueOperatorAssetList(),
+		QueAssetStakers(),
+		QueAssetOperators(),
+		QueLzNonce(),
+		QueWithdrawalOutflow(),
+		QueGuardian(),
+		QueStakerExoCoreAddr(),
+		QueExoCoreAddrStakers(),
 	)
 	return cmd
 }
@@ -314,3 +324,350 @@ func QueStakerExoCoreAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// QueClientChainInfoList queries the client chain info list by page
+func QueClientChainInfoList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueClientChainInfoList",
+		Short: "Get the client chain info list by page",
+		Long:  "Get the client chain info list by page",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryClientChainInfoListReq{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueClientChainInfoList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueClientChainInfoList")
+	return cmd
+}
+
+// QueStakingAssetInfoList queries the staking asset info list by page
+func QueStakingAssetInfoList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueStakingAssetInfoList",
+		Short: "Get the staking asset info list by page",
+		Long:  "Get the staking asset info list by page",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryStakingAssetInfoListReq{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueStakingAssetInfoList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueStakingAssetInfoList")
+	return cmd
+}
+
+// QueStakerAssetList queries the asset states of a staker by page
+func QueStakerAssetList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueStakerAssetList stakerID",
+		Short: "Get the asset states of a staker by page",
+		Long:  "Get the asset states of a staker by page",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryStakerAssetListReq{
+				StakerID:   args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueStakerAssetList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueStakerAssetList")
+	return cmd
+}
+
+// QueOperatorAssetList queries the asset states of an operator by page
+func QueOperatorAssetList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueOperatorAssetList operatorAddr",
+		Short: "Get the asset states of an operator by page",
+		Long:  "Get the asset states of an operator by page",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOperatorAssetListReq{
+				OperatorAddr: args[0],
+				Pagination:   pageReq,
+			}
+			res, err := queryClient.QueOperatorAssetList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueOperatorAssetList")
+	return cmd
+}
+
+// QueAssetStakers queries the stakers holding the asset by page
+func QueAssetStakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueAssetStakers assetAddr clientChainLzID",
+		Short: "Get the stakers holding the asset by page",
+		Long:  "Get the stakers holding the asset by page",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientChainLzID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			_, assetID := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, "", args[0])
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAssetStakersReq{
+				AssetID:    assetID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueAssetStakers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueAssetStakers")
+	return cmd
+}
+
+// QueAssetOperators queries the operators holding the asset by page
+func QueAssetOperators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueAssetOperators assetAddr clientChainLzID",
+		Short: "Get the operators holding the asset by page",
+		Long:  "Get the operators holding the asset by page",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientChainLzID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			_, assetID := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, "", args[0])
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryAssetOperatorsReq{
+				AssetID:    assetID,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueAssetOperators(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueAssetOperators")
+	return cmd
+}
+
+// QueLzNonce queries the last processed LayerZero nonce of the client chain
+func QueLzNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueLzNonce clientChainLzID",
+		Short: "Get the last processed LayerZero nonce of the client chain",
+		Long:  "Get the last processed LayerZero nonce of the client chain, the relayer can resume from the next nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, err.Error())
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryLzNonceReq{
+				ClientChainLzID: clientChainLzID,
+			}
+			res, err := queryClient.QueLzNonce(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueWithdrawalOutflow queries the withdrawal outflow cap and the current outflow of the asset
+func QueWithdrawalOutflow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueWithdrawalOutflow assetAddr clientChainLzID",
+		Short: "Get the withdrawal outflow cap and the current outflow of the asset",
+		Long:  "Get the withdrawal outflow cap and the withdrawn amount of the asset in the current block and epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientChainLzID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			_, assetID := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, "", args[0])
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryWithdrawalOutflowReq{
+				AssetID: assetID,
+			}
+			res, err := queryClient.QueWithdrawalOutflow(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueGuardian queries the guardian who can pause the client chains and assets
+func QueGuardian() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueGuardian",
+		Short: "Get the guardian who can pause the client chains and assets",
+		Long:  "Get the guardian who can pause the client chains and assets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.QueGuardian(context.Background(), &types.QueryGuardianReq{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueExoCoreAddrStakers queries the stakers bound to the exoCore address
+func QueExoCoreAddrStakers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "QueExoCoreAddrStakers exoCoreAddr",
+		Short: "Get the stakers bound to the exoCore address",
+		Long:  "Get the stakers bound to the exoCore address, they are ordered by the stakerID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryExoCoreAddrStakersReq{
+				ExoCoreAddr: args[0],
+				Pagination:  pageReq,
+			}
+			res, err := queryClient.QueExoCoreAddrStakers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "QueExoCoreAddrStakers")
+	return cmd
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
@@ -25,14 +27,70 @@ func NewTxCmd() *cobra.Command {
 	}
 
 	txCmd.AddCommand(
+		SetStakerExoCoreAddr(),
 		RegisterClientChain(),
 		RegisterAsset(),
+		UpdateAsset(),
+		DeprecateAsset(),
+		SetGuardian(),
+		PauseClientChain(),
+		PauseAsset(),
+		SetWithdrawalOutflowCap(),
 	)
 	return txCmd
 }
 
+const (
+	FlagLzNonceGapTolerant = "lz-nonce-gap-tolerant"
+	FlagSignatureType      = "signature-type"
+	FlagRebind             = "rebind"
+)
+
+// SetStakerExoCoreAddr bind the exoCore address of the `--from` account to the staker
+// The signature is the hex-encoded signature of the binding message signed by the staker's client chain key,
+// the message can be generated by types.GetExoCoreAddrBindingMsg.
+func SetStakerExoCoreAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SetStakerExoCoreAddr clientChainLzID clientChainAddr signature",
+		Short: "bind the exoCore address to the staker of the client chain",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[0]))
+			}
+			rebind, err := cmd.Flags().GetBool(FlagRebind)
+			if err != nil {
+				return err
+			}
+			msg := &restakingtype.MsgSetExoCoreAddr{
+				FromAddress:                cliCtx.GetFromAddress().String(),
+				SetAddress:                 cliCtx.GetFromAddress().String(),
+				ClientChainAddr:            args[1],
+				ClientChainIndex:           clientChainLzID,
+				StakerClientChainSignature: args[2],
+				Rebind:                     rebind,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRebind, false, "replace the exoCore address that has been bound to the staker")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // RegisterClientChain register client chain
-// todo: this function should be controlled by governance in the future
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
 func RegisterClientChain() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "RegisterClientChain Name MetaInfo LZChainId AddressLength",
@@ -44,9 +102,8 @@ func RegisterClientChain() *cobra.Command {
 				return err
 			}
 
-			sender := cliCtx.GetFromAddress()
 			msg := &restakingtype.RegisterClientChainReq{
-				FromAddress: sender.String(),
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Info: &restakingtype.ClientChainInfo{
 					Name:     args[0],
 					MetaInfo: args[1],
@@ -60,8 +117,18 @@ func RegisterClientChain() *cobra.Command {
 			if err != nil {
 				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[3]))
 			}
+			gapTolerant, err := cmd.Flags().GetBool(FlagLzNonceGapTolerant)
+			if err != nil {
+				return err
+			}
+			signatureType, err := cmd.Flags().GetString(FlagSignatureType)
+			if err != nil {
+				return err
+			}
 			msg.Info.LayerZeroChainID = lzChainID
 			msg.Info.AddressLength = uint32(addressLength)
+			msg.Info.LzNonceGapTolerant = gapTolerant
+			msg.Info.SignatureType = signatureType
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -69,12 +136,15 @@ func RegisterClientChain() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagLzNonceGapTolerant, false, "allow the gaps between the LayerZero nonces of the client chain")
+	cmd.Flags().String(FlagSignatureType, restakingtype.SignatureTypeEIP191, "the signature type used by the stakers of the client chain")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
 // RegisterAsset register the asset on the client chain
-// todo: this function should be controlled by governance in the future
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
 func RegisterAsset() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "RegisterAsset Name Symbol Address MetaInfo TotalSupply LZChainId Decimals",
@@ -86,9 +156,8 @@ func RegisterAsset() *cobra.Command {
 				return err
 			}
 
-			sender := cliCtx.GetFromAddress()
 			msg := &restakingtype.RegisterAssetReq{
-				FromAddress: sender.String(),
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Info: &restakingtype.AssetInfo{
 					Name:     args[0],
 					Symbol:   args[1],
@@ -123,3 +192,217 @@ func RegisterAsset() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// UpdateAsset update the meta info and total supply of the registered asset
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
+func UpdateAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "UpdateAsset AssetID MetaInfo TotalSupply",
+		Short: "update the registered asset",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &restakingtype.UpdateAssetReq{
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AssetID:     strings.ToLower(args[0]),
+				MetaInfo:    args[1],
+			}
+			totalSupply, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[2]))
+			}
+			msg.TotalSupply = totalSupply
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeprecateAsset deprecate the registered asset or revert the deprecation
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
+func DeprecateAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "DeprecateAsset AssetID Deprecated",
+		Short: "deprecate the registered asset, the deprecated asset can't be deposited anymore",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deprecated, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			msg := &restakingtype.DeprecateAssetReq{
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				AssetID:     strings.ToLower(args[0]),
+				Deprecated:  deprecated,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetGuardian set the guardian who can pause the client chains and assets, an empty guardian removes it.
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
+func SetGuardian() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SetGuardian Guardian",
+		Short: "set the guardian who can pause the client chains and assets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &restakingtype.SetGuardianReq{
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Guardian:    args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// PauseClientChain pause the client chain or unpause it
+// The message is signed by the `--from` address, which should be the guardian to pause the client chain.
+// Otherwise, it should be the governance module address with `--generate-only`, then be submitted through a
+// governance proposal.
+func PauseClientChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "PauseClientChain clientChainLzID Paused",
+		Short: "pause the client chain, the messages from the paused client chain are rejected",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientChainLzID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[0]))
+			}
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			msg := &restakingtype.PauseClientChainReq{
+				FromAddress:      cliCtx.GetFromAddress().String(),
+				LayerZeroChainID: clientChainLzID,
+				Paused:           paused,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// PauseAsset pause the asset or unpause it
+// The message is signed by the `--from` address, which should be the guardian to pause the asset.
+// Otherwise, it should be the governance module address with `--generate-only`, then be submitted through a
+// governance proposal.
+func PauseAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "PauseAsset AssetID Paused",
+		Short: "pause the asset, the paused asset can't be deposited, delegated, undelegated or withdrawn",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			msg := &restakingtype.PauseAssetReq{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				AssetID:     strings.ToLower(args[0]),
+				Paused:      paused,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetWithdrawalOutflowCap set the per-block and per-epoch withdrawal outflow caps of the asset, zero means no limit.
+// The message can only be executed by the governance, so it should be generated by `--generate-only` and then
+// submitted through a governance proposal.
+func SetWithdrawalOutflowCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SetWithdrawalOutflowCap AssetID PerBlockCap PerEpochCap EpochIdentifier",
+		Short: "set the withdrawal outflow caps of the asset",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			perBlockCap, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[1]))
+			}
+			perEpochCap, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return errorsmod.Wrap(restakingtype.ErrCliCmdInputArg, fmt.Sprintf("error arg is:%v", args[2]))
+			}
+			msg := &restakingtype.SetWithdrawalOutflowCapReq{
+				FromAddress: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Cap: restakingtype.WithdrawalOutflowCap{
+					AssetID:         strings.ToLower(args[0]),
+					PerBlockCap:     perBlockCap,
+					PerEpochCap:     perEpochCap,
+					EpochIdentifier: args[3],
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,10 @@ package restaking_assets_manage // nolint: revive,stylecheck // Package naming t
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
@@ -27,6 +31,7 @@ func DefaultGenesisState() *restakingtype.GenesisState {
 		ChainId:            1,
 		FinalizationBlocks: 10,
 		LayerZeroChainID:   101,
+		SignatureType:      restakingtype.SignatureTypeEIP191,
 		AddressLength:      20,
 	}
 	usdtClientChainAsset := &restakingtype.AssetInfo{
@@ -56,19 +61,169 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 
 // ValidateGenesis performs basic validation of restaking_assets_manage genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(restakingtype.GenesisState) error {
-	// todo: check the validation of client chain and token info
+func ValidateGenesis(data restakingtype.GenesisState) error {
+	clientChains := make(map[uint64]*restakingtype.ClientChainInfo, len(data.DefaultSupportedClientChains))
+	for _, chain := range data.DefaultSupportedClientChains {
+		if err := chain.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := clientChains[chain.LayerZeroChainID]; ok {
+			return errorsmod.Wrap(restakingtype.ErrClientChainAlreadyExist, fmt.Sprintf("duplicated layerZero chain ID:%d", chain.LayerZeroChainID))
+		}
+		clientChains[chain.LayerZeroChainID] = chain
+	}
+
+	assets := make(map[string]struct{}, len(data.DefaultSupportedClientChainTokens))
+	for _, asset := range data.DefaultSupportedClientChainTokens {
+		if err := asset.ValidateBasic(); err != nil {
+			return err
+		}
+		chain, ok := clientChains[asset.LayerZeroChainID]
+		if !ok {
+			return errorsmod.Wrap(restakingtype.ErrNoClientChainKey, fmt.Sprintf("the layerZero chain ID of asset is:%d", asset.LayerZeroChainID))
+		}
+		if err := restakingtype.ValidateAssetAddress(asset, chain); err != nil {
+			return err
+		}
+		_, assetID := restakingtype.GetStakeIDAndAssetIDFromStr(asset.LayerZeroChainID, "", asset.Address)
+		if _, ok := assets[assetID]; ok {
+			return errorsmod.Wrap(restakingtype.ErrAssetAlreadyExist, fmt.Sprintf("duplicated assetID:%s", assetID))
+		}
+		assets[assetID] = struct{}{}
+	}
+
+	for _, assetID := range data.DeprecatedAssetIDs {
+		if _, ok := assets[assetID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the deprecated asset isn't registered:%s", assetID))
+		}
+	}
+
+	stakerStates := make(map[string]struct{}, len(data.StakerAssetStates))
+	for _, state := range data.StakerAssetStates {
+		if _, ok := assets[state.AssetID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the asset of staker isn't registered,stakerID:%s,assetID:%s", state.StakerID, state.AssetID))
+		}
+		_, stakerLzID, err := restakingtype.ParseID(state.StakerID)
+		if err != nil {
+			return err
+		}
+		_, assetLzID, err := restakingtype.ParseID(state.AssetID)
+		if err != nil {
+			return err
+		}
+		if stakerLzID != assetLzID {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the client chain of staker and asset are mismatched,stakerID:%s,assetID:%s", state.StakerID, state.AssetID))
+		}
+		key := string(restakingtype.GetAssetStateKey(state.StakerID, state.AssetID))
+		if _, ok := stakerStates[key]; ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated staker asset state:%s", key))
+		}
+		stakerStates[key] = struct{}{}
+
+		info := state.Info
+		if !isNonNegative(info.TotalDepositAmountOrWantChangeValue, info.CanWithdrawAmountOrWantChangeValue, info.WaitUndelegationAmountOrWantChangeValue) {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the staker asset amounts should be non-negative:%s", key))
+		}
+		if info.CanWithdrawAmountOrWantChangeValue.Add(info.WaitUndelegationAmountOrWantChangeValue).GT(info.TotalDepositAmountOrWantChangeValue) {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the canWithdraw and waitUndelegation amounts exceed the total deposit amount:%s", key))
+		}
+	}
+
+	operatorStates := make(map[string]struct{}, len(data.OperatorAssetStates))
+	for _, state := range data.OperatorAssetStates {
+		if _, err := sdk.AccAddressFromBech32(state.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address is invalid:%s", state.OperatorAddr))
+		}
+		if _, ok := assets[state.AssetID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the asset of operator isn't registered,operator:%s,assetID:%s", state.OperatorAddr, state.AssetID))
+		}
+		key := string(restakingtype.GetAssetStateKey(state.OperatorAddr, state.AssetID))
+		if _, ok := operatorStates[key]; ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated operator asset state:%s", key))
+		}
+		operatorStates[key] = struct{}{}
+
+		info := state.Info
+		if !isNonNegative(info.TotalAmountOrWantChangeValue, info.OperatorOwnAmountOrWantChangeValue, info.WaitUndelegationAmountOrWantChangeValue) {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the operator asset amounts should be non-negative:%s", key))
+		}
+		if info.WaitUndelegationAmountOrWantChangeValue.GT(info.TotalAmountOrWantChangeValue) ||
+			info.OperatorOwnAmountOrWantChangeValue.GT(info.TotalAmountOrWantChangeValue) {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the waitUndelegation or operatorOwn amount exceeds the total amount:%s", key))
+		}
+	}
+
+	lzNonces := make(map[uint64]struct{}, len(data.LzNonces))
+	for _, nonce := range data.LzNonces {
+		if _, ok := clientChains[nonce.LayerZeroChainID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrNoClientChainKey, fmt.Sprintf("the layerZero chain ID of nonce is:%d", nonce.LayerZeroChainID))
+		}
+		if _, ok := lzNonces[nonce.LayerZeroChainID]; ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated nonce of layerZero chain ID:%d", nonce.LayerZeroChainID))
+		}
+		lzNonces[nonce.LayerZeroChainID] = struct{}{}
+	}
+
+	for _, assetID := range data.PausedAssetIDs {
+		if _, ok := assets[assetID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the paused asset isn't registered:%s", assetID))
+		}
+	}
+	if data.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(data.Guardian); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the guardian address is invalid:%s", data.Guardian))
+		}
+	}
+	outflowCaps := make(map[string]struct{}, len(data.WithdrawalOutflowCaps))
+	for i := range data.WithdrawalOutflowCaps {
+		outflowCap := data.WithdrawalOutflowCaps[i]
+		if err := outflowCap.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := assets[outflowCap.AssetID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("the asset of withdrawal outflow cap isn't registered:%s", outflowCap.AssetID))
+		}
+		if _, ok := outflowCaps[outflowCap.AssetID]; ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated withdrawal outflow cap:%s", outflowCap.AssetID))
+		}
+		outflowCaps[outflowCap.AssetID] = struct{}{}
+	}
+
+	bindings := make(map[string]struct{}, len(data.ExoCoreAddrBindings))
+	for _, binding := range data.ExoCoreAddrBindings {
+		_, stakerLzID, err := restakingtype.ParseID(binding.StakerID)
+		if err != nil {
+			return err
+		}
+		if _, ok := clientChains[stakerLzID]; !ok {
+			return errorsmod.Wrap(restakingtype.ErrNoClientChainKey, fmt.Sprintf("the layerZero chain ID of the bound staker is:%d", stakerLzID))
+		}
+		if _, err := sdk.AccAddressFromBech32(binding.ExoCoreAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the bound exoCore address is invalid:%s", binding.ExoCoreAddr))
+		}
+		if _, ok := bindings[binding.StakerID]; ok {
+			return errorsmod.Wrap(restakingtype.ErrInvalidGenesisData, fmt.Sprintf("duplicated exoCore address binding:%s", binding.StakerID))
+		}
+		bindings[binding.StakerID] = struct{}{}
+	}
 	return nil
 }
 
+func isNonNegative(amounts ...math.Int) bool {
+	for _, amount := range amounts {
+		if amount.IsNil() || amount.IsNegative() {
+			return false
+		}
+	}
+	return true
+}
+
 // InitGenesis import module genesis
 func InitGenesis(
 	ctx sdk.Context,
 	k keeper.Keeper,
 	data restakingtype.GenesisState,
 ) {
-	// todo: might need to sort the clientChains and tokens before handling.
-
 	c := sdk.UnwrapSDKContext(ctx)
 	var err error
 	// save default supported client chain
@@ -79,33 +234,129 @@ func InitGenesis(
 		}
 	}
 	// save default supported client chain assets
+	deprecatedAssets := make(map[string]struct{}, len(data.DeprecatedAssetIDs))
+	for _, assetID := range data.DeprecatedAssetIDs {
+		deprecatedAssets[assetID] = struct{}{}
+	}
+	pausedAssets := make(map[string]struct{}, len(data.PausedAssetIDs))
+	for _, assetID := range data.PausedAssetIDs {
+		pausedAssets[assetID] = struct{}{}
+	}
 	for _, asset := range data.DefaultSupportedClientChainTokens {
+		_, assetID := restakingtype.GetStakeIDAndAssetIDFromStr(asset.LayerZeroChainID, "", asset.Address)
+		_, deprecated := deprecatedAssets[assetID]
+		_, paused := pausedAssets[assetID]
 		err = k.SetStakingAssetInfo(c, &restakingtype.StakingAssetInfo{
 			AssetBasicInfo:     asset,
 			StakingTotalAmount: math.NewInt(0),
+			Deprecated:         deprecated,
+			Paused:             paused,
 		})
 		if err != nil {
 			panic(err)
 		}
 	}
+
+	// the staking total amount of the asset is the sum of all stakers' deposits, so it's recovered from the staker states.
+	for _, state := range data.StakerAssetStates {
+		err = k.UpdateStakerAssetState(c, state.StakerID, state.AssetID, state.Info)
+		if err != nil {
+			panic(err)
+		}
+		err = k.UpdateStakingAssetTotalAmount(c, state.AssetID, state.Info.TotalDepositAmountOrWantChangeValue)
+		if err != nil {
+			panic(err)
+		}
+	}
+	for _, state := range data.OperatorAssetStates {
+		err = k.UpdateOperatorAssetState(c, sdk.MustAccAddressFromBech32(state.OperatorAddr), state.AssetID, state.Info)
+		if err != nil {
+			panic(err)
+		}
+	}
+	// the reverse indexes from the assets to the stakers and operators are built from the imported states
+	if err = k.BuildAssetIndexes(c); err != nil {
+		panic(err)
+	}
+	for _, nonce := range data.LzNonces {
+		k.SetLastLzNonce(c, nonce.LayerZeroChainID, nonce.LastNonce)
+	}
+	k.SetGuardianAddr(c, data.Guardian)
+	for i := range data.WithdrawalOutflowCaps {
+		err = k.SetAssetWithdrawalOutflowCap(c, &data.WithdrawalOutflowCaps[i])
+		if err != nil {
+			panic(err)
+		}
+	}
+	for i := range data.ExoCoreAddrBindings {
+		k.SetExoCoreAddrBinding(c, &data.ExoCoreAddrBindings[i])
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *restakingtype.GenesisState {
 	clientChainList := make([]*restakingtype.ClientChainInfo, 0)
 	c := sdk.UnwrapSDKContext(ctx)
-	clientChainInfo, _ := k.GetAllClientChainInfo(c)
+	clientChainInfo, err := k.GetAllClientChainInfo(c)
+	if err != nil {
+		panic(err)
+	}
 	for _, v := range clientChainInfo {
 		clientChainList = append(clientChainList, v)
 	}
+	// the infos are stored in maps, so they are sorted to make the exported genesis deterministic.
+	sort.Slice(clientChainList, func(i, j int) bool {
+		return clientChainList[i].LayerZeroChainID < clientChainList[j].LayerZeroChainID
+	})
 
+	clientChainAssets, err := k.GetAllStakingAssetsInfo(c)
+	if err != nil {
+		panic(err)
+	}
+	assetIDs := make([]string, 0, len(clientChainAssets))
+	for assetID := range clientChainAssets {
+		assetIDs = append(assetIDs, assetID)
+	}
+	sort.Strings(assetIDs)
 	clientChainAssetsList := make([]*restakingtype.AssetInfo, 0)
-	clientChainAssets, _ := k.GetAllStakingAssetsInfo(c)
-	for _, v := range clientChainAssets {
-		clientChainAssetsList = append(clientChainAssetsList, v.AssetBasicInfo)
+	deprecatedAssetIDs := make([]string, 0)
+	pausedAssetIDs := make([]string, 0)
+	for _, assetID := range assetIDs {
+		clientChainAssetsList = append(clientChainAssetsList, clientChainAssets[assetID].AssetBasicInfo)
+		if clientChainAssets[assetID].Deprecated {
+			deprecatedAssetIDs = append(deprecatedAssetIDs, assetID)
+		}
+		if clientChainAssets[assetID].Paused {
+			pausedAssetIDs = append(pausedAssetIDs, assetID)
+		}
+	}
+
+	stakerAssetStates, err := k.GetAllStakerAssetStates(c)
+	if err != nil {
+		panic(err)
+	}
+	operatorAssetStates, err := k.GetAllOperatorAssetStates(c)
+	if err != nil {
+		panic(err)
+	}
+	// the nonces are ordered by the key of chain index, so they are sorted again by the layerZero chain ID.
+	lzNonces, err := k.GetAllLzNonces(c)
+	if err != nil {
+		panic(err)
 	}
+	sort.Slice(lzNonces, func(i, j int) bool {
+		return lzNonces[i].LayerZeroChainID < lzNonces[j].LayerZeroChainID
+	})
 	return &restakingtype.GenesisState{
 		DefaultSupportedClientChains:      clientChainList,
 		DefaultSupportedClientChainTokens: clientChainAssetsList,
+		DeprecatedAssetIDs:                deprecatedAssetIDs,
+		StakerAssetStates:                 stakerAssetStates,
+		OperatorAssetStates:               operatorAssetStates,
+		LzNonces:                          lzNonces,
+		PausedAssetIDs:                    pausedAssetIDs,
+		Guardian:                          k.GetGuardianAddr(c),
+		WithdrawalOutflowCaps:             k.GetAllWithdrawalOutflowCaps(c),
+		ExoCoreAddrBindings:               k.GetAllExoCoreAddrBindings(c),
 	}
 }
@@ -1,11 +1,60 @@
 package keeper
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GetStakerExoCoreAddr outdated, will be deprecated.
-func (k Keeper) GetStakerExoCoreAddr(sdk.Context, string) (string, error) {
-	// TODO implement me
-	panic("implement me")
+// GetStakerExoCoreAddr returns the exoCore address bound to the staker through SetStakerExoCoreAddr.
+func (k Keeper) GetStakerExoCoreAddr(ctx sdk.Context, stakerID string) (string, error) {
+	binding := k.GetExoCoreAddrBinding(ctx, stakerID)
+	if binding == nil {
+		return "", errorsmod.Wrap(restakingtype.ErrNoStakerExoCoreAddr, fmt.Sprintf("the stakerID is:%s", stakerID))
+	}
+	return binding.ExoCoreAddr, nil
+}
+
+// GetExoCoreAddrBinding returns the binding of the staker, it's nil if the staker hasn't been bound.
+func (k Keeper) GetExoCoreAddrBinding(ctx sdk.Context, stakerID string) *restakingtype.ExoCoreAddrBinding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerExoCoreAddr)
+	value := store.Get([]byte(stakerID))
+	if value == nil {
+		return nil
+	}
+	binding := restakingtype.ExoCoreAddrBinding{}
+	k.cdc.MustUnmarshal(value, &binding)
+	return &binding
+}
+
+// SetExoCoreAddrBinding saves the binding of the staker and updates the reverse index from the exoCore address
+// to the stakers, the reverse index of the previously bound exoCore address is removed.
+func (k Keeper) SetExoCoreAddrBinding(ctx sdk.Context, binding *restakingtype.ExoCoreAddrBinding) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerExoCoreAddrReverse)
+	if oldBinding := k.GetExoCoreAddrBinding(ctx, binding.StakerID); oldBinding != nil {
+		indexStore.Delete(restakingtype.GetAssetStateKey(oldBinding.ExoCoreAddr, binding.StakerID))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerExoCoreAddr)
+	bz := k.cdc.MustMarshal(binding)
+	store.Set([]byte(binding.StakerID), bz)
+	indexStore.Set(restakingtype.GetAssetStateKey(binding.ExoCoreAddr, binding.StakerID), []byte{})
+}
+
+// GetAllExoCoreAddrBindings returns the bindings of all stakers ordered by the stakerID, it's used to export the genesis state.
+func (k Keeper) GetAllExoCoreAddrBindings(ctx sdk.Context) []restakingtype.ExoCoreAddrBinding {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerExoCoreAddr)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]restakingtype.ExoCoreAddrBinding, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var binding restakingtype.ExoCoreAddrBinding
+		k.cdc.MustUnmarshal(iterator.Value(), &binding)
+		ret = append(ret, binding)
+	}
+	return ret
 }
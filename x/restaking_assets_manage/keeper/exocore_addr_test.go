@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// signBinding signs the binding message by the staker's key as the `personal_sign` of the wallets
+func (suite *KeeperTestSuite) signBinding(stakerID, exoCoreAddr string, nonce uint64) string {
+	msg := types.GetExoCoreAddrBindingMsg(suite.ctx.ChainID(), stakerID, exoCoreAddr, nonce)
+	sig, _, err := suite.signer.Sign("", accounts.TextHash(msg))
+	suite.NoError(err)
+	// the wallets return the recovery ID as 27 or 28
+	sig[len(sig)-1] += 27
+	return hexutil.Encode(sig)
+}
+
+func (suite *KeeperTestSuite) TestSetStakerExoCoreAddr() {
+	keeper := suite.app.StakingAssetsManageKeeper
+	exoCoreAddr := sdk.AccAddress(common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").Bytes()).String()
+	anotherExoCoreAddr := sdk.AccAddress(suite.address.Bytes()).String()
+	clientChainAddr := hexutil.Encode(suite.address.Bytes())
+	stakerID, _ := types.GetStakeIDAndAssetIDFromStr(101, clientChainAddr, "")
+	msg := &types.MsgSetExoCoreAddr{
+		FromAddress:                exoCoreAddr,
+		SetAddress:                 exoCoreAddr,
+		ClientChainAddr:            clientChainAddr,
+		ClientChainIndex:           101,
+		StakerClientChainSignature: suite.signBinding(stakerID, anotherExoCoreAddr, 0),
+	}
+
+	// the signature should be signed for the bound exoCore address
+	_, err := keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidClientChainSignature)
+	msg.StakerClientChainSignature = suite.signBinding(stakerID, exoCoreAddr, 0)
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.NoError(err)
+	addr, err := keeper.GetStakerExoCoreAddr(suite.ctx, stakerID)
+	suite.NoError(err)
+	suite.Equal(exoCoreAddr, addr)
+
+	// the staker can only be bound once without the rebind flag
+	msg.FromAddress = anotherExoCoreAddr
+	msg.SetAddress = anotherExoCoreAddr
+	msg.StakerClientChainSignature = suite.signBinding(stakerID, anotherExoCoreAddr, 1)
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrStakerExoCoreAddrAlreadyBound)
+
+	// the signature of the previous binding can't be replayed
+	msg.Rebind = true
+	msg.StakerClientChainSignature = suite.signBinding(stakerID, anotherExoCoreAddr, 0)
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrInvalidClientChainSignature)
+	msg.StakerClientChainSignature = suite.signBinding(stakerID, anotherExoCoreAddr, 1)
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.NoError(err)
+
+	// the reverse index is moved to the new exoCore address
+	res, err := keeper.QueExoCoreAddrStakers(suite.ctx, &types.QueryExoCoreAddrStakersReq{ExoCoreAddr: exoCoreAddr})
+	suite.NoError(err)
+	suite.Empty(res.StakerIDs)
+	res, err = keeper.QueExoCoreAddrStakers(suite.ctx, &types.QueryExoCoreAddrStakersReq{ExoCoreAddr: anotherExoCoreAddr})
+	suite.NoError(err)
+	suite.Equal([]string{stakerID}, res.StakerIDs)
+	addrRes, err := keeper.QueStakerExoCoreAddr(suite.ctx, &types.QueryStakerExCoreAddr{StakerID: stakerID})
+	suite.NoError(err)
+	suite.Equal(anotherExoCoreAddr, addrRes.ExCoreAddr)
+	suite.Equal([]types.ExoCoreAddrBinding{{StakerID: stakerID, ExoCoreAddr: anotherExoCoreAddr, Nonce: 2}}, keeper.GetAllExoCoreAddrBindings(suite.ctx))
+
+	// the exoCore address to be bound should sign the tx
+	msg.FromAddress = exoCoreAddr
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrMismatchedExoCoreAddr)
+
+	// the signature type of the client chain should be supported
+	info, err := keeper.GetClientChainInfoByIndex(suite.ctx, 101)
+	suite.NoError(err)
+	info.SignatureType = ""
+	suite.NoError(keeper.SetClientChainInfo(suite.ctx, info))
+	msg.FromAddress = anotherExoCoreAddr
+	msg.StakerClientChainSignature = suite.signBinding(stakerID, anotherExoCoreAddr, 2)
+	_, err = keeper.SetStakerExoCoreAddr(suite.ctx, msg)
+	suite.ErrorIs(err, types.ErrUnsupportedSignatureType)
+}
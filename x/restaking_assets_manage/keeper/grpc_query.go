@@ -4,7 +4,9 @@ import (
 	"context"
 
 	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -82,8 +84,11 @@ func (k Keeper) QueOperatorSpecifiedAssetAmount(ctx context.Context, req *restak
 	return k.GetOperatorSpecifiedAssetInfo(c, addr, req.AssetID)
 }
 
-// QueStakerExoCoreAddr outdated,will be deprecated
+// QueStakerExoCoreAddr query the exoCore address bound to the staker.
 func (k Keeper) QueStakerExoCoreAddr(ctx context.Context, req *restakingtype.QueryStakerExCoreAddr) (*restakingtype.QueryStakerExCoreAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 	c := sdk.UnwrapSDKContext(ctx)
 	exoCoreAddr, err := k.GetStakerExoCoreAddr(c, req.StakerID)
 	if err != nil {
@@ -91,3 +96,216 @@ func (k Keeper) QueStakerExoCoreAddr(ctx context.Context, req *restakingtype.Que
 	}
 	return &restakingtype.QueryStakerExCoreAddrResponse{ExCoreAddr: exoCoreAddr}, nil
 }
+
+// QueExoCoreAddrStakers query the stakers bound to the exoCore address by page, they are ordered by stakerID.
+func (k Keeper) QueExoCoreAddrStakers(ctx context.Context, req *restakingtype.QueryExoCoreAddrStakersReq) (*restakingtype.QueryExoCoreAddrStakersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := restakingtype.GetAssetStateIteratorPrefix(req.ExoCoreAddr)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(restakingtype.KeyPrefixReStakerExoCoreAddrReverse, prefixKey...))
+	stakerIDs := make([]string, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		stakerIDs = append(stakerIDs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryExoCoreAddrStakersResponse{StakerIDs: stakerIDs, Pagination: pageRes}, nil
+}
+
+// QueClientChainInfoList query the registered client chains by page, they are ordered by the store key.
+func (k Keeper) QueClientChainInfoList(ctx context.Context, req *restakingtype.QueryClientChainInfoListReq) (*restakingtype.QueryClientChainInfoListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(c.KVStore(k.storeKey), restakingtype.KeyPrefixClientChainInfo)
+	infos := make([]*restakingtype.ClientChainInfo, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var info restakingtype.ClientChainInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, &info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryClientChainInfoListResponse{ClientChainInfos: infos, Pagination: pageRes}, nil
+}
+
+// QueStakingAssetInfoList query the registered client chain assets by page, they are ordered by assetID.
+func (k Keeper) QueStakingAssetInfoList(ctx context.Context, req *restakingtype.QueryStakingAssetInfoListReq) (*restakingtype.QueryStakingAssetInfoListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(c.KVStore(k.storeKey), restakingtype.KeyPrefixReStakingAssetInfo)
+	infos := make([]*restakingtype.StakingAssetInfo, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var info restakingtype.StakingAssetInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		infos = append(infos, &info)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryStakingAssetInfoListResponse{StakingAssetInfos: infos, Pagination: pageRes}, nil
+}
+
+// QueStakerAssetList query the asset states of a staker by page, they are ordered by assetID.
+func (k Keeper) QueStakerAssetList(ctx context.Context, req *restakingtype.QueryStakerAssetListReq) (*restakingtype.QueryStakerAssetStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := restakingtype.GetAssetStateIteratorPrefix(req.StakerID)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(restakingtype.KeyPrefixReStakerAssetInfos, prefixKey...))
+	states := make([]restakingtype.StakerAssetState, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var info restakingtype.StakerSingleAssetOrChangeInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		states = append(states, restakingtype.StakerAssetState{StakerID: req.StakerID, AssetID: string(key), Info: info})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryStakerAssetStatesResponse{States: states, Pagination: pageRes}, nil
+}
+
+// QueOperatorAssetList query the asset states of an operator by page, they are ordered by assetID.
+func (k Keeper) QueOperatorAssetList(ctx context.Context, req *restakingtype.QueryOperatorAssetListReq) (*restakingtype.QueryOperatorAssetStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	if _, err := sdk.AccAddressFromBech32(req.OperatorAddr); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	prefixKey := restakingtype.GetAssetStateIteratorPrefix(req.OperatorAddr)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(restakingtype.KeyPrefixOperatorAssetInfos, prefixKey...))
+	states := make([]restakingtype.OperatorAssetState, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var info restakingtype.OperatorSingleAssetOrChangeInfo
+		if err := k.cdc.Unmarshal(value, &info); err != nil {
+			return err
+		}
+		states = append(states, restakingtype.OperatorAssetState{OperatorAddr: req.OperatorAddr, AssetID: string(key), Info: info})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryOperatorAssetStatesResponse{States: states, Pagination: pageRes}, nil
+}
+
+// QueAssetStakers query the stakers holding the specified asset by page, they are ordered by stakerID.
+func (k Keeper) QueAssetStakers(ctx context.Context, req *restakingtype.QueryAssetStakersReq) (*restakingtype.QueryStakerAssetStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := restakingtype.GetAssetStateIteratorPrefix(req.AssetID)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(restakingtype.KeyPrefixAssetStakers, prefixKey...))
+	states := make([]restakingtype.StakerAssetState, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		stakerID := string(key)
+		info, err := k.GetStakerSpecifiedAssetInfo(c, stakerID, req.AssetID)
+		if err != nil {
+			return err
+		}
+		states = append(states, restakingtype.StakerAssetState{StakerID: stakerID, AssetID: req.AssetID, Info: *info})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryStakerAssetStatesResponse{States: states, Pagination: pageRes}, nil
+}
+
+// QueAssetOperators query the operators holding the specified asset and their asset states by page,
+// they are ordered by the operator address.
+func (k Keeper) QueAssetOperators(ctx context.Context, req *restakingtype.QueryAssetOperatorsReq) (*restakingtype.QueryOperatorAssetStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+
+	prefixKey := restakingtype.GetAssetStateIteratorPrefix(req.AssetID)
+	store := prefix.NewStore(c.KVStore(k.storeKey), append(restakingtype.KeyPrefixAssetOperators, prefixKey...))
+	states := make([]restakingtype.OperatorAssetState, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		operatorAddr, err := sdk.AccAddressFromBech32(string(key))
+		if err != nil {
+			return err
+		}
+		info, err := k.GetOperatorSpecifiedAssetInfo(c, operatorAddr, req.AssetID)
+		if err != nil {
+			return err
+		}
+		states = append(states, restakingtype.OperatorAssetState{OperatorAddr: string(key), AssetID: req.AssetID, Info: *info})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &restakingtype.QueryOperatorAssetStatesResponse{States: states, Pagination: pageRes}, nil
+}
+
+// QueLzNonce query the last processed LayerZero nonce of the client chain, the relayer can resume from the next nonce.
+func (k Keeper) QueLzNonce(ctx context.Context, req *restakingtype.QueryLzNonceReq) (*restakingtype.QueryLzNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	info, err := k.GetClientChainInfoByIndex(c, req.ClientChainLzID)
+	if err != nil {
+		return nil, err
+	}
+	return &restakingtype.QueryLzNonceResponse{
+		LastNonce:   k.GetLastLzNonce(c, req.ClientChainLzID),
+		GapTolerant: info.LzNonceGapTolerant,
+	}, nil
+}
+
+// QueWithdrawalOutflow query the withdrawal outflow cap and the withdrawn amount of the asset in the current block and epoch.
+func (k Keeper) QueWithdrawalOutflow(ctx context.Context, req *restakingtype.QueryWithdrawalOutflowReq) (*restakingtype.QueryWithdrawalOutflowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	if !k.IsStakingAsset(c, req.AssetID) {
+		return nil, restakingtype.ErrNoClientChainAssetKey
+	}
+	return &restakingtype.QueryWithdrawalOutflowResponse{
+		Cap:     k.GetWithdrawalOutflowCap(c, req.AssetID),
+		Outflow: k.GetWithdrawalOutflow(c, req.AssetID),
+	}, nil
+}
+
+// QueGuardian query the guardian who can pause the client chains and assets.
+func (k Keeper) QueGuardian(ctx context.Context, req *restakingtype.QueryGuardianReq) (*restakingtype.QueryGuardianResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	c := sdk.UnwrapSDKContext(ctx)
+	return &restakingtype.QueryGuardianResponse{
+		Guardian: k.GetGuardianAddr(c),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperTestSuite) TestListQueries() {
+	usdtAssetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	uniAssetID := "0x1f9840a85d5af5bf1d1762f925bdaddc4201f984_0x65"
+	// the second stakerID has the first one as its prefix
+	stakerIDs := []string{suite.address.String() + "_0x6", suite.address.String() + "_0x65"}
+	for _, stakerID := range stakerIDs {
+		err := suite.app.StakingAssetsManageKeeper.UpdateStakerAssetState(suite.ctx, stakerID, usdtAssetID, restakingtype.StakerSingleAssetOrChangeInfo{
+			TotalDepositAmountOrWantChangeValue: math.NewInt(100),
+			CanWithdrawAmountOrWantChangeValue:  math.NewInt(100),
+		})
+		suite.NoError(err)
+	}
+	err := suite.app.StakingAssetsManageKeeper.UpdateStakerAssetState(suite.ctx, stakerIDs[0], uniAssetID, restakingtype.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: math.NewInt(50),
+		CanWithdrawAmountOrWantChangeValue:  math.NewInt(50),
+	})
+	suite.NoError(err)
+
+	// the client chains and assets are listed by page
+	chainRes, err := suite.app.StakingAssetsManageKeeper.QueClientChainInfoList(suite.ctx, &restakingtype.QueryClientChainInfoListReq{})
+	suite.NoError(err)
+	allChains, err := suite.app.StakingAssetsManageKeeper.GetAllClientChainInfo(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(len(allChains), len(chainRes.ClientChainInfos))
+	assetRes, err := suite.app.StakingAssetsManageKeeper.QueStakingAssetInfoList(suite.ctx, &restakingtype.QueryStakingAssetInfoListReq{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(assetRes.StakingAssetInfos))
+	allAssets, err := suite.app.StakingAssetsManageKeeper.GetAllStakingAssetsInfo(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(uint64(len(allAssets)), assetRes.Pagination.Total)
+
+	// the asset states of the staker are ordered by assetID
+	stakerRes, err := suite.app.StakingAssetsManageKeeper.QueStakerAssetList(suite.ctx, &restakingtype.QueryStakerAssetListReq{StakerID: stakerIDs[0]})
+	suite.NoError(err)
+	suite.Equal(2, len(stakerRes.States))
+	suite.Equal(uniAssetID, stakerRes.States[0].AssetID)
+	suite.Equal(usdtAssetID, stakerRes.States[1].AssetID)
+	suite.Equal(math.NewInt(100), stakerRes.States[1].Info.TotalDepositAmountOrWantChangeValue)
+
+	stakerRes, err = suite.app.StakingAssetsManageKeeper.QueStakerAssetList(suite.ctx, &restakingtype.QueryStakerAssetListReq{
+		StakerID:   stakerIDs[0],
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(stakerRes.States))
+	suite.Equal(uniAssetID, stakerRes.States[0].AssetID)
+	stakerRes, err = suite.app.StakingAssetsManageKeeper.QueStakerAssetList(suite.ctx, &restakingtype.QueryStakerAssetListReq{
+		StakerID:   stakerIDs[0],
+		Pagination: &query.PageRequest{Key: stakerRes.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Equal(1, len(stakerRes.States))
+	suite.Equal(usdtAssetID, stakerRes.States[0].AssetID)
+
+	assetStakers, err := suite.app.StakingAssetsManageKeeper.QueAssetStakers(suite.ctx, &restakingtype.QueryAssetStakersReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(2, len(assetStakers.States))
+	suite.Equal(stakerIDs[0], assetStakers.States[0].StakerID)
+	suite.Equal(stakerIDs[1], assetStakers.States[1].StakerID)
+
+	// the staker is removed from the asset stakers when its total deposit amount is zero
+	err = suite.app.StakingAssetsManageKeeper.UpdateStakerAssetState(suite.ctx, stakerIDs[0], usdtAssetID, restakingtype.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: math.NewInt(-100),
+		CanWithdrawAmountOrWantChangeValue:  math.NewInt(-100),
+	})
+	suite.NoError(err)
+	assetStakers, err = suite.app.StakingAssetsManageKeeper.QueAssetStakers(suite.ctx, &restakingtype.QueryAssetStakersReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(1, len(assetStakers.States))
+	suite.Equal(stakerIDs[1], assetStakers.States[0].StakerID)
+
+	// the operators holding the asset
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	err = suite.app.StakingAssetsManageKeeper.UpdateOperatorAssetState(suite.ctx, opAccAddr, usdtAssetID, restakingtype.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: math.NewInt(60),
+	})
+	suite.NoError(err)
+	operatorRes, err := suite.app.StakingAssetsManageKeeper.QueOperatorAssetList(suite.ctx, &restakingtype.QueryOperatorAssetListReq{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(1, len(operatorRes.States))
+	suite.Equal(usdtAssetID, operatorRes.States[0].AssetID)
+	assetOperators, err := suite.app.StakingAssetsManageKeeper.QueAssetOperators(suite.ctx, &restakingtype.QueryAssetOperatorsReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(1, len(assetOperators.States))
+	suite.Equal(opAccAddr.String(), assetOperators.States[0].OperatorAddr)
+	suite.Equal(math.NewInt(60), assetOperators.States[0].Info.TotalAmountOrWantChangeValue)
+
+	err = suite.app.StakingAssetsManageKeeper.UpdateOperatorAssetState(suite.ctx, opAccAddr, usdtAssetID, restakingtype.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: math.NewInt(-60),
+	})
+	suite.NoError(err)
+	assetOperators, err = suite.app.StakingAssetsManageKeeper.QueAssetOperators(suite.ctx, &restakingtype.QueryAssetOperatorsReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(0, len(assetOperators.States))
+
+	_, err = suite.app.StakingAssetsManageKeeper.QueOperatorAssetList(suite.ctx, &restakingtype.QueryOperatorAssetListReq{OperatorAddr: "invalid"})
+	suite.Error(err)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/evmos/evmos/v14/x/epochs/types"
+)
+
+// Hooks wrapper struct for restaking_assets_manage keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart is a noop
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
+}
+
+// AfterEpochEnd resets the per-epoch withdrawal outflows of the assets whose caps use the ended epoch.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	h.k.resetEpochWithdrawalOutflows(ctx, epochIdentifier)
+}
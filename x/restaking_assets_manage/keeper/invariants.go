@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all restaking_assets_manage invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(restakingtype.ModuleName, "staking-total-amount", StakingTotalAmountInvariant(k))
+	ir.RegisterRoute(restakingtype.ModuleName, "staker-asset-amounts", StakerAssetAmountsInvariant(k))
+	ir.RegisterRoute(restakingtype.ModuleName, "operator-asset-amounts", OperatorAssetAmountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the restaking_assets_manage module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := StakingTotalAmountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = StakerAssetAmountsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return OperatorAssetAmountsInvariant(k)(ctx)
+	}
+}
+
+// StakingTotalAmountInvariant checks that the staking total amount of every asset equals
+// the sum of the total deposit amounts of all stakers.
+func StakingTotalAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		assets, err := k.GetAllStakingAssetsInfo(ctx)
+		if err != nil {
+			panic(err)
+		}
+		stakerStates, err := k.GetAllStakerAssetStates(ctx)
+		if err != nil {
+			panic(err)
+		}
+		deposited := make(map[string]sdkmath.Int, len(assets))
+		for _, state := range stakerStates {
+			if _, ok := deposited[state.AssetID]; !ok {
+				deposited[state.AssetID] = sdkmath.NewInt(0)
+			}
+			deposited[state.AssetID] = deposited[state.AssetID].Add(state.Info.TotalDepositAmountOrWantChangeValue)
+		}
+		assetIDs := make([]string, 0, len(assets))
+		for assetID := range assets {
+			assetIDs = append(assetIDs, assetID)
+		}
+		sort.Strings(assetIDs)
+		for _, assetID := range assetIDs {
+			info := assets[assetID]
+			amount, ok := deposited[assetID]
+			if !ok {
+				amount = sdkmath.NewInt(0)
+			}
+			if !info.StakingTotalAmount.Equal(amount) {
+				broken = true
+				msg += fmt.Sprintf("\tasset:%s,stakingTotalAmount:%s,sum of deposits:%s\n", assetID, info.StakingTotalAmount, amount)
+			}
+			delete(deposited, assetID)
+		}
+		// the remaining assets aren't registered, they are checked in the order of staker states
+		for _, state := range stakerStates {
+			if amount, ok := deposited[state.AssetID]; ok {
+				broken = true
+				msg += fmt.Sprintf("\tthe deposited asset isn't registered:%s,sum of deposits:%s\n", state.AssetID, amount)
+				delete(deposited, state.AssetID)
+			}
+		}
+		return sdk.FormatInvariant(restakingtype.ModuleName, "staking-total-amount", msg), broken
+	}
+}
+
+// StakerAssetAmountsInvariant checks that the canWithdraw and waitUndelegation amounts of every staker asset
+// don't exceed its total deposit amount.
+func StakerAssetAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		stakerStates, err := k.GetAllStakerAssetStates(ctx)
+		if err != nil {
+			panic(err)
+		}
+		for _, state := range stakerStates {
+			info := state.Info
+			if info.CanWithdrawAmountOrWantChangeValue.Add(info.WaitUndelegationAmountOrWantChangeValue).GT(info.TotalDepositAmountOrWantChangeValue) {
+				broken = true
+				msg += fmt.Sprintf("\tstaker:%s,asset:%s,totalDeposit:%s,canWithdraw:%s,waitUndelegation:%s\n",
+					state.StakerID, state.AssetID, info.TotalDepositAmountOrWantChangeValue, info.CanWithdrawAmountOrWantChangeValue, info.WaitUndelegationAmountOrWantChangeValue)
+			}
+		}
+		return sdk.FormatInvariant(restakingtype.ModuleName, "staker-asset-amounts", msg), broken
+	}
+}
+
+// OperatorAssetAmountsInvariant checks that the waitUndelegation amount of every operator asset
+// doesn't exceed its total amount.
+func OperatorAssetAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		operatorStates, err := k.GetAllOperatorAssetStates(ctx)
+		if err != nil {
+			panic(err)
+		}
+		for _, state := range operatorStates {
+			info := state.Info
+			if info.WaitUndelegationAmountOrWantChangeValue.GT(info.TotalAmountOrWantChangeValue) {
+				broken = true
+				msg += fmt.Sprintf("\toperator:%s,asset:%s,total:%s,waitUndelegation:%s\n",
+					state.OperatorAddr, state.AssetID, info.TotalAmountOrWantChangeValue, info.WaitUndelegationAmountOrWantChangeValue)
+			}
+		}
+		return sdk.FormatInvariant(restakingtype.ModuleName, "operator-asset-amounts", msg), broken
+	}
+}
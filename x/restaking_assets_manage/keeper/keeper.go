@@ -12,18 +12,31 @@ import (
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
+	// the address capable of registering the client chains and assets, it should be the gov module account.
+	authority string
 }
 
 func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
+	authority string,
 ) Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
 	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetAllOperatorAssetOptedInMiddleWare This function should be implemented in the operator opt-in module
 func (k Keeper) GetAllOperatorAssetOptedInMiddleWare(sdk.Address) (optedInInfos map[string][]sdk.Address, err error) {
 	// TODO implement me
@@ -54,7 +67,7 @@ type IRestakingAssetsManage interface {
 	GetOperatorSpecifiedAssetInfo(ctx sdk.Context, operatorAddr sdk.Address, assetID string) (info *restakingtype.OperatorSingleAssetOrChangeInfo, err error)
 	UpdateOperatorAssetState(ctx sdk.Context, operatorAddr sdk.Address, assetID string, changeAmount restakingtype.OperatorSingleAssetOrChangeInfo) (err error)
 
-	// SetStakerExoCoreAddr handle the SetStakerExoCoreAddr txs from msg service
+	// SetStakerExoCoreAddr handle the SetStakerExoCoreAddr txs from msg service, the binding is verified by the staker's signature
 	SetStakerExoCoreAddr(ctx context.Context, addr *restakingtype.MsgSetExoCoreAddr) (*restakingtype.MsgSetExoCoreAddrResponse, error)
 	GetStakerExoCoreAddr(ctx sdk.Context, stakerID string) (string, error)
 
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GetLastLzNonce returns the last processed LayerZero nonce of the client chain, it's zero if no message has been processed.
+func (k Keeper) GetLastLzNonce(ctx sdk.Context, clientChainLzID uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixClientChainLzNonce)
+	value := store.Get([]byte(hexutil.EncodeUint64(clientChainLzID)))
+	if value == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(value)
+}
+
+func (k Keeper) SetLastLzNonce(ctx sdk.Context, clientChainLzID, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixClientChainLzNonce)
+	store.Set([]byte(hexutil.EncodeUint64(clientChainLzID)), sdk.Uint64ToBigEndian(nonce))
+}
+
+// CheckAndUpdateLzNonce protects the cross-chain messages from being replayed. The nonce should be the last
+// processed nonce plus one, or only be greater than the last processed nonce if the client chain is gap-tolerant.
+// The nonce is recorded as the last processed nonce if the check passes, so the caller should revert the state
+// if the message fails to be processed. The messages from a paused client chain are rejected before the nonce
+// is checked, so they can be processed again once the client chain is unpaused.
+func (k Keeper) CheckAndUpdateLzNonce(ctx sdk.Context, clientChainLzID, nonce uint64) error {
+	info, err := k.GetClientChainInfoByIndex(ctx, clientChainLzID)
+	if err != nil {
+		return err
+	}
+	if info.Paused {
+		return errorsmod.Wrap(restakingtype.ErrClientChainPaused, fmt.Sprintf("clientChainLzID:%d,nonce:%d", clientChainLzID, nonce))
+	}
+	lastNonce := k.GetLastLzNonce(ctx, clientChainLzID)
+	if nonce <= lastNonce {
+		return errorsmod.Wrap(restakingtype.ErrDuplicateLzNonce, fmt.Sprintf("clientChainLzID:%d,nonce:%d,lastNonce:%d", clientChainLzID, nonce, lastNonce))
+	}
+	if !info.LzNonceGapTolerant && nonce != lastNonce+1 {
+		return errorsmod.Wrap(restakingtype.ErrUnorderedLzNonce, fmt.Sprintf("clientChainLzID:%d,nonce:%d,expected:%d", clientChainLzID, nonce, lastNonce+1))
+	}
+	k.SetLastLzNonce(ctx, clientChainLzID, nonce)
+	return nil
+}
+
+// GetAllLzNonces returns the last processed nonces of all client chains, it's used to export the genesis state.
+func (k Keeper) GetAllLzNonces(ctx sdk.Context) ([]restakingtype.ClientChainLzNonce, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixClientChainLzNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]restakingtype.ClientChainLzNonce, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		clientChainLzID, err := hexutil.DecodeUint64(string(iterator.Key()))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, restakingtype.ClientChainLzNonce{
+			LayerZeroChainID: clientChainLzID,
+			LastNonce:        sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return ret, nil
+}
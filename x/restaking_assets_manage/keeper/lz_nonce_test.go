@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+)
+
+func (suite *KeeperTestSuite) TestLzNonce() {
+	clientChainLzID := uint64(101)
+	keeper := suite.app.StakingAssetsManageKeeper
+
+	// the nonce of the unregistered client chain can't be processed
+	err := keeper.CheckAndUpdateLzNonce(suite.ctx, 102, 1)
+	suite.ErrorIs(err, types.ErrNoClientChainKey)
+
+	// the nonces should be processed in order
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 2)
+	suite.ErrorIs(err, types.ErrUnorderedLzNonce)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 1)
+	suite.NoError(err)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 1)
+	suite.ErrorIs(err, types.ErrDuplicateLzNonce)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 2)
+	suite.NoError(err)
+
+	res, err := keeper.QueLzNonce(suite.ctx, &types.QueryLzNonceReq{ClientChainLzID: clientChainLzID})
+	suite.NoError(err)
+	suite.Equal(types.QueryLzNonceResponse{LastNonce: 2, GapTolerant: false}, *res)
+
+	// the gap is allowed if the client chain is gap-tolerant, but the old nonces are still rejected
+	info, err := keeper.GetClientChainInfoByIndex(suite.ctx, clientChainLzID)
+	suite.NoError(err)
+	info.LzNonceGapTolerant = true
+	err = keeper.SetClientChainInfo(suite.ctx, info)
+	suite.NoError(err)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 5)
+	suite.NoError(err)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, clientChainLzID, 4)
+	suite.ErrorIs(err, types.ErrDuplicateLzNonce)
+
+	res, err = keeper.QueLzNonce(suite.ctx, &types.QueryLzNonceReq{ClientChainLzID: clientChainLzID})
+	suite.NoError(err)
+	suite.Equal(types.QueryLzNonceResponse{LastNonce: 5, GapTolerant: true}, *res)
+
+	nonces, err := keeper.GetAllLzNonces(suite.ctx)
+	suite.NoError(err)
+	suite.Equal([]types.ClientChainLzNonce{{LayerZeroChainID: clientChainLzID, LastNonce: 5}}, nonces)
+}
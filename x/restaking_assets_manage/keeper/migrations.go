@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	v2 "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/migrations/v2"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2, the legacy exoCore address bindings are removed
+// and the reverse indexes from the assets to their stakers and operators are built.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	return m.keeper.BuildAssetIndexes(ctx)
+}
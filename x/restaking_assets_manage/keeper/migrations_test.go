@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func (suite *KeeperTestSuite) TestMigrateExoCoreAddrBindings() {
+	exoCoreAddr := sdk.AccAddress(suite.address.Bytes()).String()
+	clientChainAddr := common.HexToAddress("0x3e108c058e8066da635321dc3018294ca82ddedf").Hex()
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixReStakerExoCoreAddr)
+
+	// the legacy binding was stored under the raw client chain address without any verification
+	legacyKey := []byte(strings.Join([]string{clientChainAddr, hexutil.EncodeUint64(101)}, "_"))
+	legacy := &types.MsgSetExoCoreAddr{
+		FromAddress:      exoCoreAddr,
+		SetAddress:       exoCoreAddr,
+		ClientChainAddr:  clientChainAddr,
+		ClientChainIndex: 101,
+	}
+	store.Set(legacyKey, suite.app.AppCodec().MustMarshal(legacy))
+
+	stakerID, _ := types.GetStakeIDAndAssetIDFromStr(101, hexutil.Encode(suite.address.Bytes()), "")
+	binding := &types.ExoCoreAddrBinding{
+		StakerID:    stakerID,
+		ExoCoreAddr: exoCoreAddr,
+		Nonce:       1,
+	}
+	suite.app.StakingAssetsManageKeeper.SetExoCoreAddrBinding(suite.ctx, binding)
+
+	m := keeper.NewMigrator(suite.app.StakingAssetsManageKeeper)
+	suite.NoError(m.Migrate1to2(suite.ctx))
+	suite.False(store.Has(legacyKey))
+	suite.Equal([]types.ExoCoreAddrBinding{*binding}, suite.app.StakingAssetsManageKeeper.GetAllExoCoreAddrBindings(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigrateAssetIndexes() {
+	usdtAssetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	stakerID := suite.address.String() + "_0x65"
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	err = suite.app.StakingAssetsManageKeeper.UpdateStakerAssetState(suite.ctx, stakerID, usdtAssetID, types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: math.NewInt(100),
+		CanWithdrawAmountOrWantChangeValue:  math.NewInt(100),
+	})
+	suite.NoError(err)
+	err = suite.app.StakingAssetsManageKeeper.UpdateOperatorAssetState(suite.ctx, opAccAddr, usdtAssetID, types.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: math.NewInt(60),
+	})
+	suite.NoError(err)
+
+	// the asset states stored before the indexes were introduced aren't indexed
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	prefix.NewStore(store, types.KeyPrefixAssetStakers).Delete(types.GetAssetStateKey(usdtAssetID, stakerID))
+	prefix.NewStore(store, types.KeyPrefixAssetOperators).Delete(types.GetAssetStateKey(usdtAssetID, opAccAddr.String()))
+	assetStakers, err := suite.app.StakingAssetsManageKeeper.QueAssetStakers(suite.ctx, &types.QueryAssetStakersReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(0, len(assetStakers.States))
+
+	m := keeper.NewMigrator(suite.app.StakingAssetsManageKeeper)
+	suite.NoError(m.Migrate1to2(suite.ctx))
+	assetStakers, err = suite.app.StakingAssetsManageKeeper.QueAssetStakers(suite.ctx, &types.QueryAssetStakersReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(1, len(assetStakers.States))
+	suite.Equal(stakerID, assetStakers.States[0].StakerID)
+	assetOperators, err := suite.app.StakingAssetsManageKeeper.QueAssetOperators(suite.ctx, &types.QueryAssetOperatorsReq{AssetID: usdtAssetID})
+	suite.NoError(err)
+	suite.Equal(1, len(assetOperators.States))
+	suite.Equal(opAccAddr.String(), assetOperators.States[0].OperatorAddr)
+}
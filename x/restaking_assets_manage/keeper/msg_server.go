@@ -2,55 +2,226 @@ package keeper
 
 import (
 	"context"
-	"strings"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var _ restakingtype.MsgServer = &Keeper{}
 
-// SetStakerExoCoreAddr outdated, will be deprecated.
-// don't check if the staker has existed temporarily,so users can set their ExoCoreAddr multiple times.
-// It may be modified later to allow setting only once
+// SetStakerExoCoreAddr binds the exoCore address to the staker, the binding should be signed by the staker's
+// client chain key and the exoCore address should be the signer of the tx. The staker can only be bound once,
+// and the binding can be replaced through the rebind flag with a new signature.
 func (k Keeper) SetStakerExoCoreAddr(ctx context.Context, addrInfo *restakingtype.MsgSetExoCoreAddr) (*restakingtype.MsgSetExoCoreAddrResponse, error) {
-	// todo: verify client chain signature according to the client chain signature algorithm type.
-
 	c := sdk.UnwrapSDKContext(ctx)
+	if err := addrInfo.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	clientChainInfo, err := k.GetClientChainInfoByIndex(c, addrInfo.ClientChainIndex)
+	if err != nil {
+		return nil, err
+	}
+	clientChainAddr, err := hexutil.Decode(addrInfo.ClientChainAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(restakingtype.ErrParseStakerOrAssetID, fmt.Sprintf("the client chain address isn't a hex string:%s", addrInfo.ClientChainAddr))
+	}
+	if len(clientChainAddr) != int(clientChainInfo.AddressLength) {
+		return nil, errorsmod.Wrap(restakingtype.ErrParseStakerOrAssetID, fmt.Sprintf("mismatched address length, address:%s,need:%d", addrInfo.ClientChainAddr, clientChainInfo.AddressLength))
+	}
 
-	store := prefix.NewStore(c.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerExoCoreAddr)
-
-	bz := k.cdc.MustMarshal(addrInfo)
-
-	key := strings.Join([]string{addrInfo.ClientChainAddr, hexutil.EncodeUint64(addrInfo.ClientChainIndex)}, "_")
-	store.Set([]byte(key), bz)
+	// the key is the stakerID, so the client chain address is converted to lowercase as GetStakeIDAndAssetID does
+	stakerID, _ := restakingtype.GetStakeIDAndAssetIDFromStr(addrInfo.ClientChainIndex, addrInfo.ClientChainAddr, "")
+	nonce := uint64(0)
+	if binding := k.GetExoCoreAddrBinding(c, stakerID); binding != nil {
+		if !addrInfo.Rebind {
+			return nil, errorsmod.Wrap(restakingtype.ErrStakerExoCoreAddrAlreadyBound, fmt.Sprintf("stakerID:%s,exoCoreAddr:%s", stakerID, binding.ExoCoreAddr))
+		}
+		nonce = binding.Nonce
+	}
 
-	// todo: save to KeyPrefixReStakerExoCoreAddrReverse
+	sig, err := hexutil.Decode(addrInfo.StakerClientChainSignature)
+	if err != nil {
+		return nil, errorsmod.Wrap(restakingtype.ErrInvalidClientChainSignature, "the signature isn't a hex string")
+	}
+	msg := restakingtype.GetExoCoreAddrBindingMsg(c.ChainID(), stakerID, addrInfo.SetAddress, nonce)
+	if err = restakingtype.VerifyClientChainSignature(clientChainInfo.SignatureType, clientChainAddr, msg, sig); err != nil {
+		return nil, err
+	}
 
+	k.SetExoCoreAddrBinding(c, &restakingtype.ExoCoreAddrBinding{
+		StakerID:    stakerID,
+		ExoCoreAddr: addrInfo.SetAddress,
+		Nonce:       nonce + 1,
+	})
 	return &restakingtype.MsgSetExoCoreAddrResponse{}, nil
 }
 
+// RegisterClientChain registers a new client chain, it can only be called by the governance.
 func (k Keeper) RegisterClientChain(ctx context.Context, req *restakingtype.RegisterClientChainReq) (*restakingtype.RegisterClientChainResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	if err := req.Info.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	// the layerZero chain ID is used as the key of the client chain, so it should be unique
+	if k.IsExistedClientChain(c, req.Info.LayerZeroChainID) {
+		return nil, errorsmod.Wrap(restakingtype.ErrClientChainAlreadyExist, fmt.Sprintf("the layerZero chain ID is:%d", req.Info.LayerZeroChainID))
+	}
 	err := k.SetClientChainInfo(c, req.Info)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &restakingtype.RegisterClientChainResponse{}, nil
 }
 
+// RegisterAsset registers a new asset of the registered client chain, it can only be called by the governance.
 func (k Keeper) RegisterAsset(ctx context.Context, req *restakingtype.RegisterAssetReq) (*restakingtype.RegisterAssetResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
-	err := k.SetStakingAssetInfo(c, &restakingtype.StakingAssetInfo{
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	if err := req.Info.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	clientChainInfo, err := k.GetClientChainInfoByIndex(c, req.Info.LayerZeroChainID)
+	if err != nil {
+		return nil, err
+	}
+	if err = restakingtype.ValidateAssetAddress(req.Info, clientChainInfo); err != nil {
+		return nil, err
+	}
+	_, assetID := restakingtype.GetStakeIDAndAssetIDFromStr(req.Info.LayerZeroChainID, "", req.Info.Address)
+	if k.IsStakingAsset(c, assetID) {
+		return nil, errorsmod.Wrap(restakingtype.ErrAssetAlreadyExist, fmt.Sprintf("the assetID is:%s", assetID))
+	}
+
+	err = k.SetStakingAssetInfo(c, &restakingtype.StakingAssetInfo{
 		AssetBasicInfo:     req.Info,
 		StakingTotalAmount: math.NewInt(0),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &restakingtype.RegisterAssetResponse{}, nil
+}
+
+// UpdateAsset updates the meta info and total supply of the registered asset, it can only be called by the governance.
+func (k Keeper) UpdateAsset(ctx context.Context, req *restakingtype.UpdateAssetReq) (*restakingtype.UpdateAssetResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	info, err := k.GetStakingAssetInfo(c, req.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	if req.MetaInfo != "" {
+		info.AssetBasicInfo.MetaInfo = req.MetaInfo
+	}
+	if !req.TotalSupply.IsNil() {
+		if req.TotalSupply.IsNegative() {
+			return nil, errorsmod.Wrap(restakingtype.ErrInvalidAssetInfo, fmt.Sprintf("the total supply is negative:%s", req.TotalSupply))
+		}
+		info.AssetBasicInfo.TotalSupply = req.TotalSupply
+	}
+	if err = k.SetStakingAssetInfo(c, info); err != nil {
+		return nil, err
+	}
+	return &restakingtype.UpdateAssetResponse{}, nil
+}
+
+// DeprecateAsset deprecates the asset or reverts the deprecation, it can only be called by the governance.
+// The deprecated asset can't be deposited anymore, but the deposited asset can still be withdrawn.
+func (k Keeper) DeprecateAsset(ctx context.Context, req *restakingtype.DeprecateAssetReq) (*restakingtype.DeprecateAssetResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	info, err := k.GetStakingAssetInfo(c, req.AssetID)
+	if err != nil {
+		return nil, err
+	}
+	info.Deprecated = req.Deprecated
+	if err = k.SetStakingAssetInfo(c, info); err != nil {
+		return nil, err
+	}
+	return &restakingtype.DeprecateAssetResponse{}, nil
+}
+
+// SetGuardian sets the guardian who can pause the client chains and assets, it can only be called by the governance.
+func (k Keeper) SetGuardian(ctx context.Context, req *restakingtype.SetGuardianReq) (*restakingtype.SetGuardianResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	k.SetGuardianAddr(c, req.Guardian)
+	return &restakingtype.SetGuardianResponse{}, nil
+}
+
+// PauseClientChain pauses the client chain or unpauses it. The client chain can be paused by the governance or
+// the guardian, but it can only be unpaused by the governance.
+func (k Keeper) PauseClientChain(ctx context.Context, req *restakingtype.PauseClientChainReq) (*restakingtype.PauseClientChainResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkPauseAuthority(c, req.FromAddress, req.Paused); err != nil {
+		return nil, err
+	}
+	if err := k.SetClientChainPaused(c, req.LayerZeroChainID, req.Paused); err != nil {
+		return nil, err
+	}
+	return &restakingtype.PauseClientChainResponse{}, nil
+}
+
+// PauseAsset pauses the asset or unpauses it. The asset can be paused by the governance or the guardian,
+// but it can only be unpaused by the governance.
+func (k Keeper) PauseAsset(ctx context.Context, req *restakingtype.PauseAssetReq) (*restakingtype.PauseAssetResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkPauseAuthority(c, req.FromAddress, req.Paused); err != nil {
+		return nil, err
+	}
+	if err := k.SetAssetPaused(c, req.AssetID, req.Paused); err != nil {
+		return nil, err
+	}
+	return &restakingtype.PauseAssetResponse{}, nil
+}
+
+// SetWithdrawalOutflowCap sets the withdrawal outflow cap of the asset, it can only be called by the governance.
+func (k Keeper) SetWithdrawalOutflowCap(ctx context.Context, req *restakingtype.SetWithdrawalOutflowCapReq) (*restakingtype.SetWithdrawalOutflowCapResponse, error) {
+	c := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkAuthority(req.FromAddress); err != nil {
+		return nil, err
+	}
+	if err := req.Cap.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := k.SetAssetWithdrawalOutflowCap(c, &req.Cap); err != nil {
+		return nil, err
+	}
+	return &restakingtype.SetWithdrawalOutflowCapResponse{}, nil
+}
+
+// checkPauseAuthority allows the guardian to pause, so it can react to an emergency without waiting for the
+// governance proposal. Unpausing always requires the governance.
+func (k Keeper) checkPauseAuthority(ctx sdk.Context, signer string, paused bool) error {
+	if signer == k.authority {
+		return nil
+	}
+	guardian := k.GetGuardianAddr(ctx)
+	if paused && guardian != "" && signer == guardian {
+		return nil
+	}
+	return errorsmod.Wrap(restakingtype.ErrInvalidAuthority, fmt.Sprintf("expected:%s or the guardian:%s,got:%s", k.authority, guardian, signer))
+}
+
+func (k Keeper) checkAuthority(signer string) error {
+	if signer != k.authority {
+		return errorsmod.Wrap(restakingtype.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, signer))
+	}
+	return nil
 }
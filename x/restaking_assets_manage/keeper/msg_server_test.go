@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	deposittype "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestRegisterClientChainAndAsset() {
+	authority := suite.app.StakingAssetsManageKeeper.GetAuthority()
+	clientChainMsg := &types.RegisterClientChainReq{
+		FromAddress: sdk.AccAddress(suite.address.Bytes()).String(),
+		Info: &types.ClientChainInfo{
+			Name:             "arbitrum",
+			LayerZeroChainID: 110,
+			AddressLength:    20,
+		},
+	}
+	suite.NoError(clientChainMsg.ValidateBasic())
+
+	// only the governance can register the client chain
+	_, err := suite.app.StakingAssetsManageKeeper.RegisterClientChain(suite.ctx, clientChainMsg)
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+
+	clientChainMsg.FromAddress = authority
+	_, err = suite.app.StakingAssetsManageKeeper.RegisterClientChain(suite.ctx, clientChainMsg)
+	suite.NoError(err)
+	info, err := suite.app.StakingAssetsManageKeeper.GetClientChainInfoByIndex(suite.ctx, 110)
+	suite.NoError(err)
+	suite.Equal(clientChainMsg.Info, info)
+
+	// the registered client chain can't be overwritten
+	_, err = suite.app.StakingAssetsManageKeeper.RegisterClientChain(suite.ctx, clientChainMsg)
+	suite.ErrorIs(err, types.ErrClientChainAlreadyExist)
+
+	clientChainMsg.Info = &types.ClientChainInfo{Name: "invalid", LayerZeroChainID: 111, AddressLength: 33}
+	suite.ErrorIs(clientChainMsg.ValidateBasic(), types.ErrInvalidClientChainInfo)
+
+	assetAddress := "0xFd086bC7CD5C481DCC9C85ebE478A1C0b69FCbb9"
+	assetMsg := &types.RegisterAssetReq{
+		FromAddress: authority,
+		Info: &types.AssetInfo{
+			Name:             "Tether USD",
+			Symbol:           "USDT",
+			Address:          "0x01",
+			Decimals:         6,
+			TotalSupply:      sdkmath.NewInt(1e18),
+			LayerZeroChainID: 110,
+		},
+	}
+	// the address length doesn't match the client chain
+	_, err = suite.app.StakingAssetsManageKeeper.RegisterAsset(suite.ctx, assetMsg)
+	suite.ErrorIs(err, types.ErrInvalidAssetInfo)
+
+	assetMsg.Info.Address = assetAddress
+	_, err = suite.app.StakingAssetsManageKeeper.RegisterAsset(suite.ctx, assetMsg)
+	suite.NoError(err)
+	_, err = suite.app.StakingAssetsManageKeeper.RegisterAsset(suite.ctx, assetMsg)
+	suite.ErrorIs(err, types.ErrAssetAlreadyExist)
+	_, assetID := types.GetStakeIDAndAssetIDFromStr(110, "", assetAddress)
+
+	// update the asset
+	_, err = suite.app.StakingAssetsManageKeeper.UpdateAsset(suite.ctx, &types.UpdateAssetReq{
+		FromAddress: authority,
+		AssetID:     assetID,
+		MetaInfo:    "Tether USD on arbitrum",
+		TotalSupply: sdkmath.NewInt(2e18),
+	})
+	suite.NoError(err)
+	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal("Tether USD on arbitrum", assetInfo.AssetBasicInfo.MetaInfo)
+	suite.Equal(sdkmath.NewInt(2e18), assetInfo.AssetBasicInfo.TotalSupply)
+
+	// the deprecated asset can't be deposited, but can still be withdrawn
+	depositParams := &depositkeeper.DepositParams{
+		ClientChainLzID: 110,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address.Bytes(),
+		AssetsAddress:   common.HexToAddress(assetAddress).Bytes(),
+		OpAmount:        sdkmath.NewInt(100),
+	}
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.NoError(err)
+	_, err = suite.app.StakingAssetsManageKeeper.DeprecateAsset(suite.ctx, &types.DeprecateAssetReq{
+		FromAddress: authority,
+		AssetID:     assetID,
+		Deprecated:  true,
+	})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.ErrorIs(err, deposittype.ErrDepositAssetDeprecated)
+
+	stakerID, _ := types.GetStakeIDAndAssetID(110, suite.address.Bytes(), nil)
+	err = suite.app.StakingAssetsManageKeeper.UpdateStakerAssetState(suite.ctx, stakerID, assetID, types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: sdkmath.NewInt(-100),
+		CanWithdrawAmountOrWantChangeValue:  sdkmath.NewInt(-100),
+	})
+	suite.NoError(err)
+
+	_, err = suite.app.StakingAssetsManageKeeper.DeprecateAsset(suite.ctx, &types.DeprecateAssetReq{
+		FromAddress: authority,
+		AssetID:     assetID,
+		Deprecated:  false,
+	})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.NoError(err)
+}
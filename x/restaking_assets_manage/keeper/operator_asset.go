@@ -13,8 +13,7 @@ import (
 func (k Keeper) GetOperatorAssetInfos(ctx sdk.Context, operatorAddr sdk.Address) (assetsInfo map[string]*restakingtype.OperatorSingleAssetOrChangeInfo, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixOperatorAssetInfos)
 	// the key is the operator address in the bech32 format
-	key := []byte(operatorAddr.String())
-	iterator := sdk.KVStorePrefixIterator(store, key)
+	iterator := sdk.KVStorePrefixIterator(store, restakingtype.GetAssetStateIteratorPrefix(operatorAddr.String()))
 	defer iterator.Close()
 
 	ret := make(map[string]*restakingtype.OperatorSingleAssetOrChangeInfo, 0)
@@ -78,6 +77,15 @@ func (k Keeper) UpdateOperatorAssetState(ctx sdk.Context, operatorAddr sdk.Addre
 
 	bz := k.cdc.MustMarshal(&assetState)
 	store.Set(key, bz)
+
+	// update the reverse index used to query the operators of an asset
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixAssetOperators)
+	indexKey := restakingtype.GetAssetStateKey(assetID, operatorAddr.String())
+	if assetState.TotalAmountOrWantChangeValue.IsZero() {
+		indexStore.Delete(indexKey)
+	} else {
+		indexStore.Set(indexKey, []byte{})
+	}
 	return nil
 }
 
@@ -85,3 +93,22 @@ func (k Keeper) UpdateOperatorAssetState(ctx sdk.Context, operatorAddr sdk.Addre
 func (k Keeper) GetOperatorAssetOptedInMiddleWare(sdk.Address, string) (middleWares []sdk.Address, err error) {
 	panic("implement me")
 }
+
+// GetAllOperatorAssetStates returns the asset states of all operators, it's used to export the genesis state.
+func (k Keeper) GetAllOperatorAssetStates(ctx sdk.Context) (states []restakingtype.OperatorAssetState, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixOperatorAssetInfos)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]restakingtype.OperatorAssetState, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var stateInfo restakingtype.OperatorSingleAssetOrChangeInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &stateInfo)
+		operatorAddr, assetID, err := restakingtype.ParseStakerAndAssetIDFromKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, restakingtype.OperatorAssetState{OperatorAddr: operatorAddr, AssetID: assetID, Info: stateInfo})
+	}
+	return ret, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetGuardianAddr returns the guardian address, it's empty if the guardian hasn't been set.
+func (k Keeper) GetGuardianAddr(ctx sdk.Context) string {
+	return string(ctx.KVStore(k.storeKey).Get(restakingtype.KeyGuardian))
+}
+
+// SetGuardianAddr sets the guardian address, the guardian is removed if the address is empty.
+func (k Keeper) SetGuardianAddr(ctx sdk.Context, guardian string) {
+	store := ctx.KVStore(k.storeKey)
+	if guardian == "" {
+		store.Delete(restakingtype.KeyGuardian)
+		return
+	}
+	store.Set(restakingtype.KeyGuardian, []byte(guardian))
+}
+
+// SetClientChainPaused pauses the client chain or unpauses it.
+func (k Keeper) SetClientChainPaused(ctx sdk.Context, clientChainLzID uint64, paused bool) error {
+	info, err := k.GetClientChainInfoByIndex(ctx, clientChainLzID)
+	if err != nil {
+		return err
+	}
+	info.Paused = paused
+	return k.SetClientChainInfo(ctx, info)
+}
+
+// SetAssetPaused pauses the asset or unpauses it.
+func (k Keeper) SetAssetPaused(ctx sdk.Context, assetID string, paused bool) error {
+	info, err := k.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	info.Paused = paused
+	return k.SetStakingAssetInfo(ctx, info)
+}
+
+// CheckClientChainNotPaused returns ErrClientChainPaused if the client chain has been paused.
+func (k Keeper) CheckClientChainNotPaused(ctx sdk.Context, clientChainLzID uint64) error {
+	info, err := k.GetClientChainInfoByIndex(ctx, clientChainLzID)
+	if err != nil {
+		return err
+	}
+	if info.Paused {
+		return errorsmod.Wrap(restakingtype.ErrClientChainPaused, fmt.Sprintf("clientChainLzID:%d", clientChainLzID))
+	}
+	return nil
+}
+
+// CheckAssetNotPaused returns ErrAssetPaused if the asset has been paused, or ErrClientChainPaused if the
+// client chain of the asset has been paused.
+func (k Keeper) CheckAssetNotPaused(ctx sdk.Context, assetID string) error {
+	info, err := k.GetStakingAssetInfo(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	if info.Paused {
+		return errorsmod.Wrap(restakingtype.ErrAssetPaused, fmt.Sprintf("the assetID is:%s", assetID))
+	}
+	return k.CheckClientChainNotPaused(ctx, info.AssetBasicInfo.LayerZeroChainID)
+}
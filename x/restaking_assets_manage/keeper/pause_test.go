@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestPauseClientChainAndAsset() {
+	keeper := suite.app.StakingAssetsManageKeeper
+	authority := keeper.GetAuthority()
+	guardian := sdk.AccAddress(suite.address.Bytes()).String()
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	_, assetID := types.GetStakeIDAndAssetID(101, nil, usdtAddress[:])
+	depositParams := &depositkeeper.DepositParams{
+		ClientChainLzID: 101,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address.Bytes(),
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	}
+
+	// only the governance can pause before the guardian is set
+	_, err := keeper.PauseAsset(suite.ctx, &types.PauseAssetReq{FromAddress: guardian, AssetID: assetID, Paused: true})
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+	_, err = keeper.SetGuardian(suite.ctx, &types.SetGuardianReq{FromAddress: guardian, Guardian: guardian})
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+	_, err = keeper.SetGuardian(suite.ctx, &types.SetGuardianReq{FromAddress: authority, Guardian: guardian})
+	suite.NoError(err)
+	res, err := keeper.QueGuardian(suite.ctx, &types.QueryGuardianReq{})
+	suite.NoError(err)
+	suite.Equal(guardian, res.Guardian)
+
+	// the guardian can pause the asset, but only the governance can unpause it
+	_, err = keeper.PauseAsset(suite.ctx, &types.PauseAssetReq{FromAddress: guardian, AssetID: assetID, Paused: true})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.ErrorIs(err, types.ErrAssetPaused)
+	_, err = keeper.PauseAsset(suite.ctx, &types.PauseAssetReq{FromAddress: guardian, AssetID: assetID, Paused: false})
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+	_, err = keeper.PauseAsset(suite.ctx, &types.PauseAssetReq{FromAddress: authority, AssetID: assetID, Paused: false})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.NoError(err)
+
+	// all assets and messages of the paused client chain are rejected
+	_, err = keeper.PauseClientChain(suite.ctx, &types.PauseClientChainReq{FromAddress: guardian, LayerZeroChainID: 101, Paused: true})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositParams)
+	suite.ErrorIs(err, types.ErrClientChainPaused)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, 101, 1)
+	suite.ErrorIs(err, types.ErrClientChainPaused)
+	suite.Equal(uint64(0), keeper.GetLastLzNonce(suite.ctx, 101))
+
+	_, err = keeper.PauseClientChain(suite.ctx, &types.PauseClientChainReq{FromAddress: authority, LayerZeroChainID: 101, Paused: false})
+	suite.NoError(err)
+	err = keeper.CheckAndUpdateLzNonce(suite.ctx, 101, 1)
+	suite.NoError(err)
+
+	// the guardian can't pause anything after it's removed
+	_, err = keeper.SetGuardian(suite.ctx, &types.SetGuardianReq{FromAddress: authority, Guardian: ""})
+	suite.NoError(err)
+	_, err = keeper.PauseClientChain(suite.ctx, &types.PauseClientChainReq{FromAddress: guardian, LayerZeroChainID: 101, Paused: true})
+	suite.ErrorIs(err, types.ErrInvalidAuthority)
+}
@@ -32,7 +32,7 @@ func UpdateAssetValue(valueToUpdate *math.Int, changeValue *math.Int) error {
 
 func (k Keeper) GetStakerAssetInfos(ctx sdk.Context, stakerID string) (assetsInfo map[string]*restakingtype.StakerSingleAssetOrChangeInfo, err error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerAssetInfos)
-	iterator := sdk.KVStorePrefixIterator(store, []byte(stakerID))
+	iterator := sdk.KVStorePrefixIterator(store, restakingtype.GetAssetStateIteratorPrefix(stakerID))
 	defer iterator.Close()
 
 	ret := make(map[string]*restakingtype.StakerSingleAssetOrChangeInfo, 0)
@@ -97,5 +97,62 @@ func (k Keeper) UpdateStakerAssetState(ctx sdk.Context, stakerID string, assetID
 	bz := k.cdc.MustMarshal(&assetState)
 	store.Set(key, bz)
 
+	// update the reverse index used to query the stakers of an asset
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixAssetStakers)
+	indexKey := restakingtype.GetAssetStateKey(assetID, stakerID)
+	if assetState.TotalDepositAmountOrWantChangeValue.IsZero() {
+		indexStore.Delete(indexKey)
+	} else {
+		indexStore.Set(indexKey, []byte{})
+	}
+	return nil
+}
+
+// BuildAssetIndexes builds the reverse indexes from the assets to their stakers and operators from the existing
+// asset states. The indexes are only updated when an asset state changes, so it's used to cover the states stored
+// before they are introduced. It's idempotent.
+func (k Keeper) BuildAssetIndexes(ctx sdk.Context) error {
+	stakerStates, err := k.GetAllStakerAssetStates(ctx)
+	if err != nil {
+		return err
+	}
+	stakerIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixAssetStakers)
+	for _, state := range stakerStates {
+		if state.Info.TotalDepositAmountOrWantChangeValue.IsZero() {
+			continue
+		}
+		stakerIndexStore.Set(restakingtype.GetAssetStateKey(state.AssetID, state.StakerID), []byte{})
+	}
+
+	operatorStates, err := k.GetAllOperatorAssetStates(ctx)
+	if err != nil {
+		return err
+	}
+	operatorIndexStore := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixAssetOperators)
+	for _, state := range operatorStates {
+		if state.Info.TotalAmountOrWantChangeValue.IsZero() {
+			continue
+		}
+		operatorIndexStore.Set(restakingtype.GetAssetStateKey(state.AssetID, state.OperatorAddr), []byte{})
+	}
 	return nil
 }
+
+// GetAllStakerAssetStates returns the asset states of all stakers, it's used to export the genesis state.
+func (k Keeper) GetAllStakerAssetStates(ctx sdk.Context) (states []restakingtype.StakerAssetState, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixReStakerAssetInfos)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]restakingtype.StakerAssetState, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var stateInfo restakingtype.StakerSingleAssetOrChangeInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &stateInfo)
+		stakerID, assetID, err := restakingtype.ParseStakerAndAssetIDFromKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, restakingtype.StakerAssetState{StakerID: stakerID, AssetID: assetID, Info: stateInfo})
+	}
+	return ret, nil
+}
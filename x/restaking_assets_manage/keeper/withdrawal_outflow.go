@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAssetWithdrawalOutflowCap sets the withdrawal outflow cap of the asset, the asset should have been registered.
+func (k Keeper) SetAssetWithdrawalOutflowCap(ctx sdk.Context, outflowCap *restakingtype.WithdrawalOutflowCap) error {
+	if !k.IsStakingAsset(ctx, outflowCap.AssetID) {
+		return errorsmod.Wrap(restakingtype.ErrNoClientChainAssetKey, fmt.Sprintf("the assetID is:%s", outflowCap.AssetID))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixWithdrawalOutflowCap)
+	bz := k.cdc.MustMarshal(outflowCap)
+	store.Set([]byte(outflowCap.AssetID), bz)
+	return nil
+}
+
+// GetWithdrawalOutflowCap returns the withdrawal outflow cap of the asset, the returned caps are zero if it
+// hasn't been set, which means there is no limit.
+func (k Keeper) GetWithdrawalOutflowCap(ctx sdk.Context, assetID string) restakingtype.WithdrawalOutflowCap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixWithdrawalOutflowCap)
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return restakingtype.WithdrawalOutflowCap{
+			AssetID:     assetID,
+			PerBlockCap: sdkmath.ZeroInt(),
+			PerEpochCap: sdkmath.ZeroInt(),
+		}
+	}
+	ret := restakingtype.WithdrawalOutflowCap{}
+	k.cdc.MustUnmarshal(value, &ret)
+	return ret
+}
+
+// GetAllWithdrawalOutflowCaps returns the withdrawal outflow caps ordered by the assetID.
+func (k Keeper) GetAllWithdrawalOutflowCaps(ctx sdk.Context) []restakingtype.WithdrawalOutflowCap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixWithdrawalOutflowCap)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]restakingtype.WithdrawalOutflowCap, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var outflowCap restakingtype.WithdrawalOutflowCap
+		k.cdc.MustUnmarshal(iterator.Value(), &outflowCap)
+		ret = append(ret, outflowCap)
+	}
+	return ret
+}
+
+// GetWithdrawalOutflow returns the withdrawn amount of the asset in the current block and epoch.
+func (k Keeper) GetWithdrawalOutflow(ctx sdk.Context, assetID string) restakingtype.WithdrawalOutflow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixWithdrawalOutflow)
+	ret := restakingtype.WithdrawalOutflow{
+		BlockHeight:  ctx.BlockHeight(),
+		BlockOutflow: sdkmath.ZeroInt(),
+		EpochOutflow: sdkmath.ZeroInt(),
+	}
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return ret
+	}
+	var outflow restakingtype.WithdrawalOutflow
+	k.cdc.MustUnmarshal(value, &outflow)
+	// the block outflow recorded in the previous blocks doesn't count
+	if outflow.BlockHeight == ctx.BlockHeight() {
+		ret.BlockOutflow = outflow.BlockOutflow
+	}
+	ret.EpochOutflow = outflow.EpochOutflow
+	ret.EpochStartHeight = outflow.EpochStartHeight
+	return ret
+}
+
+func (k Keeper) setWithdrawalOutflow(ctx sdk.Context, assetID string, outflow *restakingtype.WithdrawalOutflow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), restakingtype.KeyPrefixWithdrawalOutflow)
+	bz := k.cdc.MustMarshal(outflow)
+	store.Set([]byte(assetID), bz)
+}
+
+// CheckAndRecordWithdrawalOutflow adds the amount to the withdrawal outflow of the asset, it returns
+// ErrWithdrawalOutflowCapExceeded if the outflow in the current block or epoch exceeds the cap. The caller
+// should revert the state if the withdrawal fails after the outflow is recorded.
+func (k Keeper) CheckAndRecordWithdrawalOutflow(ctx sdk.Context, assetID string, amount sdkmath.Int) error {
+	outflowCap := k.GetWithdrawalOutflowCap(ctx, assetID)
+	outflow := k.GetWithdrawalOutflow(ctx, assetID)
+	outflow.BlockOutflow = outflow.BlockOutflow.Add(amount)
+	outflow.EpochOutflow = outflow.EpochOutflow.Add(amount)
+	if outflowCap.PerBlockCap.IsPositive() && outflow.BlockOutflow.GT(outflowCap.PerBlockCap) {
+		return errorsmod.Wrap(restakingtype.ErrWithdrawalOutflowCapExceeded, fmt.Sprintf("assetID:%s,blockOutflow:%s,perBlockCap:%s", assetID, outflow.BlockOutflow, outflowCap.PerBlockCap))
+	}
+	if outflowCap.PerEpochCap.IsPositive() && outflow.EpochOutflow.GT(outflowCap.PerEpochCap) {
+		return errorsmod.Wrap(restakingtype.ErrWithdrawalOutflowCapExceeded, fmt.Sprintf("assetID:%s,epochOutflow:%s,perEpochCap:%s", assetID, outflow.EpochOutflow, outflowCap.PerEpochCap))
+	}
+	k.setWithdrawalOutflow(ctx, assetID, &outflow)
+	return nil
+}
+
+// ReleaseWithdrawalOutflow removes the amount of the cancelled withdrawal from the withdrawal outflow of the
+// asset. The amount is only removed from the outflows that it was counted in, that's the block outflow if the
+// withdrawal was requested in the current block, and the epoch outflow if it was requested in the current epoch.
+func (k Keeper) ReleaseWithdrawalOutflow(ctx sdk.Context, assetID string, amount sdkmath.Int, requestHeight int64) {
+	outflow := k.GetWithdrawalOutflow(ctx, assetID)
+	if requestHeight == outflow.BlockHeight {
+		outflow.BlockOutflow = sdkmath.MaxInt(outflow.BlockOutflow.Sub(amount), sdkmath.ZeroInt())
+	}
+	if requestHeight >= outflow.EpochStartHeight {
+		outflow.EpochOutflow = sdkmath.MaxInt(outflow.EpochOutflow.Sub(amount), sdkmath.ZeroInt())
+	}
+	k.setWithdrawalOutflow(ctx, assetID, &outflow)
+}
+
+// resetEpochWithdrawalOutflows clears the epoch outflows of the assets whose caps use the epoch identifier.
+// The height of the reset is recorded, so the withdrawals requested before it aren't released from the new epoch.
+func (k Keeper) resetEpochWithdrawalOutflows(ctx sdk.Context, epochIdentifier string) {
+	for _, outflowCap := range k.GetAllWithdrawalOutflowCaps(ctx) {
+		if outflowCap.EpochIdentifier != epochIdentifier {
+			continue
+		}
+		outflow := k.GetWithdrawalOutflow(ctx, outflowCap.AssetID)
+		outflow.EpochOutflow = sdkmath.ZeroInt()
+		outflow.EpochStartHeight = ctx.BlockHeight()
+		k.setWithdrawalOutflow(ctx, outflowCap.AssetID, &outflow)
+	}
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ethereum/go-ethereum/common"
+	epochstypes "github.com/evmos/evmos/v14/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawalOutflowCap() {
+	keeper := suite.app.StakingAssetsManageKeeper
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	_, assetID := types.GetStakeIDAndAssetID(101, nil, usdtAddress[:])
+
+	// there is no limit if the cap isn't set
+	err := keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(1000))
+	suite.NoError(err)
+
+	outflowCap := types.WithdrawalOutflowCap{
+		AssetID:         assetID,
+		PerBlockCap:     sdkmath.NewInt(100),
+		PerEpochCap:     sdkmath.NewInt(1150),
+		EpochIdentifier: epochstypes.DayEpochID,
+	}
+	_, err = keeper.SetWithdrawalOutflowCap(suite.ctx, &types.SetWithdrawalOutflowCapReq{FromAddress: keeper.GetAuthority(), Cap: outflowCap})
+	suite.NoError(err)
+
+	// the per-block cap
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(101))
+	suite.ErrorIs(err, types.ErrWithdrawalOutflowCapExceeded)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(100))
+	suite.ErrorIs(err, types.ErrWithdrawalOutflowCapExceeded)
+
+	// the block outflow is restarted in the next block, but the epoch outflow is accumulated
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(100))
+	suite.NoError(err)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(100))
+	suite.ErrorIs(err, types.ErrWithdrawalOutflowCapExceeded)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(50))
+	suite.NoError(err)
+
+	res, err := keeper.QueWithdrawalOutflow(suite.ctx, &types.QueryWithdrawalOutflowReq{AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(outflowCap, res.Cap)
+	suite.Equal(types.WithdrawalOutflow{
+		BlockHeight:  suite.ctx.BlockHeight(),
+		BlockOutflow: sdkmath.NewInt(50),
+		EpochOutflow: sdkmath.NewInt(1150),
+	}, res.Outflow)
+
+	// the epoch outflow is only restarted by the epoch used by the cap
+	keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(1))
+	suite.ErrorIs(err, types.ErrWithdrawalOutflowCapExceeded)
+	keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	err = keeper.CheckAndRecordWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(50))
+	suite.NoError(err)
+	outflow := keeper.GetWithdrawalOutflow(suite.ctx, assetID)
+	suite.Equal(sdkmath.NewInt(100), outflow.BlockOutflow)
+	suite.Equal(sdkmath.NewInt(50), outflow.EpochOutflow)
+
+	// the cancelled withdrawal is only released from the outflows that it was counted in
+	keeper.ReleaseWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(30), suite.ctx.BlockHeight()-1)
+	outflow = keeper.GetWithdrawalOutflow(suite.ctx, assetID)
+	suite.Equal(sdkmath.NewInt(100), outflow.BlockOutflow)
+	suite.Equal(sdkmath.NewInt(50), outflow.EpochOutflow)
+	keeper.ReleaseWithdrawalOutflow(suite.ctx, assetID, sdkmath.NewInt(30), suite.ctx.BlockHeight())
+	outflow = keeper.GetWithdrawalOutflow(suite.ctx, assetID)
+	suite.Equal(sdkmath.NewInt(70), outflow.BlockOutflow)
+	suite.Equal(sdkmath.NewInt(20), outflow.EpochOutflow)
+
+	// the per-epoch cap requires the epoch identifier
+	outflowCap.EpochIdentifier = ""
+	suite.ErrorIs(outflowCap.ValidateBasic(), types.ErrInvalidWithdrawalOutflowCap)
+}
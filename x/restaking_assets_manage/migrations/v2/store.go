@@ -0,0 +1,34 @@
+package v2
+
+import (
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore removes the legacy exoCore address bindings. They were the unverified MsgSetExoCoreAddr stored
+// under the raw client chain address, so they can't be decoded as ExoCoreAddrBinding and aren't reachable by
+// the lowercased stakerID. The stakers need to bind their exoCore addresses again with a signature.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), restakingtype.KeyPrefixReStakerExoCoreAddr)
+	iterator := store.Iterator(nil, nil)
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		var binding restakingtype.ExoCoreAddrBinding
+		if err := cdc.Unmarshal(values[i], &binding); err == nil && binding.StakerID == string(key) {
+			// the binding has been in the new format
+			continue
+		}
+		store.Delete(key)
+	}
+	return nil
+}
@@ -26,6 +26,12 @@ const (
 	setExoCoreAddrName  = "exocore/MsgSetExoCoreAddr"
 	registerClientChain = "exocore/RegisterClientChain"
 	registerAsset       = "exocore/RegisterAsset"
+	updateAsset         = "exocore/UpdateAsset"
+	deprecateAsset      = "exocore/DeprecateAsset"
+	setGuardian         = "exocore/SetGuardian"
+	pauseClientChain    = "exocore/PauseClientChain"
+	pauseAsset          = "exocore/PauseAsset"
+	setOutflowCap       = "exocore/SetWithdrawalOutflowCap"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -39,6 +45,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSetExoCoreAddr{},
+		&RegisterClientChainReq{},
+		&RegisterAssetReq{},
+		&UpdateAssetReq{},
+		&DeprecateAssetReq{},
+		&SetGuardianReq{},
+		&PauseClientChainReq{},
+		&PauseAssetReq{},
+		&SetWithdrawalOutflowCapReq{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -51,4 +65,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetExoCoreAddr{}, setExoCoreAddrName, nil)
 	cdc.RegisterConcrete(&RegisterClientChainReq{}, registerClientChain, nil)
 	cdc.RegisterConcrete(&RegisterAssetReq{}, registerAsset, nil)
+	cdc.RegisterConcrete(&UpdateAssetReq{}, updateAsset, nil)
+	cdc.RegisterConcrete(&DeprecateAssetReq{}, deprecateAsset, nil)
+	cdc.RegisterConcrete(&SetGuardianReq{}, setGuardian, nil)
+	cdc.RegisterConcrete(&PauseClientChainReq{}, pauseClientChain, nil)
+	cdc.RegisterConcrete(&PauseAssetReq{}, pauseAsset, nil)
+	cdc.RegisterConcrete(&SetWithdrawalOutflowCapReq{}, setOutflowCap, nil)
 }
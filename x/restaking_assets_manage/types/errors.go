@@ -20,4 +20,40 @@ var (
 	ErrCliCmdInputArg = errorsmod.Register(ModuleName, 6, "there is an error in the input client command args")
 
 	ErrInputPointerIsNil = errorsmod.Register(ModuleName, 7, "the input pointer is nil")
+
+	ErrNoStakerExoCoreAddr = errorsmod.Register(ModuleName, 8, "the staker hasn't set its exoCore address")
+
+	ErrParseStakerOrAssetID = errorsmod.Register(ModuleName, 9, "the stakerID or assetID can't be parsed")
+
+	ErrInvalidClientChainInfo = errorsmod.Register(ModuleName, 10, "the client chain info is invalid")
+
+	ErrInvalidAssetInfo = errorsmod.Register(ModuleName, 11, "the asset info is invalid")
+
+	ErrClientChainAlreadyExist = errorsmod.Register(ModuleName, 12, "the client chain has been registered")
+
+	ErrAssetAlreadyExist = errorsmod.Register(ModuleName, 13, "the asset has been registered")
+
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 14, "the signer isn't the governance authority")
+
+	ErrInvalidGenesisData = errorsmod.Register(ModuleName, 15, "the genesis data supplied is invalid")
+
+	ErrDuplicateLzNonce = errorsmod.Register(ModuleName, 16, "the LayerZero nonce has been processed")
+
+	ErrUnorderedLzNonce = errorsmod.Register(ModuleName, 17, "the LayerZero nonce isn't the next nonce to be processed")
+
+	ErrClientChainPaused = errorsmod.Register(ModuleName, 18, "the client chain has been paused")
+
+	ErrAssetPaused = errorsmod.Register(ModuleName, 19, "the asset has been paused")
+
+	ErrWithdrawalOutflowCapExceeded = errorsmod.Register(ModuleName, 20, "the withdrawal outflow cap of the asset is exceeded")
+
+	ErrInvalidWithdrawalOutflowCap = errorsmod.Register(ModuleName, 21, "the withdrawal outflow cap is invalid")
+
+	ErrUnsupportedSignatureType = errorsmod.Register(ModuleName, 22, "the signature type of the client chain isn't supported")
+
+	ErrInvalidClientChainSignature = errorsmod.Register(ModuleName, 23, "the client chain signature is invalid")
+
+	ErrStakerExoCoreAddrAlreadyBound = errorsmod.Register(ModuleName, 24, "the staker has been bound to an exoCore address")
+
+	ErrMismatchedExoCoreAddr = errorsmod.Register(ModuleName, 25, "the signer isn't the exoCore address to be bound")
 )
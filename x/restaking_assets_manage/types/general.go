@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -33,6 +35,10 @@ const (
 	DelegateTo
 	UndelegateFrom
 	Slash
+	// RegisterNativePod and the following actions are used by the native ETH restaking through the beacon chain validators
+	RegisterNativePod
+	UpdateNativeValidatorBalance
+	WithdrawNativeValidator
 )
 
 // GetStakeIDAndAssetID stakerID = stakerAddress+'_'+clientChainLzID,assetID = assetAddress+'_'+clientChainLzID
@@ -60,3 +66,20 @@ func GetStakeIDAndAssetIDFromStr(clientChainLzID uint64, stakerAddress string, a
 	}
 	return
 }
+
+// ParseID parses the stakerID or assetID to the client chain address and clientChainLzID
+func ParseID(id string) (addr []byte, clientChainLzID uint64, err error) {
+	stringList := strings.Split(id, "_")
+	if len(stringList) != 2 {
+		return nil, 0, errorsmod.Wrap(ErrParseStakerOrAssetID, fmt.Sprintf("the id is:%s", id))
+	}
+	addr, err = hexutil.Decode(stringList[0])
+	if err != nil {
+		return nil, 0, errorsmod.Wrap(ErrParseStakerOrAssetID, fmt.Sprintf("the address is:%s,err:%s", stringList[0], err))
+	}
+	clientChainLzID, err = hexutil.DecodeUint64(stringList[1])
+	if err != nil {
+		return nil, 0, errorsmod.Wrap(ErrParseStakerOrAssetID, fmt.Sprintf("the clientChainLzID is:%s,err:%s", stringList[1], err))
+	}
+	return addr, clientChainLzID, nil
+}
@@ -27,6 +27,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	DefaultSupportedClientChains      []*ClientChainInfo `protobuf:"bytes,1,rep,name=DefaultSupportedClientChains,proto3" json:"DefaultSupportedClientChains,omitempty"`
 	DefaultSupportedClientChainTokens []*AssetInfo       `protobuf:"bytes,2,rep,name=DefaultSupportedClientChainTokens,proto3" json:"DefaultSupportedClientChainTokens,omitempty"`
+	// DeprecatedAssetIDs are the assets that can't be deposited anymore
+	DeprecatedAssetIDs  []string             `protobuf:"bytes,3,rep,name=DeprecatedAssetIDs,proto3" json:"DeprecatedAssetIDs,omitempty"`
+	StakerAssetStates   []StakerAssetState   `protobuf:"bytes,4,rep,name=StakerAssetStates,proto3" json:"StakerAssetStates"`
+	OperatorAssetStates []OperatorAssetState `protobuf:"bytes,5,rep,name=OperatorAssetStates,proto3" json:"OperatorAssetStates"`
+	// LzNonces are the last processed LayerZero nonces of the client chains
+	LzNonces []ClientChainLzNonce `protobuf:"bytes,6,rep,name=LzNonces,proto3" json:"LzNonces"`
+	// PausedAssetIDs are the assets that can't be deposited, delegated, undelegated or withdrawn
+	PausedAssetIDs []string `protobuf:"bytes,7,rep,name=PausedAssetIDs,proto3" json:"PausedAssetIDs,omitempty"`
+	// Guardian is the address that can pause the client chains and assets besides the governance
+	Guardian string `protobuf:"bytes,8,opt,name=Guardian,proto3" json:"Guardian,omitempty"`
+	// WithdrawalOutflowCaps are the withdrawal outflow caps of the assets, the outflow records
+	// aren't exported, so they are restarted from zero.
+	WithdrawalOutflowCaps []WithdrawalOutflowCap `protobuf:"bytes,9,rep,name=WithdrawalOutflowCaps,proto3" json:"WithdrawalOutflowCaps"`
+	// ExoCoreAddrBindings are the exoCore addresses bound to the stakers
+	ExoCoreAddrBindings []ExoCoreAddrBinding `protobuf:"bytes,10,rep,name=ExoCoreAddrBindings,proto3" json:"ExoCoreAddrBindings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,8 +91,242 @@ func (m *GenesisState) GetDefaultSupportedClientChainTokens() []*AssetInfo {
 	return nil
 }
 
+func (m *GenesisState) GetDeprecatedAssetIDs() []string {
+	if m != nil {
+		return m.DeprecatedAssetIDs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakerAssetStates() []StakerAssetState {
+	if m != nil {
+		return m.StakerAssetStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorAssetStates() []OperatorAssetState {
+	if m != nil {
+		return m.OperatorAssetStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLzNonces() []ClientChainLzNonce {
+	if m != nil {
+		return m.LzNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedAssetIDs() []string {
+	if m != nil {
+		return m.PausedAssetIDs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *GenesisState) GetWithdrawalOutflowCaps() []WithdrawalOutflowCap {
+	if m != nil {
+		return m.WithdrawalOutflowCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExoCoreAddrBindings() []ExoCoreAddrBinding {
+	if m != nil {
+		return m.ExoCoreAddrBindings
+	}
+	return nil
+}
+
+// StakerAssetState is the state of an asset deposited by the staker
+type StakerAssetState struct {
+	StakerID string                        `protobuf:"bytes,1,opt,name=StakerID,proto3" json:"StakerID,omitempty"`
+	AssetID  string                        `protobuf:"bytes,2,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	Info     StakerSingleAssetOrChangeInfo `protobuf:"bytes,3,opt,name=Info,proto3" json:"Info"`
+}
+
+func (m *StakerAssetState) Reset()         { *m = StakerAssetState{} }
+func (m *StakerAssetState) String() string { return proto.CompactTextString(m) }
+func (*StakerAssetState) ProtoMessage()    {}
+func (*StakerAssetState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_554af23024865cd5, []int{1}
+}
+func (m *StakerAssetState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerAssetState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerAssetState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerAssetState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerAssetState.Merge(m, src)
+}
+func (m *StakerAssetState) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerAssetState) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerAssetState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerAssetState proto.InternalMessageInfo
+
+func (m *StakerAssetState) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *StakerAssetState) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *StakerAssetState) GetInfo() StakerSingleAssetOrChangeInfo {
+	if m != nil {
+		return m.Info
+	}
+	return StakerSingleAssetOrChangeInfo{}
+}
+
+// OperatorAssetState is the state of an asset delegated to the operator
+type OperatorAssetState struct {
+	OperatorAddr string                          `protobuf:"bytes,1,opt,name=OperatorAddr,proto3" json:"OperatorAddr,omitempty"`
+	AssetID      string                          `protobuf:"bytes,2,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	Info         OperatorSingleAssetOrChangeInfo `protobuf:"bytes,3,opt,name=Info,proto3" json:"Info"`
+}
+
+func (m *OperatorAssetState) Reset()         { *m = OperatorAssetState{} }
+func (m *OperatorAssetState) String() string { return proto.CompactTextString(m) }
+func (*OperatorAssetState) ProtoMessage()    {}
+func (*OperatorAssetState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_554af23024865cd5, []int{2}
+}
+func (m *OperatorAssetState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAssetState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAssetState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAssetState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAssetState.Merge(m, src)
+}
+func (m *OperatorAssetState) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAssetState) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAssetState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAssetState proto.InternalMessageInfo
+
+func (m *OperatorAssetState) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *OperatorAssetState) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *OperatorAssetState) GetInfo() OperatorSingleAssetOrChangeInfo {
+	if m != nil {
+		return m.Info
+	}
+	return OperatorSingleAssetOrChangeInfo{}
+}
+
+// ClientChainLzNonce is the last LayerZero nonce processed for the client chain
+type ClientChainLzNonce struct {
+	LayerZeroChainID uint64 `protobuf:"varint,1,opt,name=LayerZeroChainID,proto3" json:"LayerZeroChainID,omitempty"`
+	LastNonce        uint64 `protobuf:"varint,2,opt,name=LastNonce,proto3" json:"LastNonce,omitempty"`
+}
+
+func (m *ClientChainLzNonce) Reset()         { *m = ClientChainLzNonce{} }
+func (m *ClientChainLzNonce) String() string { return proto.CompactTextString(m) }
+func (*ClientChainLzNonce) ProtoMessage()    {}
+func (*ClientChainLzNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_554af23024865cd5, []int{3}
+}
+func (m *ClientChainLzNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientChainLzNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientChainLzNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientChainLzNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientChainLzNonce.Merge(m, src)
+}
+func (m *ClientChainLzNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientChainLzNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientChainLzNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientChainLzNonce proto.InternalMessageInfo
+
+func (m *ClientChainLzNonce) GetLayerZeroChainID() uint64 {
+	if m != nil {
+		return m.LayerZeroChainID
+	}
+	return 0
+}
+
+func (m *ClientChainLzNonce) GetLastNonce() uint64 {
+	if m != nil {
+		return m.LastNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.restaking_assets_manage.v1.GenesisState")
+	proto.RegisterType((*StakerAssetState)(nil), "exocore.restaking_assets_manage.v1.StakerAssetState")
+	proto.RegisterType((*OperatorAssetState)(nil), "exocore.restaking_assets_manage.v1.OperatorAssetState")
+	proto.RegisterType((*ClientChainLzNonce)(nil), "exocore.restaking_assets_manage.v1.ClientChainLzNonce")
 }
 
 func init() {
@@ -85,24 +334,45 @@ func init() {
 }
 
 var fileDescriptor_554af23024865cd5 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x48, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x4a, 0x2d, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0x8f, 0x4f, 0x2c,
-	0x2e, 0x4e, 0x2d, 0x29, 0x8e, 0xcf, 0x4d, 0xcc, 0x4b, 0x4c, 0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x82, 0xea,
-	0xd0, 0xc3, 0xa1, 0x43, 0xaf, 0xcc, 0x50, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x5c, 0x1f,
-	0xc4, 0x82, 0xe8, 0x94, 0xd2, 0x26, 0xc2, 0xae, 0x92, 0x0a, 0x88, 0x62, 0xa5, 0x16, 0x26, 0x2e,
-	0x1e, 0x77, 0x88, 0xc5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xe5, 0x5c, 0x32, 0x2e, 0xa9, 0x69,
-	0x89, 0xa5, 0x39, 0x25, 0xc1, 0xa5, 0x05, 0x05, 0xf9, 0x45, 0x25, 0xa9, 0x29, 0xce, 0x39, 0x99,
-	0xa9, 0x79, 0x25, 0xce, 0x19, 0x89, 0x99, 0x79, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46,
-	0xc6, 0x7a, 0x84, 0x9d, 0xa7, 0x87, 0xa4, 0xcf, 0x33, 0x2f, 0x2d, 0x3f, 0x08, 0xaf, 0xc1, 0x42,
-	0xd5, 0x5c, 0x8a, 0x78, 0xe4, 0x43, 0xf2, 0xb3, 0x53, 0xf3, 0x8a, 0x25, 0x98, 0xc0, 0xb6, 0xeb,
-	0x12, 0x63, 0xbb, 0x23, 0x48, 0x00, 0x6c, 0x2f, 0x61, 0x73, 0x9d, 0xa2, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x31, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0xdf, 0x15, 0x62, 0xab, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0xb6, 0x3e, 0x2c, 0x9c,
-	0x2b, 0x70, 0x86, 0x74, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xa8, 0x8d, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x9b, 0xe4, 0x07, 0xcf, 0x05, 0x02, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x6f, 0x4f, 0xd3, 0x40,
+	0x18, 0x5f, 0xd9, 0x04, 0x76, 0x10, 0x83, 0xa7, 0x26, 0x17, 0x42, 0xea, 0xdc, 0x0b, 0xb3, 0x68,
+	0x6c, 0x05, 0x8c, 0xf1, 0xed, 0xe8, 0x08, 0x21, 0x21, 0x60, 0x3a, 0x13, 0x0d, 0x44, 0xc9, 0xb1,
+	0x3e, 0x74, 0xcd, 0xc6, 0x5d, 0x73, 0x77, 0x65, 0x43, 0xbf, 0x84, 0xf1, 0x3b, 0xf8, 0x01, 0xfc,
+	0x16, 0xbc, 0xe4, 0xa5, 0xaf, 0x8c, 0x81, 0x2f, 0x62, 0x7a, 0xed, 0xe6, 0xb6, 0x8e, 0x51, 0xdf,
+	0xed, 0x7e, 0x4f, 0x7f, 0x7f, 0xf6, 0xeb, 0xd3, 0x43, 0xaf, 0xa0, 0xcf, 0x5b, 0x5c, 0x80, 0x2d,
+	0x40, 0x2a, 0xda, 0x09, 0x98, 0x7f, 0x4c, 0xa5, 0x04, 0x25, 0x8f, 0xcf, 0x28, 0xa3, 0x3e, 0xd8,
+	0xe7, 0xeb, 0xb6, 0x0f, 0x0c, 0x64, 0x20, 0xad, 0x50, 0x70, 0xc5, 0x71, 0x35, 0x65, 0x58, 0xb7,
+	0x30, 0xac, 0xf3, 0xf5, 0xd5, 0x47, 0x3e, 0xf7, 0xb9, 0x7e, 0xdc, 0x8e, 0x7f, 0x25, 0xcc, 0xd5,
+	0x17, 0x39, 0xbc, 0x54, 0x3f, 0x79, 0xb8, 0xfa, 0x7d, 0x01, 0x2d, 0xef, 0x24, 0xc6, 0x4d, 0x45,
+	0x15, 0xe0, 0x1e, 0x5a, 0x6b, 0xc0, 0x29, 0x8d, 0xba, 0xaa, 0x19, 0x85, 0x21, 0x17, 0x0a, 0x3c,
+	0xa7, 0x1b, 0x00, 0x53, 0x4e, 0x9b, 0x06, 0x4c, 0x12, 0xa3, 0x52, 0xac, 0x2d, 0x6d, 0x6c, 0x5a,
+	0x77, 0xc7, 0xb3, 0x46, 0x78, 0xbb, 0xec, 0x94, 0xbb, 0x33, 0x85, 0xf1, 0x57, 0xf4, 0x74, 0xc6,
+	0xfc, 0x3d, 0xef, 0x00, 0x93, 0x64, 0x4e, 0xbb, 0xbf, 0xcc, 0xe3, 0x5e, 0x8f, 0x01, 0xed, 0x7b,
+	0xb7, 0x2e, 0xb6, 0x10, 0x6e, 0x40, 0x28, 0xa0, 0x45, 0x15, 0x78, 0x09, 0xb3, 0x21, 0x49, 0xb1,
+	0x52, 0xac, 0x95, 0xdd, 0x29, 0x13, 0xdc, 0x46, 0x0f, 0x9a, 0x8a, 0x76, 0x40, 0x68, 0x44, 0x37,
+	0x27, 0x49, 0x49, 0x87, 0x7b, 0x9d, 0x27, 0xdc, 0x24, 0x79, 0xab, 0x74, 0xf9, 0xfb, 0x49, 0xc1,
+	0xcd, 0x8a, 0x62, 0x86, 0x1e, 0x1e, 0x84, 0x20, 0xa8, 0xe2, 0x63, 0x5e, 0xf7, 0xb4, 0xd7, 0x9b,
+	0x3c, 0x5e, 0x59, 0x7a, 0xea, 0x36, 0x4d, 0x18, 0x7f, 0x44, 0x8b, 0x7b, 0x5f, 0xf6, 0x39, 0x6b,
+	0x81, 0x24, 0xf3, 0xf9, 0x4d, 0x46, 0x2a, 0x4d, 0xe9, 0xa9, 0xc9, 0x50, 0x0d, 0x3f, 0x43, 0xf7,
+	0xdf, 0xd1, 0x48, 0x8e, 0xf4, 0xbb, 0xa0, 0xfb, 0x9d, 0x40, 0xf1, 0x2a, 0x5a, 0xdc, 0x89, 0xa8,
+	0xf0, 0x02, 0xca, 0xc8, 0x62, 0xc5, 0xa8, 0x95, 0xdd, 0xe1, 0x19, 0x2b, 0xf4, 0xf8, 0x43, 0xa0,
+	0xda, 0x9e, 0xa0, 0x3d, 0xda, 0x3d, 0x88, 0xd4, 0x69, 0x97, 0xf7, 0x1c, 0x1a, 0x4a, 0x52, 0xd6,
+	0x51, 0xdf, 0xe6, 0x89, 0x3a, 0x4d, 0x20, 0x0d, 0x3b, 0x5d, 0x3c, 0x7e, 0x07, 0xdb, 0x7d, 0xee,
+	0x70, 0x01, 0x75, 0xcf, 0x13, 0x5b, 0x01, 0xf3, 0x02, 0xe6, 0x4b, 0x82, 0xf2, 0xd7, 0x93, 0xa5,
+	0x0f, 0xde, 0xc1, 0x14, 0xe1, 0xea, 0x0f, 0x03, 0xad, 0x4c, 0x6e, 0x42, 0x5c, 0x4b, 0x82, 0xed,
+	0x36, 0x88, 0x91, 0xd4, 0x32, 0x38, 0x63, 0x82, 0x16, 0xd2, 0xfa, 0xc8, 0x9c, 0x1e, 0x0d, 0x8e,
+	0xf8, 0x08, 0x95, 0xe2, 0x6f, 0x80, 0x14, 0x2b, 0x46, 0x6d, 0x69, 0xa3, 0x9e, 0x7f, 0x37, 0x9b,
+	0x01, 0xf3, 0xbb, 0xa0, 0x65, 0x0e, 0x84, 0xd3, 0xa6, 0xcc, 0x87, 0x58, 0x28, 0x8d, 0xad, 0x45,
+	0xab, 0x3f, 0x0d, 0x84, 0xb3, 0x3b, 0x84, 0xab, 0x68, 0x79, 0x88, 0x7a, 0x9e, 0x48, 0xd3, 0x8e,
+	0x61, 0x33, 0x12, 0x7f, 0x1a, 0x4b, 0xec, 0xfc, 0xcf, 0x86, 0xe7, 0xc9, 0xfc, 0x19, 0xe1, 0xec,
+	0xae, 0xe2, 0xe7, 0x68, 0x65, 0x8f, 0x5e, 0x80, 0x38, 0x04, 0xc1, 0xf5, 0x20, 0x2d, 0xb9, 0xe4,
+	0x66, 0x70, 0xbc, 0x86, 0xca, 0x7b, 0x54, 0x2a, 0x4d, 0xd4, 0xe1, 0x4b, 0xee, 0x3f, 0x60, 0xeb,
+	0xe8, 0xf2, 0xda, 0x34, 0xae, 0xae, 0x4d, 0xe3, 0xcf, 0xb5, 0x69, 0x7c, 0xbb, 0x31, 0x0b, 0x57,
+	0x37, 0x66, 0xe1, 0xd7, 0x8d, 0x59, 0x38, 0xac, 0xfb, 0x81, 0x6a, 0x47, 0x27, 0x56, 0x8b, 0x9f,
+	0xd9, 0xdb, 0xc9, 0x9f, 0xda, 0x07, 0xd5, 0xe3, 0xa2, 0x63, 0x0f, 0x6e, 0xec, 0xfe, 0xad, 0x77,
+	0xb6, 0xba, 0x08, 0x41, 0x9e, 0xcc, 0xeb, 0x4b, 0x7b, 0xf3, 0xef, 0x00, 0xcb, 0xfc, 0xd7, 0xa6,
+	0x4f, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +395,101 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExoCoreAddrBindings) > 0 {
+		for iNdEx := len(m.ExoCoreAddrBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExoCoreAddrBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.WithdrawalOutflowCaps) > 0 {
+		for iNdEx := len(m.WithdrawalOutflowCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawalOutflowCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PausedAssetIDs) > 0 {
+		for iNdEx := len(m.PausedAssetIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedAssetIDs[iNdEx])
+			copy(dAtA[i:], m.PausedAssetIDs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedAssetIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LzNonces) > 0 {
+		for iNdEx := len(m.LzNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LzNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OperatorAssetStates) > 0 {
+		for iNdEx := len(m.OperatorAssetStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorAssetStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StakerAssetStates) > 0 {
+		for iNdEx := len(m.StakerAssetStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerAssetStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DeprecatedAssetIDs) > 0 {
+		for iNdEx := len(m.DeprecatedAssetIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeprecatedAssetIDs[iNdEx])
+			copy(dAtA[i:], m.DeprecatedAssetIDs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeprecatedAssetIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DefaultSupportedClientChainTokens) > 0 {
 		for iNdEx := len(m.DefaultSupportedClientChainTokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -156,45 +521,655 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StakerAssetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *StakerAssetState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerAssetState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAssetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAssetState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAssetState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DefaultSupportedClientChains) > 0 {
-		for _, e := range m.DefaultSupportedClientChains {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.DefaultSupportedClientChainTokens) > 0 {
-		for _, e := range m.DefaultSupportedClientChainTokens {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientChainLzNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientChainLzNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientChainLzNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LayerZeroChainID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LayerZeroChainID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DefaultSupportedClientChains) > 0 {
+		for _, e := range m.DefaultSupportedClientChains {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DefaultSupportedClientChainTokens) > 0 {
+		for _, e := range m.DefaultSupportedClientChainTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeprecatedAssetIDs) > 0 {
+		for _, s := range m.DeprecatedAssetIDs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakerAssetStates) > 0 {
+		for _, e := range m.StakerAssetStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorAssetStates) > 0 {
+		for _, e := range m.OperatorAssetStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LzNonces) > 0 {
+		for _, e := range m.LzNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedAssetIDs) > 0 {
+		for _, s := range m.PausedAssetIDs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.WithdrawalOutflowCaps) > 0 {
+		for _, e := range m.WithdrawalOutflowCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExoCoreAddrBindings) > 0 {
+		for _, e := range m.ExoCoreAddrBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakerAssetState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OperatorAssetState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Info.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ClientChainLzNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LayerZeroChainID != 0 {
+		n += 1 + sovGenesis(uint64(m.LayerZeroChainID))
+	}
+	if m.LastNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastNonce))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSupportedClientChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultSupportedClientChains = append(m.DefaultSupportedClientChains, &ClientChainInfo{})
+			if err := m.DefaultSupportedClientChains[len(m.DefaultSupportedClientChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSupportedClientChainTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultSupportedClientChainTokens = append(m.DefaultSupportedClientChainTokens, &AssetInfo{})
+			if err := m.DefaultSupportedClientChainTokens[len(m.DefaultSupportedClientChainTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedAssetIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeprecatedAssetIDs = append(m.DeprecatedAssetIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAssetStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAssetStates = append(m.StakerAssetStates, StakerAssetState{})
+			if err := m.StakerAssetStates[len(m.StakerAssetStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAssetStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAssetStates = append(m.OperatorAssetStates, OperatorAssetState{})
+			if err := m.OperatorAssetStates[len(m.OperatorAssetStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LzNonces = append(m.LzNonces, ClientChainLzNonce{})
+			if err := m.LzNonces[len(m.LzNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAssetIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedAssetIDs = append(m.PausedAssetIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalOutflowCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalOutflowCaps = append(m.WithdrawalOutflowCaps, WithdrawalOutflowCap{})
+			if err := m.WithdrawalOutflowCaps[len(m.WithdrawalOutflowCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreAddrBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExoCoreAddrBindings = append(m.ExoCoreAddrBindings, ExoCoreAddrBinding{})
+			if err := m.ExoCoreAddrBindings[len(m.ExoCoreAddrBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *StakerAssetState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -217,15 +1192,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: StakerAssetState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StakerAssetState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSupportedClientChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -252,14 +1291,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultSupportedClientChains = append(m.DefaultSupportedClientChains, &ClientChainInfo{})
-			if err := m.DefaultSupportedClientChains[len(m.DefaultSupportedClientChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAssetState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAssetState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAssetState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSupportedClientChainTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -286,8 +1438,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultSupportedClientChainTokens = append(m.DefaultSupportedClientChainTokens, &AssetInfo{})
-			if err := m.DefaultSupportedClientChainTokens[len(m.DefaultSupportedClientChainTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -312,6 +1463,94 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClientChainLzNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainLzNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainLzNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
+			}
+			m.LayerZeroChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LayerZeroChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
+			}
+			m.LastNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ValidateBasic checks the client chain info. The address length can't exceed GeneralClientChainAddrLength,
+// because the client chain addresses inputted by the precompiles are padded to that length.
+func (m *ClientChainInfo) ValidateBasic() error {
+	if m.Name == "" {
+		return errorsmod.Wrap(ErrInvalidClientChainInfo, "the name is empty")
+	}
+	if m.LayerZeroChainID == 0 {
+		return errorsmod.Wrap(ErrInvalidClientChainInfo, "the layerZero chain ID is zero")
+	}
+	if m.AddressLength == 0 || m.AddressLength > GeneralClientChainAddrLength {
+		return errorsmod.Wrap(ErrInvalidClientChainInfo, fmt.Sprintf("the address length should be in (0, %d], it's:%d", GeneralClientChainAddrLength, m.AddressLength))
+	}
+	return nil
+}
+
+// ValidateBasic checks the asset info, the address length is checked against the client chain when the asset is registered.
+func (m *AssetInfo) ValidateBasic() error {
+	if m.Name == "" || m.Symbol == "" {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, "the name or symbol is empty")
+	}
+	if m.LayerZeroChainID == 0 {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, "the layerZero chain ID is zero")
+	}
+	if _, err := hexutil.Decode(m.Address); err != nil {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, fmt.Sprintf("the address isn't a hex string:%s", m.Address))
+	}
+	if m.TotalSupply.IsNil() || m.TotalSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, fmt.Sprintf("the total supply is invalid:%s", m.TotalSupply))
+	}
+	return nil
+}
+
+// ValidateAssetAddress checks if the length of asset address matches the address length of its client chain
+func ValidateAssetAddress(asset *AssetInfo, clientChain *ClientChainInfo) error {
+	addr, err := hexutil.Decode(asset.Address)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, fmt.Sprintf("the address isn't a hex string:%s", asset.Address))
+	}
+	if len(addr) != int(clientChain.AddressLength) {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, fmt.Sprintf("mismatched address length, address:%s,need:%d", asset.Address, clientChain.AddressLength))
+	}
+	return nil
+}
+
+// ValidateBasic checks the withdrawal outflow cap, the epoch identifier is required by a non-zero per-epoch cap.
+func (m *WithdrawalOutflowCap) ValidateBasic() error {
+	if _, _, err := ParseID(m.AssetID); err != nil {
+		return err
+	}
+	if m.PerBlockCap.IsNil() || m.PerBlockCap.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidWithdrawalOutflowCap, fmt.Sprintf("the per-block cap is invalid:%s", m.PerBlockCap))
+	}
+	if m.PerEpochCap.IsNil() || m.PerEpochCap.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidWithdrawalOutflowCap, fmt.Sprintf("the per-epoch cap is invalid:%s", m.PerEpochCap))
+	}
+	if !m.PerEpochCap.IsZero() && m.EpochIdentifier == "" {
+		return errorsmod.Wrap(ErrInvalidWithdrawalOutflowCap, "the epoch identifier is empty")
+	}
+	return nil
+}
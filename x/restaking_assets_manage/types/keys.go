@@ -40,6 +40,15 @@ const (
 
 	prefixRestakerExocoreAddrReverse
 
+	prefixAssetStakers
+	prefixAssetOperators
+
+	prefixClientChainLzNonce
+
+	prefixGuardian
+	prefixWithdrawalOutflowCap
+	prefixWithdrawalOutflow
+
 	// prefixReStakingAssetList
 	// prefixReStakerAssetList
 	// prefixOperatorAssetList
@@ -99,11 +108,31 @@ var (
 	KeyPrefixOperatorOptedInMiddleWareAssetInfos = []byte{prefixOperatorOptedInMiddlewareAssetInfo}
 
 	// KeyPrefixReStakerExoCoreAddr reStakerId = clientChainAddr+'_'+ExoCoreChainIndex
-	// KeyPrefixReStakerExoCoreAddr key-value: reStakerId->exoCoreAddr
+	// KeyPrefixReStakerExoCoreAddr key-value: reStakerId->ExoCoreAddrBinding
 	KeyPrefixReStakerExoCoreAddr = []byte{prefixRestakerExocoreAddr}
-	// KeyPrefixReStakerExoCoreAddrReverse k->v: exocoreAddress -> map[clientChainIndex]clientChainAddress
+	// KeyPrefixReStakerExoCoreAddrReverse key->value: exoCoreAddr+'/'+reStakerId->struct{}
 	// used to retrieve all user assets based on their exoCore address
 	KeyPrefixReStakerExoCoreAddrReverse = []byte{prefixRestakerExocoreAddrReverse}
+
+	// KeyPrefixAssetStakers key->value: AssetId+'/'+reStakerId->struct{}
+	// it's the reverse index of KeyPrefixReStakerAssetInfos, only the stakers with non-zero total deposit amount are recorded.
+	KeyPrefixAssetStakers = []byte{prefixAssetStakers}
+
+	// KeyPrefixAssetOperators key->value: AssetId+'/'+operatorAddr->struct{}
+	// it's the reverse index of KeyPrefixOperatorAssetInfos, only the operators with non-zero total amount are recorded.
+	KeyPrefixAssetOperators = []byte{prefixAssetOperators}
+
+	// KeyPrefixClientChainLzNonce key->value: chainIndex->the last processed LayerZero nonce
+	KeyPrefixClientChainLzNonce = []byte{prefixClientChainLzNonce}
+
+	// KeyGuardian is the key of the guardian address who can pause the client chains and assets
+	KeyGuardian = []byte{prefixGuardian}
+
+	// KeyPrefixWithdrawalOutflowCap key->value: AssetId->WithdrawalOutflowCap
+	KeyPrefixWithdrawalOutflowCap = []byte{prefixWithdrawalOutflowCap}
+
+	// KeyPrefixWithdrawalOutflow key->value: AssetId->WithdrawalOutflow
+	KeyPrefixWithdrawalOutflow = []byte{prefixWithdrawalOutflow}
 )
 
 // GetAssetStateKey assetStateKey = stakerID+'/'+assetID
@@ -118,3 +147,9 @@ func ParseStakerAndAssetIDFromKey(key []byte) (stakerID string, assetID string,
 	}
 	return stringList[0], stringList[1], nil
 }
+
+// GetAssetStateIteratorPrefix returns the prefix used to iterate all asset states of a staker or an operator.
+// The separator is appended to avoid matching the IDs that have the input as their prefix.
+func GetAssetStateIteratorPrefix(stakerOrOperator string) []byte {
+	return []byte(stakerOrOperator + "/")
+}
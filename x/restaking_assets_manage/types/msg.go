@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +11,12 @@ var (
 	_ sdk.Msg = &MsgSetExoCoreAddr{}
 	_ sdk.Msg = &RegisterClientChainReq{}
 	_ sdk.Msg = &RegisterAssetReq{}
+	_ sdk.Msg = &UpdateAssetReq{}
+	_ sdk.Msg = &DeprecateAssetReq{}
+	_ sdk.Msg = &SetGuardianReq{}
+	_ sdk.Msg = &PauseClientChainReq{}
+	_ sdk.Msg = &PauseAssetReq{}
+	_ sdk.Msg = &SetWithdrawalOutflowCapReq{}
 )
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
@@ -25,6 +33,13 @@ func (m *MsgSetExoCoreAddr) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.SetAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid set address")
 	}
+	// the exoCore address should sign the tx to prove that it's controlled by the staker
+	if m.FromAddress != m.SetAddress {
+		return errorsmod.Wrap(ErrMismatchedExoCoreAddr, fmt.Sprintf("signer:%s,set address:%s", m.FromAddress, m.SetAddress))
+	}
+	if m.StakerClientChainSignature == "" {
+		return errorsmod.Wrap(ErrInvalidClientChainSignature, "the signature is empty")
+	}
 	return nil
 }
 
@@ -44,7 +59,10 @@ func (m *RegisterClientChainReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
-	return nil
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidClientChainInfo, "the client chain info is nil")
+	}
+	return m.Info.ValidateBasic()
 }
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -63,10 +81,147 @@ func (m *RegisterAssetReq) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
 		return errorsmod.Wrap(err, "invalid from address")
 	}
-	return nil
+	if m.Info == nil {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, "the asset info is nil")
+	}
+	return m.Info.ValidateBasic()
 }
 
 // GetSignBytes implements the LegacyMsg interface.
 func (m *RegisterAssetReq) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a UpdateAssetReq message.
+func (m *UpdateAssetReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *UpdateAssetReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, _, err := ParseID(m.AssetID); err != nil {
+		return err
+	}
+	if !m.TotalSupply.IsNil() && m.TotalSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidAssetInfo, "the total supply is negative")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *UpdateAssetReq) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a DeprecateAssetReq message.
+func (m *DeprecateAssetReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *DeprecateAssetReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, _, err := ParseID(m.AssetID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *DeprecateAssetReq) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a SetGuardianReq message.
+func (m *SetGuardianReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *SetGuardianReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Guardian); err != nil {
+			return errorsmod.Wrap(err, "invalid guardian address")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *SetGuardianReq) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a PauseClientChainReq message.
+func (m *PauseClientChainReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *PauseClientChainReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.LayerZeroChainID == 0 {
+		return errorsmod.Wrap(ErrInvalidClientChainInfo, "the layerZero chain ID is zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *PauseClientChainReq) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a PauseAssetReq message.
+func (m *PauseAssetReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *PauseAssetReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, _, err := ParseID(m.AssetID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *PauseAssetReq) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a SetWithdrawalOutflowCapReq message.
+func (m *SetWithdrawalOutflowCapReq) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *SetWithdrawalOutflowCapReq) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	return m.Cap.ValidateBasic()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *SetWithdrawalOutflowCapReq) GetSignBytes() []byte {
+	return nil
+}
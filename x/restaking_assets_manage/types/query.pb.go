@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -647,1193 +647,5467 @@ func (m *QueryStakerExCoreAddrResponse) GetExCoreAddr() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryClientChainInfo")
-	proto.RegisterType((*QueryAllClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfo")
-	proto.RegisterType((*QueryAllClientChainInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfoResponse")
-	proto.RegisterMapType((map[uint64]*ClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfoResponse.AllClientChainInfosEntry")
-	proto.RegisterType((*QueryStakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryStakingAssetInfo")
-	proto.RegisterType((*QueryAllStakingAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfo")
-	proto.RegisterType((*QueryAllStakingAssetsInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfoResponse")
-	proto.RegisterMapType((map[string]*StakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfoResponse.AllStakingAssetsInfoEntry")
-	proto.RegisterType((*QueryStakerAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryStakerAssetInfo")
-	proto.RegisterType((*QueryAssetInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAssetInfoResponse")
-	proto.RegisterMapType((map[string]*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAssetInfoResponse.AssetInfosEntry")
-	proto.RegisterType((*QuerySpecifiedAssetAmountReq)(nil), "exocore.restaking_assets_manage.v1.QuerySpecifiedAssetAmountReq")
-	proto.RegisterType((*QueryOperatorAssetInfos)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfos")
-	proto.RegisterType((*QueryOperatorAssetInfosResponse)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfosResponse")
-	proto.RegisterMapType((map[string]*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfosResponse.AssetInfosEntry")
-	proto.RegisterType((*QueryOperatorSpecifiedAssetAmountReq)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorSpecifiedAssetAmountReq")
-	proto.RegisterType((*QueryStakerExCoreAddr)(nil), "exocore.restaking_assets_manage.v1.QueryStakerExCoreAddr")
-	proto.RegisterType((*QueryStakerExCoreAddrResponse)(nil), "exocore.restaking_assets_manage.v1.QueryStakerExCoreAddrResponse")
+// QueryExoCoreAddrStakersReq is used to query the stakers bound to the exoCore address
+type QueryExoCoreAddrStakersReq struct {
+	ExoCoreAddr string             `protobuf:"bytes,1,opt,name=exoCoreAddr,proto3" json:"exoCoreAddr,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("exocore/restaking_assets_manage/v1/query.proto", fileDescriptor_6d13900d4f268106)
+func (m *QueryExoCoreAddrStakersReq) Reset()         { *m = QueryExoCoreAddrStakersReq{} }
+func (m *QueryExoCoreAddrStakersReq) String() string { return proto.CompactTextString(m) }
+func (*QueryExoCoreAddrStakersReq) ProtoMessage()    {}
+func (*QueryExoCoreAddrStakersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{14}
 }
-
-var fileDescriptor_6d13900d4f268106 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xee, 0x64, 0x59, 0xd8, 0x9d, 0x45, 0x62, 0x35, 0x1b, 0x20, 0xf1, 0x2e, 0xa1, 0x58, 0x1c,
-	0x2a, 0x10, 0xb6, 0x9a, 0x22, 0x48, 0x36, 0x54, 0x90, 0x7a, 0x83, 0xb6, 0x3d, 0xec, 0xd2, 0x04,
-	0xa9, 0x2a, 0x20, 0x45, 0x6e, 0x32, 0x75, 0x4d, 0x13, 0x4f, 0xea, 0x71, 0x4a, 0xa2, 0xaa, 0xa8,
-	0xea, 0x05, 0x4e, 0x08, 0xa9, 0x37, 0xf8, 0x11, 0x70, 0xe0, 0xc0, 0x4f, 0xe0, 0xc0, 0xa1, 0x80,
-	0x84, 0x38, 0x42, 0x8b, 0x40, 0xdc, 0xf9, 0x01, 0xab, 0xcc, 0xf8, 0x2b, 0x8e, 0x9d, 0x4e, 0x3e,
-	0x6e, 0x99, 0x99, 0xf7, 0xeb, 0x79, 0x9f, 0xf1, 0xfb, 0x4c, 0xa0, 0x82, 0x7b, 0xa4, 0x41, 0x6c,
-	0xac, 0xda, 0x98, 0x3a, 0xfa, 0xbe, 0x69, 0x19, 0x75, 0x9d, 0x52, 0xec, 0xd0, 0x7a, 0x5b, 0xb7,
-	0x74, 0x03, 0xab, 0x87, 0xcb, 0xea, 0x41, 0x17, 0xdb, 0x7d, 0xa5, 0x63, 0x13, 0x87, 0x20, 0xd9,
-	0xb5, 0x57, 0x12, 0xec, 0x95, 0xc3, 0x65, 0x29, 0x6d, 0x10, 0x83, 0x30, 0x73, 0x75, 0xf0, 0x8b,
-	0x7b, 0x4a, 0xf7, 0x0c, 0x42, 0x8c, 0x16, 0x56, 0xf5, 0x8e, 0xa9, 0xea, 0x96, 0x45, 0x1c, 0xdd,
-	0x31, 0x89, 0x45, 0xdd, 0xd3, 0xbb, 0x0d, 0x42, 0xdb, 0x84, 0xf2, 0x5c, 0x91, 0xa4, 0x52, 0x96,
-	0x1f, 0xd6, 0x79, 0x4c, 0xbe, 0x70, 0x8f, 0x5e, 0x17, 0xa8, 0xdf, 0xe9, 0x71, 0x63, 0xf9, 0x2d,
-	0x98, 0xde, 0x1c, 0x84, 0xd5, 0x5a, 0x26, 0xb6, 0x1c, 0x6d, 0x4f, 0x37, 0xad, 0x75, 0x6b, 0x97,
-	0xa0, 0x1c, 0x84, 0x0d, 0xbe, 0x68, 0xe2, 0x5e, 0x06, 0x2c, 0x82, 0xa5, 0xa7, 0xaa, 0xa1, 0x1d,
-	0x39, 0x0b, 0x5f, 0x64, 0x7e, 0xe5, 0x56, 0x2b, 0xe2, 0x2a, 0x7f, 0x97, 0x82, 0x2f, 0x27, 0x9c,
-	0x55, 0x31, 0xed, 0x10, 0x8b, 0x62, 0xf4, 0x15, 0x80, 0x77, 0xf4, 0x91, 0x63, 0x9a, 0x01, 0x8b,
-	0xd7, 0x96, 0x6e, 0xe5, 0x3f, 0x51, 0xae, 0x6e, 0xa9, 0x72, 0x45, 0x0a, 0x65, 0xf4, 0x88, 0x56,
-	0x2c, 0xc7, 0xee, 0x57, 0xe3, 0x12, 0x4b, 0x47, 0x30, 0x93, 0xe4, 0x80, 0x6e, 0xc3, 0x6b, 0xfb,
-	0xb8, 0xef, 0x36, 0x61, 0xf0, 0x13, 0xad, 0xc3, 0xeb, 0x87, 0x7a, 0xab, 0x8b, 0x33, 0xa9, 0x45,
-	0xb0, 0x74, 0x2b, 0xbf, 0x22, 0x52, 0x6f, 0xb4, 0x4e, 0x1e, 0xe1, 0x7e, 0xaa, 0x00, 0xe4, 0x65,
-	0xf8, 0x3c, 0x43, 0x53, 0xe3, 0xbe, 0xe5, 0x81, 0x2b, 0x63, 0x21, 0x03, 0x9f, 0x61, 0x71, 0xd6,
-	0x1f, 0xb0, 0xec, 0x37, 0xab, 0xde, 0x52, 0xbe, 0x0b, 0xb3, 0x5e, 0x03, 0xc2, 0x5e, 0x94, 0x31,
-	0xf0, 0x63, 0x0a, 0xbe, 0x92, 0x78, 0xea, 0x73, 0x70, 0x06, 0x60, 0x5a, 0x8f, 0x31, 0x70, 0x49,
-	0xa8, 0x4f, 0x42, 0x42, 0x62, 0x16, 0x25, 0xee, 0x90, 0xf3, 0x10, 0x9b, 0x5c, 0x3a, 0x86, 0xd9,
-	0x44, 0x97, 0x30, 0x13, 0x37, 0x39, 0x13, 0x1b, 0xc3, 0x4c, 0xbc, 0x29, 0x52, 0x74, 0xb4, 0xcd,
-	0x61, 0x2a, 0xf2, 0xee, 0xf7, 0x30, 0xb0, 0xc1, 0x76, 0xc0, 0x84, 0x04, 0x6f, 0x50, 0xb6, 0xe5,
-	0x53, 0xe1, 0xaf, 0xe5, 0x2f, 0x52, 0xf0, 0x05, 0xde, 0x08, 0x3f, 0xa2, 0xd7, 0xe3, 0x4f, 0x21,
-	0xd4, 0xbd, 0x4d, 0xef, 0x76, 0x6f, 0x88, 0x37, 0x36, 0x1a, 0x4f, 0xf1, 0x77, 0xdc, 0xbb, 0x1c,
-	0x8a, 0x2e, 0x9d, 0x00, 0xf8, 0x5c, 0xe4, 0x3c, 0xa6, 0x61, 0x5b, 0xc3, 0x0d, 0x2b, 0x8b, 0x36,
-	0x0c, 0xdb, 0x35, 0xd3, 0x32, 0x5a, 0x98, 0x65, 0x78, 0x6c, 0x6b, 0x7b, 0xba, 0x65, 0xe0, 0x68,
-	0xf7, 0x3e, 0x84, 0xf7, 0x78, 0xf7, 0x3a, 0xb8, 0x61, 0xee, 0x9a, 0xb8, 0xc9, 0xac, 0xcb, 0x6d,
-	0xd2, 0xb5, 0x9c, 0x2a, 0x3e, 0x18, 0xd7, 0xc5, 0xf0, 0x5d, 0x4f, 0x0d, 0xdf, 0xf5, 0x2d, 0x77,
-	0xd6, 0x3c, 0xee, 0x60, 0x5b, 0x77, 0x48, 0xc0, 0x0a, 0x45, 0xef, 0xc0, 0x67, 0x89, 0xb7, 0xdb,
-	0x6c, 0xda, 0x3c, 0xe8, 0x5a, 0xe6, 0xd7, 0x1f, 0xde, 0x48, 0xbb, 0x33, 0x71, 0xb0, 0x8d, 0x29,
-	0xad, 0x39, 0xb6, 0x69, 0x19, 0xd5, 0x21, 0x6b, 0xf9, 0x5b, 0x6f, 0x52, 0x8d, 0x46, 0xf6, 0x19,
-	0xa4, 0x31, 0x0c, 0xd6, 0x84, 0x19, 0x4c, 0x0e, 0x3c, 0x96, 0xca, 0x53, 0x21, 0x2a, 0xb7, 0x87,
-	0xa9, 0xd4, 0x44, 0xaa, 0xf2, 0x0a, 0x12, 0x20, 0xf3, 0x73, 0xf8, 0xea, 0x10, 0x86, 0x24, 0x52,
-	0x67, 0xe2, 0x60, 0x0c, 0xed, 0x2b, 0xa1, 0xa9, 0x88, 0xed, 0x4a, 0x4f, 0x23, 0x36, 0x66, 0x2e,
-	0x12, 0xbc, 0x51, 0x8b, 0xdc, 0x22, 0x6f, 0x2d, 0x6f, 0xc3, 0x97, 0x62, 0x9d, 0x7c, 0x3e, 0x0b,
-	0x10, 0x06, 0xbb, 0x57, 0xd6, 0x1a, 0xb2, 0xcd, 0x7f, 0x73, 0x1b, 0x5e, 0x67, 0xb1, 0xd1, 0xef,
-	0x80, 0x4d, 0xdf, 0xc8, 0x44, 0x5f, 0xeb, 0x33, 0x69, 0x44, 0x05, 0xe1, 0xdb, 0x11, 0x09, 0x20,
-	0x4d, 0xa3, 0x23, 0xf2, 0xc6, 0x97, 0xff, 0x7e, 0xff, 0x1a, 0x38, 0xfd, 0xed, 0xef, 0xb3, 0xd4,
-	0xbb, 0x68, 0x55, 0x15, 0x10, 0xff, 0xe4, 0xd2, 0xff, 0x02, 0xac, 0xe7, 0xa3, 0x4a, 0x88, 0x4a,
-	0x33, 0x48, 0xb2, 0xa4, 0xcd, 0x41, 0xcf, 0xe5, 0xf7, 0x03, 0x9c, 0x25, 0x54, 0x14, 0xc4, 0x19,
-	0x83, 0xe4, 0x67, 0x00, 0xef, 0x6c, 0x76, 0xf1, 0x88, 0xd6, 0x16, 0x85, 0x8b, 0x8c, 0xba, 0x4a,
-	0x53, 0xa9, 0x8e, 0xfc, 0x20, 0x00, 0x54, 0x44, 0x6f, 0x0b, 0x02, 0x1a, 0x29, 0xfb, 0x3f, 0xc0,
-	0xa6, 0x63, 0x9c, 0x66, 0xa2, 0xd5, 0x99, 0x24, 0x5c, 0xaa, 0xcc, 0xe5, 0x05, 0x20, 0x3f, 0x0c,
-	0x70, 0xae, 0xa2, 0x92, 0x38, 0x71, 0xa3, 0x78, 0x7e, 0x09, 0xa8, 0xc3, 0x61, 0x15, 0x28, 0x4c,
-	0x44, 0x5d, 0xc8, 0x55, 0xba, 0x3f, 0xbd, 0x16, 0x4f, 0xcf, 0xdf, 0x50, 0xed, 0xff, 0x03, 0x36,
-	0xb1, 0x5c, 0x89, 0x8d, 0x19, 0xb1, 0xe8, 0x3d, 0x71, 0x74, 0xf1, 0x13, 0x5a, 0x9a, 0x5d, 0xe4,
-	0xe5, 0x47, 0x01, 0x58, 0x0d, 0x95, 0x27, 0x02, 0x1b, 0x0b, 0xca, 0x9d, 0x34, 0x31, 0x92, 0x5e,
-	0x9a, 0x41, 0x5c, 0x27, 0x98, 0x34, 0xc9, 0xca, 0x3c, 0xdd, 0xa4, 0x89, 0x41, 0x72, 0xc2, 0x9f,
-	0x17, 0xe3, 0xf4, 0x13, 0x3d, 0x9c, 0xb8, 0xe0, 0x24, 0x92, 0xe7, 0x21, 0xff, 0x73, 0xa7, 0xf9,
-	0x1f, 0xc0, 0xde, 0xd3, 0x9e, 0x1a, 0x13, 0x5f, 0xc3, 0x8b, 0x13, 0x7e, 0xb2, 0x81, 0x0e, 0x8b,
-	0xdd, 0xe6, 0xb1, 0x8f, 0x00, 0xf9, 0x83, 0x00, 0x66, 0x05, 0x69, 0x13, 0xc1, 0x0c, 0x81, 0x50,
-	0x8f, 0xbc, 0x67, 0xc7, 0xf1, 0xda, 0xc7, 0x3f, 0x5d, 0xe4, 0xc0, 0xf9, 0x45, 0x0e, 0xfc, 0x79,
-	0x91, 0x03, 0x5f, 0x5f, 0xe6, 0x16, 0xce, 0x2f, 0x73, 0x0b, 0x7f, 0x5c, 0xe6, 0x16, 0x3e, 0x2a,
-	0x1b, 0xa6, 0xb3, 0xd7, 0xdd, 0x51, 0x1a, 0xa4, 0xad, 0x56, 0x78, 0xa2, 0x47, 0xd8, 0xf9, 0x8c,
-	0xd8, 0xfb, 0x7e, 0xde, 0x5e, 0x62, 0x66, 0xa7, 0xdf, 0xc1, 0x74, 0xe7, 0x69, 0xf6, 0x5f, 0x7d,
-	0xe5, 0x49, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x2b, 0xcf, 0xb5, 0x9a, 0x10, 0x00, 0x00,
+func (m *QueryExoCoreAddrStakersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExoCoreAddrStakersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExoCoreAddrStakersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExoCoreAddrStakersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExoCoreAddrStakersReq.Merge(m, src)
+}
+func (m *QueryExoCoreAddrStakersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExoCoreAddrStakersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExoCoreAddrStakersReq.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryExoCoreAddrStakersReq proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Balance queries the balance of a single coin for a single account.
-	QueClientChainInfoByIndex(ctx context.Context, in *QueryClientChainInfo, opts ...grpc.CallOption) (*ClientChainInfo, error)
-	QueAllClientChainInfo(ctx context.Context, in *QueryAllClientChainInfo, opts ...grpc.CallOption) (*QueryAllClientChainInfoResponse, error)
-	QueStakingAssetInfo(ctx context.Context, in *QueryStakingAssetInfo, opts ...grpc.CallOption) (*StakingAssetInfo, error)
-	QueAllStakingAssetsInfo(ctx context.Context, in *QueryAllStakingAssetsInfo, opts ...grpc.CallOption) (*QueryAllStakingAssetsInfoResponse, error)
-	QueStakerAssetInfos(ctx context.Context, in *QueryStakerAssetInfo, opts ...grpc.CallOption) (*QueryAssetInfoResponse, error)
-	QueStakerSpecifiedAssetAmount(ctx context.Context, in *QuerySpecifiedAssetAmountReq, opts ...grpc.CallOption) (*StakerSingleAssetOrChangeInfo, error)
-	QueOperatorAssetInfos(ctx context.Context, in *QueryOperatorAssetInfos, opts ...grpc.CallOption) (*QueryOperatorAssetInfosResponse, error)
-	QueOperatorSpecifiedAssetAmount(ctx context.Context, in *QueryOperatorSpecifiedAssetAmountReq, opts ...grpc.CallOption) (*OperatorSingleAssetOrChangeInfo, error)
-	QueStakerExoCoreAddr(ctx context.Context, in *QueryStakerExCoreAddr, opts ...grpc.CallOption) (*QueryStakerExCoreAddrResponse, error)
+func (m *QueryExoCoreAddrStakersReq) GetExoCoreAddr() string {
+	if m != nil {
+		return m.ExoCoreAddr
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryExoCoreAddrStakersReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryExoCoreAddrStakersResponse struct {
+	StakerIDs  []string            `protobuf:"bytes,1,rep,name=stakerIDs,proto3" json:"stakerIDs,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QueClientChainInfoByIndex(ctx context.Context, in *QueryClientChainInfo, opts ...grpc.CallOption) (*ClientChainInfo, error) {
-	out := new(ClientChainInfo)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoByIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryExoCoreAddrStakersResponse) Reset()         { *m = QueryExoCoreAddrStakersResponse{} }
+func (m *QueryExoCoreAddrStakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExoCoreAddrStakersResponse) ProtoMessage()    {}
+func (*QueryExoCoreAddrStakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{15}
+}
+func (m *QueryExoCoreAddrStakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExoCoreAddrStakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExoCoreAddrStakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryExoCoreAddrStakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExoCoreAddrStakersResponse.Merge(m, src)
+}
+func (m *QueryExoCoreAddrStakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExoCoreAddrStakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExoCoreAddrStakersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueAllClientChainInfo(ctx context.Context, in *QueryAllClientChainInfo, opts ...grpc.CallOption) (*QueryAllClientChainInfoResponse, error) {
-	out := new(QueryAllClientChainInfoResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAllClientChainInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExoCoreAddrStakersResponse proto.InternalMessageInfo
+
+func (m *QueryExoCoreAddrStakersResponse) GetStakerIDs() []string {
+	if m != nil {
+		return m.StakerIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueStakingAssetInfo(ctx context.Context, in *QueryStakingAssetInfo, opts ...grpc.CallOption) (*StakingAssetInfo, error) {
-	out := new(StakingAssetInfo)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryExoCoreAddrStakersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueAllStakingAssetsInfo(ctx context.Context, in *QueryAllStakingAssetsInfo, opts ...grpc.CallOption) (*QueryAllStakingAssetsInfoResponse, error) {
-	out := new(QueryAllStakingAssetsInfoResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAllStakingAssetsInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// The following list queries are paginated, and the results are ordered by the store keys,
+// so they are deterministic and can be walked page by page.
+type QueryClientChainInfoListReq struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QueStakerAssetInfos(ctx context.Context, in *QueryStakerAssetInfo, opts ...grpc.CallOption) (*QueryAssetInfoResponse, error) {
-	out := new(QueryAssetInfoResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClientChainInfoListReq) Reset()         { *m = QueryClientChainInfoListReq{} }
+func (m *QueryClientChainInfoListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryClientChainInfoListReq) ProtoMessage()    {}
+func (*QueryClientChainInfoListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{16}
+}
+func (m *QueryClientChainInfoListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientChainInfoListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientChainInfoListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClientChainInfoListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientChainInfoListReq.Merge(m, src)
+}
+func (m *QueryClientChainInfoListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientChainInfoListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientChainInfoListReq.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueStakerSpecifiedAssetAmount(ctx context.Context, in *QuerySpecifiedAssetAmountReq, opts ...grpc.CallOption) (*StakerSingleAssetOrChangeInfo, error) {
-	out := new(StakerSingleAssetOrChangeInfo)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerSpecifiedAssetAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientChainInfoListReq proto.InternalMessageInfo
+
+func (m *QueryClientChainInfoListReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueOperatorAssetInfos(ctx context.Context, in *QueryOperatorAssetInfos, opts ...grpc.CallOption) (*QueryOperatorAssetInfosResponse, error) {
-	out := new(QueryOperatorAssetInfosResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetInfos", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryClientChainInfoListResponse struct {
+	ClientChainInfos []*ClientChainInfo  `protobuf:"bytes,1,rep,name=clientChainInfos,proto3" json:"clientChainInfos,omitempty"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) QueOperatorSpecifiedAssetAmount(ctx context.Context, in *QueryOperatorSpecifiedAssetAmountReq, opts ...grpc.CallOption) (*OperatorSingleAssetOrChangeInfo, error) {
-	out := new(OperatorSingleAssetOrChangeInfo)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueOperatorSpecifiedAssetAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClientChainInfoListResponse) Reset()         { *m = QueryClientChainInfoListResponse{} }
+func (m *QueryClientChainInfoListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientChainInfoListResponse) ProtoMessage()    {}
+func (*QueryClientChainInfoListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{17}
+}
+func (m *QueryClientChainInfoListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientChainInfoListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientChainInfoListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClientChainInfoListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientChainInfoListResponse.Merge(m, src)
+}
+func (m *QueryClientChainInfoListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientChainInfoListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientChainInfoListResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueStakerExoCoreAddr(ctx context.Context, in *QueryStakerExCoreAddr, opts ...grpc.CallOption) (*QueryStakerExCoreAddrResponse, error) {
-	out := new(QueryStakerExCoreAddrResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerExoCoreAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientChainInfoListResponse proto.InternalMessageInfo
+
+func (m *QueryClientChainInfoListResponse) GetClientChainInfos() []*ClientChainInfo {
+	if m != nil {
+		return m.ClientChainInfos
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Balance queries the balance of a single coin for a single account.
-	QueClientChainInfoByIndex(context.Context, *QueryClientChainInfo) (*ClientChainInfo, error)
-	QueAllClientChainInfo(context.Context, *QueryAllClientChainInfo) (*QueryAllClientChainInfoResponse, error)
-	QueStakingAssetInfo(context.Context, *QueryStakingAssetInfo) (*StakingAssetInfo, error)
-	QueAllStakingAssetsInfo(context.Context, *QueryAllStakingAssetsInfo) (*QueryAllStakingAssetsInfoResponse, error)
-	QueStakerAssetInfos(context.Context, *QueryStakerAssetInfo) (*QueryAssetInfoResponse, error)
-	QueStakerSpecifiedAssetAmount(context.Context, *QuerySpecifiedAssetAmountReq) (*StakerSingleAssetOrChangeInfo, error)
-	QueOperatorAssetInfos(context.Context, *QueryOperatorAssetInfos) (*QueryOperatorAssetInfosResponse, error)
-	QueOperatorSpecifiedAssetAmount(context.Context, *QueryOperatorSpecifiedAssetAmountReq) (*OperatorSingleAssetOrChangeInfo, error)
-	QueStakerExoCoreAddr(context.Context, *QueryStakerExCoreAddr) (*QueryStakerExCoreAddrResponse, error)
+func (m *QueryClientChainInfoListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryStakingAssetInfoListReq struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) QueClientChainInfoByIndex(ctx context.Context, req *QueryClientChainInfo) (*ClientChainInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueClientChainInfoByIndex not implemented")
+func (m *QueryStakingAssetInfoListReq) Reset()         { *m = QueryStakingAssetInfoListReq{} }
+func (m *QueryStakingAssetInfoListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingAssetInfoListReq) ProtoMessage()    {}
+func (*QueryStakingAssetInfoListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{18}
 }
-func (*UnimplementedQueryServer) QueAllClientChainInfo(ctx context.Context, req *QueryAllClientChainInfo) (*QueryAllClientChainInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueAllClientChainInfo not implemented")
+func (m *QueryStakingAssetInfoListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueStakingAssetInfo(ctx context.Context, req *QueryStakingAssetInfo) (*StakingAssetInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueStakingAssetInfo not implemented")
+func (m *QueryStakingAssetInfoListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingAssetInfoListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueAllStakingAssetsInfo(ctx context.Context, req *QueryAllStakingAssetsInfo) (*QueryAllStakingAssetsInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueAllStakingAssetsInfo not implemented")
+func (m *QueryStakingAssetInfoListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingAssetInfoListReq.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueStakerAssetInfos(ctx context.Context, req *QueryStakerAssetInfo) (*QueryAssetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueStakerAssetInfos not implemented")
+func (m *QueryStakingAssetInfoListReq) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueStakerSpecifiedAssetAmount(ctx context.Context, req *QuerySpecifiedAssetAmountReq) (*StakerSingleAssetOrChangeInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueStakerSpecifiedAssetAmount not implemented")
+func (m *QueryStakingAssetInfoListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingAssetInfoListReq.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueOperatorAssetInfos(ctx context.Context, req *QueryOperatorAssetInfos) (*QueryOperatorAssetInfosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorAssetInfos not implemented")
+
+var xxx_messageInfo_QueryStakingAssetInfoListReq proto.InternalMessageInfo
+
+func (m *QueryStakingAssetInfoListReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueOperatorSpecifiedAssetAmount(ctx context.Context, req *QueryOperatorSpecifiedAssetAmountReq) (*OperatorSingleAssetOrChangeInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorSpecifiedAssetAmount not implemented")
+
+type QueryStakingAssetInfoListResponse struct {
+	StakingAssetInfos []*StakingAssetInfo `protobuf:"bytes,1,rep,name=stakingAssetInfos,proto3" json:"stakingAssetInfos,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) QueStakerExoCoreAddr(ctx context.Context, req *QueryStakerExCoreAddr) (*QueryStakerExCoreAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueStakerExoCoreAddr not implemented")
+
+func (m *QueryStakingAssetInfoListResponse) Reset()         { *m = QueryStakingAssetInfoListResponse{} }
+func (m *QueryStakingAssetInfoListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingAssetInfoListResponse) ProtoMessage()    {}
+func (*QueryStakingAssetInfoListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{19}
+}
+func (m *QueryStakingAssetInfoListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingAssetInfoListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingAssetInfoListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingAssetInfoListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingAssetInfoListResponse.Merge(m, src)
+}
+func (m *QueryStakingAssetInfoListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingAssetInfoListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingAssetInfoListResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryStakingAssetInfoListResponse proto.InternalMessageInfo
+
+func (m *QueryStakingAssetInfoListResponse) GetStakingAssetInfos() []*StakingAssetInfo {
+	if m != nil {
+		return m.StakingAssetInfos
+	}
+	return nil
 }
 
-func _Query_QueClientChainInfoByIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClientChainInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueClientChainInfoByIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoByIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueClientChainInfoByIndex(ctx, req.(*QueryClientChainInfo))
+func (m *QueryStakingAssetInfoListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueAllClientChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClientChainInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueAllClientChainInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAllClientChainInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueAllClientChainInfo(ctx, req.(*QueryAllClientChainInfo))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryStakerAssetListReq struct {
+	StakerID   string             `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueStakingAssetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingAssetInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueStakingAssetInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueStakingAssetInfo(ctx, req.(*QueryStakingAssetInfo))
+func (m *QueryStakerAssetListReq) Reset()         { *m = QueryStakerAssetListReq{} }
+func (m *QueryStakerAssetListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerAssetListReq) ProtoMessage()    {}
+func (*QueryStakerAssetListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{20}
+}
+func (m *QueryStakerAssetListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerAssetListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerAssetListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStakerAssetListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerAssetListReq.Merge(m, src)
+}
+func (m *QueryStakerAssetListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerAssetListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerAssetListReq.DiscardUnknown(m)
 }
 
-func _Query_QueAllStakingAssetsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStakingAssetsInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueAllStakingAssetsInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAllStakingAssetsInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueAllStakingAssetsInfo(ctx, req.(*QueryAllStakingAssetsInfo))
+var xxx_messageInfo_QueryStakerAssetListReq proto.InternalMessageInfo
+
+func (m *QueryStakerAssetListReq) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueStakerAssetInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakerAssetInfo)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueStakerAssetInfos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetInfos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueStakerAssetInfos(ctx, req.(*QueryStakerAssetInfo))
+func (m *QueryStakerAssetListReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueStakerSpecifiedAssetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpecifiedAssetAmountReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueStakerSpecifiedAssetAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerSpecifiedAssetAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueStakerSpecifiedAssetAmount(ctx, req.(*QuerySpecifiedAssetAmountReq))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAssetStakersReq is used to query the stakers holding the asset
+type QueryAssetStakersReq struct {
+	AssetID    string             `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QueOperatorAssetInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorAssetInfos)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueOperatorAssetInfos(ctx, in)
+func (m *QueryAssetStakersReq) Reset()         { *m = QueryAssetStakersReq{} }
+func (m *QueryAssetStakersReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetStakersReq) ProtoMessage()    {}
+func (*QueryAssetStakersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{21}
+}
+func (m *QueryAssetStakersReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetStakersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetStakersReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetInfos",
+}
+func (m *QueryAssetStakersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetStakersReq.Merge(m, src)
+}
+func (m *QueryAssetStakersReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetStakersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetStakersReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetStakersReq proto.InternalMessageInfo
+
+func (m *QueryAssetStakersReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueOperatorAssetInfos(ctx, req.(*QueryOperatorAssetInfos))
+	return ""
+}
+
+func (m *QueryAssetStakersReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueOperatorSpecifiedAssetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOperatorSpecifiedAssetAmountReq)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryStakerAssetStatesResponse struct {
+	States     []StakerAssetState  `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakerAssetStatesResponse) Reset()         { *m = QueryStakerAssetStatesResponse{} }
+func (m *QueryStakerAssetStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerAssetStatesResponse) ProtoMessage()    {}
+func (*QueryStakerAssetStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{22}
+}
+func (m *QueryStakerAssetStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerAssetStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerAssetStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueOperatorSpecifiedAssetAmount(ctx, in)
+}
+func (m *QueryStakerAssetStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerAssetStatesResponse.Merge(m, src)
+}
+func (m *QueryStakerAssetStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerAssetStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerAssetStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerAssetStatesResponse proto.InternalMessageInfo
+
+func (m *QueryStakerAssetStatesResponse) GetStates() []StakerAssetState {
+	if m != nil {
+		return m.States
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueOperatorSpecifiedAssetAmount",
+	return nil
+}
+
+func (m *QueryStakerAssetStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueOperatorSpecifiedAssetAmount(ctx, req.(*QueryOperatorSpecifiedAssetAmountReq))
+	return nil
+}
+
+type QueryOperatorAssetListReq struct {
+	OperatorAddr string             `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorAssetListReq) Reset()         { *m = QueryOperatorAssetListReq{} }
+func (m *QueryOperatorAssetListReq) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAssetListReq) ProtoMessage()    {}
+func (*QueryOperatorAssetListReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{23}
+}
+func (m *QueryOperatorAssetListReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorAssetListReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorAssetListReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryOperatorAssetListReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAssetListReq.Merge(m, src)
+}
+func (m *QueryOperatorAssetListReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorAssetListReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAssetListReq.DiscardUnknown(m)
 }
 
-func _Query_QueStakerExoCoreAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakerExCoreAddr)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOperatorAssetListReq proto.InternalMessageInfo
+
+func (m *QueryOperatorAssetListReq) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueStakerExoCoreAddr(ctx, in)
+	return ""
+}
+
+func (m *QueryOperatorAssetListReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerExoCoreAddr",
+	return nil
+}
+
+// QueryAssetOperatorsReq is used to query the operators holding the asset
+type QueryAssetOperatorsReq struct {
+	AssetID    string             `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAssetOperatorsReq) Reset()         { *m = QueryAssetOperatorsReq{} }
+func (m *QueryAssetOperatorsReq) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetOperatorsReq) ProtoMessage()    {}
+func (*QueryAssetOperatorsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{24}
+}
+func (m *QueryAssetOperatorsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetOperatorsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetOperatorsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueStakerExoCoreAddr(ctx, req.(*QueryStakerExCoreAddr))
+}
+func (m *QueryAssetOperatorsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetOperatorsReq.Merge(m, src)
+}
+func (m *QueryAssetOperatorsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetOperatorsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetOperatorsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetOperatorsReq proto.InternalMessageInfo
+
+func (m *QueryAssetOperatorsReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.restaking_assets_manage.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueClientChainInfoByIndex",
-			Handler:    _Query_QueClientChainInfoByIndex_Handler,
-		},
-		{
-			MethodName: "QueAllClientChainInfo",
-			Handler:    _Query_QueAllClientChainInfo_Handler,
-		},
-		{
-			MethodName: "QueStakingAssetInfo",
-			Handler:    _Query_QueStakingAssetInfo_Handler,
-		},
-		{
-			MethodName: "QueAllStakingAssetsInfo",
-			Handler:    _Query_QueAllStakingAssetsInfo_Handler,
-		},
-		{
-			MethodName: "QueStakerAssetInfos",
-			Handler:    _Query_QueStakerAssetInfos_Handler,
-		},
-		{
-			MethodName: "QueStakerSpecifiedAssetAmount",
-			Handler:    _Query_QueStakerSpecifiedAssetAmount_Handler,
-		},
-		{
-			MethodName: "QueOperatorAssetInfos",
-			Handler:    _Query_QueOperatorAssetInfos_Handler,
-		},
-		{
-			MethodName: "QueOperatorSpecifiedAssetAmount",
-			Handler:    _Query_QueOperatorSpecifiedAssetAmount_Handler,
-		},
-		{
-			MethodName: "QueStakerExoCoreAddr",
-			Handler:    _Query_QueStakerExoCoreAddr_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/restaking_assets_manage/v1/query.proto",
-}
-
-func (m *QueryClientChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAssetOperatorsReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLzNonceReq is used to query the last processed LayerZero nonce of the client chain
+type QueryLzNonceReq struct {
+	ClientChainLzID uint64 `protobuf:"varint,1,opt,name=clientChainLzID,proto3" json:"clientChainLzID,omitempty"`
 }
 
-func (m *QueryClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryLzNonceReq) Reset()         { *m = QueryLzNonceReq{} }
+func (m *QueryLzNonceReq) String() string { return proto.CompactTextString(m) }
+func (*QueryLzNonceReq) ProtoMessage()    {}
+func (*QueryLzNonceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{25}
+}
+func (m *QueryLzNonceReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLzNonceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLzNonceReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLzNonceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLzNonceReq.Merge(m, src)
+}
+func (m *QueryLzNonceReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLzNonceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLzNonceReq.DiscardUnknown(m)
 }
 
-func (m *QueryAllClientChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLzNonceReq proto.InternalMessageInfo
+
+func (m *QueryLzNonceReq) GetClientChainLzID() uint64 {
+	if m != nil {
+		return m.ClientChainLzID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLzNonceResponse struct {
+	LastNonce uint64 `protobuf:"varint,1,opt,name=lastNonce,proto3" json:"lastNonce,omitempty"`
+	// gapTolerant indicates whether the nonces of the client chain can skip some values
+	GapTolerant bool `protobuf:"varint,2,opt,name=gapTolerant,proto3" json:"gapTolerant,omitempty"`
 }
 
-func (m *QueryAllClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLzNonceResponse) Reset()         { *m = QueryLzNonceResponse{} }
+func (m *QueryLzNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLzNonceResponse) ProtoMessage()    {}
+func (*QueryLzNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{26}
 }
-
-func (m *QueryAllClientChainInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLzNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLzNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLzNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllClientChainInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLzNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLzNonceResponse.Merge(m, src)
+}
+func (m *QueryLzNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLzNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLzNonceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllClientChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllClientChainInfos) > 0 {
-		for k := range m.AllClientChainInfos {
-			v := m.AllClientChainInfos[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryLzNonceResponse proto.InternalMessageInfo
+
+func (m *QueryLzNonceResponse) GetLastNonce() uint64 {
+	if m != nil {
+		return m.LastNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryStakingAssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLzNonceResponse) GetGapTolerant() bool {
+	if m != nil {
+		return m.GapTolerant
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryStakingAssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryWithdrawalOutflowReq is used to query the withdrawal outflow cap and the current outflow of the asset
+type QueryWithdrawalOutflowReq struct {
+	AssetID string `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
 }
 
-func (m *QueryStakingAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryWithdrawalOutflowReq) Reset()         { *m = QueryWithdrawalOutflowReq{} }
+func (m *QueryWithdrawalOutflowReq) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalOutflowReq) ProtoMessage()    {}
+func (*QueryWithdrawalOutflowReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{27}
+}
+func (m *QueryWithdrawalOutflowReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalOutflowReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalOutflowReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryWithdrawalOutflowReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalOutflowReq.Merge(m, src)
+}
+func (m *QueryWithdrawalOutflowReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalOutflowReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalOutflowReq.DiscardUnknown(m)
 }
 
-func (m *QueryAllStakingAssetsInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalOutflowReq proto.InternalMessageInfo
+
+func (m *QueryWithdrawalOutflowReq) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllStakingAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWithdrawalOutflowResponse struct {
+	Cap     WithdrawalOutflowCap `protobuf:"bytes,1,opt,name=cap,proto3" json:"cap"`
+	Outflow WithdrawalOutflow    `protobuf:"bytes,2,opt,name=outflow,proto3" json:"outflow"`
 }
 
-func (m *QueryAllStakingAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryWithdrawalOutflowResponse) Reset()         { *m = QueryWithdrawalOutflowResponse{} }
+func (m *QueryWithdrawalOutflowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalOutflowResponse) ProtoMessage()    {}
+func (*QueryWithdrawalOutflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{28}
+}
+func (m *QueryWithdrawalOutflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalOutflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalOutflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalOutflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalOutflowResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalOutflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalOutflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalOutflowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllStakingAssetsInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawalOutflowResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalOutflowResponse) GetCap() WithdrawalOutflowCap {
+	if m != nil {
+		return m.Cap
 	}
-	return dAtA[:n], nil
+	return WithdrawalOutflowCap{}
 }
 
-func (m *QueryAllStakingAssetsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWithdrawalOutflowResponse) GetOutflow() WithdrawalOutflow {
+	if m != nil {
+		return m.Outflow
+	}
+	return WithdrawalOutflow{}
 }
 
-func (m *QueryAllStakingAssetsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllStakingAssetsInfo) > 0 {
-		for k := range m.AllStakingAssetsInfo {
-			v := m.AllStakingAssetsInfo[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryGuardianReq is used to query the guardian who can pause the client chains and assets
+type QueryGuardianReq struct {
 }
 
-func (m *QueryStakerAssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGuardianReq) Reset()         { *m = QueryGuardianReq{} }
+func (m *QueryGuardianReq) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianReq) ProtoMessage()    {}
+func (*QueryGuardianReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{29}
+}
+func (m *QueryGuardianReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryGuardianReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianReq.Merge(m, src)
+}
+func (m *QueryGuardianReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianReq.DiscardUnknown(m)
 }
 
-func (m *QueryStakerAssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGuardianReq proto.InternalMessageInfo
+
+type QueryGuardianResponse struct {
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
 }
 
-func (m *QueryStakerAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGuardianResponse) Reset()         { *m = QueryGuardianResponse{} }
+func (m *QueryGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGuardianResponse) ProtoMessage()    {}
+func (*QueryGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{30}
+}
+func (m *QueryGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGuardianResponse.Merge(m, src)
+}
+func (m *QueryGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGuardianResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAssetInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGuardianResponse proto.InternalMessageInfo
+
+func (m *QueryGuardianResponse) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAssetInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOperatorAssetStatesResponse struct {
+	States     []OperatorAssetState `protobuf:"bytes,1,rep,name=states,proto3" json:"states"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAssetInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetInfos) > 0 {
-		for k := range m.AssetInfos {
-			v := m.AssetInfos[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOperatorAssetStatesResponse) Reset()         { *m = QueryOperatorAssetStatesResponse{} }
+func (m *QueryOperatorAssetStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorAssetStatesResponse) ProtoMessage()    {}
+func (*QueryOperatorAssetStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d13900d4f268106, []int{31}
+}
+func (m *QueryOperatorAssetStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorAssetStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorAssetStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOperatorAssetStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorAssetStatesResponse.Merge(m, src)
+}
+func (m *QueryOperatorAssetStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorAssetStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorAssetStatesResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySpecifiedAssetAmountReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOperatorAssetStatesResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorAssetStatesResponse) GetStates() []OperatorAssetState {
+	if m != nil {
+		return m.States
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySpecifiedAssetAmountReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOperatorAssetStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySpecifiedAssetAmountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryClientChainInfo")
+	proto.RegisterType((*QueryAllClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfo")
+	proto.RegisterType((*QueryAllClientChainInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfoResponse")
+	proto.RegisterMapType((map[uint64]*ClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllClientChainInfoResponse.AllClientChainInfosEntry")
+	proto.RegisterType((*QueryStakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryStakingAssetInfo")
+	proto.RegisterType((*QueryAllStakingAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfo")
+	proto.RegisterType((*QueryAllStakingAssetsInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfoResponse")
+	proto.RegisterMapType((map[string]*StakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAllStakingAssetsInfoResponse.AllStakingAssetsInfoEntry")
+	proto.RegisterType((*QueryStakerAssetInfo)(nil), "exocore.restaking_assets_manage.v1.QueryStakerAssetInfo")
+	proto.RegisterType((*QueryAssetInfoResponse)(nil), "exocore.restaking_assets_manage.v1.QueryAssetInfoResponse")
+	proto.RegisterMapType((map[string]*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.QueryAssetInfoResponse.AssetInfosEntry")
+	proto.RegisterType((*QuerySpecifiedAssetAmountReq)(nil), "exocore.restaking_assets_manage.v1.QuerySpecifiedAssetAmountReq")
+	proto.RegisterType((*QueryOperatorAssetInfos)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfos")
+	proto.RegisterType((*QueryOperatorAssetInfosResponse)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfosResponse")
+	proto.RegisterMapType((map[string]*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetInfosResponse.AssetInfosEntry")
+	proto.RegisterType((*QueryOperatorSpecifiedAssetAmountReq)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorSpecifiedAssetAmountReq")
+	proto.RegisterType((*QueryStakerExCoreAddr)(nil), "exocore.restaking_assets_manage.v1.QueryStakerExCoreAddr")
+	proto.RegisterType((*QueryStakerExCoreAddrResponse)(nil), "exocore.restaking_assets_manage.v1.QueryStakerExCoreAddrResponse")
+	proto.RegisterType((*QueryExoCoreAddrStakersReq)(nil), "exocore.restaking_assets_manage.v1.QueryExoCoreAddrStakersReq")
+	proto.RegisterType((*QueryExoCoreAddrStakersResponse)(nil), "exocore.restaking_assets_manage.v1.QueryExoCoreAddrStakersResponse")
+	proto.RegisterType((*QueryClientChainInfoListReq)(nil), "exocore.restaking_assets_manage.v1.QueryClientChainInfoListReq")
+	proto.RegisterType((*QueryClientChainInfoListResponse)(nil), "exocore.restaking_assets_manage.v1.QueryClientChainInfoListResponse")
+	proto.RegisterType((*QueryStakingAssetInfoListReq)(nil), "exocore.restaking_assets_manage.v1.QueryStakingAssetInfoListReq")
+	proto.RegisterType((*QueryStakingAssetInfoListResponse)(nil), "exocore.restaking_assets_manage.v1.QueryStakingAssetInfoListResponse")
+	proto.RegisterType((*QueryStakerAssetListReq)(nil), "exocore.restaking_assets_manage.v1.QueryStakerAssetListReq")
+	proto.RegisterType((*QueryAssetStakersReq)(nil), "exocore.restaking_assets_manage.v1.QueryAssetStakersReq")
+	proto.RegisterType((*QueryStakerAssetStatesResponse)(nil), "exocore.restaking_assets_manage.v1.QueryStakerAssetStatesResponse")
+	proto.RegisterType((*QueryOperatorAssetListReq)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetListReq")
+	proto.RegisterType((*QueryAssetOperatorsReq)(nil), "exocore.restaking_assets_manage.v1.QueryAssetOperatorsReq")
+	proto.RegisterType((*QueryLzNonceReq)(nil), "exocore.restaking_assets_manage.v1.QueryLzNonceReq")
+	proto.RegisterType((*QueryLzNonceResponse)(nil), "exocore.restaking_assets_manage.v1.QueryLzNonceResponse")
+	proto.RegisterType((*QueryWithdrawalOutflowReq)(nil), "exocore.restaking_assets_manage.v1.QueryWithdrawalOutflowReq")
+	proto.RegisterType((*QueryWithdrawalOutflowResponse)(nil), "exocore.restaking_assets_manage.v1.QueryWithdrawalOutflowResponse")
+	proto.RegisterType((*QueryGuardianReq)(nil), "exocore.restaking_assets_manage.v1.QueryGuardianReq")
+	proto.RegisterType((*QueryGuardianResponse)(nil), "exocore.restaking_assets_manage.v1.QueryGuardianResponse")
+	proto.RegisterType((*QueryOperatorAssetStatesResponse)(nil), "exocore.restaking_assets_manage.v1.QueryOperatorAssetStatesResponse")
 }
 
-func (m *QueryOperatorAssetInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("exocore/restaking_assets_manage/v1/query.proto", fileDescriptor_6d13900d4f268106)
 }
 
-func (m *QueryOperatorAssetInfos) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_6d13900d4f268106 = []byte{
+	// 1713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa4, 0x5f, 0xc9, 0x0b, 0x52, 0xdb, 0x69, 0x80, 0x64, 0xdb, 0xba, 0xe9, 0x0a, 0x41,
+	0x54, 0x84, 0x4d, 0x92, 0xa6, 0x75, 0x92, 0x96, 0x36, 0x76, 0xdc, 0x34, 0x55, 0x69, 0x9b, 0x4d,
+	0xa0, 0x2a, 0x20, 0x45, 0x13, 0x67, 0xb2, 0x59, 0xea, 0xec, 0x3a, 0xbb, 0xeb, 0xd6, 0x69, 0x14,
+	0x54, 0xf5, 0x00, 0x3d, 0x21, 0xa4, 0x1e, 0x39, 0xf1, 0x17, 0xc0, 0x81, 0x03, 0x07, 0x38, 0x70,
+	0x2b, 0x55, 0x0f, 0x2d, 0x48, 0x08, 0x38, 0xa0, 0x92, 0x22, 0x3e, 0x24, 0x4e, 0x88, 0x3f, 0x00,
+	0x79, 0x76, 0xd6, 0xfb, 0x69, 0x67, 0x77, 0x6d, 0xb8, 0xc5, 0x6f, 0xe6, 0x7d, 0xfc, 0xde, 0x6f,
+	0x66, 0xde, 0xdb, 0xa7, 0x40, 0x9a, 0x56, 0xb5, 0xa2, 0xa6, 0xd3, 0x8c, 0x4e, 0x0d, 0x93, 0x5c,
+	0x57, 0x54, 0x79, 0x81, 0x18, 0x06, 0x35, 0x8d, 0x85, 0x55, 0xa2, 0x12, 0x99, 0x66, 0x6e, 0x0c,
+	0x65, 0xd6, 0x2a, 0x54, 0x5f, 0x4f, 0x97, 0x75, 0xcd, 0xd4, 0xb0, 0xc8, 0xf7, 0xa7, 0x1b, 0xec,
+	0x4f, 0xdf, 0x18, 0x12, 0x7a, 0x65, 0x4d, 0xd6, 0xd8, 0xf6, 0x4c, 0xed, 0x2f, 0x4b, 0x53, 0x38,
+	0x24, 0x6b, 0x9a, 0x5c, 0xa2, 0x19, 0x52, 0x56, 0x32, 0x44, 0x55, 0x35, 0x93, 0x98, 0x8a, 0xa6,
+	0x1a, 0x7c, 0xf5, 0x60, 0x51, 0x33, 0x56, 0x35, 0xc3, 0xf2, 0xe5, 0x73, 0x2a, 0xf4, 0x5b, 0x8b,
+	0x0b, 0x96, 0x4d, 0xeb, 0x07, 0x5f, 0x3a, 0xc6, 0xf5, 0x16, 0x89, 0x41, 0xeb, 0xca, 0x8b, 0xd4,
+	0x24, 0x43, 0x99, 0x32, 0x91, 0x15, 0x95, 0x39, 0xe1, 0x7b, 0x5f, 0x8d, 0x80, 0x55, 0xa6, 0x2a,
+	0x35, 0x14, 0xdb, 0xfa, 0xcb, 0x11, 0x34, 0xcc, 0xaa, 0xb5, 0x59, 0x3c, 0x01, 0xbd, 0xb3, 0xb5,
+	0x00, 0xf2, 0x25, 0x85, 0xaa, 0x66, 0x7e, 0x85, 0x28, 0xea, 0x8c, 0xba, 0xac, 0xe1, 0x14, 0x40,
+	0xd1, 0xfa, 0xb1, 0x44, 0xab, 0x7d, 0x68, 0x00, 0x0d, 0xee, 0x94, 0x5c, 0x12, 0xb1, 0x1f, 0x9e,
+	0x67, 0x7a, 0x93, 0xa5, 0x92, 0x4f, 0x55, 0xfc, 0xb4, 0x13, 0x8e, 0x34, 0x58, 0x93, 0xa8, 0x51,
+	0xd6, 0x54, 0x83, 0xe2, 0x0f, 0x11, 0x1c, 0x20, 0x81, 0x65, 0xa3, 0x0f, 0x0d, 0xec, 0x18, 0xec,
+	0x19, 0x7e, 0x27, 0xbd, 0x3d, 0x61, 0xe9, 0x6d, 0x5c, 0xa4, 0x83, 0x4b, 0x46, 0x41, 0x35, 0xf5,
+	0x75, 0x29, 0xcc, 0xb1, 0xb0, 0x01, 0x7d, 0x8d, 0x14, 0xf0, 0x3e, 0xd8, 0x71, 0x9d, 0xae, 0xf3,
+	0x24, 0xd4, 0xfe, 0xc4, 0x33, 0xb0, 0xeb, 0x06, 0x29, 0x55, 0x68, 0x5f, 0xe7, 0x00, 0x1a, 0xec,
+	0x19, 0x1e, 0x89, 0x12, 0xaf, 0x3f, 0x4e, 0xcb, 0xc2, 0x78, 0x67, 0x16, 0x89, 0x43, 0xf0, 0x2c,
+	0x43, 0x33, 0x67, 0xe9, 0x4e, 0xd6, 0x54, 0x19, 0x0b, 0x7d, 0xb0, 0x87, 0xd9, 0x99, 0x99, 0x62,
+	0xde, 0xbb, 0x25, 0xfb, 0xa7, 0x78, 0x10, 0xfa, 0xed, 0x04, 0xb8, 0xb5, 0x0c, 0xc6, 0xc0, 0x17,
+	0x9d, 0x70, 0xb4, 0xe1, 0x6a, 0x9d, 0x83, 0x7b, 0x08, 0x7a, 0x49, 0xc8, 0x06, 0x4e, 0xc2, 0x42,
+	0x1c, 0x12, 0x1a, 0x7a, 0x49, 0x87, 0x2d, 0x5a, 0x3c, 0x84, 0x3a, 0x17, 0x36, 0xa1, 0xbf, 0xa1,
+	0x8a, 0x9b, 0x89, 0x6e, 0x8b, 0x89, 0x0b, 0x5e, 0x26, 0x8e, 0x47, 0x09, 0xda, 0x9f, 0x66, 0x37,
+	0x15, 0xc3, 0xfc, 0x3e, 0xd4, 0xf6, 0x50, 0xdd, 0x61, 0x42, 0x80, 0x2e, 0x83, 0x89, 0xea, 0x54,
+	0xd4, 0x7f, 0x8b, 0x1f, 0x74, 0xc2, 0x73, 0x56, 0x22, 0xea, 0x16, 0xed, 0x1c, 0xbf, 0x0b, 0x40,
+	0x6c, 0xa1, 0x7d, 0xba, 0x2f, 0x44, 0x4f, 0xac, 0xdf, 0x5e, 0xba, 0x2e, 0xe1, 0x67, 0xd9, 0x65,
+	0x5d, 0xb8, 0x8d, 0x60, 0xaf, 0x6f, 0x3d, 0x24, 0x61, 0x57, 0xbd, 0x09, 0x9b, 0x8c, 0x9a, 0x30,
+	0xaa, 0xcf, 0x29, 0xaa, 0x5c, 0xa2, 0xcc, 0xc3, 0x65, 0x3d, 0xbf, 0x42, 0x54, 0x99, 0xfa, 0xb3,
+	0x37, 0x0f, 0x87, 0xac, 0xec, 0x95, 0x69, 0x51, 0x59, 0x56, 0xe8, 0x12, 0xdb, 0x3d, 0xb9, 0xaa,
+	0x55, 0x54, 0x53, 0xa2, 0x6b, 0xcd, 0xb2, 0xe8, 0x3e, 0xeb, 0x9d, 0xde, 0xb3, 0x7e, 0x95, 0xbf,
+	0x35, 0x97, 0xcb, 0x54, 0x27, 0xa6, 0xe6, 0xb0, 0x62, 0xe0, 0x53, 0xf0, 0x8c, 0x66, 0x4b, 0x97,
+	0x96, 0x74, 0xcb, 0x68, 0xae, 0xef, 0xdb, 0xcf, 0x5f, 0xe9, 0xe5, 0x2f, 0x6e, 0x4d, 0x4c, 0x0d,
+	0x63, 0xce, 0xd4, 0x15, 0x55, 0x96, 0x3c, 0xbb, 0xc5, 0x8f, 0xed, 0x97, 0x2a, 0x68, 0xb9, 0xce,
+	0xa0, 0x11, 0xc2, 0xe0, 0x5c, 0x64, 0x06, 0x1b, 0x1b, 0x6e, 0x4a, 0xe5, 0x9d, 0x48, 0x54, 0x5e,
+	0xf3, 0x52, 0x99, 0x8f, 0x12, 0x95, 0x1d, 0x50, 0x04, 0x32, 0xdf, 0x83, 0x17, 0x3c, 0x18, 0x1a,
+	0x91, 0xda, 0x12, 0x07, 0x4d, 0x68, 0x1f, 0x71, 0xbd, 0x8a, 0x54, 0x2f, 0x54, 0xf3, 0x9a, 0x4e,
+	0x99, 0x8a, 0x00, 0x5d, 0x73, 0xbe, 0x53, 0x64, 0xff, 0x16, 0xaf, 0xc1, 0xe1, 0x50, 0xa5, 0x3a,
+	0x9f, 0x59, 0x00, 0x47, 0xba, 0x6d, 0xac, 0xae, 0xbd, 0xe2, 0xfb, 0x08, 0x04, 0x66, 0xbb, 0x50,
+	0xd5, 0x6c, 0xa1, 0xe5, 0xc6, 0xa8, 0xa5, 0x61, 0x00, 0x7a, 0x68, 0x55, 0xf3, 0x5a, 0x96, 0xdc,
+	0x22, 0x7c, 0x0e, 0xc0, 0x29, 0xef, 0x9c, 0xb4, 0x17, 0xd3, 0xdc, 0x6f, 0xad, 0x17, 0x48, 0x5b,
+	0xfd, 0x03, 0xef, 0x05, 0xd2, 0x57, 0x88, 0x4c, 0x25, 0xba, 0x56, 0xa1, 0x86, 0x29, 0xb9, 0x34,
+	0xc5, 0xbb, 0x08, 0x8e, 0x34, 0x0c, 0x84, 0xc3, 0x3c, 0x04, 0xdd, 0xf6, 0xcd, 0xb2, 0x4e, 0x6d,
+	0xb7, 0xe4, 0x08, 0xf0, 0x74, 0x48, 0x24, 0x2f, 0x6d, 0x1b, 0x89, 0x65, 0xda, 0x13, 0x0a, 0x85,
+	0x83, 0x61, 0xed, 0xc3, 0x45, 0xc5, 0x60, 0x47, 0xc3, 0x8b, 0x18, 0x25, 0x46, 0xfc, 0x10, 0xc1,
+	0x40, 0x63, 0x3f, 0x1c, 0xf2, 0x02, 0xec, 0x2b, 0x86, 0xf7, 0x13, 0x89, 0xea, 0x73, 0xc0, 0x58,
+	0xfb, 0xb2, 0xb6, 0x6c, 0x3f, 0x93, 0xbe, 0x42, 0xd4, 0xee, 0xb4, 0x3d, 0x42, 0x70, 0xb4, 0x89,
+	0x23, 0x9e, 0xb7, 0x45, 0xd8, 0x6f, 0xf8, 0xd6, 0xed, 0xc4, 0x25, 0x2b, 0xa7, 0x41, 0x73, 0xed,
+	0x4b, 0xdd, 0x26, 0xaf, 0x05, 0xae, 0xfa, 0x6c, 0x67, 0xad, 0x59, 0x71, 0x69, 0xd7, 0xd5, 0xab,
+	0xf2, 0xf6, 0x80, 0x39, 0x76, 0x5d, 0xfe, 0x86, 0x8d, 0x5a, 0xdb, 0x3c, 0x7f, 0x85, 0x20, 0xe5,
+	0x47, 0x3e, 0x67, 0x12, 0x93, 0x3a, 0x77, 0x5e, 0x82, 0xdd, 0x06, 0x93, 0xc4, 0x65, 0xcf, 0x6d,
+	0x2e, 0xb7, 0xf3, 0xfe, 0xcf, 0x47, 0x3a, 0x24, 0x6e, 0xa9, 0x7d, 0xc4, 0x7d, 0x82, 0xa0, 0xdf,
+	0x53, 0x4e, 0x3c, 0xdc, 0xb5, 0x56, 0x43, 0xda, 0x95, 0xe3, 0x5b, 0xee, 0x3e, 0xce, 0x8e, 0xf3,
+	0x7f, 0xe2, 0x77, 0x02, 0xf6, 0x32, 0xdf, 0x17, 0x6f, 0x5d, 0xd2, 0xd4, 0x62, 0x6d, 0x0b, 0x1e,
+	0x84, 0xbd, 0xae, 0x37, 0xe8, 0xe2, 0x2d, 0xee, 0x7c, 0xa7, 0xe4, 0x17, 0x8b, 0x6f, 0x42, 0xaf,
+	0x57, 0xd9, 0xa9, 0x02, 0x25, 0x62, 0x98, 0x4c, 0xc8, 0x75, 0x1d, 0x41, 0xad, 0x62, 0xc9, 0xa4,
+	0x3c, 0xaf, 0x95, 0xa8, 0x4e, 0x54, 0x93, 0xc5, 0xde, 0x25, 0xb9, 0x45, 0xe2, 0x28, 0xe7, 0xec,
+	0xaa, 0x62, 0xae, 0x2c, 0xe9, 0xe4, 0x26, 0x29, 0x5d, 0xae, 0x98, 0xcb, 0x25, 0xed, 0x66, 0xd3,
+	0x9c, 0x88, 0xdf, 0xd8, 0x67, 0x35, 0x44, 0x8f, 0x47, 0x76, 0x05, 0x76, 0x14, 0x49, 0x99, 0xbf,
+	0x6d, 0xd9, 0x28, 0x07, 0x35, 0x60, 0x2b, 0x4f, 0xca, 0xfc, 0xb0, 0xd6, 0x4c, 0xe1, 0x37, 0x60,
+	0x8f, 0x66, 0x2d, 0x70, 0x16, 0x46, 0x13, 0x59, 0xe5, 0x26, 0x6d, 0x5b, 0x22, 0x86, 0x7d, 0x0c,
+	0xca, 0x74, 0x85, 0xe8, 0x4b, 0x0a, 0x51, 0x25, 0xba, 0x56, 0xef, 0x4c, 0x1c, 0x19, 0x47, 0x25,
+	0x40, 0x97, 0xcc, 0x65, 0xf6, 0x13, 0x64, 0xff, 0x16, 0xbf, 0xb6, 0x6b, 0x98, 0xe7, 0x02, 0xf8,
+	0xae, 0xf0, 0xbc, 0xef, 0x0a, 0x9f, 0x88, 0xd3, 0xd3, 0xfd, 0xe7, 0x97, 0x78, 0xf8, 0xc1, 0x51,
+	0xd8, 0xc5, 0x30, 0xe0, 0xef, 0xad, 0xeb, 0xec, 0x2b, 0x9a, 0xb9, 0x75, 0x36, 0x1d, 0xc0, 0xd9,
+	0xc8, 0x0d, 0xb2, 0xcf, 0x80, 0x90, 0xa4, 0x54, 0x8b, 0x17, 0xee, 0xfe, 0xfe, 0xd9, 0x31, 0x74,
+	0xe7, 0xbb, 0x5f, 0xef, 0x75, 0x9e, 0xc1, 0xa7, 0x33, 0x11, 0xe6, 0x1f, 0x8d, 0x43, 0xff, 0x05,
+	0x31, 0x72, 0x83, 0xc3, 0x00, 0x3c, 0xd1, 0xc2, 0x54, 0x42, 0xc8, 0xb7, 0x61, 0xa4, 0x21, 0x9e,
+	0x73, 0x70, 0x4e, 0xe0, 0xb1, 0x88, 0x38, 0x43, 0x90, 0x3c, 0x44, 0x70, 0x60, 0xb6, 0x42, 0x03,
+	0xe3, 0x86, 0xb1, 0xc8, 0x41, 0xfa, 0x55, 0x85, 0x44, 0x9d, 0x82, 0x38, 0xe5, 0x00, 0x1a, 0xc3,
+	0x27, 0x23, 0x02, 0x0a, 0x84, 0xfd, 0x27, 0x62, 0x4d, 0x41, 0xd8, 0xd8, 0x00, 0x9f, 0x6e, 0x69,
+	0x8a, 0x21, 0x14, 0xda, 0x32, 0x04, 0x11, 0xcf, 0x3b, 0x38, 0x4f, 0xe3, 0x89, 0xe8, 0xc4, 0x05,
+	0xf1, 0x3c, 0x76, 0xa8, 0xa3, 0xee, 0x0f, 0xe1, 0x6c, 0x2c, 0xea, 0x5c, 0xaa, 0xc2, 0x78, 0xf2,
+	0x71, 0x44, 0x72, 0xfe, 0x3c, 0xb1, 0xff, 0x83, 0xe0, 0x70, 0x5d, 0x1e, 0xf6, 0x95, 0x89, 0xcf,
+	0x46, 0x47, 0x17, 0xfe, 0x91, 0x2a, 0xb4, 0x3e, 0xe7, 0x10, 0x2f, 0x39, 0x60, 0xf3, 0x78, 0x32,
+	0x16, 0xd8, 0x50, 0x50, 0xfc, 0xa5, 0x09, 0x99, 0x6a, 0x4c, 0xb4, 0x30, 0x5f, 0x10, 0xf2, 0x2d,
+	0x28, 0xb7, 0xf6, 0xd2, 0x84, 0x20, 0xb9, 0x6d, 0x4d, 0x58, 0x9a, 0x8d, 0x10, 0xf0, 0xf9, 0xd8,
+	0x01, 0x37, 0x22, 0xb9, 0x1d, 0x13, 0x90, 0xb6, 0xd3, 0xfc, 0x1b, 0x82, 0xde, 0xfa, 0x0e, 0xd7,
+	0x17, 0x7b, 0xcc, 0xd7, 0xd6, 0x3d, 0xcc, 0x10, 0x26, 0x13, 0xab, 0xd6, 0x19, 0xbe, 0xe2, 0xc0,
+	0x2c, 0xe0, 0x7c, 0x2c, 0x98, 0x2e, 0x10, 0x99, 0x0d, 0x7b, 0xf2, 0xb2, 0x89, 0xff, 0xb6, 0xce,
+	0x73, 0x70, 0x28, 0x81, 0x5f, 0x8b, 0x1c, 0x6e, 0xe8, 0x68, 0x45, 0xc8, 0xb7, 0xa4, 0xcf, 0x01,
+	0xcf, 0x3b, 0x80, 0x67, 0xf0, 0x74, 0x44, 0xc0, 0x41, 0x7b, 0x99, 0x0d, 0xd7, 0x48, 0x67, 0x13,
+	0xff, 0x81, 0xd8, 0x37, 0x43, 0xc8, 0x5c, 0x02, 0x9f, 0x49, 0xda, 0x04, 0xf1, 0x8f, 0x22, 0x61,
+	0xaa, 0x35, 0x03, 0x1c, 0xf7, 0xb4, 0x83, 0xfb, 0x14, 0x1e, 0x4f, 0xd6, 0x1c, 0x31, 0x3c, 0x7f,
+	0x59, 0x65, 0x36, 0x6c, 0x96, 0x10, 0xe7, 0x81, 0x0e, 0x9f, 0x79, 0x08, 0x85, 0x16, 0x2d, 0xb4,
+	0x52, 0x69, 0x43, 0x21, 0xfd, 0x84, 0x00, 0x7b, 0xab, 0x15, 0x13, 0x4f, 0x24, 0x29, 0xb4, 0x36,
+	0xc8, 0x5c, 0x12, 0x65, 0xef, 0x27, 0x82, 0x98, 0x77, 0x10, 0x66, 0xf1, 0x89, 0xf8, 0x35, 0x97,
+	0xa1, 0xd8, 0xb2, 0x1e, 0xa5, 0xc0, 0xb7, 0x78, 0x8c, 0x7e, 0x29, 0xec, 0x3b, 0x5e, 0x98, 0x4a,
+	0xa6, 0xee, 0x83, 0x58, 0x70, 0x20, 0x8e, 0xe3, 0x6c, 0x92, 0xea, 0xc3, 0xb0, 0x3c, 0x46, 0xec,
+	0x9b, 0xda, 0x3d, 0xab, 0x89, 0xd1, 0x27, 0xf9, 0x46, 0x3c, 0x6d, 0xe1, 0xee, 0xac, 0x03, 0x6c,
+	0x14, 0x8f, 0x44, 0xed, 0x03, 0xdd, 0xf1, 0xff, 0x88, 0x60, 0xbf, 0x2d, 0xb3, 0x11, 0x1b, 0x38,
+	0x66, 0x0f, 0xe7, 0x1e, 0x6d, 0xb4, 0x89, 0xb2, 0x9c, 0x83, 0xec, 0x24, 0x1e, 0x8d, 0x83, 0xcc,
+	0x41, 0xf1, 0x00, 0x01, 0xcc, 0x56, 0x28, 0x1f, 0x62, 0xe0, 0x91, 0xc8, 0x81, 0x39, 0x33, 0x13,
+	0x21, 0x1b, 0x5f, 0x89, 0x23, 0x78, 0xdd, 0x41, 0x90, 0xc3, 0x67, 0x23, 0x22, 0xe0, 0x46, 0x32,
+	0x1b, 0xbe, 0x89, 0xcc, 0x26, 0x7e, 0x62, 0xdd, 0xb0, 0xc0, 0x7c, 0x21, 0xc6, 0x0d, 0x0b, 0x9b,
+	0xba, 0x08, 0xb9, 0x56, 0xd4, 0x5b, 0xb9, 0x5f, 0x41, 0x24, 0x5f, 0x22, 0xe8, 0x99, 0xad, 0x50,
+	0x7b, 0x0a, 0x82, 0x8f, 0x47, 0x0e, 0xcd, 0x35, 0x4c, 0x11, 0xc6, 0x12, 0x68, 0x71, 0x1c, 0xa7,
+	0x1c, 0x1c, 0x43, 0x38, 0x13, 0x11, 0x87, 0x6d, 0x25, 0xf7, 0xf6, 0xfd, 0xad, 0x14, 0x7a, 0xb4,
+	0x95, 0x42, 0x4f, 0xb6, 0x52, 0xe8, 0xa3, 0xa7, 0xa9, 0x8e, 0x47, 0x4f, 0x53, 0x1d, 0x3f, 0x3c,
+	0x4d, 0x75, 0xbc, 0x35, 0x29, 0x2b, 0xe6, 0x4a, 0x65, 0x31, 0x5d, 0xd4, 0x56, 0x33, 0x05, 0xcb,
+	0xe8, 0x25, 0x6a, 0xde, 0xd4, 0xf4, 0xeb, 0x75, 0x1f, 0xd5, 0x86, 0x5e, 0xcc, 0xf5, 0x32, 0x35,
+	0x16, 0x77, 0xb3, 0x7f, 0xaf, 0x18, 0xf9, 0x77, 0x00, 0x7f, 0x01, 0x59, 0x25, 0xab, 0x22, 0x00,
+	0x00,
 }
 
-func (m *QueryOperatorAssetInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Balance queries the balance of a single coin for a single account.
+	QueClientChainInfoByIndex(ctx context.Context, in *QueryClientChainInfo, opts ...grpc.CallOption) (*ClientChainInfo, error)
+	QueAllClientChainInfo(ctx context.Context, in *QueryAllClientChainInfo, opts ...grpc.CallOption) (*QueryAllClientChainInfoResponse, error)
+	QueStakingAssetInfo(ctx context.Context, in *QueryStakingAssetInfo, opts ...grpc.CallOption) (*StakingAssetInfo, error)
+	QueAllStakingAssetsInfo(ctx context.Context, in *QueryAllStakingAssetsInfo, opts ...grpc.CallOption) (*QueryAllStakingAssetsInfoResponse, error)
+	QueStakerAssetInfos(ctx context.Context, in *QueryStakerAssetInfo, opts ...grpc.CallOption) (*QueryAssetInfoResponse, error)
+	QueStakerSpecifiedAssetAmount(ctx context.Context, in *QuerySpecifiedAssetAmountReq, opts ...grpc.CallOption) (*StakerSingleAssetOrChangeInfo, error)
+	QueOperatorAssetInfos(ctx context.Context, in *QueryOperatorAssetInfos, opts ...grpc.CallOption) (*QueryOperatorAssetInfosResponse, error)
+	QueOperatorSpecifiedAssetAmount(ctx context.Context, in *QueryOperatorSpecifiedAssetAmountReq, opts ...grpc.CallOption) (*OperatorSingleAssetOrChangeInfo, error)
+	QueStakerExoCoreAddr(ctx context.Context, in *QueryStakerExCoreAddr, opts ...grpc.CallOption) (*QueryStakerExCoreAddrResponse, error)
+	// QueExoCoreAddrStakers queries the stakers bound to the exoCore address, they are ordered by the stakerID.
+	QueExoCoreAddrStakers(ctx context.Context, in *QueryExoCoreAddrStakersReq, opts ...grpc.CallOption) (*QueryExoCoreAddrStakersResponse, error)
+	QueClientChainInfoList(ctx context.Context, in *QueryClientChainInfoListReq, opts ...grpc.CallOption) (*QueryClientChainInfoListResponse, error)
+	QueStakingAssetInfoList(ctx context.Context, in *QueryStakingAssetInfoListReq, opts ...grpc.CallOption) (*QueryStakingAssetInfoListResponse, error)
+	QueStakerAssetList(ctx context.Context, in *QueryStakerAssetListReq, opts ...grpc.CallOption) (*QueryStakerAssetStatesResponse, error)
+	QueOperatorAssetList(ctx context.Context, in *QueryOperatorAssetListReq, opts ...grpc.CallOption) (*QueryOperatorAssetStatesResponse, error)
+	// QueAssetStakers queries the stakers whose total deposit amount of the asset isn't zero
+	QueAssetStakers(ctx context.Context, in *QueryAssetStakersReq, opts ...grpc.CallOption) (*QueryStakerAssetStatesResponse, error)
+	// QueAssetOperators queries the operators whose total amount of the asset isn't zero
+	QueAssetOperators(ctx context.Context, in *QueryAssetOperatorsReq, opts ...grpc.CallOption) (*QueryOperatorAssetStatesResponse, error)
+	// QueLzNonce queries the last processed LayerZero nonce of the client chain, the
+	// relayer can resume from the next nonce.
+	QueLzNonce(ctx context.Context, in *QueryLzNonceReq, opts ...grpc.CallOption) (*QueryLzNonceResponse, error)
+	// QueWithdrawalOutflow queries the withdrawal outflow cap and the withdrawn amount of
+	// the asset in the current block and epoch.
+	QueWithdrawalOutflow(ctx context.Context, in *QueryWithdrawalOutflowReq, opts ...grpc.CallOption) (*QueryWithdrawalOutflowResponse, error)
+	// QueGuardian queries the guardian who can pause the client chains and assets.
+	QueGuardian(ctx context.Context, in *QueryGuardianReq, opts ...grpc.CallOption) (*QueryGuardianResponse, error)
 }
 
-func (m *QueryOperatorAssetInfosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueClientChainInfoByIndex(ctx context.Context, in *QueryClientChainInfo, opts ...grpc.CallOption) (*ClientChainInfo, error) {
+	out := new(ClientChainInfo)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoByIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperatorAssetInfosResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueAllClientChainInfo(ctx context.Context, in *QueryAllClientChainInfo, opts ...grpc.CallOption) (*QueryAllClientChainInfoResponse, error) {
+	out := new(QueryAllClientChainInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAllClientChainInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperatorAssetInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetInfos) > 0 {
-		for k := range m.AssetInfos {
-			v := m.AssetInfos[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueStakingAssetInfo(ctx context.Context, in *QueryStakingAssetInfo, opts ...grpc.CallOption) (*StakingAssetInfo, error) {
+	out := new(StakingAssetInfo)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryOperatorSpecifiedAssetAmountReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueAllStakingAssetsInfo(ctx context.Context, in *QueryAllStakingAssetsInfo, opts ...grpc.CallOption) (*QueryAllStakingAssetsInfoResponse, error) {
+	out := new(QueryAllStakingAssetsInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAllStakingAssetsInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryOperatorSpecifiedAssetAmountReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueStakerAssetInfos(ctx context.Context, in *QueryStakerAssetInfo, opts ...grpc.CallOption) (*QueryAssetInfoResponse, error) {
+	out := new(QueryAssetInfoResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryOperatorSpecifiedAssetAmountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AssetID) > 0 {
-		i -= len(m.AssetID)
-		copy(dAtA[i:], m.AssetID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorAddr) > 0 {
-		i -= len(m.OperatorAddr)
-		copy(dAtA[i:], m.OperatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueStakerSpecifiedAssetAmount(ctx context.Context, in *QuerySpecifiedAssetAmountReq, opts ...grpc.CallOption) (*StakerSingleAssetOrChangeInfo, error) {
+	out := new(StakerSingleAssetOrChangeInfo)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerSpecifiedAssetAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueOperatorAssetInfos(ctx context.Context, in *QueryOperatorAssetInfos, opts ...grpc.CallOption) (*QueryOperatorAssetInfosResponse, error) {
+	out := new(QueryOperatorAssetInfosResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetInfos", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueOperatorSpecifiedAssetAmount(ctx context.Context, in *QueryOperatorSpecifiedAssetAmountReq, opts ...grpc.CallOption) (*OperatorSingleAssetOrChangeInfo, error) {
+	out := new(OperatorSingleAssetOrChangeInfo)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueOperatorSpecifiedAssetAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakerID) > 0 {
-		i -= len(m.StakerID)
-		copy(dAtA[i:], m.StakerID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueStakerExoCoreAddr(ctx context.Context, in *QueryStakerExCoreAddr, opts ...grpc.CallOption) (*QueryStakerExCoreAddrResponse, error) {
+	out := new(QueryStakerExCoreAddrResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerExoCoreAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueExoCoreAddrStakers(ctx context.Context, in *QueryExoCoreAddrStakersReq, opts ...grpc.CallOption) (*QueryExoCoreAddrStakersResponse, error) {
+	out := new(QueryExoCoreAddrStakersResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueExoCoreAddrStakers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueClientChainInfoList(ctx context.Context, in *QueryClientChainInfoListReq, opts ...grpc.CallOption) (*QueryClientChainInfoListResponse, error) {
+	out := new(QueryClientChainInfoListResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryStakerExCoreAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExCoreAddr) > 0 {
-		i -= len(m.ExCoreAddr)
-		copy(dAtA[i:], m.ExCoreAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExCoreAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueStakingAssetInfoList(ctx context.Context, in *QueryStakingAssetInfoListReq, opts ...grpc.CallOption) (*QueryStakingAssetInfoListResponse, error) {
+	out := new(QueryStakingAssetInfoListResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfoList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) QueStakerAssetList(ctx context.Context, in *QueryStakerAssetListReq, opts ...grpc.CallOption) (*QueryStakerAssetStatesResponse, error) {
+	out := new(QueryStakerAssetStatesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryClientChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainIndex != 0 {
-		n += 1 + sovQuery(uint64(m.ChainIndex))
+
+func (c *queryClient) QueOperatorAssetList(ctx context.Context, in *QueryOperatorAssetListReq, opts ...grpc.CallOption) (*QueryOperatorAssetStatesResponse, error) {
+	out := new(QueryOperatorAssetStatesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllClientChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueAssetStakers(ctx context.Context, in *QueryAssetStakersReq, opts ...grpc.CallOption) (*QueryStakerAssetStatesResponse, error) {
+	out := new(QueryStakerAssetStatesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAssetStakers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryAllClientChainInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllClientChainInfos) > 0 {
-		for k, v := range m.AllClientChainInfos {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + sovQuery(uint64(k)) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+func (c *queryClient) QueAssetOperators(ctx context.Context, in *QueryAssetOperatorsReq, opts ...grpc.CallOption) (*QueryOperatorAssetStatesResponse, error) {
+	out := new(QueryOperatorAssetStatesResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueAssetOperators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryStakingAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) QueLzNonce(ctx context.Context, in *QueryLzNonceReq, opts ...grpc.CallOption) (*QueryLzNonceResponse, error) {
+	out := new(QueryLzNonceResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueLzNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllStakingAssetsInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueWithdrawalOutflow(ctx context.Context, in *QueryWithdrawalOutflowReq, opts ...grpc.CallOption) (*QueryWithdrawalOutflowResponse, error) {
+	out := new(QueryWithdrawalOutflowResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueWithdrawalOutflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryAllStakingAssetsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AllStakingAssetsInfo) > 0 {
-		for k, v := range m.AllStakingAssetsInfo {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+func (c *queryClient) QueGuardian(ctx context.Context, in *QueryGuardianReq, opts ...grpc.CallOption) (*QueryGuardianResponse, error) {
+	out := new(QueryGuardianResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Query/QueGuardian", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryStakerAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Balance queries the balance of a single coin for a single account.
+	QueClientChainInfoByIndex(context.Context, *QueryClientChainInfo) (*ClientChainInfo, error)
+	QueAllClientChainInfo(context.Context, *QueryAllClientChainInfo) (*QueryAllClientChainInfoResponse, error)
+	QueStakingAssetInfo(context.Context, *QueryStakingAssetInfo) (*StakingAssetInfo, error)
+	QueAllStakingAssetsInfo(context.Context, *QueryAllStakingAssetsInfo) (*QueryAllStakingAssetsInfoResponse, error)
+	QueStakerAssetInfos(context.Context, *QueryStakerAssetInfo) (*QueryAssetInfoResponse, error)
+	QueStakerSpecifiedAssetAmount(context.Context, *QuerySpecifiedAssetAmountReq) (*StakerSingleAssetOrChangeInfo, error)
+	QueOperatorAssetInfos(context.Context, *QueryOperatorAssetInfos) (*QueryOperatorAssetInfosResponse, error)
+	QueOperatorSpecifiedAssetAmount(context.Context, *QueryOperatorSpecifiedAssetAmountReq) (*OperatorSingleAssetOrChangeInfo, error)
+	QueStakerExoCoreAddr(context.Context, *QueryStakerExCoreAddr) (*QueryStakerExCoreAddrResponse, error)
+	// QueExoCoreAddrStakers queries the stakers bound to the exoCore address, they are ordered by the stakerID.
+	QueExoCoreAddrStakers(context.Context, *QueryExoCoreAddrStakersReq) (*QueryExoCoreAddrStakersResponse, error)
+	QueClientChainInfoList(context.Context, *QueryClientChainInfoListReq) (*QueryClientChainInfoListResponse, error)
+	QueStakingAssetInfoList(context.Context, *QueryStakingAssetInfoListReq) (*QueryStakingAssetInfoListResponse, error)
+	QueStakerAssetList(context.Context, *QueryStakerAssetListReq) (*QueryStakerAssetStatesResponse, error)
+	QueOperatorAssetList(context.Context, *QueryOperatorAssetListReq) (*QueryOperatorAssetStatesResponse, error)
+	// QueAssetStakers queries the stakers whose total deposit amount of the asset isn't zero
+	QueAssetStakers(context.Context, *QueryAssetStakersReq) (*QueryStakerAssetStatesResponse, error)
+	// QueAssetOperators queries the operators whose total amount of the asset isn't zero
+	QueAssetOperators(context.Context, *QueryAssetOperatorsReq) (*QueryOperatorAssetStatesResponse, error)
+	// QueLzNonce queries the last processed LayerZero nonce of the client chain, the
+	// relayer can resume from the next nonce.
+	QueLzNonce(context.Context, *QueryLzNonceReq) (*QueryLzNonceResponse, error)
+	// QueWithdrawalOutflow queries the withdrawal outflow cap and the withdrawn amount of
+	// the asset in the current block and epoch.
+	QueWithdrawalOutflow(context.Context, *QueryWithdrawalOutflowReq) (*QueryWithdrawalOutflowResponse, error)
+	// QueGuardian queries the guardian who can pause the client chains and assets.
+	QueGuardian(context.Context, *QueryGuardianReq) (*QueryGuardianResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueClientChainInfoByIndex(ctx context.Context, req *QueryClientChainInfo) (*ClientChainInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueClientChainInfoByIndex not implemented")
+}
+func (*UnimplementedQueryServer) QueAllClientChainInfo(ctx context.Context, req *QueryAllClientChainInfo) (*QueryAllClientChainInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueAllClientChainInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueStakingAssetInfo(ctx context.Context, req *QueryStakingAssetInfo) (*StakingAssetInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakingAssetInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueAllStakingAssetsInfo(ctx context.Context, req *QueryAllStakingAssetsInfo) (*QueryAllStakingAssetsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueAllStakingAssetsInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueStakerAssetInfos(ctx context.Context, req *QueryStakerAssetInfo) (*QueryAssetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakerAssetInfos not implemented")
+}
+func (*UnimplementedQueryServer) QueStakerSpecifiedAssetAmount(ctx context.Context, req *QuerySpecifiedAssetAmountReq) (*StakerSingleAssetOrChangeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakerSpecifiedAssetAmount not implemented")
+}
+func (*UnimplementedQueryServer) QueOperatorAssetInfos(ctx context.Context, req *QueryOperatorAssetInfos) (*QueryOperatorAssetInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorAssetInfos not implemented")
+}
+func (*UnimplementedQueryServer) QueOperatorSpecifiedAssetAmount(ctx context.Context, req *QueryOperatorSpecifiedAssetAmountReq) (*OperatorSingleAssetOrChangeInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorSpecifiedAssetAmount not implemented")
+}
+func (*UnimplementedQueryServer) QueStakerExoCoreAddr(ctx context.Context, req *QueryStakerExCoreAddr) (*QueryStakerExCoreAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakerExoCoreAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueExoCoreAddrStakers(ctx context.Context, req *QueryExoCoreAddrStakersReq) (*QueryExoCoreAddrStakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueExoCoreAddrStakers not implemented")
+}
+func (*UnimplementedQueryServer) QueClientChainInfoList(ctx context.Context, req *QueryClientChainInfoListReq) (*QueryClientChainInfoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueClientChainInfoList not implemented")
+}
+func (*UnimplementedQueryServer) QueStakingAssetInfoList(ctx context.Context, req *QueryStakingAssetInfoListReq) (*QueryStakingAssetInfoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakingAssetInfoList not implemented")
+}
+func (*UnimplementedQueryServer) QueStakerAssetList(ctx context.Context, req *QueryStakerAssetListReq) (*QueryStakerAssetStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueStakerAssetList not implemented")
+}
+func (*UnimplementedQueryServer) QueOperatorAssetList(ctx context.Context, req *QueryOperatorAssetListReq) (*QueryOperatorAssetStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueOperatorAssetList not implemented")
+}
+func (*UnimplementedQueryServer) QueAssetStakers(ctx context.Context, req *QueryAssetStakersReq) (*QueryStakerAssetStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueAssetStakers not implemented")
+}
+func (*UnimplementedQueryServer) QueAssetOperators(ctx context.Context, req *QueryAssetOperatorsReq) (*QueryOperatorAssetStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueAssetOperators not implemented")
+}
+func (*UnimplementedQueryServer) QueLzNonce(ctx context.Context, req *QueryLzNonceReq) (*QueryLzNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueLzNonce not implemented")
+}
+func (*UnimplementedQueryServer) QueWithdrawalOutflow(ctx context.Context, req *QueryWithdrawalOutflowReq) (*QueryWithdrawalOutflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueWithdrawalOutflow not implemented")
+}
+func (*UnimplementedQueryServer) QueGuardian(ctx context.Context, req *QueryGuardianReq) (*QueryGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueGuardian not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueClientChainInfoByIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientChainInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueClientChainInfoByIndex(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoByIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueClientChainInfoByIndex(ctx, req.(*QueryClientChainInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAssetInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueAllClientChainInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClientChainInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AssetInfos) > 0 {
-		for k, v := range m.AssetInfos {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).QueAllClientChainInfo(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAllClientChainInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueAllClientChainInfo(ctx, req.(*QueryAllClientChainInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpecifiedAssetAmountReq) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueStakingAssetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingAssetInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakingAssetInfo(ctx, in)
 	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfo",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakingAssetInfo(ctx, req.(*QueryStakingAssetInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorAssetInfos) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueAllStakingAssetsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStakingAssetsInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueAllStakingAssetsInfo(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAllStakingAssetsInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueAllStakingAssetsInfo(ctx, req.(*QueryAllStakingAssetsInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOperatorAssetInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueStakerAssetInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerAssetInfo)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.AssetInfos) > 0 {
-		for k, v := range m.AssetInfos {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakerAssetInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakerAssetInfos(ctx, req.(*QueryStakerAssetInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueStakerSpecifiedAssetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpecifiedAssetAmountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakerSpecifiedAssetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerSpecifiedAssetAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakerSpecifiedAssetAmount(ctx, req.(*QuerySpecifiedAssetAmountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueOperatorAssetInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAssetInfos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueOperatorAssetInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueOperatorAssetInfos(ctx, req.(*QueryOperatorAssetInfos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueOperatorSpecifiedAssetAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorSpecifiedAssetAmountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueOperatorSpecifiedAssetAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueOperatorSpecifiedAssetAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueOperatorSpecifiedAssetAmount(ctx, req.(*QueryOperatorSpecifiedAssetAmountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueStakerExoCoreAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerExCoreAddr)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakerExoCoreAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerExoCoreAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakerExoCoreAddr(ctx, req.(*QueryStakerExCoreAddr))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueExoCoreAddrStakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExoCoreAddrStakersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueExoCoreAddrStakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueExoCoreAddrStakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueExoCoreAddrStakers(ctx, req.(*QueryExoCoreAddrStakersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueClientChainInfoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientChainInfoListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueClientChainInfoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueClientChainInfoList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueClientChainInfoList(ctx, req.(*QueryClientChainInfoListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueStakingAssetInfoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingAssetInfoListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakingAssetInfoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakingAssetInfoList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakingAssetInfoList(ctx, req.(*QueryStakingAssetInfoListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueStakerAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerAssetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueStakerAssetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueStakerAssetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueStakerAssetList(ctx, req.(*QueryStakerAssetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueOperatorAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorAssetListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueOperatorAssetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueOperatorAssetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueOperatorAssetList(ctx, req.(*QueryOperatorAssetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueAssetStakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetStakersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueAssetStakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAssetStakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueAssetStakers(ctx, req.(*QueryAssetStakersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueAssetOperators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetOperatorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueAssetOperators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueAssetOperators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueAssetOperators(ctx, req.(*QueryAssetOperatorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueLzNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLzNonceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueLzNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueLzNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueLzNonce(ctx, req.(*QueryLzNonceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueWithdrawalOutflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalOutflowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueWithdrawalOutflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueWithdrawalOutflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueWithdrawalOutflow(ctx, req.(*QueryWithdrawalOutflowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGuardianReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Query/QueGuardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueGuardian(ctx, req.(*QueryGuardianReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.restaking_assets_manage.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueClientChainInfoByIndex",
+			Handler:    _Query_QueClientChainInfoByIndex_Handler,
+		},
+		{
+			MethodName: "QueAllClientChainInfo",
+			Handler:    _Query_QueAllClientChainInfo_Handler,
+		},
+		{
+			MethodName: "QueStakingAssetInfo",
+			Handler:    _Query_QueStakingAssetInfo_Handler,
+		},
+		{
+			MethodName: "QueAllStakingAssetsInfo",
+			Handler:    _Query_QueAllStakingAssetsInfo_Handler,
+		},
+		{
+			MethodName: "QueStakerAssetInfos",
+			Handler:    _Query_QueStakerAssetInfos_Handler,
+		},
+		{
+			MethodName: "QueStakerSpecifiedAssetAmount",
+			Handler:    _Query_QueStakerSpecifiedAssetAmount_Handler,
+		},
+		{
+			MethodName: "QueOperatorAssetInfos",
+			Handler:    _Query_QueOperatorAssetInfos_Handler,
+		},
+		{
+			MethodName: "QueOperatorSpecifiedAssetAmount",
+			Handler:    _Query_QueOperatorSpecifiedAssetAmount_Handler,
+		},
+		{
+			MethodName: "QueStakerExoCoreAddr",
+			Handler:    _Query_QueStakerExoCoreAddr_Handler,
+		},
+		{
+			MethodName: "QueExoCoreAddrStakers",
+			Handler:    _Query_QueExoCoreAddrStakers_Handler,
+		},
+		{
+			MethodName: "QueClientChainInfoList",
+			Handler:    _Query_QueClientChainInfoList_Handler,
+		},
+		{
+			MethodName: "QueStakingAssetInfoList",
+			Handler:    _Query_QueStakingAssetInfoList_Handler,
+		},
+		{
+			MethodName: "QueStakerAssetList",
+			Handler:    _Query_QueStakerAssetList_Handler,
+		},
+		{
+			MethodName: "QueOperatorAssetList",
+			Handler:    _Query_QueOperatorAssetList_Handler,
+		},
+		{
+			MethodName: "QueAssetStakers",
+			Handler:    _Query_QueAssetStakers_Handler,
+		},
+		{
+			MethodName: "QueAssetOperators",
+			Handler:    _Query_QueAssetOperators_Handler,
+		},
+		{
+			MethodName: "QueLzNonce",
+			Handler:    _Query_QueLzNonce_Handler,
+		},
+		{
+			MethodName: "QueWithdrawalOutflow",
+			Handler:    _Query_QueWithdrawalOutflow_Handler,
+		},
+		{
+			MethodName: "QueGuardian",
+			Handler:    _Query_QueGuardian_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/restaking_assets_manage/v1/query.proto",
+}
+
+func (m *QueryClientChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClientChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClientChainInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClientChainInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClientChainInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllClientChainInfos) > 0 {
+		for k := range m.AllClientChainInfos {
+			v := m.AllClientChainInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAssetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAssetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStakingAssetsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStakingAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStakingAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStakingAssetsInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStakingAssetsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStakingAssetsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllStakingAssetsInfo) > 0 {
+		for k := range m.AllStakingAssetsInfo {
+			v := m.AllStakingAssetsInfo[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerAssetInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerAssetInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetInfos) > 0 {
+		for k := range m.AssetInfos {
+			v := m.AssetInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpecifiedAssetAmountReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpecifiedAssetAmountReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpecifiedAssetAmountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAssetInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAssetInfos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAssetInfos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAssetInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAssetInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAssetInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetInfos) > 0 {
+		for k := range m.AssetInfos {
+			v := m.AssetInfos[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorSpecifiedAssetAmountReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorSpecifiedAssetAmountReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSpecifiedAssetAmountReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerExCoreAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerExCoreAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerExCoreAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerExCoreAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerExCoreAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerExCoreAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExCoreAddr) > 0 {
+		i -= len(m.ExCoreAddr)
+		copy(dAtA[i:], m.ExCoreAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExCoreAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExoCoreAddrStakersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExoCoreAddrStakersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExoCoreAddrStakersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExoCoreAddr) > 0 {
+		i -= len(m.ExoCoreAddr)
+		copy(dAtA[i:], m.ExoCoreAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExoCoreAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExoCoreAddrStakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExoCoreAddrStakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExoCoreAddrStakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerIDs) > 0 {
+		for iNdEx := len(m.StakerIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakerIDs[iNdEx])
+			copy(dAtA[i:], m.StakerIDs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerIDs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientChainInfoListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientChainInfoListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientChainInfoListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientChainInfoListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientChainInfoListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientChainInfoListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientChainInfos) > 0 {
+		for iNdEx := len(m.ClientChainInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientChainInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAssetInfoListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAssetInfoListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAssetInfoListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAssetInfoListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAssetInfoListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAssetInfoListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingAssetInfos) > 0 {
+		for iNdEx := len(m.StakingAssetInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingAssetInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerAssetListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerAssetListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerAssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetStakersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetStakersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetStakersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerAssetStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerAssetStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerAssetStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAssetListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAssetListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetOperatorsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetOperatorsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetOperatorsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLzNonceReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLzNonceReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLzNonceReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientChainLzID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClientChainLzID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLzNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLzNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLzNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GapTolerant {
+		i--
+		if m.GapTolerant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalOutflowReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalOutflowReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalOutflowReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalOutflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalOutflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalOutflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outflow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorAssetStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorAssetStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorAssetStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClientChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainIndex != 0 {
+		n += 1 + sovQuery(uint64(m.ChainIndex))
+	}
+	return n
+}
+
+func (m *QueryAllClientChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllClientChainInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllClientChainInfos) > 0 {
+		for k, v := range m.AllClientChainInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + sovQuery(uint64(k)) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakingAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStakingAssetsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllStakingAssetsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllStakingAssetsInfo) > 0 {
+		for k, v := range m.AllStakingAssetsInfo {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakerAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetInfos) > 0 {
+		for k, v := range m.AssetInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpecifiedAssetAmountReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAssetInfos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAssetInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AssetInfos) > 0 {
+		for k, v := range m.AssetInfos {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryOperatorSpecifiedAssetAmountReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerExCoreAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerExCoreAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExCoreAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExoCoreAddrStakersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExoCoreAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExoCoreAddrStakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakerIDs) > 0 {
+		for _, s := range m.StakerIDs {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientChainInfoListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientChainInfoListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientChainInfos) > 0 {
+		for _, e := range m.ClientChainInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingAssetInfoListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingAssetInfoListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingAssetInfos) > 0 {
+		for _, e := range m.StakingAssetInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerAssetListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetStakersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerAssetStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAssetListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetOperatorsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLzNonceReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientChainLzID != 0 {
+		n += 1 + sovQuery(uint64(m.ClientChainLzID))
+	}
+	return n
+}
+
+func (m *QueryLzNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastNonce))
+	}
+	if m.GapTolerant {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWithdrawalOutflowReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalOutflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGuardianReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorAssetStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryClientChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIndex", wireType)
+			}
+			m.ChainIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClientChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClientChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClientChainInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClientChainInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClientChainInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllClientChainInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllClientChainInfos == nil {
+				m.AllClientChainInfos = make(map[uint64]*ClientChainInfo)
+			}
+			var mapkey uint64
+			var mapvalue *ClientChainInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ClientChainInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllClientChainInfos[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStakingAssetsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStakingAssetsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStakingAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStakingAssetsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStakingAssetsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStakingAssetsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllStakingAssetsInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllStakingAssetsInfo == nil {
+				m.AllStakingAssetsInfo = make(map[string]*StakingAssetInfo)
+			}
+			var mapkey string
+			var mapvalue *StakingAssetInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StakingAssetInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllStakingAssetsInfo[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AssetInfos == nil {
+				m.AssetInfos = make(map[string]*StakerSingleAssetOrChangeInfo)
+			}
+			var mapkey string
+			var mapvalue *StakerSingleAssetOrChangeInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StakerSingleAssetOrChangeInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AssetInfos[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpecifiedAssetAmountReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpecifiedAssetAmountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorAssetInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorAssetInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorAssetInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorAssetInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorAssetInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorAssetInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AssetInfos == nil {
+				m.AssetInfos = make(map[string]*OperatorSingleAssetOrChangeInfo)
+			}
+			var mapkey string
+			var mapvalue *OperatorSingleAssetOrChangeInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OperatorSingleAssetOrChangeInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AssetInfos[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorSpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorSpecifiedAssetAmountReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorSpecifiedAssetAmountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerExCoreAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerExCoreAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerExCoreAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerExCoreAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerExCoreAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerExCoreAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExCoreAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExCoreAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExoCoreAddrStakersReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExoCoreAddrStakersReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExoCoreAddrStakersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExoCoreAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExoCoreAddrStakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExoCoreAddrStakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExoCoreAddrStakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerIDs = append(m.StakerIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientChainInfoListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientChainInfoListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientChainInfoListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientChainInfoListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientChainInfoListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientChainInfoListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientChainInfos = append(m.ClientChainInfos, &ClientChainInfo{})
+			if err := m.ClientChainInfos[len(m.ClientChainInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryOperatorSpecifiedAssetAmountReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AssetID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryStakerExCoreAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakerID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryStakerExCoreAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ExCoreAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryClientChainInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingAssetInfoListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1856,17 +6130,17 @@ func (m *QueryClientChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingAssetInfoListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingAssetInfoListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ChainIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1876,11 +6150,28 @@ func (m *QueryClientChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1902,7 +6193,7 @@ func (m *QueryClientChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClientChainInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingAssetInfoListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,12 +6216,82 @@ func (m *QueryAllClientChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClientChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingAssetInfoListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingAssetInfoListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingAssetInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingAssetInfos = append(m.StakingAssetInfos, &StakingAssetInfo{})
+			if err := m.StakingAssetInfos[len(m.StakingAssetInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1952,7 +6313,7 @@ func (m *QueryAllClientChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClientChainInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakerAssetListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1975,17 +6336,17 @@ func (m *QueryAllClientChainInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClientChainInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakerAssetListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClientChainInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakerAssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllClientChainInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1995,106 +6356,59 @@ func (m *QueryAllClientChainInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllClientChainInfos == nil {
-				m.AllClientChainInfos = make(map[uint64]*ClientChainInfo)
-			}
-			var mapkey uint64
-			var mapvalue *ClientChainInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ClientChainInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AllClientChainInfos[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2117,7 +6431,7 @@ func (m *QueryAllClientChainInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingAssetInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetStakersReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2140,10 +6454,10 @@ func (m *QueryStakingAssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingAssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetStakersReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetStakersReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2178,6 +6492,42 @@ func (m *QueryStakingAssetInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2199,7 +6549,7 @@ func (m *QueryStakingAssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStakingAssetsInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryStakerAssetStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,12 +6572,82 @@ func (m *QueryAllStakingAssetsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStakingAssetsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakerAssetStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStakingAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakerAssetStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, StakerAssetState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2249,7 +6669,7 @@ func (m *QueryAllStakingAssetsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStakingAssetsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorAssetListReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,140 +6692,79 @@ func (m *QueryAllStakingAssetsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStakingAssetsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorAssetListReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStakingAssetsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorAssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllStakingAssetsInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AllStakingAssetsInfo == nil {
-				m.AllStakingAssetsInfo = make(map[string]*StakingAssetInfo)
-			}
-			var mapkey string
-			var mapvalue *StakingAssetInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StakingAssetInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AllStakingAssetsInfo[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2428,7 +6787,7 @@ func (m *QueryAllStakingAssetsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerAssetInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryAssetOperatorsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,15 +6810,15 @@ func (m *QueryStakerAssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerAssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAssetOperatorsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAssetOperatorsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2487,7 +6846,43 @@ func (m *QueryStakerAssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2510,7 +6905,7 @@ func (m *QueryStakerAssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAssetInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLzNonceReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2533,17 +6928,17 @@ func (m *QueryAssetInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAssetInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLzNonceReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAssetInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLzNonceReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetInfos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainLzID", wireType)
 			}
-			var msglen int
+			m.ClientChainLzID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2553,121 +6948,11 @@ func (m *QueryAssetInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClientChainLzID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AssetInfos == nil {
-				m.AssetInfos = make(map[string]*StakerSingleAssetOrChangeInfo)
-			}
-			var mapkey string
-			var mapvalue *StakerSingleAssetOrChangeInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StakerSingleAssetOrChangeInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.AssetInfos[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2689,7 +6974,7 @@ func (m *QueryAssetInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
+func (m *QueryLzNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,17 +6997,17 @@ func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpecifiedAssetAmountReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLzNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpecifiedAssetAmountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLzNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNonce", wireType)
 			}
-			var stringLen uint64
+			m.LastNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2732,29 +7017,16 @@ func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapTolerant", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2764,24 +7036,12 @@ func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			}
+			m.GapTolerant = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2803,7 +7063,7 @@ func (m *QuerySpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorAssetInfos) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalOutflowReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2826,15 +7086,15 @@ func (m *QueryOperatorAssetInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorAssetInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalOutflowReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorAssetInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalOutflowReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2862,7 +7122,7 @@ func (m *QueryOperatorAssetInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2885,7 +7145,7 @@ func (m *QueryOperatorAssetInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorAssetInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawalOutflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2908,15 +7168,15 @@ func (m *QueryOperatorAssetInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorAssetInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawalOutflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorAssetInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawalOutflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2943,105 +7203,42 @@ func (m *QueryOperatorAssetInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AssetInfos == nil {
-				m.AssetInfos = make(map[string]*OperatorSingleAssetOrChangeInfo)
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *OperatorSingleAssetOrChangeInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &OperatorSingleAssetOrChangeInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AssetInfos[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3064,7 +7261,7 @@ func (m *QueryOperatorAssetInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOperatorSpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
+func (m *QueryGuardianReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3087,76 +7284,12 @@ func (m *QueryOperatorSpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOperatorSpecifiedAssetAmountReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGuardianReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOperatorSpecifiedAssetAmountReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGuardianReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AssetID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3178,7 +7311,7 @@ func (m *QueryOperatorSpecifiedAssetAmountReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerExCoreAddr) Unmarshal(dAtA []byte) error {
+func (m *QueryGuardianResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3201,15 +7334,15 @@ func (m *QueryStakerExCoreAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerExCoreAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGuardianResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerExCoreAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3237,7 +7370,7 @@ func (m *QueryStakerExCoreAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerID = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3260,7 +7393,7 @@ func (m *QueryStakerExCoreAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakerExCoreAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOperatorAssetStatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3283,17 +7416,17 @@ func (m *QueryStakerExCoreAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakerExCoreAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOperatorAssetStatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakerExCoreAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOperatorAssetStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExCoreAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3303,23 +7436,61 @@ func (m *QueryStakerExCoreAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExCoreAddr = string(dAtA[iNdEx:postIndex])
+			m.States = append(m.States, OperatorAssetState{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
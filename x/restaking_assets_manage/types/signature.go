@@ -0,0 +1,58 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// SignatureTypeEIP191 is used by the EVM client chains, the staker signs the message through
+	// `personal_sign` with its secp256k1 key.
+	SignatureTypeEIP191 = "EIP191"
+)
+
+// GetExoCoreAddrBindingMsg returns the message that should be signed by the staker to bind the exoCore address.
+// The chain ID and nonce are included to prevent the signature from being replayed on another chain or binding.
+// The stakerID contains the lowercase client chain address and the layerZero chain ID.
+func GetExoCoreAddrBindingMsg(chainID, stakerID, exoCoreAddr string, nonce uint64) []byte {
+	return []byte(fmt.Sprintf(
+		"Bind the exoCore address\nchainID:%s\nstakerID:%s\nexoCoreAddr:%s\nnonce:%d",
+		chainID, stakerID, exoCoreAddr, nonce,
+	))
+}
+
+// VerifyClientChainSignature verifies that the message is signed by the client chain address according to
+// the signature type of the client chain.
+func VerifyClientChainSignature(signatureType string, clientChainAddr, msg, sig []byte) error {
+	switch signatureType {
+	case SignatureTypeEIP191:
+		return verifyEIP191Signature(clientChainAddr, msg, sig)
+	default:
+		return errorsmod.Wrap(ErrUnsupportedSignatureType, fmt.Sprintf("the signature type is:%s", signatureType))
+	}
+}
+
+func verifyEIP191Signature(clientChainAddr, msg, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrap(ErrInvalidClientChainSignature, fmt.Sprintf("the signature length should be %d, it's:%d", crypto.SignatureLength, len(sig)))
+	}
+	// the recovery ID might be 27 or 28 when the message is signed by the wallets
+	sigCopy := make([]byte, len(sig))
+	copy(sigCopy, sig)
+	if sigCopy[crypto.RecoveryIDOffset] >= 27 {
+		sigCopy[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(msg), sigCopy)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidClientChainSignature, err.Error())
+	}
+	recovered := crypto.PubkeyToAddress(*pubKey)
+	if !bytes.Equal(recovered.Bytes(), clientChainAddr) {
+		return errorsmod.Wrap(ErrInvalidClientChainSignature, fmt.Sprintf("the signer is:%s", recovered))
+	}
+	return nil
+}
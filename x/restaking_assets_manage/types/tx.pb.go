@@ -41,6 +41,13 @@ type ClientChainInfo struct {
 	LayerZeroChainID   uint64 `protobuf:"varint,6,opt,name=LayerZeroChainID,proto3" json:"LayerZeroChainID,omitempty"`
 	SignatureType      string `protobuf:"bytes,7,opt,name=SignatureType,proto3" json:"SignatureType,omitempty"`
 	AddressLength      uint32 `protobuf:"varint,8,opt,name=AddressLength,proto3" json:"AddressLength,omitempty"`
+	// LzNonceGapTolerant allows the LayerZero nonces of the cross-chain messages to
+	// skip some values, the nonces still need to be increasing. Otherwise, the nonce
+	// of a message should be exactly the last processed nonce plus one.
+	LzNonceGapTolerant bool `protobuf:"varint,9,opt,name=LzNonceGapTolerant,proto3" json:"LzNonceGapTolerant,omitempty"`
+	// Paused the messages from a paused client chain are rejected, it's used to
+	// stop processing the messages when the bridge of the client chain is compromised.
+	Paused bool `protobuf:"varint,10,opt,name=Paused,proto3" json:"Paused,omitempty"`
 }
 
 func (m *ClientChainInfo) Reset()         { *m = ClientChainInfo{} }
@@ -132,6 +139,20 @@ func (m *ClientChainInfo) GetAddressLength() uint32 {
 	return 0
 }
 
+func (m *ClientChainInfo) GetLzNonceGapTolerant() bool {
+	if m != nil {
+		return m.LzNonceGapTolerant
+	}
+	return false
+}
+
+func (m *ClientChainInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
 type AssetInfo struct {
 	Name              string                                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	Symbol            string                                 `protobuf:"bytes,2,opt,name=Symbol,proto3" json:"Symbol,omitempty"`
@@ -228,6 +249,11 @@ func (m *AssetInfo) GetMetaInfo() string {
 type StakingAssetInfo struct {
 	AssetBasicInfo     *AssetInfo                             `protobuf:"bytes,1,opt,name=AssetBasicInfo,proto3" json:"AssetBasicInfo,omitempty"`
 	StakingTotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=StakingTotalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"StakingTotalAmount"`
+	// Deprecated the deprecated asset can't be deposited anymore,
+	// but the deposited asset can still be withdrawn.
+	Deprecated bool `protobuf:"varint,3,opt,name=Deprecated,proto3" json:"Deprecated,omitempty"`
+	// Paused the paused asset can't be deposited, delegated, undelegated or withdrawn.
+	Paused bool `protobuf:"varint,4,opt,name=Paused,proto3" json:"Paused,omitempty"`
 }
 
 func (m *StakingAssetInfo) Reset()         { *m = StakingAssetInfo{} }
@@ -270,6 +296,135 @@ func (m *StakingAssetInfo) GetAssetBasicInfo() *AssetInfo {
 	return nil
 }
 
+func (m *StakingAssetInfo) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *StakingAssetInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+// WithdrawalOutflowCap limits the amount of the asset that can be withdrawn in a block
+// and in an epoch of the x/epochs module, a zero cap means that there is no limit.
+type WithdrawalOutflowCap struct {
+	AssetID     string                                 `protobuf:"bytes,1,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	PerBlockCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=PerBlockCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"PerBlockCap"`
+	PerEpochCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=PerEpochCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"PerEpochCap"`
+	// EpochIdentifier is the identifier of the epoch used by the per-epoch cap, it's
+	// required when the per-epoch cap isn't zero.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=EpochIdentifier,proto3" json:"EpochIdentifier,omitempty"`
+}
+
+func (m *WithdrawalOutflowCap) Reset()         { *m = WithdrawalOutflowCap{} }
+func (m *WithdrawalOutflowCap) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalOutflowCap) ProtoMessage()    {}
+func (*WithdrawalOutflowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{3}
+}
+func (m *WithdrawalOutflowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalOutflowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalOutflowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalOutflowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalOutflowCap.Merge(m, src)
+}
+func (m *WithdrawalOutflowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalOutflowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalOutflowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalOutflowCap proto.InternalMessageInfo
+
+func (m *WithdrawalOutflowCap) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *WithdrawalOutflowCap) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// WithdrawalOutflow records the withdrawn amount of the asset in the current block and epoch.
+type WithdrawalOutflow struct {
+	BlockHeight  int64                                  `protobuf:"varint,1,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=BlockOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"BlockOutflow"`
+	EpochOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=EpochOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"EpochOutflow"`
+	// EpochStartHeight is the block height at which the epoch outflow was reset, the
+	// withdrawals requested before it aren't counted in the epoch outflow.
+	EpochStartHeight int64 `protobuf:"varint,4,opt,name=EpochStartHeight,proto3" json:"EpochStartHeight,omitempty"`
+}
+
+func (m *WithdrawalOutflow) Reset()         { *m = WithdrawalOutflow{} }
+func (m *WithdrawalOutflow) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalOutflow) ProtoMessage()    {}
+func (*WithdrawalOutflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{4}
+}
+func (m *WithdrawalOutflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalOutflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalOutflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalOutflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalOutflow.Merge(m, src)
+}
+func (m *WithdrawalOutflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalOutflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalOutflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalOutflow proto.InternalMessageInfo
+
+func (m *WithdrawalOutflow) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *WithdrawalOutflow) GetEpochStartHeight() int64 {
+	if m != nil {
+		return m.EpochStartHeight
+	}
+	return 0
+}
+
 type StakerSingleAssetOrChangeInfo struct {
 	TotalDepositAmountOrWantChangeValue     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=TotalDepositAmountOrWantChangeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"TotalDepositAmountOrWantChangeValue"`
 	CanWithdrawAmountOrWantChangeValue      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=CanWithdrawAmountOrWantChangeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"CanWithdrawAmountOrWantChangeValue"`
@@ -280,7 +435,7 @@ func (m *StakerSingleAssetOrChangeInfo) Reset()         { *m = StakerSingleAsset
 func (m *StakerSingleAssetOrChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*StakerSingleAssetOrChangeInfo) ProtoMessage()    {}
 func (*StakerSingleAssetOrChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{3}
+	return fileDescriptor_b24e66e530cc30d1, []int{5}
 }
 func (m *StakerSingleAssetOrChangeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +472,7 @@ func (m *StakerAllAssetsInfo) Reset()         { *m = StakerAllAssetsInfo{} }
 func (m *StakerAllAssetsInfo) String() string { return proto.CompactTextString(m) }
 func (*StakerAllAssetsInfo) ProtoMessage()    {}
 func (*StakerAllAssetsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{4}
+	return fileDescriptor_b24e66e530cc30d1, []int{6}
 }
 func (m *StakerAllAssetsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +510,7 @@ func (m *StakerAllAssetsInfo) GetAllAssetsState() map[string]*StakerSingleAssetO
 
 type OperatorSingleAssetOrChangeInfo struct {
 	TotalAmountOrWantChangeValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=TotalAmountOrWantChangeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"TotalAmountOrWantChangeValue"`
-	// todo: the field is used to mark operator's own assets and is not temporarily used now
+	//todo: the field is used to mark operator's own assets and is not temporarily used now
 	OperatorOwnAmountOrWantChangeValue      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=OperatorOwnAmountOrWantChangeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"OperatorOwnAmountOrWantChangeValue"`
 	WaitUndelegationAmountOrWantChangeValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=WaitUndelegationAmountOrWantChangeValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"WaitUndelegationAmountOrWantChangeValue"`
 }
@@ -364,7 +519,7 @@ func (m *OperatorSingleAssetOrChangeInfo) Reset()         { *m = OperatorSingleA
 func (m *OperatorSingleAssetOrChangeInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorSingleAssetOrChangeInfo) ProtoMessage()    {}
 func (*OperatorSingleAssetOrChangeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{5}
+	return fileDescriptor_b24e66e530cc30d1, []int{7}
 }
 func (m *OperatorSingleAssetOrChangeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +556,7 @@ func (m *OperatorAllAssetsInfo) Reset()         { *m = OperatorAllAssetsInfo{} }
 func (m *OperatorAllAssetsInfo) String() string { return proto.CompactTextString(m) }
 func (*OperatorAllAssetsInfo) ProtoMessage()    {}
 func (*OperatorAllAssetsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{6}
+	return fileDescriptor_b24e66e530cc30d1, []int{8}
 }
 func (m *OperatorAllAssetsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,18 +593,23 @@ func (m *OperatorAllAssetsInfo) GetAllAssetsState() map[string]*OperatorSingleAs
 }
 
 type MsgSetExoCoreAddr struct {
-	FromAddress                string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
-	SetAddress                 string `protobuf:"bytes,2,opt,name=setAddress,proto3" json:"setAddress,omitempty"`
-	ClientChainAddr            string `protobuf:"bytes,3,opt,name=clientChainAddr,proto3" json:"clientChainAddr,omitempty"`
-	ClientChainIndex           uint64 `protobuf:"varint,4,opt,name=clientChainIndex,proto3" json:"clientChainIndex,omitempty"`
+	FromAddress      string `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	SetAddress       string `protobuf:"bytes,2,opt,name=setAddress,proto3" json:"setAddress,omitempty"`
+	ClientChainAddr  string `protobuf:"bytes,3,opt,name=clientChainAddr,proto3" json:"clientChainAddr,omitempty"`
+	ClientChainIndex uint64 `protobuf:"varint,4,opt,name=clientChainIndex,proto3" json:"clientChainIndex,omitempty"`
+	// StakerClientChainSignature is the hex-encoded signature of the binding message signed by
+	// the staker's client chain key, the signature algorithm depends on the client chain.
 	StakerClientChainSignature string `protobuf:"bytes,5,opt,name=StakerClientChainSignature,proto3" json:"StakerClientChainSignature,omitempty"`
+	// rebind replaces the exoCore address that has been bound to the staker, the binding
+	// is rejected if the staker has been bound and rebind is false.
+	Rebind bool `protobuf:"varint,6,opt,name=rebind,proto3" json:"rebind,omitempty"`
 }
 
 func (m *MsgSetExoCoreAddr) Reset()         { *m = MsgSetExoCoreAddr{} }
 func (m *MsgSetExoCoreAddr) String() string { return proto.CompactTextString(m) }
 func (*MsgSetExoCoreAddr) ProtoMessage()    {}
 func (*MsgSetExoCoreAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{7}
+	return fileDescriptor_b24e66e530cc30d1, []int{9}
 }
 func (m *MsgSetExoCoreAddr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,6 +638,69 @@ func (m *MsgSetExoCoreAddr) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetExoCoreAddr proto.InternalMessageInfo
 
+// ExoCoreAddrBinding is the exoCore address bound to the staker
+type ExoCoreAddrBinding struct {
+	StakerID    string `protobuf:"bytes,1,opt,name=StakerID,proto3" json:"StakerID,omitempty"`
+	ExoCoreAddr string `protobuf:"bytes,2,opt,name=ExoCoreAddr,proto3" json:"ExoCoreAddr,omitempty"`
+	// Nonce is the number of the bindings that have been made by the staker, it's
+	// included in the signed binding message to prevent the signature from being replayed.
+	Nonce uint64 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *ExoCoreAddrBinding) Reset()         { *m = ExoCoreAddrBinding{} }
+func (m *ExoCoreAddrBinding) String() string { return proto.CompactTextString(m) }
+func (*ExoCoreAddrBinding) ProtoMessage()    {}
+func (*ExoCoreAddrBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{10}
+}
+func (m *ExoCoreAddrBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExoCoreAddrBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExoCoreAddrBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExoCoreAddrBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExoCoreAddrBinding.Merge(m, src)
+}
+func (m *ExoCoreAddrBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExoCoreAddrBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExoCoreAddrBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExoCoreAddrBinding proto.InternalMessageInfo
+
+func (m *ExoCoreAddrBinding) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *ExoCoreAddrBinding) GetExoCoreAddr() string {
+	if m != nil {
+		return m.ExoCoreAddr
+	}
+	return ""
+}
+
+func (m *ExoCoreAddrBinding) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type MsgSetExoCoreAddrResponse struct {
 }
 
@@ -485,7 +708,7 @@ func (m *MsgSetExoCoreAddrResponse) Reset()         { *m = MsgSetExoCoreAddrResp
 func (m *MsgSetExoCoreAddrResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetExoCoreAddrResponse) ProtoMessage()    {}
 func (*MsgSetExoCoreAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{8}
+	return fileDescriptor_b24e66e530cc30d1, []int{11}
 }
 func (m *MsgSetExoCoreAddrResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +746,7 @@ func (m *RegisterClientChainReq) Reset()         { *m = RegisterClientChainReq{}
 func (m *RegisterClientChainReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterClientChainReq) ProtoMessage()    {}
 func (*RegisterClientChainReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{9}
+	return fileDescriptor_b24e66e530cc30d1, []int{12}
 }
 func (m *RegisterClientChainReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,7 +782,7 @@ func (m *RegisterClientChainResponse) Reset()         { *m = RegisterClientChain
 func (m *RegisterClientChainResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterClientChainResponse) ProtoMessage()    {}
 func (*RegisterClientChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{10}
+	return fileDescriptor_b24e66e530cc30d1, []int{13}
 }
 func (m *RegisterClientChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,7 +820,7 @@ func (m *RegisterAssetReq) Reset()         { *m = RegisterAssetReq{} }
 func (m *RegisterAssetReq) String() string { return proto.CompactTextString(m) }
 func (*RegisterAssetReq) ProtoMessage()    {}
 func (*RegisterAssetReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{11}
+	return fileDescriptor_b24e66e530cc30d1, []int{14}
 }
 func (m *RegisterAssetReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +856,7 @@ func (m *RegisterAssetResponse) Reset()         { *m = RegisterAssetResponse{} }
 func (m *RegisterAssetResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterAssetResponse) ProtoMessage()    {}
 func (*RegisterAssetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b24e66e530cc30d1, []int{12}
+	return fileDescriptor_b24e66e530cc30d1, []int{15}
 }
 func (m *RegisterAssetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,729 +885,979 @@ func (m *RegisterAssetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegisterAssetResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.ClientChainInfo")
-	proto.RegisterType((*AssetInfo)(nil), "exocore.restaking_assets_manage.v1.AssetInfo")
-	proto.RegisterType((*StakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.StakingAssetInfo")
-	proto.RegisterType((*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.StakerSingleAssetOrChangeInfo")
-	proto.RegisterType((*StakerAllAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.StakerAllAssetsInfo")
-	proto.RegisterMapType((map[string]*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.StakerAllAssetsInfo.AllAssetsStateEntry")
-	proto.RegisterType((*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorSingleAssetOrChangeInfo")
-	proto.RegisterType((*OperatorAllAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorAllAssetsInfo")
-	proto.RegisterMapType((map[string]*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorAllAssetsInfo.AllAssetsStateEntry")
-	proto.RegisterType((*MsgSetExoCoreAddr)(nil), "exocore.restaking_assets_manage.v1.MsgSetExoCoreAddr")
-	proto.RegisterType((*MsgSetExoCoreAddrResponse)(nil), "exocore.restaking_assets_manage.v1.MsgSetExoCoreAddrResponse")
-	proto.RegisterType((*RegisterClientChainReq)(nil), "exocore.restaking_assets_manage.v1.RegisterClientChainReq")
-	proto.RegisterType((*RegisterClientChainResponse)(nil), "exocore.restaking_assets_manage.v1.RegisterClientChainResponse")
-	proto.RegisterType((*RegisterAssetReq)(nil), "exocore.restaking_assets_manage.v1.RegisterAssetReq")
-	proto.RegisterType((*RegisterAssetResponse)(nil), "exocore.restaking_assets_manage.v1.RegisterAssetResponse")
+// UpdateAssetReq updates the mutable information of the registered asset.
+type UpdateAssetReq struct {
+	FromAddress string                                 `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	AssetID     string                                 `protobuf:"bytes,2,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	MetaInfo    string                                 `protobuf:"bytes,3,opt,name=MetaInfo,proto3" json:"MetaInfo,omitempty"`
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=TotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"TotalSupply"`
 }
 
-func init() {
-	proto.RegisterFile("exocore/restaking_assets_manage/v1/tx.proto", fileDescriptor_b24e66e530cc30d1)
+func (m *UpdateAssetReq) Reset()         { *m = UpdateAssetReq{} }
+func (m *UpdateAssetReq) String() string { return proto.CompactTextString(m) }
+func (*UpdateAssetReq) ProtoMessage()    {}
+func (*UpdateAssetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{16}
 }
-
-var fileDescriptor_b24e66e530cc30d1 = []byte{
-	// 1129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x4f, 0x1b, 0xc7,
-	0x1b, 0xf7, 0xda, 0xbc, 0x3e, 0x16, 0x09, 0x0c, 0x24, 0x59, 0x9c, 0x7f, 0x0c, 0xda, 0x7f, 0xd5,
-	0x22, 0x5a, 0x6c, 0x85, 0x34, 0x55, 0x4a, 0xdf, 0x64, 0x0c, 0x54, 0xa8, 0x21, 0x48, 0xeb, 0xa4,
-	0xa8, 0xa9, 0x54, 0x34, 0xd8, 0x93, 0x65, 0xc5, 0xee, 0x8c, 0xbb, 0x33, 0x06, 0xdc, 0x13, 0xaa,
-	0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xed, 0xa1, 0xbd, 0xe6, 0x23, 0x50, 0x35, 0xdf, 0xa0, 0x17, 0x0e,
-	0x3d, 0x44, 0xb9, 0x24, 0xca, 0x21, 0xaa, 0xe0, 0x40, 0x3f, 0x46, 0xb5, 0xb3, 0xbb, 0xb0, 0x8b,
-	0xd7, 0xb0, 0x40, 0x22, 0xf5, 0x62, 0xef, 0x3c, 0xf3, 0xbc, 0xfe, 0x9e, 0xdf, 0xec, 0x3c, 0x0b,
-	0x6f, 0x92, 0x4d, 0x56, 0x65, 0x0e, 0x29, 0x3a, 0x84, 0x0b, 0xbc, 0x66, 0x52, 0x63, 0x19, 0x73,
-	0x4e, 0x04, 0x5f, 0xb6, 0x31, 0xc5, 0x06, 0x29, 0xae, 0x5f, 0x2f, 0x8a, 0xcd, 0x42, 0xdd, 0x61,
-	0x82, 0x21, 0xcd, 0x57, 0x2e, 0xb4, 0x51, 0x2e, 0xac, 0x5f, 0xcf, 0x5d, 0xa9, 0x32, 0x6e, 0x33,
-	0x5e, 0xb4, 0xb9, 0xe1, 0xda, 0xda, 0xdc, 0xf0, 0x8c, 0x73, 0xc3, 0xde, 0xc6, 0xb2, 0x5c, 0x15,
-	0xbd, 0x85, 0xbf, 0x35, 0x64, 0x30, 0x83, 0x79, 0x72, 0xf7, 0xc9, 0x97, 0x0e, 0x60, 0xdb, 0xa4,
-	0xac, 0x28, 0x7f, 0x3d, 0x91, 0xf6, 0x7b, 0x1a, 0x2e, 0x96, 0x2d, 0x93, 0x50, 0x51, 0x5e, 0xc5,
-	0x26, 0x9d, 0xa7, 0x0f, 0x18, 0x42, 0xd0, 0x71, 0x07, 0xdb, 0x44, 0x55, 0x46, 0x95, 0xb1, 0x5e,
-	0x5d, 0x3e, 0xa3, 0x1c, 0xf4, 0x2c, 0x10, 0x81, 0xdd, 0x7d, 0x35, 0x2d, 0xe5, 0x07, 0x6b, 0xa4,
-	0x42, 0xb7, 0x67, 0x5c, 0x53, 0x33, 0xa3, 0xca, 0x58, 0x87, 0x1e, 0x2c, 0xd1, 0x5b, 0x30, 0x30,
-	0xbb, 0xc9, 0xca, 0xcc, 0x21, 0xbe, 0xf7, 0x1a, 0xd9, 0x54, 0x3b, 0xa4, 0x4e, 0xeb, 0x06, 0x2a,
-	0x00, 0x9a, 0x33, 0x29, 0xb6, 0xcc, 0xaf, 0xb0, 0x30, 0x19, 0x9d, 0xb6, 0x58, 0x75, 0x8d, 0xab,
-	0x9d, 0x52, 0x3d, 0x66, 0x07, 0x8d, 0x43, 0xff, 0x6d, 0xdc, 0x24, 0xce, 0x7d, 0xe2, 0x30, 0xcf,
-	0xcd, 0x8c, 0xda, 0x25, 0xb5, 0x5b, 0xe4, 0xe8, 0x35, 0xe8, 0xab, 0x98, 0x06, 0xc5, 0xa2, 0xe1,
-	0x90, 0xbb, 0xcd, 0x3a, 0x51, 0xbb, 0x65, 0x11, 0x51, 0xa1, 0xab, 0x55, 0xaa, 0xd5, 0x1c, 0xc2,
-	0xf9, 0x6d, 0x42, 0x0d, 0xb1, 0xaa, 0xf6, 0x8c, 0x2a, 0x63, 0x7d, 0x7a, 0x54, 0xa8, 0xfd, 0x99,
-	0x86, 0xde, 0x92, 0xdb, 0xa5, 0xb6, 0x68, 0x5d, 0x86, 0xae, 0x4a, 0xd3, 0x5e, 0x61, 0x96, 0x8f,
-	0x95, 0xbf, 0x72, 0x91, 0xf2, 0x5d, 0x49, 0xa4, 0x7a, 0xf5, 0x60, 0xe9, 0xe2, 0x3b, 0x43, 0xaa,
-	0xa6, 0x8d, 0x2d, 0x2e, 0x01, 0xea, 0xd3, 0x0f, 0xd6, 0xe8, 0x0b, 0xc8, 0xde, 0x65, 0x02, 0x5b,
-	0x95, 0x46, 0xbd, 0x6e, 0x35, 0x25, 0x20, 0xbd, 0xd3, 0xef, 0xef, 0xbc, 0x18, 0x49, 0x3d, 0x7f,
-	0x31, 0xf2, 0xba, 0x61, 0x8a, 0xd5, 0xc6, 0x4a, 0xa1, 0xca, 0x6c, 0x9f, 0x02, 0xfe, 0xdf, 0x04,
-	0xaf, 0xad, 0x15, 0x45, 0xb3, 0x4e, 0x78, 0x61, 0x9e, 0x8a, 0x27, 0x8f, 0x26, 0xc0, 0x67, 0xc8,
-	0x3c, 0x15, 0x7a, 0xd8, 0xe1, 0xa9, 0x70, 0x8c, 0xed, 0x68, 0x77, 0xbb, 0x8e, 0x86, 0x59, 0xd3,
-	0x13, 0x65, 0x8d, 0xf6, 0x54, 0x81, 0xfe, 0x8a, 0xc7, 0xf9, 0x43, 0x30, 0xef, 0xc1, 0x05, 0xb9,
-	0x98, 0xc6, 0xdc, 0xac, 0x4a, 0x33, 0x17, 0xd6, 0xec, 0xe4, 0x44, 0xe1, 0xe4, 0x83, 0x52, 0x38,
-	0x70, 0xa3, 0x1f, 0x71, 0x82, 0x2c, 0x40, 0x7e, 0x28, 0x59, 0x77, 0xc9, 0x66, 0x0d, 0x2a, 0xbc,
-	0xde, 0x9c, 0x13, 0xc8, 0x18, 0xbf, 0xda, 0xb3, 0x0c, 0x5c, 0x73, 0xc5, 0xc4, 0xa9, 0x98, 0xd4,
-	0xb0, 0x88, 0x4c, 0x66, 0xd1, 0x29, 0xaf, 0x62, 0x6a, 0x10, 0x99, 0xcf, 0x8f, 0x0a, 0xfc, 0x5f,
-	0x5a, 0xcc, 0x90, 0x3a, 0xe3, 0xa6, 0xf0, 0x0c, 0x17, 0x9d, 0x25, 0x2c, 0xcf, 0x21, 0x35, 0xc8,
-	0xa7, 0xd8, 0x6a, 0xf8, 0x9c, 0x3a, 0x67, 0x86, 0x49, 0x02, 0xa1, 0x1f, 0x14, 0xd0, 0xca, 0x98,
-	0x2e, 0x99, 0x62, 0xb5, 0xe6, 0xe0, 0x8d, 0x76, 0xf9, 0xbc, 0x0c, 0xc4, 0x12, 0xc4, 0x41, 0xbf,
-	0x2a, 0xf0, 0xc6, 0x12, 0x36, 0xc5, 0x3d, 0x5a, 0x23, 0x16, 0x31, 0xe4, 0xa1, 0x6f, 0x97, 0x53,
-	0xe6, 0x25, 0xe4, 0x94, 0x34, 0x98, 0xf6, 0x73, 0x1a, 0x06, 0xbd, 0xd6, 0x96, 0x2c, 0x4b, 0xf6,
-	0x95, 0xcb, 0x86, 0x72, 0xb8, 0x80, 0x03, 0x41, 0x45, 0x60, 0xe1, 0xb6, 0x2e, 0x33, 0x96, 0x9d,
-	0xfc, 0x24, 0x09, 0x6f, 0x63, 0x1c, 0x16, 0x4a, 0x11, 0x6f, 0xb3, 0x54, 0x38, 0x4d, 0xfd, 0x48,
-	0x88, 0xdc, 0x37, 0x0a, 0x0c, 0xc6, 0xe8, 0xa1, 0x7e, 0xc8, 0xac, 0x91, 0xa6, 0xff, 0x42, 0x72,
-	0x1f, 0xd1, 0x12, 0x74, 0xae, 0x1f, 0x34, 0x30, 0x3b, 0x59, 0x4a, 0x9e, 0x55, 0x1b, 0x06, 0xeb,
-	0x9e, 0xbf, 0xa9, 0xf4, 0x2d, 0x45, 0xfb, 0x2b, 0x03, 0x23, 0x8b, 0x75, 0xe2, 0x60, 0xc1, 0xda,
-	0x12, 0x7e, 0x4b, 0x81, 0xff, 0x85, 0x8e, 0xc8, 0xab, 0x61, 0xfa, 0xb1, 0x11, 0x24, 0xc5, 0x83,
-	0x34, 0x17, 0x37, 0xe8, 0x2b, 0xa5, 0xf8, 0xc9, 0x71, 0xfe, 0xbb, 0x14, 0xff, 0x2d, 0x0d, 0x97,
-	0x82, 0xfc, 0xa3, 0x24, 0x6f, 0xb4, 0x21, 0xf9, 0x42, 0x12, 0x3a, 0xc5, 0xba, 0x4c, 0x44, 0xf3,
-	0x6f, 0x13, 0xd3, 0xfc, 0xb3, 0x28, 0xcd, 0xcb, 0xa7, 0xc9, 0x2b, 0x01, 0xd1, 0x9f, 0xa6, 0x61,
-	0x60, 0x81, 0x1b, 0x15, 0x22, 0xfc, 0x9b, 0xce, 0xbd, 0xbc, 0xd1, 0x14, 0x64, 0x1f, 0x38, 0xcc,
-	0x0e, 0xee, 0x75, 0x8f, 0xc8, 0xea, 0x93, 0x47, 0x13, 0x43, 0x3e, 0xfa, 0xfe, 0x4e, 0x45, 0x38,
-	0x26, 0x35, 0xf4, 0xb0, 0x32, 0xba, 0x05, 0xc0, 0x89, 0x08, 0x4c, 0xd3, 0x27, 0x98, 0x86, 0x74,
-	0xd1, 0x18, 0x5c, 0xac, 0x1e, 0x8e, 0x6d, 0xae, 0xd4, 0x9f, 0x28, 0x8e, 0x8a, 0xdd, 0xdb, 0xbd,
-	0x1a, 0x1e, 0xf0, 0x0e, 0x47, 0xb0, 0x16, 0x39, 0xfa, 0x10, 0x72, 0xde, 0xb1, 0x0f, 0x8d, 0x84,
-	0x07, 0x13, 0x92, 0x37, 0x78, 0xe8, 0xc7, 0x68, 0x4c, 0xbd, 0xf3, 0xdd, 0xc3, 0x91, 0xd4, 0x3f,
-	0x0f, 0x47, 0x52, 0x5f, 0xef, 0x6f, 0x8f, 0x87, 0x2b, 0xfd, 0x7e, 0x7f, 0x7b, 0x7c, 0x38, 0x18,
-	0x8c, 0x5b, 0x30, 0xd4, 0xae, 0xc2, 0x70, 0x8b, 0x50, 0x27, 0xbc, 0xce, 0x28, 0x27, 0xda, 0x73,
-	0x05, 0x2e, 0xeb, 0xc4, 0x30, 0xb9, 0x88, 0x44, 0xd5, 0xc9, 0x97, 0x2e, 0xf6, 0x73, 0xa7, 0xc1,
-	0x3e, 0xa4, 0x8c, 0x3e, 0x86, 0x0e, 0x33, 0x98, 0x66, 0xb3, 0x93, 0x37, 0x92, 0x70, 0xe5, 0xc8,
-	0xa0, 0xac, 0x4b, 0x07, 0x53, 0xef, 0x45, 0x8a, 0x9e, 0x8b, 0x16, 0x9d, 0x0f, 0x1d, 0xcd, 0x98,
-	0x22, 0xb4, 0x6b, 0x70, 0x35, 0xb6, 0x36, 0xbf, 0xf6, 0x1d, 0x05, 0xfa, 0x83, 0x7d, 0xc9, 0xcd,
-	0xf3, 0x56, 0x5d, 0x8a, 0x54, 0x7d, 0xca, 0xb1, 0xca, 0xab, 0xf7, 0xe6, 0x71, 0xf5, 0xaa, 0x31,
-	0xf5, 0x4a, 0x07, 0xda, 0x15, 0xb8, 0x74, 0xa4, 0x12, 0xaf, 0xc6, 0xc9, 0x3f, 0x32, 0x90, 0x59,
-	0xe0, 0x86, 0xfb, 0x82, 0x1e, 0xaa, 0x10, 0xe1, 0xd1, 0x2b, 0x7c, 0xc2, 0x6e, 0x26, 0xc9, 0xb2,
-	0x85, 0x3f, 0xb9, 0x0f, 0xce, 0x64, 0x16, 0xa4, 0x85, 0x7e, 0x51, 0x60, 0x30, 0xa6, 0x35, 0x68,
-	0x2a, 0x89, 0xdb, 0x78, 0xbe, 0xe6, 0x3e, 0x3a, 0xb3, 0xad, 0x9f, 0xd4, 0x96, 0x02, 0x7d, 0x11,
-	0x14, 0xd1, 0xdb, 0xa7, 0x71, 0x19, 0x50, 0x28, 0xf7, 0xee, 0x19, 0xac, 0xbc, 0x14, 0x72, 0x9d,
-	0x5b, 0xfb, 0xdb, 0xe3, 0xca, 0xf4, 0xe7, 0x3b, 0xbb, 0x79, 0xe5, 0xf1, 0x6e, 0x5e, 0xf9, 0x7b,
-	0x37, 0xaf, 0xfc, 0xb4, 0x97, 0x4f, 0x3d, 0xde, 0xcb, 0xa7, 0x9e, 0xed, 0xe5, 0x53, 0xf7, 0x4b,
-	0xa1, 0xfb, 0x69, 0xd6, 0x8b, 0x72, 0x87, 0x88, 0x0d, 0xe6, 0xac, 0x15, 0x83, 0x37, 0xc0, 0x66,
-	0xdb, 0x8f, 0x63, 0x79, 0x7d, 0xad, 0x74, 0xc9, 0x8f, 0xd3, 0x1b, 0xff, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x91, 0x6a, 0xc4, 0xed, 0x4c, 0x0f, 0x00, 0x00,
+func (m *UpdateAssetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateAssetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAssetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateAssetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAssetReq.Merge(m, src)
+}
+func (m *UpdateAssetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateAssetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAssetReq.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_UpdateAssetReq proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SetStakerExoCoreAddr(ctx context.Context, in *MsgSetExoCoreAddr, opts ...grpc.CallOption) (*MsgSetExoCoreAddrResponse, error)
-	RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error)
-	RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error)
+type UpdateAssetResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *UpdateAssetResponse) Reset()         { *m = UpdateAssetResponse{} }
+func (m *UpdateAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateAssetResponse) ProtoMessage()    {}
+func (*UpdateAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{17}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *UpdateAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SetStakerExoCoreAddr(ctx context.Context, in *MsgSetExoCoreAddr, opts ...grpc.CallOption) (*MsgSetExoCoreAddrResponse, error) {
-	out := new(MsgSetExoCoreAddrResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/SetStakerExoCoreAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error) {
-	out := new(RegisterClientChainResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/RegisterClientChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAssetResponse.Merge(m, src)
 }
-
-func (c *msgClient) RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error) {
-	out := new(RegisterAssetResponse)
-	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/RegisterAsset", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateAssetResponse) XXX_Size() int {
+	return m.Size()
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SetStakerExoCoreAddr(context.Context, *MsgSetExoCoreAddr) (*MsgSetExoCoreAddrResponse, error)
-	RegisterClientChain(context.Context, *RegisterClientChainReq) (*RegisterClientChainResponse, error)
-	RegisterAsset(context.Context, *RegisterAssetReq) (*RegisterAssetResponse, error)
+func (m *UpdateAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAssetResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_UpdateAssetResponse proto.InternalMessageInfo
+
+// DeprecateAssetReq deprecates the registered asset or reverts the deprecation.
+type DeprecateAssetReq struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	AssetID     string `protobuf:"bytes,2,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	Deprecated  bool   `protobuf:"varint,3,opt,name=Deprecated,proto3" json:"Deprecated,omitempty"`
 }
 
-func (*UnimplementedMsgServer) SetStakerExoCoreAddr(ctx context.Context, req *MsgSetExoCoreAddr) (*MsgSetExoCoreAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetStakerExoCoreAddr not implemented")
+func (m *DeprecateAssetReq) Reset()         { *m = DeprecateAssetReq{} }
+func (m *DeprecateAssetReq) String() string { return proto.CompactTextString(m) }
+func (*DeprecateAssetReq) ProtoMessage()    {}
+func (*DeprecateAssetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{18}
 }
-func (*UnimplementedMsgServer) RegisterClientChain(ctx context.Context, req *RegisterClientChainReq) (*RegisterClientChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterClientChain not implemented")
+func (m *DeprecateAssetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RegisterAsset(ctx context.Context, req *RegisterAssetReq) (*RegisterAssetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAsset not implemented")
+func (m *DeprecateAssetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateAssetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecateAssetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateAssetReq.Merge(m, src)
+}
+func (m *DeprecateAssetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateAssetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateAssetReq.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_DeprecateAssetReq proto.InternalMessageInfo
+
+type DeprecateAssetResponse struct {
 }
 
-func _Msg_SetStakerExoCoreAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetExoCoreAddr)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetStakerExoCoreAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/SetStakerExoCoreAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetStakerExoCoreAddr(ctx, req.(*MsgSetExoCoreAddr))
+func (m *DeprecateAssetResponse) Reset()         { *m = DeprecateAssetResponse{} }
+func (m *DeprecateAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*DeprecateAssetResponse) ProtoMessage()    {}
+func (*DeprecateAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{19}
+}
+func (m *DeprecateAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecateAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DeprecateAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateAssetResponse.Merge(m, src)
+}
+func (m *DeprecateAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateAssetResponse.DiscardUnknown(m)
 }
 
-func _Msg_RegisterClientChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterClientChainReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterClientChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/RegisterClientChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterClientChain(ctx, req.(*RegisterClientChainReq))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_DeprecateAssetResponse proto.InternalMessageInfo
+
+// SetGuardianReq sets the guardian who can pause the client chains and assets in an emergency.
+type SetGuardianReq struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	// Guardian an empty guardian removes the current guardian
+	Guardian string `protobuf:"bytes,2,opt,name=Guardian,proto3" json:"Guardian,omitempty"`
 }
 
-func _Msg_RegisterAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterAssetReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAsset(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/RegisterAsset",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAsset(ctx, req.(*RegisterAssetReq))
+func (m *SetGuardianReq) Reset()         { *m = SetGuardianReq{} }
+func (m *SetGuardianReq) String() string { return proto.CompactTextString(m) }
+func (*SetGuardianReq) ProtoMessage()    {}
+func (*SetGuardianReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{20}
+}
+func (m *SetGuardianReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetGuardianReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetGuardianReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *SetGuardianReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGuardianReq.Merge(m, src)
+}
+func (m *SetGuardianReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetGuardianReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGuardianReq.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "exocore.restaking_assets_manage.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetStakerExoCoreAddr",
-			Handler:    _Msg_SetStakerExoCoreAddr_Handler,
-		},
-		{
-			MethodName: "RegisterClientChain",
-			Handler:    _Msg_RegisterClientChain_Handler,
-		},
-		{
-			MethodName: "RegisterAsset",
-			Handler:    _Msg_RegisterAsset_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "exocore/restaking_assets_manage/v1/tx.proto",
+var xxx_messageInfo_SetGuardianReq proto.InternalMessageInfo
+
+type SetGuardianResponse struct {
 }
 
-func (m *ClientChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetGuardianResponse) Reset()         { *m = SetGuardianResponse{} }
+func (m *SetGuardianResponse) String() string { return proto.CompactTextString(m) }
+func (*SetGuardianResponse) ProtoMessage()    {}
+func (*SetGuardianResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{21}
+}
+func (m *SetGuardianResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetGuardianResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetGuardianResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SetGuardianResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGuardianResponse.Merge(m, src)
+}
+func (m *SetGuardianResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetGuardianResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGuardianResponse.DiscardUnknown(m)
 }
 
-func (m *ClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SetGuardianResponse proto.InternalMessageInfo
+
+// PauseClientChainReq pauses the client chain or unpauses it.
+type PauseClientChainReq struct {
+	FromAddress      string `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	LayerZeroChainID uint64 `protobuf:"varint,2,opt,name=LayerZeroChainID,proto3" json:"LayerZeroChainID,omitempty"`
+	Paused           bool   `protobuf:"varint,3,opt,name=Paused,proto3" json:"Paused,omitempty"`
 }
 
-func (m *ClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AddressLength != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AddressLength))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SignatureType) > 0 {
-		i -= len(m.SignatureType)
-		copy(dAtA[i:], m.SignatureType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SignatureType)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.LayerZeroChainID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LayerZeroChainID))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.FinalizationBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FinalizationBlocks))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExoCoreChainIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExoCoreChainIndex))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.MetaInfo) > 0 {
-		i -= len(m.MetaInfo)
-		copy(dAtA[i:], m.MetaInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaInfo)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *PauseClientChainReq) Reset()         { *m = PauseClientChainReq{} }
+func (m *PauseClientChainReq) String() string { return proto.CompactTextString(m) }
+func (*PauseClientChainReq) ProtoMessage()    {}
+func (*PauseClientChainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{22}
+}
+func (m *PauseClientChainReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseClientChainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseClientChainReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PauseClientChainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseClientChainReq.Merge(m, src)
+}
+func (m *PauseClientChainReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseClientChainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseClientChainReq.DiscardUnknown(m)
 }
 
-func (m *AssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PauseClientChainReq proto.InternalMessageInfo
+
+type PauseClientChainResponse struct {
+}
+
+func (m *PauseClientChainResponse) Reset()         { *m = PauseClientChainResponse{} }
+func (m *PauseClientChainResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseClientChainResponse) ProtoMessage()    {}
+func (*PauseClientChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{23}
+}
+func (m *PauseClientChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseClientChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseClientChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PauseClientChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseClientChainResponse.Merge(m, src)
+}
+func (m *PauseClientChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseClientChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseClientChainResponse.DiscardUnknown(m)
 }
 
-func (m *AssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PauseClientChainResponse proto.InternalMessageInfo
+
+// PauseAssetReq pauses the asset or unpauses it.
+type PauseAssetReq struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	AssetID     string `protobuf:"bytes,2,opt,name=AssetID,proto3" json:"AssetID,omitempty"`
+	Paused      bool   `protobuf:"varint,3,opt,name=Paused,proto3" json:"Paused,omitempty"`
 }
 
-func (m *AssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetaInfo) > 0 {
-		i -= len(m.MetaInfo)
-		copy(dAtA[i:], m.MetaInfo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaInfo)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.ExoCoreChainIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExoCoreChainIndex))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.LayerZeroChainID != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LayerZeroChainID))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.TotalSupply.Size()
-		i -= size
-		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PauseAssetReq) Reset()         { *m = PauseAssetReq{} }
+func (m *PauseAssetReq) String() string { return proto.CompactTextString(m) }
+func (*PauseAssetReq) ProtoMessage()    {}
+func (*PauseAssetReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{24}
+}
+func (m *PauseAssetReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseAssetReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseAssetReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *PauseAssetReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseAssetReq.Merge(m, src)
+}
+func (m *PauseAssetReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseAssetReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseAssetReq.DiscardUnknown(m)
 }
 
-func (m *StakingAssetInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PauseAssetReq proto.InternalMessageInfo
+
+type PauseAssetResponse struct {
+}
+
+func (m *PauseAssetResponse) Reset()         { *m = PauseAssetResponse{} }
+func (m *PauseAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseAssetResponse) ProtoMessage()    {}
+func (*PauseAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{25}
+}
+func (m *PauseAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PauseAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseAssetResponse.Merge(m, src)
+}
+func (m *PauseAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseAssetResponse.DiscardUnknown(m)
 }
 
-func (m *StakingAssetInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PauseAssetResponse proto.InternalMessageInfo
+
+// SetWithdrawalOutflowCapReq sets the withdrawal outflow cap of the asset.
+type SetWithdrawalOutflowCapReq struct {
+	FromAddress string               `protobuf:"bytes,1,opt,name=FromAddress,proto3" json:"FromAddress,omitempty"`
+	Cap         WithdrawalOutflowCap `protobuf:"bytes,2,opt,name=Cap,proto3" json:"Cap"`
 }
 
-func (m *StakingAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.StakingTotalAmount.Size()
-		i -= size
-		if _, err := m.StakingTotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SetWithdrawalOutflowCapReq) Reset()         { *m = SetWithdrawalOutflowCapReq{} }
+func (m *SetWithdrawalOutflowCapReq) String() string { return proto.CompactTextString(m) }
+func (*SetWithdrawalOutflowCapReq) ProtoMessage()    {}
+func (*SetWithdrawalOutflowCapReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{26}
+}
+func (m *SetWithdrawalOutflowCapReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetWithdrawalOutflowCapReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetWithdrawalOutflowCapReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.AssetBasicInfo != nil {
-		{
-			size, err := m.AssetBasicInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *SetWithdrawalOutflowCapReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWithdrawalOutflowCapReq.Merge(m, src)
+}
+func (m *SetWithdrawalOutflowCapReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetWithdrawalOutflowCapReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWithdrawalOutflowCapReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWithdrawalOutflowCapReq proto.InternalMessageInfo
+
+type SetWithdrawalOutflowCapResponse struct {
+}
+
+func (m *SetWithdrawalOutflowCapResponse) Reset()         { *m = SetWithdrawalOutflowCapResponse{} }
+func (m *SetWithdrawalOutflowCapResponse) String() string { return proto.CompactTextString(m) }
+func (*SetWithdrawalOutflowCapResponse) ProtoMessage()    {}
+func (*SetWithdrawalOutflowCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b24e66e530cc30d1, []int{27}
+}
+func (m *SetWithdrawalOutflowCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetWithdrawalOutflowCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetWithdrawalOutflowCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SetWithdrawalOutflowCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWithdrawalOutflowCapResponse.Merge(m, src)
+}
+func (m *SetWithdrawalOutflowCapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetWithdrawalOutflowCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWithdrawalOutflowCapResponse.DiscardUnknown(m)
 }
 
-func (m *StakerSingleAssetOrChangeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SetWithdrawalOutflowCapResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ClientChainInfo)(nil), "exocore.restaking_assets_manage.v1.ClientChainInfo")
+	proto.RegisterType((*AssetInfo)(nil), "exocore.restaking_assets_manage.v1.AssetInfo")
+	proto.RegisterType((*StakingAssetInfo)(nil), "exocore.restaking_assets_manage.v1.StakingAssetInfo")
+	proto.RegisterType((*WithdrawalOutflowCap)(nil), "exocore.restaking_assets_manage.v1.WithdrawalOutflowCap")
+	proto.RegisterType((*WithdrawalOutflow)(nil), "exocore.restaking_assets_manage.v1.WithdrawalOutflow")
+	proto.RegisterType((*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.StakerSingleAssetOrChangeInfo")
+	proto.RegisterType((*StakerAllAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.StakerAllAssetsInfo")
+	proto.RegisterMapType((map[string]*StakerSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.StakerAllAssetsInfo.AllAssetsStateEntry")
+	proto.RegisterType((*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorSingleAssetOrChangeInfo")
+	proto.RegisterType((*OperatorAllAssetsInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorAllAssetsInfo")
+	proto.RegisterMapType((map[string]*OperatorSingleAssetOrChangeInfo)(nil), "exocore.restaking_assets_manage.v1.OperatorAllAssetsInfo.AllAssetsStateEntry")
+	proto.RegisterType((*MsgSetExoCoreAddr)(nil), "exocore.restaking_assets_manage.v1.MsgSetExoCoreAddr")
+	proto.RegisterType((*ExoCoreAddrBinding)(nil), "exocore.restaking_assets_manage.v1.ExoCoreAddrBinding")
+	proto.RegisterType((*MsgSetExoCoreAddrResponse)(nil), "exocore.restaking_assets_manage.v1.MsgSetExoCoreAddrResponse")
+	proto.RegisterType((*RegisterClientChainReq)(nil), "exocore.restaking_assets_manage.v1.RegisterClientChainReq")
+	proto.RegisterType((*RegisterClientChainResponse)(nil), "exocore.restaking_assets_manage.v1.RegisterClientChainResponse")
+	proto.RegisterType((*RegisterAssetReq)(nil), "exocore.restaking_assets_manage.v1.RegisterAssetReq")
+	proto.RegisterType((*RegisterAssetResponse)(nil), "exocore.restaking_assets_manage.v1.RegisterAssetResponse")
+	proto.RegisterType((*UpdateAssetReq)(nil), "exocore.restaking_assets_manage.v1.UpdateAssetReq")
+	proto.RegisterType((*UpdateAssetResponse)(nil), "exocore.restaking_assets_manage.v1.UpdateAssetResponse")
+	proto.RegisterType((*DeprecateAssetReq)(nil), "exocore.restaking_assets_manage.v1.DeprecateAssetReq")
+	proto.RegisterType((*DeprecateAssetResponse)(nil), "exocore.restaking_assets_manage.v1.DeprecateAssetResponse")
+	proto.RegisterType((*SetGuardianReq)(nil), "exocore.restaking_assets_manage.v1.SetGuardianReq")
+	proto.RegisterType((*SetGuardianResponse)(nil), "exocore.restaking_assets_manage.v1.SetGuardianResponse")
+	proto.RegisterType((*PauseClientChainReq)(nil), "exocore.restaking_assets_manage.v1.PauseClientChainReq")
+	proto.RegisterType((*PauseClientChainResponse)(nil), "exocore.restaking_assets_manage.v1.PauseClientChainResponse")
+	proto.RegisterType((*PauseAssetReq)(nil), "exocore.restaking_assets_manage.v1.PauseAssetReq")
+	proto.RegisterType((*PauseAssetResponse)(nil), "exocore.restaking_assets_manage.v1.PauseAssetResponse")
+	proto.RegisterType((*SetWithdrawalOutflowCapReq)(nil), "exocore.restaking_assets_manage.v1.SetWithdrawalOutflowCapReq")
+	proto.RegisterType((*SetWithdrawalOutflowCapResponse)(nil), "exocore.restaking_assets_manage.v1.SetWithdrawalOutflowCapResponse")
+}
+
+func init() {
+	proto.RegisterFile("exocore/restaking_assets_manage/v1/tx.proto", fileDescriptor_b24e66e530cc30d1)
+}
+
+var fileDescriptor_b24e66e530cc30d1 = []byte{
+	// 1709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0xdb, 0x46,
+	0x16, 0x36, 0x29, 0xd9, 0xb1, 0x9f, 0xd6, 0x8e, 0x3d, 0x76, 0x1c, 0x86, 0xd9, 0xc8, 0x5e, 0xee,
+	0x62, 0xd7, 0xf0, 0xae, 0xa5, 0x8d, 0xf3, 0x5b, 0x35, 0x4d, 0x21, 0xcb, 0x4e, 0x1a, 0x34, 0x8e,
+	0x03, 0x2a, 0xa9, 0xd1, 0x14, 0x68, 0x3a, 0x96, 0xc6, 0x34, 0x61, 0x8a, 0x54, 0xc9, 0x51, 0x6c,
+	0xe7, 0x14, 0xa4, 0x45, 0xd1, 0x16, 0x45, 0xff, 0x50, 0xb4, 0x40, 0x0f, 0x45, 0x2e, 0xbd, 0x16,
+	0x39, 0xe4, 0xdc, 0x4b, 0x2f, 0x39, 0xe4, 0x10, 0xe4, 0xd2, 0x20, 0x87, 0xa0, 0x48, 0x0e, 0xc9,
+	0xad, 0xe7, 0xde, 0x0a, 0x0e, 0x87, 0x32, 0x29, 0x51, 0x36, 0x65, 0xcb, 0x40, 0x2f, 0x89, 0xde,
+	0x9b, 0x79, 0x6f, 0xde, 0xf7, 0xe6, 0x9b, 0x37, 0xf3, 0x68, 0xf8, 0x2f, 0x59, 0xb7, 0x4a, 0x96,
+	0x4d, 0xb2, 0x36, 0x71, 0x28, 0x5e, 0xd5, 0x4d, 0xed, 0x3a, 0x76, 0x1c, 0x42, 0x9d, 0xeb, 0x15,
+	0x6c, 0x62, 0x8d, 0x64, 0x6f, 0x1c, 0xcd, 0xd2, 0xf5, 0x4c, 0xd5, 0xb6, 0xa8, 0x85, 0x14, 0x3e,
+	0x39, 0xd3, 0x62, 0x72, 0xe6, 0xc6, 0x51, 0xf9, 0x60, 0xc9, 0x72, 0x2a, 0x96, 0x93, 0xad, 0x38,
+	0x9a, 0x6b, 0x5b, 0x71, 0x34, 0xcf, 0x58, 0x3e, 0xe4, 0x0d, 0x5c, 0x67, 0x52, 0xd6, 0x13, 0xf8,
+	0xd0, 0x88, 0x66, 0x69, 0x96, 0xa7, 0x77, 0x7f, 0x71, 0xed, 0x10, 0xae, 0xe8, 0xa6, 0x95, 0x65,
+	0xff, 0x7a, 0x2a, 0xe5, 0x0f, 0x11, 0xf6, 0x17, 0x0c, 0x9d, 0x98, 0xb4, 0xb0, 0x82, 0x75, 0xf3,
+	0x82, 0xb9, 0x6c, 0x21, 0x04, 0xc9, 0x4b, 0xb8, 0x42, 0x24, 0x61, 0x5c, 0x98, 0xe8, 0x53, 0xd9,
+	0x6f, 0x24, 0x43, 0xef, 0x3c, 0xa1, 0xd8, 0x1d, 0x97, 0x44, 0xa6, 0xaf, 0xcb, 0x48, 0x82, 0x7d,
+	0x9e, 0x71, 0x59, 0x4a, 0x8c, 0x0b, 0x13, 0x49, 0xd5, 0x17, 0xd1, 0xff, 0x60, 0x68, 0x6e, 0xdd,
+	0x2a, 0x58, 0x36, 0xe1, 0xde, 0xcb, 0x64, 0x5d, 0x4a, 0xb2, 0x39, 0xcd, 0x03, 0x28, 0x03, 0xe8,
+	0x9c, 0x6e, 0x62, 0x43, 0xbf, 0x89, 0xa9, 0x6e, 0x99, 0x33, 0x86, 0x55, 0x5a, 0x75, 0xa4, 0x6e,
+	0x36, 0x3d, 0x62, 0x04, 0x4d, 0xc2, 0xe0, 0x45, 0xbc, 0x41, 0xec, 0x6b, 0xc4, 0xb6, 0x3c, 0x37,
+	0xb3, 0x52, 0x0f, 0x9b, 0xdd, 0xa4, 0x47, 0xff, 0x82, 0xfe, 0xa2, 0xae, 0x99, 0x98, 0xd6, 0x6c,
+	0x72, 0x65, 0xa3, 0x4a, 0xa4, 0x7d, 0x0c, 0x44, 0x58, 0xe9, 0xce, 0xca, 0x97, 0xcb, 0x36, 0x71,
+	0x9c, 0x8b, 0xc4, 0xd4, 0xe8, 0x8a, 0xd4, 0x3b, 0x2e, 0x4c, 0xf4, 0xab, 0x61, 0xa5, 0x1b, 0xe7,
+	0xc5, 0x9b, 0x97, 0x2c, 0xb3, 0x44, 0xce, 0xe3, 0xea, 0x15, 0xcb, 0x20, 0x36, 0x36, 0xa9, 0xd4,
+	0x37, 0x2e, 0x4c, 0xf4, 0xaa, 0x11, 0x23, 0x68, 0x14, 0x7a, 0x2e, 0xe3, 0x9a, 0x43, 0xca, 0x12,
+	0xb0, 0x39, 0x5c, 0x52, 0x7e, 0x11, 0xa1, 0x2f, 0xef, 0xee, 0x76, 0xcb, 0xac, 0x8f, 0x42, 0x4f,
+	0x71, 0xa3, 0xb2, 0x64, 0x19, 0x3c, 0xe7, 0x5c, 0x72, 0x33, 0xce, 0x43, 0x62, 0x19, 0xef, 0x53,
+	0x7d, 0xd1, 0xdd, 0xa7, 0x59, 0x52, 0xd2, 0x2b, 0xd8, 0x70, 0x58, 0xa2, 0xfb, 0xd5, 0xba, 0x8c,
+	0xde, 0x85, 0xd4, 0x15, 0x8b, 0x62, 0xa3, 0x58, 0xab, 0x56, 0x8d, 0x0d, 0x96, 0xd8, 0xbe, 0x99,
+	0x33, 0xf7, 0x9f, 0x8e, 0x75, 0x3d, 0x79, 0x3a, 0xf6, 0x6f, 0x4d, 0xa7, 0x2b, 0xb5, 0xa5, 0x4c,
+	0xc9, 0xaa, 0x70, 0x2a, 0xf1, 0xff, 0xa6, 0x9c, 0xf2, 0x6a, 0x96, 0x6e, 0x54, 0x89, 0x93, 0xb9,
+	0x60, 0xd2, 0x47, 0xf7, 0xa6, 0xc0, 0xd3, 0xbb, 0x92, 0x1a, 0x74, 0xd8, 0xd6, 0x7e, 0x44, 0x32,
+	0x63, 0x5f, 0x2b, 0x66, 0x04, 0xd9, 0xd7, 0x1b, 0x66, 0x9f, 0xf2, 0x85, 0x08, 0x83, 0x45, 0xef,
+	0xec, 0x6c, 0x26, 0xf3, 0x2a, 0x0c, 0x30, 0x61, 0x06, 0x3b, 0x7a, 0x89, 0x99, 0xb9, 0x69, 0x4d,
+	0x4d, 0x4f, 0x65, 0xb6, 0x3f, 0x70, 0x99, 0xba, 0x1b, 0xb5, 0xc1, 0x09, 0x32, 0x00, 0xf1, 0xa5,
+	0x18, 0xee, 0x7c, 0xc5, 0xaa, 0x99, 0x54, 0x12, 0x3b, 0x90, 0xc8, 0x08, 0xbf, 0x28, 0x0d, 0x30,
+	0x4b, 0xaa, 0x36, 0x29, 0x61, 0x4a, 0xbc, 0xa3, 0xd5, 0xab, 0x06, 0x34, 0x01, 0x5e, 0x25, 0x43,
+	0xbc, 0xfa, 0x5e, 0x84, 0x91, 0x45, 0x9d, 0xae, 0x94, 0x6d, 0xbc, 0x86, 0x8d, 0x85, 0x1a, 0x5d,
+	0x36, 0xac, 0xb5, 0x02, 0xae, 0x32, 0xda, 0x30, 0x6c, 0xb3, 0x9c, 0x65, 0xbe, 0xe8, 0x52, 0xe3,
+	0x32, 0xb1, 0xd9, 0xb9, 0x2a, 0xe0, 0x6a, 0x47, 0x10, 0x05, 0x1d, 0x72, 0xff, 0x73, 0x55, 0xab,
+	0xb4, 0xe2, 0xfa, 0x4f, 0x74, 0xc8, 0xbf, 0xef, 0x10, 0x4d, 0xc0, 0x7e, 0xf6, 0xfb, 0x42, 0x99,
+	0x98, 0x54, 0x5f, 0xd6, 0x89, 0xcd, 0x72, 0xd2, 0xa7, 0x36, 0xaa, 0x95, 0x1f, 0x45, 0x18, 0x6a,
+	0x4a, 0x0e, 0x1a, 0x87, 0x14, 0x8b, 0xf5, 0x0d, 0xa2, 0x6b, 0x2b, 0x94, 0x65, 0x27, 0xa1, 0x06,
+	0x55, 0xe8, 0x3d, 0xf8, 0x1b, 0x13, 0xb9, 0x45, 0x47, 0x52, 0x14, 0xf2, 0xe8, 0xae, 0xc0, 0x82,
+	0xf5, 0x57, 0xe8, 0x44, 0x92, 0x42, 0x1e, 0xdd, 0x03, 0xca, 0xe4, 0x22, 0xc5, 0x36, 0xe5, 0x50,
+	0x93, 0x0c, 0x6a, 0x93, 0x5e, 0x79, 0x9c, 0x80, 0x23, 0x2e, 0x27, 0x89, 0x5d, 0xd4, 0x4d, 0xcd,
+	0x20, 0x8c, 0x29, 0x0b, 0x76, 0x61, 0x05, 0x9b, 0x1a, 0x61, 0x87, 0xe1, 0x73, 0x01, 0xfe, 0xc9,
+	0xe8, 0x3a, 0x4b, 0xaa, 0x96, 0xa3, 0x53, 0x8f, 0xb5, 0x0b, 0xf6, 0x22, 0x66, 0x97, 0x89, 0xa9,
+	0x91, 0xb7, 0xb0, 0x51, 0xe3, 0x05, 0x6d, 0x97, 0x38, 0xe2, 0x2c, 0x84, 0x3e, 0x13, 0x40, 0x29,
+	0x60, 0xd3, 0xdf, 0xdd, 0x56, 0xf1, 0x74, 0x62, 0xe7, 0x62, 0xac, 0x83, 0xbe, 0x15, 0xe0, 0x3f,
+	0x8b, 0x58, 0xa7, 0x57, 0xcd, 0x32, 0x31, 0x88, 0xc6, 0x6e, 0xae, 0x56, 0x31, 0x75, 0x62, 0xaf,
+	0xe3, 0x2e, 0xa6, 0x7c, 0x25, 0xc2, 0xb0, 0xb7, 0xb5, 0x79, 0xc3, 0x60, 0xfb, 0xea, 0xb0, 0x0d,
+	0x75, 0x60, 0x00, 0xfb, 0x8a, 0x22, 0xc5, 0xd4, 0xdd, 0xba, 0xc4, 0x44, 0x6a, 0xfa, 0xcd, 0x38,
+	0x45, 0x33, 0xc2, 0x61, 0x26, 0x1f, 0xf2, 0x36, 0x67, 0x52, 0x7b, 0x43, 0x6d, 0x58, 0x42, 0xfe,
+	0x50, 0x80, 0xe1, 0x88, 0x79, 0x68, 0x10, 0x12, 0xab, 0x64, 0x83, 0xd7, 0x29, 0xf7, 0x27, 0x5a,
+	0x84, 0xee, 0x1b, 0xf5, 0x0d, 0x4c, 0x4d, 0xe7, 0xe3, 0x47, 0xd5, 0x82, 0xc1, 0xaa, 0xe7, 0x2f,
+	0x27, 0x9e, 0x16, 0x94, 0x07, 0x09, 0x18, 0x5b, 0xa8, 0x12, 0x1b, 0x53, 0xab, 0x25, 0xe1, 0x6f,
+	0x09, 0xf0, 0xf7, 0x40, 0x7d, 0xde, 0x1b, 0xa6, 0x6f, 0xb9, 0x02, 0xa3, 0xb8, 0x1f, 0xe6, 0xc2,
+	0x9a, 0xb9, 0xa7, 0x14, 0xdf, 0x7e, 0x9d, 0xbf, 0x2e, 0xc5, 0xbf, 0x13, 0xe1, 0x80, 0x1f, 0x7f,
+	0x98, 0xe4, 0xb5, 0x16, 0x24, 0x9f, 0x8f, 0x43, 0xa7, 0x48, 0x97, 0xb1, 0x68, 0xfe, 0x51, 0x6c,
+	0x9a, 0xbf, 0x1d, 0xa6, 0x79, 0xa1, 0x9d, 0xb8, 0x62, 0x10, 0xfd, 0x77, 0x11, 0x86, 0xe6, 0x1d,
+	0xad, 0x48, 0x28, 0x7f, 0x66, 0xb9, 0x2f, 0x47, 0x94, 0x83, 0xd4, 0xb2, 0x6d, 0x55, 0xfc, 0x47,
+	0xa5, 0x47, 0x64, 0xe9, 0xd1, 0xbd, 0xa9, 0x11, 0x9e, 0x7d, 0x3e, 0x52, 0xa4, 0xb6, 0x6e, 0x6a,
+	0x6a, 0x70, 0x32, 0x3a, 0x0d, 0xe0, 0x10, 0xea, 0x9b, 0x8a, 0xdb, 0x98, 0x06, 0xe6, 0xba, 0xb7,
+	0x76, 0x69, 0xb3, 0xf7, 0x70, 0xb5, 0xfc, 0x39, 0xdb, 0xa8, 0x76, 0x6f, 0xae, 0x52, 0xb0, 0x4b,
+	0xd9, 0xec, 0x23, 0x9a, 0xf4, 0xe8, 0x2c, 0xc8, 0xde, 0xb1, 0x0f, 0xf4, 0x35, 0xf5, 0x67, 0xbe,
+	0xf7, 0xea, 0x55, 0xb7, 0x98, 0xe1, 0x3e, 0xab, 0x6c, 0xb2, 0xa4, 0x9b, 0x65, 0xf6, 0x78, 0xed,
+	0x55, 0xb9, 0x94, 0x3b, 0xf9, 0xf1, 0x9d, 0xb1, 0xae, 0x97, 0x77, 0xc6, 0xba, 0x6e, 0xbf, 0xb8,
+	0x3b, 0x19, 0xcc, 0xc0, 0xa7, 0x2f, 0xee, 0x4e, 0x1e, 0xf2, 0xbb, 0xbe, 0xa6, 0xdc, 0x2a, 0xb7,
+	0x05, 0x40, 0x01, 0x79, 0x46, 0x37, 0xcb, 0xba, 0xa9, 0xb9, 0x6f, 0x5a, 0x2f, 0x88, 0xfa, 0x6b,
+	0xac, 0x2e, 0xbb, 0xdb, 0x11, 0xb0, 0xd8, 0x36, 0xa7, 0xc1, 0xc9, 0x68, 0x04, 0xba, 0x59, 0x07,
+	0xc2, 0x7b, 0x31, 0x4f, 0x50, 0x0e, 0xc3, 0xa1, 0xa6, 0xc8, 0x54, 0xe2, 0x54, 0x2d, 0xd3, 0x21,
+	0xca, 0x13, 0x01, 0x46, 0x55, 0xa2, 0xe9, 0x0e, 0x0d, 0xa5, 0x44, 0x25, 0xef, 0xbb, 0x91, 0x9c,
+	0x6b, 0x87, 0x18, 0x81, 0xc9, 0xe8, 0x3c, 0x24, 0x75, 0xbf, 0x5f, 0x4c, 0x4d, 0x1f, 0x8b, 0x43,
+	0xe4, 0x86, 0x56, 0x54, 0x65, 0x0e, 0x72, 0xaf, 0x86, 0x32, 0x7f, 0x2e, 0x9c, 0xf9, 0x74, 0xa0,
+	0x6e, 0x44, 0x80, 0x50, 0x8e, 0xc0, 0xe1, 0x48, 0x6c, 0x1c, 0xfb, 0x7d, 0x01, 0x06, 0xfd, 0x71,
+	0x76, 0x70, 0x76, 0x8b, 0x3a, 0x1f, 0x42, 0xdd, 0x66, 0xc3, 0xe1, 0xe1, 0x3d, 0xb1, 0x15, 0x5e,
+	0x29, 0x02, 0x2f, 0x73, 0xa0, 0x1c, 0x84, 0x03, 0x0d, 0x48, 0x38, 0xc6, 0x6f, 0x44, 0x18, 0xb8,
+	0x5a, 0x2d, 0x63, 0x4a, 0x3a, 0x82, 0x30, 0xd0, 0x46, 0x88, 0xe1, 0x36, 0x22, 0xd8, 0xa7, 0x25,
+	0x1a, 0xbe, 0x12, 0x34, 0x74, 0x9f, 0xc9, 0x0e, 0x77, 0x9f, 0xb9, 0xff, 0x6f, 0x95, 0xb4, 0x61,
+	0xff, 0x78, 0x06, 0xd2, 0xa0, 0x1c, 0x80, 0xe1, 0x50, 0x56, 0x78, 0xb6, 0x7e, 0x16, 0x60, 0xa8,
+	0xde, 0x65, 0xed, 0x71, 0xc2, 0xb6, 0x69, 0xf1, 0x72, 0xc7, 0xb6, 0x02, 0x35, 0xea, 0x83, 0x0a,
+	0x47, 0xab, 0x48, 0x30, 0xda, 0x18, 0x3f, 0x87, 0x76, 0x4f, 0x80, 0x81, 0x22, 0xa1, 0xe7, 0x6b,
+	0xd8, 0x2e, 0xeb, 0x78, 0xd7, 0x07, 0xfc, 0x38, 0xf4, 0xfa, 0xae, 0xb6, 0xad, 0x51, 0xf5, 0x99,
+	0x31, 0x37, 0x2a, 0x10, 0xa6, 0xbb, 0x51, 0xa1, 0xa8, 0x39, 0x9a, 0x07, 0x02, 0x0c, 0xb3, 0x96,
+	0xb7, 0x83, 0x35, 0x2b, 0xea, 0x1b, 0x86, 0xd8, 0xe2, 0x1b, 0xc6, 0x66, 0xff, 0x9d, 0x08, 0xf6,
+	0xdf, 0xdb, 0x1c, 0x5f, 0x1f, 0x60, 0x63, 0xe4, 0x8a, 0x0c, 0x52, 0x33, 0x1a, 0x0e, 0xf5, 0x27,
+	0x01, 0xfa, 0xd9, 0xe0, 0x1e, 0xf3, 0xb1, 0x15, 0xa4, 0xec, 0x56, 0x90, 0x50, 0x08, 0x92, 0xc7,
+	0xc1, 0x11, 0x40, 0xc1, 0x78, 0x39, 0x8c, 0x97, 0x02, 0xc8, 0x45, 0x42, 0xa3, 0x3e, 0x4e, 0xec,
+	0x16, 0xd3, 0x65, 0x48, 0xf8, 0x5f, 0x2e, 0x52, 0xd3, 0xa7, 0xe3, 0x54, 0xdd, 0xa8, 0x28, 0x66,
+	0x92, 0x6e, 0x41, 0x52, 0x5d, 0x57, 0xb9, 0x33, 0x5b, 0x61, 0x1e, 0x0b, 0xf0, 0x34, 0xca, 0x91,
+	0xf2, 0x0f, 0x18, 0x6b, 0x89, 0xd4, 0xcb, 0xc6, 0xf4, 0xaf, 0x7d, 0x90, 0x98, 0x77, 0x34, 0xf7,
+	0x51, 0x3f, 0x52, 0x24, 0xd4, 0xbb, 0xfd, 0x83, 0x57, 0xf9, 0x89, 0x38, 0x30, 0x9a, 0xae, 0x75,
+	0xf9, 0xb5, 0x1d, 0x99, 0xf9, 0x61, 0xa1, 0xaf, 0x05, 0x18, 0x8e, 0xb8, 0x31, 0x51, 0x2e, 0x8e,
+	0xdb, 0xe8, 0x67, 0x84, 0xfc, 0xfa, 0x8e, 0x6d, 0x79, 0x50, 0xb7, 0x04, 0xe8, 0x0f, 0x5d, 0x6e,
+	0xe8, 0x78, 0x3b, 0x2e, 0xfd, 0x63, 0x23, 0xbf, 0xb2, 0x03, 0x2b, 0x1e, 0xc2, 0x4d, 0x48, 0x05,
+	0xae, 0x0b, 0x34, 0x1d, 0xc7, 0x53, 0xf8, 0xd6, 0x95, 0x4f, 0xb5, 0x6d, 0xc3, 0xd7, 0xfe, 0x40,
+	0x80, 0x81, 0x70, 0x4d, 0x8f, 0x47, 0x8e, 0xa6, 0x7b, 0x4c, 0xce, 0xed, 0xc4, 0x6c, 0x33, 0x03,
+	0x81, 0x3a, 0x1c, 0x2f, 0x03, 0xe1, 0xeb, 0x46, 0x3e, 0xd5, 0xb6, 0x0d, 0x5f, 0xfb, 0x13, 0x01,
+	0x06, 0x1b, 0xcb, 0x23, 0x8a, 0xe5, 0x2d, 0xe2, 0x8a, 0x90, 0xcf, 0xec, 0xcc, 0x90, 0xc7, 0xb2,
+	0x06, 0xb0, 0x59, 0xdc, 0xd0, 0xd1, 0xd8, 0xbe, 0xea, 0x9b, 0x70, 0xb2, 0x5d, 0x13, 0xbe, 0xf0,
+	0x0f, 0x02, 0x1c, 0x6c, 0x51, 0x55, 0xd0, 0xd9, 0x98, 0x99, 0x6d, 0x51, 0x7c, 0xe5, 0xc2, 0xae,
+	0xec, 0xbd, 0x00, 0xe5, 0xee, 0x5b, 0x2f, 0xee, 0x4e, 0x0a, 0x33, 0xef, 0xdc, 0x7f, 0x96, 0x16,
+	0x1e, 0x3e, 0x4b, 0x0b, 0xbf, 0x3d, 0x4b, 0x0b, 0x5f, 0x3e, 0x4f, 0x77, 0x3d, 0x7c, 0x9e, 0xee,
+	0x7a, 0xfc, 0x3c, 0xdd, 0x75, 0x2d, 0x1f, 0x78, 0xe8, 0xcd, 0x79, 0xeb, 0x5d, 0x22, 0x74, 0xcd,
+	0xb2, 0x57, 0xb3, 0x7e, 0x45, 0x5d, 0x6f, 0xf9, 0x97, 0x33, 0xf6, 0x0e, 0x5c, 0xea, 0x61, 0x7f,
+	0xb9, 0x3a, 0xf6, 0xe7, 0x00, 0x72, 0xe5, 0xda, 0xfe, 0x69, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetStakerExoCoreAddr(ctx context.Context, in *MsgSetExoCoreAddr, opts ...grpc.CallOption) (*MsgSetExoCoreAddrResponse, error)
+	RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error)
+	RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error)
+	UpdateAsset(ctx context.Context, in *UpdateAssetReq, opts ...grpc.CallOption) (*UpdateAssetResponse, error)
+	DeprecateAsset(ctx context.Context, in *DeprecateAssetReq, opts ...grpc.CallOption) (*DeprecateAssetResponse, error)
+	SetGuardian(ctx context.Context, in *SetGuardianReq, opts ...grpc.CallOption) (*SetGuardianResponse, error)
+	PauseClientChain(ctx context.Context, in *PauseClientChainReq, opts ...grpc.CallOption) (*PauseClientChainResponse, error)
+	PauseAsset(ctx context.Context, in *PauseAssetReq, opts ...grpc.CallOption) (*PauseAssetResponse, error)
+	SetWithdrawalOutflowCap(ctx context.Context, in *SetWithdrawalOutflowCapReq, opts ...grpc.CallOption) (*SetWithdrawalOutflowCapResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetStakerExoCoreAddr(ctx context.Context, in *MsgSetExoCoreAddr, opts ...grpc.CallOption) (*MsgSetExoCoreAddrResponse, error) {
+	out := new(MsgSetExoCoreAddrResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/SetStakerExoCoreAddr", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StakerSingleAssetOrChangeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterClientChain(ctx context.Context, in *RegisterClientChainReq, opts ...grpc.CallOption) (*RegisterClientChainResponse, error) {
+	out := new(RegisterClientChainResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/RegisterClientChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StakerSingleAssetOrChangeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.WaitUndelegationAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.WaitUndelegationAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) RegisterAsset(ctx context.Context, in *RegisterAssetReq, opts ...grpc.CallOption) (*RegisterAssetResponse, error) {
+	out := new(RegisterAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/RegisterAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CanWithdrawAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.CanWithdrawAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAsset(ctx context.Context, in *UpdateAssetReq, opts ...grpc.CallOption) (*UpdateAssetResponse, error) {
+	out := new(UpdateAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/UpdateAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalDepositAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.TotalDepositAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *msgClient) DeprecateAsset(ctx context.Context, in *DeprecateAssetReq, opts ...grpc.CallOption) (*DeprecateAssetResponse, error) {
+	out := new(DeprecateAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/DeprecateAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StakerAllAssetsInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetGuardian(ctx context.Context, in *SetGuardianReq, opts ...grpc.CallOption) (*SetGuardianResponse, error) {
+	out := new(SetGuardianResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/SetGuardian", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StakerAllAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PauseClientChain(ctx context.Context, in *PauseClientChainReq, opts ...grpc.CallOption) (*PauseClientChainResponse, error) {
+	out := new(PauseClientChainResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/PauseClientChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StakerAllAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllAssetsState) > 0 {
-		for k := range m.AllAssetsState {
-			v := m.AllAssetsState[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintTx(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *msgClient) PauseAsset(ctx context.Context, in *PauseAssetReq, opts ...grpc.CallOption) (*PauseAssetResponse, error) {
+	out := new(PauseAssetResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/PauseAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *OperatorSingleAssetOrChangeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetWithdrawalOutflowCap(ctx context.Context, in *SetWithdrawalOutflowCapReq, opts ...grpc.CallOption) (*SetWithdrawalOutflowCapResponse, error) {
+	out := new(SetWithdrawalOutflowCapResponse)
+	err := c.cc.Invoke(ctx, "/exocore.restaking_assets_manage.v1.Msg/SetWithdrawalOutflowCap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *OperatorSingleAssetOrChangeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetStakerExoCoreAddr(context.Context, *MsgSetExoCoreAddr) (*MsgSetExoCoreAddrResponse, error)
+	RegisterClientChain(context.Context, *RegisterClientChainReq) (*RegisterClientChainResponse, error)
+	RegisterAsset(context.Context, *RegisterAssetReq) (*RegisterAssetResponse, error)
+	UpdateAsset(context.Context, *UpdateAssetReq) (*UpdateAssetResponse, error)
+	DeprecateAsset(context.Context, *DeprecateAssetReq) (*DeprecateAssetResponse, error)
+	SetGuardian(context.Context, *SetGuardianReq) (*SetGuardianResponse, error)
+	PauseClientChain(context.Context, *PauseClientChainReq) (*PauseClientChainResponse, error)
+	PauseAsset(context.Context, *PauseAssetReq) (*PauseAssetResponse, error)
+	SetWithdrawalOutflowCap(context.Context, *SetWithdrawalOutflowCapReq) (*SetWithdrawalOutflowCapResponse, error)
 }
 
-func (m *OperatorSingleAssetOrChangeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.WaitUndelegationAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.WaitUndelegationAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.OperatorOwnAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.OperatorOwnAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalAmountOrWantChangeValue.Size()
-		i -= size
-		if _, err := m.TotalAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *OperatorAllAssetsInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) SetStakerExoCoreAddr(ctx context.Context, req *MsgSetExoCoreAddr) (*MsgSetExoCoreAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStakerExoCoreAddr not implemented")
 }
-
-func (m *OperatorAllAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) RegisterClientChain(ctx context.Context, req *RegisterClientChainReq) (*RegisterClientChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClientChain not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAsset(ctx context.Context, req *RegisterAssetReq) (*RegisterAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAsset not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAsset(ctx context.Context, req *UpdateAssetReq) (*UpdateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsset not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateAsset(ctx context.Context, req *DeprecateAssetReq) (*DeprecateAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateAsset not implemented")
+}
+func (*UnimplementedMsgServer) SetGuardian(ctx context.Context, req *SetGuardianReq) (*SetGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuardian not implemented")
+}
+func (*UnimplementedMsgServer) PauseClientChain(ctx context.Context, req *PauseClientChainReq) (*PauseClientChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseClientChain not implemented")
+}
+func (*UnimplementedMsgServer) PauseAsset(ctx context.Context, req *PauseAssetReq) (*PauseAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAsset not implemented")
+}
+func (*UnimplementedMsgServer) SetWithdrawalOutflowCap(ctx context.Context, req *SetWithdrawalOutflowCapReq) (*SetWithdrawalOutflowCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWithdrawalOutflowCap not implemented")
 }
 
-func (m *OperatorAllAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllAssetsState) > 0 {
-		for k := range m.AllAssetsState {
-			v := m.AllAssetsState[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintTx(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgSetExoCoreAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetStakerExoCoreAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExoCoreAddr)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetStakerExoCoreAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/SetStakerExoCoreAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetStakerExoCoreAddr(ctx, req.(*MsgSetExoCoreAddr))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetExoCoreAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RegisterClientChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterClientChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/RegisterClientChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterClientChain(ctx, req.(*RegisterClientChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetExoCoreAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakerClientChainSignature) > 0 {
-		i -= len(m.StakerClientChainSignature)
-		copy(dAtA[i:], m.StakerClientChainSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerClientChainSignature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ClientChainIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ClientChainIndex))
-		i--
-		dAtA[i] = 0x20
+func _Msg_RegisterAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAssetReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClientChainAddr) > 0 {
-		i -= len(m.ClientChainAddr)
-		copy(dAtA[i:], m.ClientChainAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainAddr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAsset(ctx, in)
 	}
-	if len(m.SetAddress) > 0 {
-		i -= len(m.SetAddress)
-		copy(dAtA[i:], m.SetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SetAddress)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/RegisterAsset",
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAsset(ctx, req.(*RegisterAssetReq))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetExoCoreAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/UpdateAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAsset(ctx, req.(*UpdateAssetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetExoCoreAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DeprecateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateAssetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/DeprecateAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateAsset(ctx, req.(*DeprecateAssetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetExoCoreAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SetGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuardianReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/SetGuardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGuardian(ctx, req.(*SetGuardianReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RegisterClientChainReq) Marshal() (dAtA []byte, err error) {
+func _Msg_PauseClientChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseClientChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseClientChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/PauseClientChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseClientChain(ctx, req.(*PauseClientChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseAssetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/PauseAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseAsset(ctx, req.(*PauseAssetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetWithdrawalOutflowCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWithdrawalOutflowCapReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWithdrawalOutflowCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.restaking_assets_manage.v1.Msg/SetWithdrawalOutflowCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWithdrawalOutflowCap(ctx, req.(*SetWithdrawalOutflowCapReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "exocore.restaking_assets_manage.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetStakerExoCoreAddr",
+			Handler:    _Msg_SetStakerExoCoreAddr_Handler,
+		},
+		{
+			MethodName: "RegisterClientChain",
+			Handler:    _Msg_RegisterClientChain_Handler,
+		},
+		{
+			MethodName: "RegisterAsset",
+			Handler:    _Msg_RegisterAsset_Handler,
+		},
+		{
+			MethodName: "UpdateAsset",
+			Handler:    _Msg_UpdateAsset_Handler,
+		},
+		{
+			MethodName: "DeprecateAsset",
+			Handler:    _Msg_DeprecateAsset_Handler,
+		},
+		{
+			MethodName: "SetGuardian",
+			Handler:    _Msg_SetGuardian_Handler,
+		},
+		{
+			MethodName: "PauseClientChain",
+			Handler:    _Msg_PauseClientChain_Handler,
+		},
+		{
+			MethodName: "PauseAsset",
+			Handler:    _Msg_PauseAsset_Handler,
+		},
+		{
+			MethodName: "SetWithdrawalOutflowCap",
+			Handler:    _Msg_SetWithdrawalOutflowCap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "exocore/restaking_assets_manage/v1/tx.proto",
+}
+
+func (m *ClientChainInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1394,39 +1867,86 @@ func (m *RegisterClientChainReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterClientChainReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClientChainInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterClientChainReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClientChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LzNonceGapTolerant {
+		i--
+		if m.LzNonceGapTolerant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x48
+	}
+	if m.AddressLength != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AddressLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SignatureType) > 0 {
+		i -= len(m.SignatureType)
+		copy(dAtA[i:], m.SignatureType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SignatureType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LayerZeroChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LayerZeroChainID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FinalizationBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalizationBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExoCoreChainIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExoCoreChainIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MetaInfo) > 0 {
+		i -= len(m.MetaInfo)
+		copy(dAtA[i:], m.MetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaInfo)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterClientChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1436,20 +1956,73 @@ func (m *RegisterClientChainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterClientChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterClientChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetaInfo) > 0 {
+		i -= len(m.MetaInfo)
+		copy(dAtA[i:], m.MetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaInfo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExoCoreChainIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExoCoreChainIndex))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LayerZeroChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LayerZeroChainID))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterAssetReq) Marshal() (dAtA []byte, err error) {
+func (m *StakingAssetInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1459,19 +2032,49 @@ func (m *RegisterAssetReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterAssetReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *StakingAssetInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StakingAssetInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StakingTotalAmount.Size()
+		i -= size
+		if _, err := m.StakingTotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AssetBasicInfo != nil {
 		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.AssetBasicInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1479,19 +2082,12 @@ func (m *RegisterAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FromAddress) > 0 {
-		i -= len(m.FromAddress)
-		copy(dAtA[i:], m.FromAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterAssetResponse) Marshal() (dAtA []byte, err error) {
+func (m *WithdrawalOutflowCap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1501,287 +2097,2869 @@ func (m *RegisterAssetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *WithdrawalOutflowCap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WithdrawalOutflowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ClientChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MetaInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if m.ExoCoreChainIndex != 0 {
-		n += 1 + sovTx(uint64(m.ExoCoreChainIndex))
-	}
-	if m.FinalizationBlocks != 0 {
-		n += 1 + sovTx(uint64(m.FinalizationBlocks))
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.LayerZeroChainID != 0 {
-		n += 1 + sovTx(uint64(m.LayerZeroChainID))
+	{
+		size := m.PerEpochCap.Size()
+		i -= size
+		if _, err := m.PerEpochCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SignatureType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PerBlockCap.Size()
+		i -= size
+		if _, err := m.PerBlockCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.AddressLength != 0 {
-		n += 1 + sovTx(uint64(m.AddressLength))
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawalOutflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalOutflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalOutflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Decimals != 0 {
-		n += 1 + sovTx(uint64(m.Decimals))
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.TotalSupply.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.LayerZeroChainID != 0 {
-		n += 1 + sovTx(uint64(m.LayerZeroChainID))
+	{
+		size := m.EpochOutflow.Size()
+		i -= size
+		if _, err := m.EpochOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.ExoCoreChainIndex != 0 {
-		n += 1 + sovTx(uint64(m.ExoCoreChainIndex))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BlockOutflow.Size()
+		i -= size
+		if _, err := m.BlockOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.MetaInfo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StakingAssetInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StakerSingleAssetOrChangeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerSingleAssetOrChangeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerSingleAssetOrChangeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetBasicInfo != nil {
-		l = m.AssetBasicInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.WaitUndelegationAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.WaitUndelegationAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.StakingTotalAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CanWithdrawAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.CanWithdrawAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalDepositAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.TotalDepositAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *StakerSingleAssetOrChangeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StakerAllAssetsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalDepositAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.CanWithdrawAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.WaitUndelegationAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StakerAllAssetsInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StakerAllAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerAllAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.AllAssetsState) > 0 {
-		for k, v := range m.AllAssetsState {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.AllAssetsState {
+			v := m.AllAssetsState[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperatorSingleAssetOrChangeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperatorSingleAssetOrChangeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.OperatorOwnAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.WaitUndelegationAmountOrWantChangeValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OperatorAllAssetsInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *OperatorSingleAssetOrChangeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorSingleAssetOrChangeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.WaitUndelegationAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.WaitUndelegationAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OperatorOwnAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.OperatorOwnAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalAmountOrWantChangeValue.Size()
+		i -= size
+		if _, err := m.TotalAmountOrWantChangeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAllAssetsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAllAssetsInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAllAssetsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
 	if len(m.AllAssetsState) > 0 {
-		for k, v := range m.AllAssetsState {
-			_ = k
-			_ = v
-			l = 0
+		for k := range m.AllAssetsState {
+			v := m.AllAssetsState[k]
+			baseI := i
 			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTx(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetExoCoreAddr) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetExoCoreAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExoCoreAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExoCoreAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Rebind {
+		i--
+		if m.Rebind {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ClientChainAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakerClientChainSignature) > 0 {
+		i -= len(m.StakerClientChainSignature)
+		copy(dAtA[i:], m.StakerClientChainSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerClientChainSignature)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.ClientChainIndex != 0 {
-		n += 1 + sovTx(uint64(m.ClientChainIndex))
+		i = encodeVarintTx(dAtA, i, uint64(m.ClientChainIndex))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.StakerClientChainSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ClientChainAddr) > 0 {
+		i -= len(m.ClientChainAddr)
+		copy(dAtA[i:], m.ClientChainAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientChainAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.SetAddress) > 0 {
+		i -= len(m.SetAddress)
+		copy(dAtA[i:], m.SetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SetAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetExoCoreAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExoCoreAddrBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RegisterClientChainReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExoCoreAddrBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExoCoreAddrBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ExoCoreAddr) > 0 {
+		i -= len(m.ExoCoreAddr)
+		copy(dAtA[i:], m.ExoCoreAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExoCoreAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterClientChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetExoCoreAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExoCoreAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExoCoreAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RegisterAssetReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisterClientChainReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterClientChainReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterClientChainReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovTx(uint64(l))
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterClientChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterClientChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterClientChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterAssetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterAssetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAssetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAssetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MetaInfo) > 0 {
+		i -= len(m.MetaInfo)
+		copy(dAtA[i:], m.MetaInfo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetaInfo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeprecateAssetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateAssetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecateAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeprecateAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeprecateAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetGuardianReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGuardianReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGuardianReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetGuardianResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetGuardianResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetGuardianResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseClientChainReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseClientChainReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseClientChainReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LayerZeroChainID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LayerZeroChainID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseClientChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseClientChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseClientChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseAssetReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseAssetReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseAssetReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetWithdrawalOutflowCapReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetWithdrawalOutflowCapReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetWithdrawalOutflowCapReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetWithdrawalOutflowCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetWithdrawalOutflowCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetWithdrawalOutflowCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if m.ExoCoreChainIndex != 0 {
+		n += 1 + sovTx(uint64(m.ExoCoreChainIndex))
+	}
+	if m.FinalizationBlocks != 0 {
+		n += 1 + sovTx(uint64(m.FinalizationBlocks))
+	}
+	if m.LayerZeroChainID != 0 {
+		n += 1 + sovTx(uint64(m.LayerZeroChainID))
+	}
+	l = len(m.SignatureType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AddressLength != 0 {
+		n += 1 + sovTx(uint64(m.AddressLength))
+	}
+	if m.LzNonceGapTolerant {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *AssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LayerZeroChainID != 0 {
+		n += 1 + sovTx(uint64(m.LayerZeroChainID))
+	}
+	if m.ExoCoreChainIndex != 0 {
+		n += 1 + sovTx(uint64(m.ExoCoreChainIndex))
+	}
+	l = len(m.MetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *StakingAssetInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetBasicInfo != nil {
+		l = m.AssetBasicInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.StakingTotalAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Deprecated {
+		n += 2
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *WithdrawalOutflowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PerBlockCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PerEpochCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawalOutflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	l = m.BlockOutflow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EpochOutflow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovTx(uint64(m.EpochStartHeight))
+	}
+	return n
+}
+
+func (m *StakerSingleAssetOrChangeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalDepositAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CanWithdrawAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.WaitUndelegationAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *StakerAllAssetsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllAssetsState) > 0 {
+		for k, v := range m.AllAssetsState {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *OperatorSingleAssetOrChangeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OperatorOwnAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.WaitUndelegationAmountOrWantChangeValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *OperatorAllAssetsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllAssetsState) > 0 {
+		for k, v := range m.AllAssetsState {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetExoCoreAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientChainAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClientChainIndex != 0 {
+		n += 1 + sovTx(uint64(m.ClientChainIndex))
+	}
+	l = len(m.StakerClientChainSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Rebind {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExoCoreAddrBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExoCoreAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgSetExoCoreAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterClientChainReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterClientChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterAssetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateAssetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetaInfo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *UpdateAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeprecateAssetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeprecateAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetGuardianReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SetGuardianResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseClientChainReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LayerZeroChainID != 0 {
+		n += 1 + sovTx(uint64(m.LayerZeroChainID))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *PauseClientChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseAssetReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *PauseAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetWithdrawalOutflowCapReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *SetWithdrawalOutflowCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreChainIndex", wireType)
+			}
+			m.ExoCoreChainIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExoCoreChainIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationBlocks", wireType)
+			}
+			m.FinalizationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
+			}
+			m.LayerZeroChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LayerZeroChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressLength", wireType)
+			}
+			m.AddressLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddressLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LzNonceGapTolerant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LzNonceGapTolerant = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
+			}
+			m.LayerZeroChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LayerZeroChainID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreChainIndex", wireType)
+			}
+			m.ExoCoreChainIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExoCoreChainIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakingAssetInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetBasicInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AssetBasicInfo == nil {
+				m.AssetBasicInfo = &AssetInfo{}
+			}
+			if err := m.AssetBasicInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalOutflowCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalOutflowCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalOutflowCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlockCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerBlockCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerEpochCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerEpochCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawalOutflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalOutflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalOutflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerSingleAssetOrChangeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerSingleAssetOrChangeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDepositAmountOrWantChangeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDepositAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanWithdrawAmountOrWantChangeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CanWithdrawAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmountOrWantChangeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WaitUndelegationAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerAllAssetsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerAllAssetsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerAllAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllAssetsState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllAssetsState == nil {
+				m.AllAssetsState = make(map[string]*StakerSingleAssetOrChangeInfo)
+			}
+			var mapkey string
+			var mapvalue *StakerSingleAssetOrChangeInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StakerSingleAssetOrChangeInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllAssetsState[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RegisterAssetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
+func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,15 +4982,15 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientChainInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorSingleAssetOrChangeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorSingleAssetOrChangeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountOrWantChangeValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1840,11 +5018,13 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorOwnAmountOrWantChangeValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1872,13 +5052,15 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaInfo = string(dAtA[iNdEx:postIndex])
+			if err := m.OperatorOwnAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmountOrWantChangeValue", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1888,73 +5070,81 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreChainIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.ExoCoreChainIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExoCoreChainIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationBlocks", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.FinalizationBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FinalizationBlocks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.WaitUndelegationAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LayerZeroChainID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LayerZeroChainID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAllAssetsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAllAssetsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAllAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllAssetsState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1964,43 +5154,121 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SignatureType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressLength", wireType)
+			if m.AllAssetsState == nil {
+				m.AllAssetsState = make(map[string]*OperatorSingleAssetOrChangeInfo)
 			}
-			m.AddressLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *OperatorSingleAssetOrChangeInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AddressLength |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OperatorSingleAssetOrChangeInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.AllAssetsState[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2022,7 +5290,7 @@ func (m *ClientChainInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetInfo) Unmarshal(dAtA []byte) error {
+func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2045,15 +5313,15 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetExoCoreAddr: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetExoCoreAddr: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2081,11 +5349,11 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2113,11 +5381,11 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.SetAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2145,13 +5413,13 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ClientChainAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainIndex", wireType)
 			}
-			m.Decimals = 0
+			m.ClientChainIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2161,14 +5429,14 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.ClientChainIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerClientChainSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2196,53 +5464,13 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerClientChainSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
-			}
-			m.LayerZeroChainID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LayerZeroChainID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreChainIndex", wireType)
-			}
-			m.ExoCoreChainIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExoCoreChainIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebind", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2252,24 +5480,12 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetaInfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Rebind = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2291,7 +5507,7 @@ func (m *AssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
+func (m *ExoCoreAddrBinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2314,17 +5530,17 @@ func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakingAssetInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExoCoreAddrBinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakingAssetInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExoCoreAddrBinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetBasicInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2334,31 +5550,27 @@ func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AssetBasicInfo == nil {
-				m.AssetBasicInfo = &AssetInfo{}
-			}
-			if err := m.AssetBasicInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExoCoreAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2386,10 +5598,77 @@ func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ExoCoreAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExoCoreAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExoCoreAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExoCoreAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2411,7 +5690,7 @@ func (m *StakingAssetInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
+func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2434,15 +5713,15 @@ func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerSingleAssetOrChangeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterClientChainReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerSingleAssetOrChangeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterClientChainReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDepositAmountOrWantChangeValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2470,15 +5749,13 @@ func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDepositAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanWithdrawAmountOrWantChangeValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2488,60 +5765,78 @@ func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CanWithdrawAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Info == nil {
+				m.Info = &ClientChainInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmountOrWantChangeValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterClientChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WaitUndelegationAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterClientChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterClientChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2563,7 +5858,7 @@ func (m *StakerSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakerAllAssetsInfo) Unmarshal(dAtA []byte) error {
+func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2586,15 +5881,47 @@ func (m *StakerAllAssetsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakerAllAssetsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterAssetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakerAllAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllAssetsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2621,106 +5948,63 @@ func (m *StakerAllAssetsInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllAssetsState == nil {
-				m.AllAssetsState = make(map[string]*StakerSingleAssetOrChangeInfo)
+			if m.Info == nil {
+				m.Info = &AssetInfo{}
 			}
-			var mapkey string
-			var mapvalue *StakerSingleAssetOrChangeInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StakerSingleAssetOrChangeInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.AllAssetsState[mapkey] = mapvalue
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterAssetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2742,7 +6026,7 @@ func (m *StakerAllAssetsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
+func (m *UpdateAssetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2765,15 +6049,15 @@ func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorSingleAssetOrChangeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAssetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorSingleAssetOrChangeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmountOrWantChangeValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2801,13 +6085,11 @@ func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorOwnAmountOrWantChangeValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2835,13 +6117,11 @@ func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperatorOwnAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitUndelegationAmountOrWantChangeValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaInfo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2869,7 +6149,39 @@ func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WaitUndelegationAmountOrWantChangeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MetaInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2894,7 +6206,7 @@ func (m *OperatorSingleAssetOrChangeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperatorAllAssetsInfo) Unmarshal(dAtA []byte) error {
+func (m *UpdateAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,17 +6229,67 @@ func (m *OperatorAllAssetsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperatorAllAssetsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperatorAllAssetsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeprecateAssetReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeprecateAssetReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeprecateAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllAssetsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2937,121 +6299,76 @@ func (m *OperatorAllAssetsInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllAssetsState == nil {
-				m.AllAssetsState = make(map[string]*OperatorSingleAssetOrChangeInfo)
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var mapkey string
-			var mapvalue *OperatorSingleAssetOrChangeInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &OperatorSingleAssetOrChangeInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.AllAssetsState[mapkey] = mapvalue
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3073,7 +6390,7 @@ func (m *OperatorAllAssetsInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
+func (m *DeprecateAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,10 +6413,60 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetExoCoreAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeprecateAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetExoCoreAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeprecateAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetGuardianReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetGuardianReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetGuardianReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3136,7 +6503,7 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3164,11 +6531,111 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SetAddress = string(dAtA[iNdEx:postIndex])
+			m.Guardian = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetGuardianResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetGuardianResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetGuardianResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseClientChainReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseClientChainReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseClientChainReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3196,13 +6663,13 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientChainAddr = string(dAtA[iNdEx:postIndex])
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientChainIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LayerZeroChainID", wireType)
 			}
-			m.ClientChainIndex = 0
+			m.LayerZeroChainID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3212,16 +6679,16 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientChainIndex |= uint64(b&0x7F) << shift
+				m.LayerZeroChainID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerClientChainSignature", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3231,24 +6698,12 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakerClientChainSignature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3270,7 +6725,7 @@ func (m *MsgSetExoCoreAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetExoCoreAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseClientChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3293,10 +6748,10 @@ func (m *MsgSetExoCoreAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetExoCoreAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseClientChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetExoCoreAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseClientChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3320,7 +6775,7 @@ func (m *MsgSetExoCoreAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
+func (m *PauseAssetReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3343,10 +6798,10 @@ func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterClientChainReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseAssetReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterClientChainReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3383,9 +6838,9 @@ func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3395,28 +6850,44 @@ func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &ClientChainInfo{}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3438,7 +6909,7 @@ func (m *RegisterClientChainReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterClientChainResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3461,10 +6932,10 @@ func (m *RegisterClientChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterClientChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterClientChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3488,7 +6959,7 @@ func (m *RegisterClientChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
+func (m *SetWithdrawalOutflowCapReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3511,10 +6982,10 @@ func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetWithdrawalOutflowCapReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetWithdrawalOutflowCapReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3551,7 +7022,7 @@ func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3578,10 +7049,7 @@ func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &AssetInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3606,7 +7074,7 @@ func (m *RegisterAssetReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
+func (m *SetWithdrawalOutflowCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3629,10 +7097,10 @@ func (m *RegisterAssetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterAssetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetWithdrawalOutflowCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetWithdrawalOutflowCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
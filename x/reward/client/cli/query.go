@@ -25,6 +25,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryRewardPool())
+	cmd.AddCommand(CmdQueryStakerRewards())
+	cmd.AddCommand(CmdQueryOperatorCommission())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
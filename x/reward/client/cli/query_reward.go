@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+)
+
+func CmdQueryRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool <operatorAddr> <assetID>",
+		Short: "shows the reward pool of the operator for the asset",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPool(cmd.Context(), &types.QueryRewardPoolRequest{
+				OperatorAddr: args[0],
+				AssetID:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryStakerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-rewards <stakerID> <assetID>",
+		Short: "shows the claimable rewards of the staker for the asset",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakerRewards(cmd.Context(), &types.QueryStakerRewardsRequest{
+				StakerID: args[0],
+				AssetID:  args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOperatorCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator-commission <operatorAddr>",
+		Short: "shows the commission rate of the operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OperatorCommission(cmd.Context(), &types.QueryOperatorCommissionRequest{
+				OperatorAddr: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/ExocoreNetwork/exocore/x/reward/types"
 )
 
+// FlagFunderAddr is the flag of the address whose deposit escrows the rewards funded by the authority
+const FlagFunderAddr = "funder"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +29,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		UpdateParams(),
+		SetOperatorCommission(),
+		FundRewardPool(),
+		ClaimCommission(),
 	)
 
 	return cmd
@@ -61,3 +68,108 @@ func UpdateParams() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// SetOperatorCommission sets the commission rate of the sender, the sender should be an operator
+func SetOperatorCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "SetOperatorCommission commissionRate",
+		Short: "set the commission rate of the operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetOperatorCommission{
+				FromAddress:    cliCtx.GetFromAddress().String(),
+				CommissionRate: rate,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FundRewardPool funds the reward pool of the operator, the avsAddress can be empty if the sender is the authority,
+// and the rewards are escrowed from the deposit of the funder specified by the flag in that case.
+func FundRewardPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "FundRewardPool operatorAddr assetID amount [avsAddress]",
+		Short: "fund the reward pool of the operator for the asset",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrInvalidRewardAmount
+			}
+
+			msg := &types.MsgFundRewardPool{
+				FromAddress:  cliCtx.GetFromAddress().String(),
+				OperatorAddr: args[0],
+				AssetID:      args[1],
+				Amount:       amount,
+			}
+			if len(args) == 4 {
+				msg.AvsAddress = args[3]
+			}
+			msg.FunderAddr, err = cmd.Flags().GetString(FlagFunderAddr)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFunderAddr, "", "the address whose deposit escrows the rewards if the AVS address is empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ClaimCommission claims the commission of the sender, the sender should be an operator
+func ClaimCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ClaimCommission assetID amount",
+		Short: "claim the commission of the operator for the asset",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return types.ErrInvalidRewardAmount
+			}
+
+			msg := &types.MsgClaimCommission{
+				FromAddress: cliCtx.GetFromAddress().String(),
+				AssetID:     args[0],
+				Amount:      amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,80 @@
+package reward
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis imports the reward pools, the reward states of the delegators, the commissions of the operators and
+// the escrowed rewards. The delegation module should be initialized before this module, the shares of the delegators
+// rebuilt by it are checked against the imported pools.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if !isEmptyParams(data.Params) {
+		if err := k.SetParams(ctx, &data.Params); err != nil {
+			panic(err)
+		}
+	}
+	rebuiltShares := make(map[string]sdkmath.Int)
+	for _, pool := range k.GetAllRewardPools(ctx) {
+		rebuiltShares[string(types.GetRewardPoolKey(pool.OperatorAddr, pool.AssetID))] = pool.TotalShares
+	}
+
+	for i := range data.Pools {
+		pool := &data.Pools[i]
+		key := string(types.GetRewardPoolKey(pool.OperatorAddr, pool.AssetID))
+		shares, ok := rebuiltShares[key]
+		if !ok {
+			shares = sdkmath.NewInt(0)
+		}
+		if !shares.Equal(pool.TotalShares) {
+			panic(errorsmod.Wrap(types.ErrInvalidGenesisData, fmt.Sprintf("the total shares of the pool:%s don't match the delegations:%s, pool:%s", pool.TotalShares, shares, key)))
+		}
+		delete(rebuiltShares, key)
+		k.SetRewardPool(ctx, pool)
+	}
+	for key, shares := range rebuiltShares {
+		if !shares.IsZero() {
+			panic(errorsmod.Wrap(types.ErrInvalidGenesisData, fmt.Sprintf("the pool of the delegations isn't imported:%s", key)))
+		}
+	}
+	for i := range data.DelegatorRewards {
+		k.SetDelegatorReward(ctx, &data.DelegatorRewards[i])
+	}
+	for i := range data.OperatorCommissions {
+		k.SetOperatorCommissionState(ctx, &data.OperatorCommissions[i])
+	}
+	for _, escrow := range data.EscrowedRewards {
+		k.SetEscrowedRewards(ctx, escrow.AssetID, escrow.Amount)
+	}
+}
+
+// ExportGenesis exports the params and the reward states
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if !errorsmod.IsOf(err, types.ErrNoParamsKey) {
+			panic(err)
+		}
+		params = &types.Params{}
+	}
+	delegatorRewards, err := k.GetAllDelegatorRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Params:              *params,
+		Pools:               k.GetAllRewardPools(ctx),
+		DelegatorRewards:    delegatorRewards,
+		OperatorCommissions: k.GetAllOperatorCommissions(ctx),
+		EscrowedRewards:     k.GetAllEscrowedRewards(ctx),
+	}
+}
+
+func isEmptyParams(params types.Params) bool {
+	return params.ExoCoreLzAppAddress == "" && params.ExoCoreLzAppEventTopic == ""
+}
@@ -22,6 +22,7 @@ import (
 
 type RewardParams struct {
 	ClientChainLzID       uint64
+	LzNonce               uint64
 	Action                types.CrossChainOpType
 	AssetsAddress         []byte
 	WithdrawRewardAddress []byte
@@ -124,6 +125,8 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethty
 	return nil
 }
 
+// RewardForWithdraw pays the accrued rewards of the staker to its deposit, the rewards can be withdrawn to
+// the client chain afterward.
 func (k Keeper) RewardForWithdraw(ctx sdk.Context, event *RewardParams) error {
 	// check event parameter then execute RewardForWithdraw operation
 	if event.OpAmount.IsNegative() {
@@ -135,12 +138,17 @@ func (k Keeper) RewardForWithdraw(ctx sdk.Context, event *RewardParams) error {
 		return errorsmod.Wrap(rtypes.ErrRewardAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
 	}
 
-	// TODO
+	// only the accrued rewards can be claimed, they are debited from the reward pools
+	err := k.ClaimStakerRewards(ctx, stakeID, assetID, event.OpAmount)
+	if err != nil {
+		return err
+	}
+
 	changeAmount := types.StakerSingleAssetOrChangeInfo{
 		TotalDepositAmountOrWantChangeValue: event.OpAmount,
 		CanWithdrawAmountOrWantChangeValue:  event.OpAmount,
 	}
-	err := k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
 	if err != nil {
 		return err
 	}
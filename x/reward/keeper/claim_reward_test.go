@@ -2,9 +2,13 @@ package keeper_test
 
 import (
 	sdkmath "cosmossdk.io/math"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	"github.com/ExocoreNetwork/exocore/x/reward/keeper"
 	rewardtype "github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -23,22 +27,75 @@ func (suite *KeeperTestSuite) TestClaimWithdrawRequest() {
 	err := suite.app.RewardKeeper.RewardForWithdraw(suite.ctx, event)
 	suite.ErrorContains(err, rewardtype.ErrRewardAssetNotExist.Error())
 
-	// test the normal case
+	// test the case that the staker hasn't accrued any reward
 	event.AssetsAddress = usdtAddress[:]
 	err = suite.app.RewardKeeper.RewardForWithdraw(suite.ctx, event)
+	suite.ErrorIs(err, rewardtype.ErrInsufficientRewards)
+
+	// delegate to an operator, then fund its reward pool
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: event.ClientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: event.ClientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	})
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(event.ClientChainLzID, event.WithdrawRewardAddress, event.AssetsAddress)
+	// the rewards are escrowed from the deposit of the operator's address
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: event.ClientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   opAccAddr,
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(10),
+	})
+	suite.NoError(err)
+	err = suite.app.RewardKeeper.FundRewardPool(suite.ctx, opAccAddr.String(), opAccAddr.String(), assetID, sdkmath.NewInt(10))
+	suite.NoError(err)
+
+	// the claimed amount can't exceed the accrued rewards
+	event.OpAmount = sdkmath.NewInt(11)
+	err = suite.app.RewardKeeper.RewardForWithdraw(suite.ctx, event)
+	suite.ErrorIs(err, rewardtype.ErrInsufficientRewards)
+
+	// test the normal case
+	event.OpAmount = sdkmath.NewInt(10)
+	err = suite.app.RewardKeeper.RewardForWithdraw(suite.ctx, event)
 	suite.NoError(err)
 
 	// check state after reward
-	stakerID, assetID := types.GetStakeIDAndAssetID(event.ClientChainLzID, event.WithdrawRewardAddress, event.AssetsAddress)
 	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
 	suite.NoError(err)
 	suite.Equal(types.StakerSingleAssetOrChangeInfo{
-		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(10),
-		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(10),
+		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(110),
+		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(60),
 		WaitUndelegationAmountOrWantChangeValue: sdkmath.NewInt(0),
 	}, *info)
 
 	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
 	suite.NoError(err)
-	suite.Equal(sdkmath.NewInt(10), assetInfo.StakingTotalAmount)
+	suite.Equal(sdkmath.NewInt(110), assetInfo.StakingTotalAmount)
+
+	pool := suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(0), pool.Balance)
+	suite.Equal(sdkmath.NewInt(0), suite.app.RewardKeeper.GetEscrowedRewards(suite.ctx, assetID))
 }
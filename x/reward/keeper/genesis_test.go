@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/ExocoreNetwork/exocore/x/delegation"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	"github.com/ExocoreNetwork/exocore/x/deposit"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	restaking "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ExocoreNetwork/exocore/x/reward"
+	rewardtype "github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v14/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	funder := utiltx.GenerateAddress()
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+
+	for _, staker := range []common.Address{suite.address, funder} {
+		err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.Deposit,
+			StakerAddress:   staker[:],
+			AssetsAddress:   usdtAddress[:],
+			OpAmount:        sdkmath.NewInt(100),
+		})
+		suite.NoError(err)
+	}
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	err = suite.app.RewardKeeper.SetOperatorCommission(suite.ctx, opAccAddr.String(), sdk.NewDecWithPrec(1, 1))
+	suite.NoError(err)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	})
+	suite.NoError(err)
+	err = suite.app.RewardKeeper.FundRewardPool(suite.ctx, sdk.AccAddress(funder.Bytes()).String(), opAccAddr.String(), assetID, sdkmath.NewInt(40))
+	suite.NoError(err)
+	err = suite.app.RewardKeeper.ClaimStakerRewards(suite.ctx, stakerID, assetID, sdkmath.NewInt(6))
+	suite.NoError(err)
+
+	restakingGenesis := restaking.ExportGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper)
+	depositGenesis := deposit.ExportGenesis(suite.ctx, suite.app.DepositKeeper)
+	delegationGenesis := delegation.ExportGenesis(suite.ctx, suite.app.DelegationKeeper)
+	rewardGenesis := reward.ExportGenesis(suite.ctx, suite.app.RewardKeeper)
+	suite.NoError(rewardGenesis.Validate())
+	suite.Equal(1, len(rewardGenesis.Pools))
+	suite.Equal(sdkmath.NewInt(34), rewardGenesis.Pools[0].Balance)
+	suite.Equal(1, len(rewardGenesis.DelegatorRewards))
+	suite.Equal(sdkmath.NewInt(30), rewardGenesis.DelegatorRewards[0].Reward.AccruedRewards)
+	suite.Equal(1, len(rewardGenesis.OperatorCommissions))
+	suite.Equal([]rewardtype.EscrowedRewards{{AssetID: assetID, Amount: sdkmath.NewInt(34)}}, rewardGenesis.EscrowedRewards)
+
+	// the balances of the pools should be backed by the escrowed rewards
+	invalidGenesis := *rewardGenesis
+	invalidGenesis.EscrowedRewards = []rewardtype.EscrowedRewards{{AssetID: assetID, Amount: sdkmath.NewInt(40)}}
+	suite.ErrorIs(invalidGenesis.Validate(), rewardtype.ErrInvalidGenesisData)
+	invalidGenesis.EscrowedRewards = nil
+	suite.ErrorIs(invalidGenesis.Validate(), rewardtype.ErrInvalidGenesisData)
+	// the total shares of the pool should be equal to the shares of its delegators
+	invalidGenesis = *rewardGenesis
+	invalidGenesis.DelegatorRewards = nil
+	suite.ErrorIs(invalidGenesis.Validate(), rewardtype.ErrInvalidGenesisData)
+
+	// import the genesis into a new chain
+	suite.SetupTest()
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	deposit.InitGenesis(suite.ctx, suite.app.DepositKeeper, *depositGenesis)
+	delegation.InitGenesis(suite.ctx, suite.app.DelegationKeeper, *delegationGenesis)
+	reward.InitGenesis(suite.ctx, suite.app.RewardKeeper, *rewardGenesis)
+	suite.Equal(rewardGenesis, reward.ExportGenesis(suite.ctx, suite.app.RewardKeeper))
+
+	// the imported rewards can be claimed from the escrow
+	err = suite.app.RewardKeeper.ClaimStakerRewards(suite.ctx, stakerID, assetID, sdkmath.NewInt(30))
+	suite.NoError(err)
+	_, err = suite.app.RewardKeeper.ClaimOperatorCommission(suite.ctx, opAccAddr.String(), assetID, sdkmath.NewInt(4))
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(0), suite.app.RewardKeeper.GetEscrowedRewards(suite.ctx, assetID))
+
+	// the total shares of the imported pool should match the delegations
+	suite.SetupTest()
+	restaking.InitGenesis(suite.ctx, suite.app.StakingAssetsManageKeeper, *restakingGenesis)
+	deposit.InitGenesis(suite.ctx, suite.app.DepositKeeper, *depositGenesis)
+	delegation.InitGenesis(suite.ctx, suite.app.DelegationKeeper, *delegationGenesis)
+	invalidGenesis = *rewardGenesis
+	invalidGenesis.Pools = nil
+	invalidGenesis.DelegatorRewards = nil
+	invalidGenesis.EscrowedRewards = nil
+	suite.NoError(invalidGenesis.Validate())
+	suite.Panics(func() {
+		reward.InitGenesis(suite.ctx, suite.app.RewardKeeper, invalidGenesis)
+	})
+}
@@ -15,23 +15,42 @@ import (
 type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
+	// the address capable of funding the reward pools without an AVS
+	authority string
 
 	// other keepers
 	restakingStateKeeper keeper.Keeper
+	delegationKeeper     types.DelegationKeeper
+	avsKeeper            types.AVSKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	restakingStateKeeper keeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+	avsKeeper types.AVSKeeper,
+	authority string,
 ) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
 	return &Keeper{
 		cdc:                  cdc,
 		storeKey:             storeKey,
+		authority:            authority,
 		restakingStateKeeper: restakingStateKeeper,
+		delegationKeeper:     delegationKeeper,
+		avsKeeper:            avsKeeper,
 	}
 }
 
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
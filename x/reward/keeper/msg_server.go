@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/x/reward/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,13 +14,82 @@ type msgServer struct {
 	Keeper
 }
 
+// NewMsgServerImpl returns an implementation of the MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
 func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
+	if params.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, params.Authority))
+	}
 	err := k.SetParams(c, &params.Params)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetOperatorCommission the signer should be a registered operator
+func (k msgServer) SetOperatorCommission(goCtx context.Context, req *types.MsgSetOperatorCommission) (*types.MsgSetOperatorCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetOperatorCommission(ctx, req.FromAddress, req.CommissionRate); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetOperatorCommissionResponse{}, nil
+}
+
+// FundRewardPool the signer should be the owner of an AVS that the operator has opted into and that accepts the
+// asset, the rewards are escrowed from the owner's deposit of the asset. The authority of the module can fund any
+// reward pool without specifying the AVS, the rewards funded by the governance are escrowed from the deposit of the
+// specified funder.
+func (k msgServer) FundRewardPool(goCtx context.Context, req *types.MsgFundRewardPool) (*types.MsgFundRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	funderAddr := req.FromAddress
+	if req.AvsAddress == "" {
+		if req.FromAddress != k.authority {
+			return nil, errorsmod.Wrap(types.ErrNotAllowedToFund, fmt.Sprintf("the AVS address is empty and the signer isn't the authority, expected:%s,got:%s", k.authority, req.FromAddress))
+		}
+		if req.FunderAddr == "" {
+			return nil, errorsmod.Wrap(types.ErrNotAllowedToFund, "the funder address is empty")
+		}
+		funderAddr = req.FunderAddr
+	} else if err := k.checkAVSFunder(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.FundRewardPool(ctx, funderAddr, req.OperatorAddr, req.AssetID, req.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgFundRewardPoolResponse{}, nil
+}
+
+func (k msgServer) checkAVSFunder(ctx sdk.Context, req *types.MsgFundRewardPool) error {
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, req.AvsAddress)
+	if err != nil {
+		return err
+	}
+	if avsInfo.Owner != req.FromAddress {
+		return errorsmod.Wrap(types.ErrNotAllowedToFund, fmt.Sprintf("the signer:%s isn't the owner of the AVS:%s", req.FromAddress, req.AvsAddress))
+	}
+	if !k.avsKeeper.IsOptedIn(ctx, req.OperatorAddr, strings.ToLower(req.AvsAddress)) {
+		return errorsmod.Wrap(types.ErrNotAllowedToFund, fmt.Sprintf("the operator:%s hasn't opted into the AVS:%s", req.OperatorAddr, req.AvsAddress))
+	}
+	for _, assetID := range avsInfo.AssetIDs {
+		if assetID == req.AssetID {
+			return nil
+		}
+	}
+	return errorsmod.Wrap(types.ErrNotAllowedToFund, fmt.Sprintf("the asset:%s isn't accepted by the AVS:%s", req.AssetID, req.AvsAddress))
+}
+
+// ClaimCommission the signer should be the operator
+func (k msgServer) ClaimCommission(goCtx context.Context, req *types.MsgClaimCommission) (*types.MsgClaimCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.ClaimOperatorCommission(ctx, req.FromAddress, req.AssetID, req.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgClaimCommissionResponse{}, nil
 }
 
-var _ types.MsgServer = msgServer{}
+var _ types.MsgServer = &msgServer{}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRewardPoolResponse{Pool: k.GetRewardPool(ctx, req.OperatorAddr, req.AssetID)}, nil
+}
+
+func (k Keeper) StakerRewards(goCtx context.Context, req *types.QueryStakerRewardsRequest) (*types.QueryStakerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, total := k.GetStakerRewards(ctx, req.StakerID, req.AssetID)
+	return &types.QueryStakerRewardsResponse{Rewards: rewards, Total: total}, nil
+}
+
+func (k Keeper) OperatorCommission(goCtx context.Context, req *types.QueryOperatorCommissionRequest) (*types.QueryOperatorCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	commission, err := k.GetOperatorCommission(ctx, req.OperatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryOperatorCommissionResponse{Commission: *commission}, nil
+}
@@ -0,0 +1,404 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	rtypes "github.com/ExocoreNetwork/exocore/x/reward/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file provides the reward accounting of the operators and their delegators.
+// The rewards funded to a pool are split into the operator's commission and the part of the delegators. The latter
+// is accumulated into the reward index of a share, so the reward of a delegator is its shares multiplied by the
+// growth of the index since its last settlement. The shares of a delegator are settled and updated by the
+// delegation module whenever its delegated amount changes.
+
+// GetRewardPool returns the reward pool of the operator for the specified asset, an empty pool is returned if
+// it doesn't exist.
+func (k Keeper) GetRewardPool(ctx sdk.Context, operatorAddr, assetID string) rtypes.RewardPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixRewardPool)
+	value := store.Get(rtypes.GetRewardPoolKey(operatorAddr, assetID))
+	if value == nil {
+		return rtypes.NewRewardPool(operatorAddr, assetID)
+	}
+	var pool rtypes.RewardPool
+	k.cdc.MustUnmarshal(value, &pool)
+	return pool
+}
+
+func (k Keeper) SetRewardPool(ctx sdk.Context, pool *rtypes.RewardPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixRewardPool)
+	store.Set(rtypes.GetRewardPoolKey(pool.OperatorAddr, pool.AssetID), k.cdc.MustMarshal(pool))
+}
+
+func (k Keeper) getDelegatorReward(ctx sdk.Context, stakerID string, pool *rtypes.RewardPool) rtypes.DelegatorReward {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixDelegatorReward)
+	value := store.Get(rtypes.GetDelegatorRewardKey(stakerID, pool.AssetID, pool.OperatorAddr))
+	if value == nil {
+		return rtypes.NewDelegatorReward(pool.RewardPerShare)
+	}
+	var reward rtypes.DelegatorReward
+	k.cdc.MustUnmarshal(value, &reward)
+	return reward
+}
+
+// setDelegatorReward stores the reward state of the delegator, it's removed when there isn't any share or reward left.
+func (k Keeper) setDelegatorReward(ctx sdk.Context, stakerID string, pool *rtypes.RewardPool, reward *rtypes.DelegatorReward) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixDelegatorReward)
+	key := rtypes.GetDelegatorRewardKey(stakerID, pool.AssetID, pool.OperatorAddr)
+	if reward.Shares.IsZero() && reward.AccruedRewards.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(reward))
+}
+
+// settleDelegatorReward accrues the reward earned by the delegator's shares since its last settlement.
+func settleDelegatorReward(pool *rtypes.RewardPool, reward *rtypes.DelegatorReward) {
+	if reward.Shares.IsPositive() {
+		earned := pool.RewardPerShare.Sub(reward.RewardPerShare).MulInt(reward.Shares).TruncateInt()
+		reward.AccruedRewards = reward.AccruedRewards.Add(earned)
+	}
+	reward.RewardPerShare = pool.RewardPerShare
+}
+
+// UpdateDelegatorRewardShares settles the reward of the delegator with its previous shares, then updates its shares.
+// It's called by the delegation module after the delegated amount of the staker is changed.
+func (k Keeper) UpdateDelegatorRewardShares(ctx sdk.Context, stakerID, assetID, operatorAddr string, shares sdkmath.Int) error {
+	if shares.IsNil() || shares.IsNegative() {
+		return errorsmod.Wrap(rtypes.ErrInvalidRewardAmount, fmt.Sprintf("the shares is:%s", shares))
+	}
+	pool := k.GetRewardPool(ctx, operatorAddr, assetID)
+	reward := k.getDelegatorReward(ctx, stakerID, &pool)
+	settleDelegatorReward(&pool, &reward)
+
+	pool.TotalShares = pool.TotalShares.Sub(reward.Shares).Add(shares)
+	reward.Shares = shares
+	k.SetRewardPool(ctx, &pool)
+	k.setDelegatorReward(ctx, stakerID, &pool, &reward)
+	return nil
+}
+
+// SetOperatorCommission sets the proportion of the funded rewards taken by the operator, it's applied to the
+// rewards funded after the update.
+func (k Keeper) SetOperatorCommission(ctx sdk.Context, operatorAddr string, rate sdk.Dec) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", operatorAddr))
+	}
+	if !k.delegationKeeper.IsOperator(ctx, opAccAddr) {
+		return errorsmod.Wrap(rtypes.ErrOperatorNotExist, fmt.Sprintf("the operator is:%s", operatorAddr))
+	}
+	if !rtypes.ValidateCommissionRate(rate) {
+		return errorsmod.Wrap(rtypes.ErrInvalidCommissionRate, fmt.Sprintf("the rate is:%s", rate))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixOperatorCommission)
+	commission := rtypes.OperatorCommission{
+		Rate:         rate,
+		UpdateHeight: uint64(ctx.BlockHeight()),
+	}
+	store.Set(opAccAddr, k.cdc.MustMarshal(&commission))
+	return nil
+}
+
+// GetOperatorCommission returns the commission of the operator, the rate is zero if it hasn't been set.
+func (k Keeper) GetOperatorCommission(ctx sdk.Context, operatorAddr string) (*rtypes.OperatorCommission, error) {
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", operatorAddr))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixOperatorCommission)
+	value := store.Get(opAccAddr)
+	if value == nil {
+		return &rtypes.OperatorCommission{Rate: sdk.ZeroDec()}, nil
+	}
+	var commission rtypes.OperatorCommission
+	k.cdc.MustUnmarshal(value, &commission)
+	return &commission, nil
+}
+
+// FundRewardPool escrows the rewards from the deposit of the funder, then adds them to the pool of the operator for
+// the specified asset. The commission is taken by the operator, and the rest is shared by the delegators according
+// to their shares. All rewards go to the operator if there isn't any delegator.
+func (k Keeper) FundRewardPool(ctx sdk.Context, funderAddr, operatorAddr, assetID string, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(rtypes.ErrInvalidRewardAmount, fmt.Sprintf("the amount is:%s", amount))
+	}
+	if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
+		return errorsmod.Wrap(rtypes.ErrRewardAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
+	}
+	commission, err := k.GetOperatorCommission(ctx, operatorAddr)
+	if err != nil {
+		return err
+	}
+	if !k.delegationKeeper.IsOperator(ctx, sdk.MustAccAddressFromBech32(operatorAddr)) {
+		return errorsmod.Wrap(rtypes.ErrOperatorNotExist, fmt.Sprintf("the operator is:%s", operatorAddr))
+	}
+	if err = k.EscrowRewards(ctx, funderAddr, assetID, amount); err != nil {
+		return err
+	}
+
+	pool := k.GetRewardPool(ctx, operatorAddr, assetID)
+	commissionAmount := amount
+	if pool.TotalShares.IsPositive() {
+		commissionAmount = commission.Rate.MulInt(amount).TruncateInt()
+		delegatorsAmount := amount.Sub(commissionAmount)
+		pool.RewardPerShare = pool.RewardPerShare.Add(sdk.NewDecFromInt(delegatorsAmount).QuoInt(pool.TotalShares))
+	}
+	pool.CommissionRewards = pool.CommissionRewards.Add(commissionAmount)
+	pool.Balance = pool.Balance.Add(amount)
+	k.SetRewardPool(ctx, &pool)
+	return nil
+}
+
+// EscrowRewards debits the rewards from the deposit of the funder's address on the client chain of the asset, so the
+// funded rewards are backed by the restaked assets. They are credited back to the deposits of the receivers when
+// claimed.
+func (k Keeper) EscrowRewards(ctx sdk.Context, funderAddr, assetID string, amount sdkmath.Int) error {
+	funderAccAddr, err := sdk.AccAddressFromBech32(funderAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", funderAddr))
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return errorsmod.Wrap(rtypes.ErrInvalidRewardAmount, fmt.Sprintf("the amount is:%s", amount))
+	}
+	_, clientChainLzID, err := restakingtype.ParseID(assetID)
+	if err != nil {
+		return err
+	}
+	funderID, _ := restakingtype.GetStakeIDAndAssetID(clientChainLzID, funderAccAddr, nil)
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, funderID, assetID, restakingtype.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: amount.Neg(),
+		CanWithdrawAmountOrWantChangeValue:  amount.Neg(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, fmt.Sprintf("failed to escrow the rewards from the funder:%s", funderID))
+	}
+	if err = k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, amount.Neg()); err != nil {
+		return err
+	}
+	k.SetEscrowedRewards(ctx, assetID, k.GetEscrowedRewards(ctx, assetID).Add(amount))
+	return nil
+}
+
+// releaseEscrowedRewards releases the claimed rewards from the escrow, the caller credits them to the deposit
+// of the receiver.
+func (k Keeper) releaseEscrowedRewards(ctx sdk.Context, assetID string, amount sdkmath.Int) error {
+	escrowed := k.GetEscrowedRewards(ctx, assetID)
+	if escrowed.LT(amount) {
+		return errorsmod.Wrap(rtypes.ErrInsufficientRewards, fmt.Sprintf("the released amount is:%s,the escrowed rewards are:%s", amount, escrowed))
+	}
+	k.SetEscrowedRewards(ctx, assetID, escrowed.Sub(amount))
+	return nil
+}
+
+// GetEscrowedRewards returns the rewards escrowed for the pools of the asset.
+func (k Keeper) GetEscrowedRewards(ctx sdk.Context, assetID string) sdkmath.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixEscrowedRewards)
+	value := store.Get([]byte(assetID))
+	if value == nil {
+		return sdkmath.NewInt(0)
+	}
+	var amount sdkmath.Int
+	if err := amount.Unmarshal(value); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) SetEscrowedRewards(ctx sdk.Context, assetID string, amount sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixEscrowedRewards)
+	if amount.IsZero() {
+		store.Delete([]byte(assetID))
+		return
+	}
+	value, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(assetID), value)
+}
+
+// GetStakerRewards returns the claimable rewards of the staker from all operators for the specified asset.
+func (k Keeper) GetStakerRewards(ctx sdk.Context, stakerID, assetID string) ([]rtypes.StakerOperatorReward, sdkmath.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixDelegatorReward)
+	iterator := sdk.KVStorePrefixIterator(store, rtypes.GetDelegatorRewardPrefix(stakerID, assetID))
+	defer iterator.Close()
+
+	prefixLength := len(rtypes.GetDelegatorRewardPrefix(stakerID, assetID))
+	rewards := make([]rtypes.StakerOperatorReward, 0)
+	total := sdkmath.NewInt(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var reward rtypes.DelegatorReward
+		k.cdc.MustUnmarshal(iterator.Value(), &reward)
+		operatorAddr := string(iterator.Key()[prefixLength:])
+		pool := k.GetRewardPool(ctx, operatorAddr, assetID)
+		settleDelegatorReward(&pool, &reward)
+		rewards = append(rewards, rtypes.StakerOperatorReward{
+			OperatorAddr: operatorAddr,
+			Amount:       reward.AccruedRewards,
+		})
+		total = total.Add(reward.AccruedRewards)
+	}
+	return rewards, total
+}
+
+// ClaimStakerRewards debits the claimed amount from the accrued rewards of the staker and the corresponding pools,
+// the rewards from the operators are debited in the order of the operator address.
+func (k Keeper) ClaimStakerRewards(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error {
+	rewards, total := k.GetStakerRewards(ctx, stakerID, assetID)
+	if total.LT(amount) {
+		return errorsmod.Wrap(rtypes.ErrInsufficientRewards, fmt.Sprintf("the claimed amount is:%s,the accrued rewards are:%s", amount, total))
+	}
+
+	if err := k.releaseEscrowedRewards(ctx, assetID, amount); err != nil {
+		return err
+	}
+	remaining := amount
+	for _, operatorReward := range rewards {
+		if remaining.IsZero() {
+			break
+		}
+		pool := k.GetRewardPool(ctx, operatorReward.OperatorAddr, assetID)
+		reward := k.getDelegatorReward(ctx, stakerID, &pool)
+		settleDelegatorReward(&pool, &reward)
+
+		claimed := sdkmath.MinInt(remaining, reward.AccruedRewards)
+		reward.AccruedRewards = reward.AccruedRewards.Sub(claimed)
+		pool.Balance = pool.Balance.Sub(claimed)
+		remaining = remaining.Sub(claimed)
+		k.SetRewardPool(ctx, &pool)
+		k.setDelegatorReward(ctx, stakerID, &pool, &reward)
+	}
+	return nil
+}
+
+// ClaimOperatorCommission debits the claimed amount from the commission of the operator, and credits it to the
+// deposit of the operator's address on the client chain of the asset. It returns the stakerID of the receiver.
+func (k Keeper) ClaimOperatorCommission(ctx sdk.Context, operatorAddr, assetID string, amount sdkmath.Int) (string, error) {
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return "", errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", operatorAddr))
+	}
+	if amount.IsNil() || !amount.IsPositive() {
+		return "", errorsmod.Wrap(rtypes.ErrInvalidRewardAmount, fmt.Sprintf("the amount is:%s", amount))
+	}
+	pool := k.GetRewardPool(ctx, operatorAddr, assetID)
+	if pool.CommissionRewards.LT(amount) {
+		return "", errorsmod.Wrap(rtypes.ErrInsufficientRewards, fmt.Sprintf("the claimed amount is:%s,the commission is:%s", amount, pool.CommissionRewards))
+	}
+	_, clientChainLzID, err := restakingtype.ParseID(assetID)
+	if err != nil {
+		return "", err
+	}
+	if err = k.releaseEscrowedRewards(ctx, assetID, amount); err != nil {
+		return "", err
+	}
+	pool.CommissionRewards = pool.CommissionRewards.Sub(amount)
+	pool.Balance = pool.Balance.Sub(amount)
+	k.SetRewardPool(ctx, &pool)
+
+	receiver, _ := restakingtype.GetStakeIDAndAssetID(clientChainLzID, opAccAddr, nil)
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, receiver, assetID, restakingtype.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: amount,
+		CanWithdrawAmountOrWantChangeValue:  amount,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err = k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, amount); err != nil {
+		return "", err
+	}
+	return receiver, nil
+}
+
+// GetAllRewardPools returns all reward pools, it's used to export the genesis state.
+func (k Keeper) GetAllRewardPools(ctx sdk.Context) []rtypes.RewardPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixRewardPool)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	pools := make([]rtypes.RewardPool, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pool rtypes.RewardPool
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// GetAllDelegatorRewards returns the reward states of all delegators, it's used to export the genesis state.
+func (k Keeper) GetAllDelegatorRewards(ctx sdk.Context) ([]rtypes.DelegatorRewardGenesis, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixDelegatorReward)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	rewards := make([]rtypes.DelegatorRewardGenesis, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		stakerID, assetID, operatorAddr, err := rtypes.ParseDelegatorRewardKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		reward := rtypes.DelegatorRewardGenesis{
+			StakerID:     stakerID,
+			AssetID:      assetID,
+			OperatorAddr: operatorAddr,
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &reward.Reward)
+		rewards = append(rewards, reward)
+	}
+	return rewards, nil
+}
+
+// SetDelegatorReward stores the reward state of the delegator, it's used to import the genesis state.
+func (k Keeper) SetDelegatorReward(ctx sdk.Context, reward *rtypes.DelegatorRewardGenesis) {
+	pool := rtypes.NewRewardPool(reward.OperatorAddr, reward.AssetID)
+	k.setDelegatorReward(ctx, reward.StakerID, &pool, &reward.Reward)
+}
+
+// GetAllOperatorCommissions returns the commissions of all operators, it's used to export the genesis state.
+func (k Keeper) GetAllOperatorCommissions(ctx sdk.Context) []rtypes.OperatorCommissionGenesis {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixOperatorCommission)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	commissions := make([]rtypes.OperatorCommissionGenesis, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		commission := rtypes.OperatorCommissionGenesis{
+			OperatorAddr: sdk.AccAddress(iterator.Key()).String(),
+		}
+		k.cdc.MustUnmarshal(iterator.Value(), &commission.Commission)
+		commissions = append(commissions, commission)
+	}
+	return commissions
+}
+
+// SetOperatorCommissionState stores the commission of the operator as it is, it's used to import the genesis state.
+func (k Keeper) SetOperatorCommissionState(ctx sdk.Context, commission *rtypes.OperatorCommissionGenesis) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixOperatorCommission)
+	store.Set(sdk.MustAccAddressFromBech32(commission.OperatorAddr), k.cdc.MustMarshal(&commission.Commission))
+}
+
+// GetAllEscrowedRewards returns the escrowed rewards of all assets, it's used to export the genesis state.
+func (k Keeper) GetAllEscrowedRewards(ctx sdk.Context) []rtypes.EscrowedRewards {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rtypes.KeyPrefixEscrowedRewards)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	escrows := make([]rtypes.EscrowedRewards, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		escrows = append(escrows, rtypes.EscrowedRewards{
+			AssetID: string(iterator.Key()),
+			Amount:  amount,
+		})
+	}
+	return escrows
+}
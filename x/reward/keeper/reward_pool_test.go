@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ExocoreNetwork/exocore/x/reward/keeper"
+	rewardtype "github.com/ExocoreNetwork/exocore/x/reward/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	utiltx "github.com/evmos/evmos/v14/testutil/tx"
+)
+
+func (suite *KeeperTestSuite) TestRewardPool() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	clientChainLzID := uint64(101)
+	anotherStaker := utiltx.GenerateAddress()
+	stakerID, assetID := types.GetStakeIDAndAssetID(clientChainLzID, suite.address[:], usdtAddress[:])
+	anotherStakerID, _ := types.GetStakeIDAndAssetID(clientChainLzID, anotherStaker[:], usdtAddress[:])
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	msgServer := keeper.NewMsgServerImpl(suite.app.RewardKeeper)
+
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	commissionMsg := &rewardtype.MsgSetOperatorCommission{
+		FromAddress:    opAccAddr.String(),
+		CommissionRate: sdk.NewDecWithPrec(1, 1),
+	}
+	// only the operator can set the commission
+	_, err = msgServer.SetOperatorCommission(suite.ctx, commissionMsg)
+	suite.ErrorIs(err, rewardtype.ErrOperatorNotExist)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	_, err = msgServer.SetOperatorCommission(suite.ctx, commissionMsg)
+	suite.NoError(err)
+	commission, err := suite.app.RewardKeeper.OperatorCommission(suite.ctx, &rewardtype.QueryOperatorCommissionRequest{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecWithPrec(1, 1), commission.Commission.Rate)
+
+	// only the authority can fund the pool without an AVS
+	fundMsg := &rewardtype.MsgFundRewardPool{
+		FromAddress:  opAccAddr.String(),
+		OperatorAddr: opAccAddr.String(),
+		AssetID:      assetID,
+		Amount:       sdkmath.NewInt(100),
+	}
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, rewardtype.ErrNotAllowedToFund)
+
+	// the rewards funded by the authority are escrowed from the deposit of the funder
+	fundMsg.FromAddress = authority
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, rewardtype.ErrNotAllowedToFund)
+	funder := utiltx.GenerateAddress()
+	funderID, _ := types.GetStakeIDAndAssetID(clientChainLzID, funder[:], nil)
+	fundMsg.FunderAddr = sdk.AccAddress(funder.Bytes()).String()
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, types.ErrSubAmountIsMoreThanOrigin)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.Deposit,
+		StakerAddress:   funder[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(1000),
+	})
+	suite.NoError(err)
+
+	// all rewards go to the operator if there isn't any delegator
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.NoError(err)
+	pool := suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(100), pool.CommissionRewards)
+	funderInfo, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, funderID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(900), funderInfo.CanWithdrawAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(100), suite.app.RewardKeeper.GetEscrowedRewards(suite.ctx, assetID))
+
+	for staker, amount := range map[common.Address]int64{suite.address: 30, anotherStaker: 10} {
+		err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.Deposit,
+			StakerAddress:   staker[:],
+			AssetsAddress:   usdtAddress[:],
+			OpAmount:        sdkmath.NewInt(100),
+		})
+		suite.NoError(err)
+		err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+			ClientChainLzID: clientChainLzID,
+			Action:          types.DelegateTo,
+			AssetsAddress:   usdtAddress[:],
+			OperatorAddress: opAccAddr,
+			StakerAddress:   staker[:],
+			OpAmount:        sdkmath.NewInt(amount),
+		})
+		suite.NoError(err)
+	}
+
+	// the commission is 20, and the delegators share the rest by 30:10
+	fundMsg.Amount = sdkmath.NewInt(200)
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.NoError(err)
+	rewards, err := suite.app.RewardKeeper.StakerRewards(suite.ctx, &rewardtype.QueryStakerRewardsRequest{StakerID: stakerID, AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(135), rewards.Total)
+
+	// the reward is settled when the delegation changes, the shares are 20:10 after the undelegation
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: clientChainLzID,
+		Action:          types.UndelegateFrom,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(10),
+	})
+	suite.NoError(err)
+	fundMsg.Amount = sdkmath.NewInt(400)
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.NoError(err)
+
+	rewards, err = suite.app.RewardKeeper.StakerRewards(suite.ctx, &rewardtype.QueryStakerRewardsRequest{StakerID: stakerID, AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(375), rewards.Total)
+	suite.Equal([]rewardtype.StakerOperatorReward{{OperatorAddr: opAccAddr.String(), Amount: sdkmath.NewInt(375)}}, rewards.Rewards)
+	rewards, err = suite.app.RewardKeeper.StakerRewards(suite.ctx, &rewardtype.QueryStakerRewardsRequest{StakerID: anotherStakerID, AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(165), rewards.Total)
+
+	pool = suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(700), pool.Balance)
+	suite.Equal(sdkmath.NewInt(30), pool.TotalShares)
+	suite.Equal(sdkmath.NewInt(160), pool.CommissionRewards)
+
+	// the claimed rewards are debited from the pool
+	err = suite.app.RewardKeeper.ClaimStakerRewards(suite.ctx, stakerID, assetID, sdkmath.NewInt(376))
+	suite.ErrorIs(err, rewardtype.ErrInsufficientRewards)
+	err = suite.app.RewardKeeper.ClaimStakerRewards(suite.ctx, stakerID, assetID, sdkmath.NewInt(375))
+	suite.NoError(err)
+	pool = suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(325), pool.Balance)
+	suite.Equal(sdkmath.NewInt(325), suite.app.RewardKeeper.GetEscrowedRewards(suite.ctx, assetID))
+
+	// the commission is credited to the deposit of the operator's address on the client chain
+	_, err = msgServer.ClaimCommission(suite.ctx, &rewardtype.MsgClaimCommission{
+		FromAddress: opAccAddr.String(),
+		AssetID:     assetID,
+		Amount:      sdkmath.NewInt(161),
+	})
+	suite.ErrorIs(err, rewardtype.ErrInsufficientRewards)
+	_, err = msgServer.ClaimCommission(suite.ctx, &rewardtype.MsgClaimCommission{
+		FromAddress: opAccAddr.String(),
+		AssetID:     assetID,
+		Amount:      sdkmath.NewInt(160),
+	})
+	suite.NoError(err)
+	receiverID, _ := types.GetStakeIDAndAssetID(clientChainLzID, opAccAddr, nil)
+	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, receiverID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(160), info.CanWithdrawAmountOrWantChangeValue)
+	pool = suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(165), pool.Balance)
+	suite.Equal(sdkmath.NewInt(0), pool.CommissionRewards)
+
+	// the AVS owner can fund the pools of its opted-in operators
+	avsAddress := "0x3e108c058e8066da635321dc3018294ca82ddedf"
+	avsOwner := sdk.AccAddress(suite.address.Bytes()).String()
+	_, err = suite.app.AVSKeeper.RegisterAVS(suite.ctx, &avstype.MsgRegisterAVS{
+		FromAddress: avsOwner,
+		Info: &avstype.AVSInfo{
+			Name:                      "avs",
+			MiddlewareContractAddress: avsAddress,
+			AssetIDs:                  []string{assetID},
+			UnbondingPeriod:           10,
+		},
+	})
+	suite.NoError(err)
+	fundMsg = &rewardtype.MsgFundRewardPool{
+		FromAddress:  avsOwner,
+		AvsAddress:   avsAddress,
+		OperatorAddr: opAccAddr.String(),
+		AssetID:      assetID,
+		Amount:       sdkmath.NewInt(30),
+	}
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, rewardtype.ErrNotAllowedToFund)
+	_, err = suite.app.AVSKeeper.OptIntoAVS(suite.ctx, &avstype.MsgOptIntoAVS{
+		FromAddress: opAccAddr.String(),
+		AvsAddress:  avsAddress,
+	})
+	suite.NoError(err)
+
+	// the rewards are escrowed from the deposit of the AVS owner, which can't exceed its withdrawable amount
+	ownerInfo, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(70), ownerInfo.CanWithdrawAmountOrWantChangeValue)
+	fundMsg.Amount = sdkmath.NewInt(71)
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, types.ErrSubAmountIsMoreThanOrigin)
+	fundMsg.Amount = sdkmath.NewInt(30)
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.NoError(err)
+	ownerInfo, err = suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(40), ownerInfo.CanWithdrawAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(70), ownerInfo.TotalDepositAmountOrWantChangeValue)
+	fundMsg.FromAddress = authority
+	_, err = msgServer.FundRewardPool(suite.ctx, fundMsg)
+	suite.ErrorIs(err, rewardtype.ErrNotAllowedToFund)
+
+	rewards, err = suite.app.RewardKeeper.StakerRewards(suite.ctx, &rewardtype.QueryStakerRewardsRequest{StakerID: anotherStakerID, AssetID: assetID})
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(174), rewards.Total)
+	pool = suite.app.RewardKeeper.GetRewardPool(suite.ctx, opAccAddr.String(), assetID)
+	suite.Equal(sdkmath.NewInt(195), pool.Balance)
+	suite.Equal(sdkmath.NewInt(195), suite.app.RewardKeeper.GetEscrowedRewards(suite.ctx, assetID))
+}
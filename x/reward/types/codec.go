@@ -23,7 +23,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "exocore/MsgUpdateParamsForReward"
+	updateParamsName          = "exocore/MsgUpdateParamsForReward"
+	setOperatorCommissionName = "exocore/MsgSetOperatorCommission"
+	fundRewardPoolName        = "exocore/MsgFundRewardPool"
+	claimCommissionName       = "exocore/MsgClaimCommission"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +40,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetOperatorCommission{},
+		&MsgFundRewardPool{},
+		&MsgClaimCommission{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +53,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetOperatorCommission{}, setOperatorCommissionName, nil)
+	cdc.RegisterConcrete(&MsgFundRewardPool{}, fundRewardPoolName, nil)
+	cdc.RegisterConcrete(&MsgClaimCommission{}, claimCommissionName, nil)
 }
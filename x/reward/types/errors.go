@@ -14,4 +14,11 @@ var (
 	ErrNoParamsKey              = errorsmod.Register(ModuleName, 2, "there is no stored key for params")
 	ErrRewardAmountIsNegative   = errorsmod.Register(ModuleName, 3, "the reward amount is negative")
 	ErrRewardAssetNotExist      = errorsmod.Register(ModuleName, 4, "the reward asset doesn't exist")
+	ErrOperatorNotExist         = errorsmod.Register(ModuleName, 5, "the operator doesn't exist")
+	ErrInvalidCommissionRate    = errorsmod.Register(ModuleName, 6, "the commission rate should be between 0 and 1")
+	ErrInvalidRewardAmount      = errorsmod.Register(ModuleName, 7, "the reward amount should be positive")
+	ErrNotAllowedToFund         = errorsmod.Register(ModuleName, 8, "the signer isn't allowed to fund the reward pool")
+	ErrInsufficientRewards      = errorsmod.Register(ModuleName, 9, "the claimed amount exceeds the accrued rewards")
+	ErrInvalidAuthority         = errorsmod.Register(ModuleName, 10, "the signer isn't the authority of the module")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 11, "the genesis data supplied is invalid")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -19,3 +20,14 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// DelegationKeeper defines the expected delegation keeper used to check the operator
+type DelegationKeeper interface {
+	IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// AVSKeeper defines the expected avs keeper used to check if the AVS is allowed to fund the reward pool
+type AVSKeeper interface {
+	GetAVSInfo(ctx sdk.Context, avsAddress string) (*avstypes.AVSInfo, error)
+	IsOptedIn(ctx sdk.Context, operatorAddr, avsAddress string) bool
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,15 +15,122 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. The consistency with the delegation states is checked in InitGenesis.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	pools := make(map[string]RewardPool, len(gs.Pools))
+	poolBalances := make(map[string]sdkmath.Int)
+	for _, pool := range gs.Pools {
+		key := string(GetRewardPoolKey(pool.OperatorAddr, pool.AssetID))
+		if _, err := sdk.AccAddressFromBech32(pool.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the pool is invalid:%s", key))
+		}
+		if _, _, err := restakingtype.ParseID(pool.AssetID); err != nil {
+			return err
+		}
+		if _, ok := pools[key]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated reward pool:%s", key))
+		}
+		if !isNonNegativeInt(pool.Balance) || !isNonNegativeInt(pool.TotalShares) || !isNonNegativeInt(pool.CommissionRewards) ||
+			pool.RewardPerShare.IsNil() || pool.RewardPerShare.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the amounts of the pool should be non-negative:%s", key))
+		}
+		if pool.CommissionRewards.GT(pool.Balance) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the commission exceeds the balance of the pool:%s", key))
+		}
+		pools[key] = pool
+		balance, ok := poolBalances[pool.AssetID]
+		if !ok {
+			balance = sdkmath.NewInt(0)
+		}
+		poolBalances[pool.AssetID] = balance.Add(pool.Balance)
+	}
+
+	// the total shares of each pool should be equal to the sum of the shares of its delegators
+	totalShares := make(map[string]sdkmath.Int, len(gs.Pools))
+	rewards := make(map[string]struct{}, len(gs.DelegatorRewards))
+	for _, reward := range gs.DelegatorRewards {
+		rewardKey := string(GetDelegatorRewardKey(reward.StakerID, reward.AssetID, reward.OperatorAddr))
+		if _, ok := rewards[rewardKey]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated delegator reward:%s", rewardKey))
+		}
+		rewards[rewardKey] = struct{}{}
+		if _, _, err := restakingtype.ParseID(reward.StakerID); err != nil {
+			return err
+		}
+		poolKey := string(GetRewardPoolKey(reward.OperatorAddr, reward.AssetID))
+		pool, ok := pools[poolKey]
+		if !ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the pool of the delegator reward doesn't exist:%s", rewardKey))
+		}
+		if !isNonNegativeInt(reward.Reward.Shares) || !isNonNegativeInt(reward.Reward.AccruedRewards) ||
+			reward.Reward.RewardPerShare.IsNil() || reward.Reward.RewardPerShare.GT(pool.RewardPerShare) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the delegator reward is invalid:%s", rewardKey))
+		}
+		shares, ok := totalShares[poolKey]
+		if !ok {
+			shares = sdkmath.NewInt(0)
+		}
+		totalShares[poolKey] = shares.Add(reward.Reward.Shares)
+	}
+	for key, pool := range pools {
+		shares, ok := totalShares[key]
+		if !ok {
+			shares = sdkmath.NewInt(0)
+		}
+		if !shares.Equal(pool.TotalShares) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the total shares of the pool doesn't match the delegator rewards:%s", key))
+		}
+	}
+
+	commissions := make(map[string]struct{}, len(gs.OperatorCommissions))
+	for _, commission := range gs.OperatorCommissions {
+		if _, err := sdk.AccAddressFromBech32(commission.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the commission is invalid:%s", commission.OperatorAddr))
+		}
+		if _, ok := commissions[commission.OperatorAddr]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated operator commission:%s", commission.OperatorAddr))
+		}
+		commissions[commission.OperatorAddr] = struct{}{}
+		if !ValidateCommissionRate(commission.Commission.Rate) {
+			return errorsmod.Wrap(ErrInvalidCommissionRate, fmt.Sprintf("operator:%s,the rate is:%s", commission.OperatorAddr, commission.Commission.Rate))
+		}
+	}
+
+	// the balances of the pools are backed by the rewards escrowed from the deposits of the funders
+	escrows := make(map[string]struct{}, len(gs.EscrowedRewards))
+	for _, escrow := range gs.EscrowedRewards {
+		if _, ok := escrows[escrow.AssetID]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated escrowed rewards:%s", escrow.AssetID))
+		}
+		escrows[escrow.AssetID] = struct{}{}
+		if !isNonNegativeInt(escrow.Amount) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the escrowed rewards should be non-negative:%s", escrow.AssetID))
+		}
+		balance, ok := poolBalances[escrow.AssetID]
+		if !ok {
+			balance = sdkmath.NewInt(0)
+		}
+		if !balance.Equal(escrow.Amount) {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the escrowed rewards:%s don't match the balances of the pools:%s, assetID:%s", escrow.Amount, balance, escrow.AssetID))
+		}
+	}
+	for assetID, balance := range poolBalances {
+		if _, ok := escrows[assetID]; !ok && !balance.IsZero() {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the balances of the pools aren't escrowed, assetID:%s", assetID))
+		}
+	}
+	return nil
+}
 
-	return gs.Params.Validate()
+func isNonNegativeInt(amount sdkmath.Int) bool {
+	return !amount.IsNil() && !amount.IsNegative()
 }
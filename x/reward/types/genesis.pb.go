@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,7 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the reward module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params              Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Pools               []RewardPool                `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	DelegatorRewards    []DelegatorRewardGenesis    `protobuf:"bytes,3,rep,name=delegatorRewards,proto3" json:"delegatorRewards"`
+	OperatorCommissions []OperatorCommissionGenesis `protobuf:"bytes,4,rep,name=operatorCommissions,proto3" json:"operatorCommissions"`
+	// escrowedRewards are the rewards escrowed from the deposits of the funders for
+	// each asset, they should be equal to the sum of the balances of the pools.
+	EscrowedRewards []EscrowedRewards `protobuf:"bytes,5,rep,name=escrowedRewards,proto3" json:"escrowedRewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,27 +76,246 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPools() []RewardPool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegatorRewards() []DelegatorRewardGenesis {
+	if m != nil {
+		return m.DelegatorRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorCommissions() []OperatorCommissionGenesis {
+	if m != nil {
+		return m.OperatorCommissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowedRewards() []EscrowedRewards {
+	if m != nil {
+		return m.EscrowedRewards
+	}
+	return nil
+}
+
+// DelegatorRewardGenesis is the reward state of a delegator in the reward pool
+// of the operator for the asset.
+type DelegatorRewardGenesis struct {
+	StakerID     string          `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	AssetID      string          `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	OperatorAddr string          `protobuf:"bytes,3,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Reward       DelegatorReward `protobuf:"bytes,4,opt,name=reward,proto3" json:"reward"`
+}
+
+func (m *DelegatorRewardGenesis) Reset()         { *m = DelegatorRewardGenesis{} }
+func (m *DelegatorRewardGenesis) String() string { return proto.CompactTextString(m) }
+func (*DelegatorRewardGenesis) ProtoMessage()    {}
+func (*DelegatorRewardGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ccfae99a1ae8f42, []int{1}
+}
+func (m *DelegatorRewardGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorRewardGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorRewardGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorRewardGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorRewardGenesis.Merge(m, src)
+}
+func (m *DelegatorRewardGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorRewardGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorRewardGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorRewardGenesis proto.InternalMessageInfo
+
+func (m *DelegatorRewardGenesis) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *DelegatorRewardGenesis) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *DelegatorRewardGenesis) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *DelegatorRewardGenesis) GetReward() DelegatorReward {
+	if m != nil {
+		return m.Reward
+	}
+	return DelegatorReward{}
+}
+
+// OperatorCommissionGenesis is the commission of an operator.
+type OperatorCommissionGenesis struct {
+	OperatorAddr string             `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Commission   OperatorCommission `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission"`
+}
+
+func (m *OperatorCommissionGenesis) Reset()         { *m = OperatorCommissionGenesis{} }
+func (m *OperatorCommissionGenesis) String() string { return proto.CompactTextString(m) }
+func (*OperatorCommissionGenesis) ProtoMessage()    {}
+func (*OperatorCommissionGenesis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ccfae99a1ae8f42, []int{2}
+}
+func (m *OperatorCommissionGenesis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorCommissionGenesis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorCommissionGenesis.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorCommissionGenesis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorCommissionGenesis.Merge(m, src)
+}
+func (m *OperatorCommissionGenesis) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorCommissionGenesis) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorCommissionGenesis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorCommissionGenesis proto.InternalMessageInfo
+
+func (m *OperatorCommissionGenesis) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *OperatorCommissionGenesis) GetCommission() OperatorCommission {
+	if m != nil {
+		return m.Commission
+	}
+	return OperatorCommission{}
+}
+
+// EscrowedRewards is the amount of an asset escrowed for the reward pools.
+type EscrowedRewards struct {
+	AssetID string                                 `protobuf:"bytes,1,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EscrowedRewards) Reset()         { *m = EscrowedRewards{} }
+func (m *EscrowedRewards) String() string { return proto.CompactTextString(m) }
+func (*EscrowedRewards) ProtoMessage()    {}
+func (*EscrowedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ccfae99a1ae8f42, []int{3}
+}
+func (m *EscrowedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowedRewards.Merge(m, src)
+}
+func (m *EscrowedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowedRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowedRewards proto.InternalMessageInfo
+
+func (m *EscrowedRewards) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "exocore.reward.GenesisState")
+	proto.RegisterType((*DelegatorRewardGenesis)(nil), "exocore.reward.DelegatorRewardGenesis")
+	proto.RegisterType((*OperatorCommissionGenesis)(nil), "exocore.reward.OperatorCommissionGenesis")
+	proto.RegisterType((*EscrowedRewards)(nil), "exocore.reward.EscrowedRewards")
 }
 
 func init() { proto.RegisterFile("exocore/reward/genesis.proto", fileDescriptor_4ccfae99a1ae8f42) }
 
 var fileDescriptor_4ccfae99a1ae8f42 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x34, 0x9a, 0x19,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x23, 0x94, 0x5c, 0xb8, 0x78, 0xdc, 0x21, 0x66, 0x06, 0x97,
-	0x24, 0x96, 0xa4, 0x0a, 0x99, 0x70, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d,
-	0xc4, 0xf4, 0x50, 0xed, 0xd0, 0x0b, 0x00, 0xcb, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04,
-	0x55, 0xeb, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31,
-	0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xae, 0x10, 0x93, 0xfc, 0x52, 0x4b,
-	0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x61, 0xce, 0xaa, 0x80, 0x39, 0xac, 0xa4, 0xb2, 0x20, 0xb5, 0x38,
-	0x89, 0x0d, 0xec, 0x30, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x1d, 0xec, 0xf9, 0xfb,
-	0x00, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x4d, 0x1a, 0xe0, 0x52, 0x51, 0x74, 0x54, 0x95, 0x6b, 0x90, 0x13, 0x79, 0xa8,
+	0xca, 0x10, 0x07, 0x05, 0xc4, 0x54, 0x06, 0x42, 0x2a, 0x08, 0x03, 0xad, 0x5c, 0x06, 0xc4, 0x82,
+	0x5c, 0xfb, 0x64, 0xac, 0xc4, 0x7e, 0xd6, 0xdd, 0x55, 0x29, 0x23, 0x2b, 0x13, 0xdf, 0x81, 0xaf,
+	0xd0, 0x0f, 0x51, 0x89, 0xa5, 0xea, 0x84, 0x18, 0x2a, 0x94, 0x7c, 0x11, 0xe4, 0xbb, 0x73, 0x15,
+	0x5f, 0x82, 0x10, 0x93, 0x7d, 0xfe, 0xff, 0xdf, 0x4f, 0xff, 0xf7, 0xce, 0x0f, 0x3f, 0xa4, 0x67,
+	0x10, 0x02, 0xa3, 0x3d, 0x46, 0xa7, 0x01, 0x8b, 0x7a, 0x31, 0xcd, 0x28, 0x4f, 0xb8, 0x97, 0x33,
+	0x10, 0x40, 0xee, 0x6a, 0xd5, 0x53, 0xaa, 0xbd, 0x15, 0x43, 0x0c, 0x52, 0xea, 0x15, 0x6f, 0xca,
+	0x65, 0xef, 0x84, 0xc0, 0x53, 0xe0, 0x1f, 0x95, 0xa0, 0x0e, 0x5a, 0x7a, 0x60, 0xe0, 0xf3, 0x80,
+	0x05, 0x69, 0x29, 0xda, 0x86, 0x28, 0x3e, 0xe7, 0x54, 0x6b, 0xee, 0xd7, 0x3a, 0xde, 0x78, 0xa5,
+	0xb2, 0x1c, 0x8b, 0x40, 0x50, 0xf2, 0x14, 0x37, 0x55, 0xb1, 0x85, 0x3a, 0x68, 0xaf, 0xd5, 0xdf,
+	0xf6, 0xaa, 0xd9, 0xbc, 0x23, 0xa9, 0x0e, 0x1a, 0x17, 0xd7, 0xed, 0x9a, 0xaf, 0xbd, 0xe4, 0x19,
+	0x5e, 0xcf, 0x01, 0x26, 0xdc, 0x5a, 0xeb, 0xd4, 0xf7, 0x5a, 0x7d, 0xdb, 0x2c, 0xf2, 0xe5, 0xe3,
+	0x08, 0x60, 0xa2, 0x0b, 0x95, 0x9d, 0xbc, 0xc7, 0xf7, 0x22, 0x3a, 0xa1, 0x71, 0x20, 0x80, 0x29,
+	0x0f, 0xb7, 0xea, 0x12, 0xb1, 0x6b, 0x22, 0x86, 0x55, 0x9f, 0x0e, 0xad, 0x71, 0x4b, 0x14, 0x12,
+	0xe0, 0xfb, 0x90, 0x53, 0x56, 0x7c, 0x7a, 0x09, 0x69, 0x9a, 0x70, 0x9e, 0x40, 0xc6, 0xad, 0x86,
+	0x84, 0x3f, 0x32, 0xe1, 0x87, 0x4b, 0xd6, 0x2a, 0x7f, 0x15, 0x8b, 0x1c, 0xe2, 0x4d, 0xca, 0x43,
+	0x06, 0x53, 0x1a, 0x95, 0xd9, 0xd7, 0x25, 0xbe, 0x6d, 0xe2, 0x0f, 0xaa, 0x36, 0x0d, 0x35, 0xab,
+	0xdd, 0x1f, 0x08, 0x6f, 0xaf, 0x6e, 0x93, 0xd8, 0xf8, 0x36, 0x17, 0xc1, 0x98, 0xb2, 0xd1, 0x50,
+	0x5e, 0xcc, 0x1d, 0xff, 0xe6, 0x4c, 0x2c, 0x7c, 0x2b, 0xe0, 0x9c, 0x8a, 0xd1, 0xd0, 0x5a, 0x93,
+	0x52, 0x79, 0x24, 0xfb, 0x78, 0xa3, 0x0c, 0xfe, 0x22, 0x8a, 0x98, 0x55, 0x2f, 0xe4, 0x81, 0x75,
+	0x75, 0xde, 0xdd, 0xd2, 0xbf, 0x4f, 0xf1, 0x99, 0x72, 0x7e, 0x2c, 0x58, 0x92, 0xc5, 0x7e, 0xc5,
+	0x4d, 0x9e, 0xe3, 0xa6, 0xca, 0x6f, 0x35, 0x3a, 0x68, 0x55, 0x5b, 0x46, 0xd6, 0xf2, 0x9f, 0x50,
+	0xaa, 0xfb, 0x1d, 0xe1, 0x9d, 0xbf, 0xce, 0x75, 0x29, 0x1a, 0xfa, 0xaf, 0x68, 0xaf, 0x31, 0x0e,
+	0x6f, 0x90, 0xb2, 0xeb, 0x56, 0xdf, 0xfd, 0xf7, 0xa5, 0xea, 0x84, 0x0b, 0xb5, 0xee, 0x17, 0x84,
+	0x37, 0x8d, 0xeb, 0x59, 0x1c, 0x28, 0xaa, 0x0e, 0xf4, 0x1d, 0x6e, 0x06, 0x29, 0x9c, 0x66, 0x42,
+	0x4d, 0x7a, 0xb0, 0x5f, 0xf0, 0x7e, 0x5d, 0xb7, 0x77, 0xe3, 0x44, 0x7c, 0x3a, 0x3d, 0xf1, 0x42,
+	0x48, 0xf5, 0x62, 0xea, 0x47, 0x97, 0x47, 0x63, 0xbd, 0x70, 0xa3, 0x4c, 0x5c, 0x9d, 0x77, 0xb1,
+	0xee, 0x6e, 0x94, 0x09, 0x5f, 0xb3, 0x06, 0x6f, 0x2e, 0x66, 0x0e, 0xba, 0x9c, 0x39, 0xe8, 0xf7,
+	0xcc, 0x41, 0xdf, 0xe6, 0x4e, 0xed, 0x72, 0xee, 0xd4, 0x7e, 0xce, 0x9d, 0xda, 0x87, 0xc7, 0x0b,
+	0xdc, 0x03, 0xd5, 0xdd, 0x5b, 0x2a, 0xa6, 0xc0, 0xc6, 0xbd, 0x72, 0xa9, 0xcf, 0x2a, 0x6b, 0x7d,
+	0xd2, 0x94, 0x7b, 0xfd, 0xe4, 0xcf, 0x00, 0xbe, 0x21, 0xea, 0xba, 0x71, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +338,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowedRewards) > 0 {
+		for iNdEx := len(m.EscrowedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OperatorCommissions) > 0 {
+		for iNdEx := len(m.OperatorCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatorRewards) > 0 {
+		for iNdEx := len(m.DelegatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -124,6 +407,140 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorRewardGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorRewardGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorRewardGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorCommissionGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorCommissionGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorCommissionGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -143,6 +560,83 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewards) > 0 {
+		for _, e := range m.DelegatorRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorCommissions) > 0 {
+		for _, e := range m.OperatorCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowedRewards) > 0 {
+		for _, e := range m.EscrowedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorRewardGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Reward.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OperatorCommissionGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Commission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EscrowedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -214,6 +708,552 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, RewardPool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorRewards = append(m.DelegatorRewards, DelegatorRewardGenesis{})
+			if err := m.DelegatorRewards[len(m.DelegatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorCommissions = append(m.OperatorCommissions, OperatorCommissionGenesis{})
+			if err := m.OperatorCommissions[len(m.OperatorCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedRewards = append(m.EscrowedRewards, EscrowedRewards{})
+			if err := m.EscrowedRewards[len(m.EscrowedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorRewardGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorRewardGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorRewardGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorCommissionGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorCommissionGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorCommissionGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowedRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowedRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
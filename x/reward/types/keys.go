@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "reward"
@@ -20,10 +27,44 @@ func KeyPrefix(p string) []byte {
 
 const (
 	prefixParams = iota + 1
+	prefixRewardPool
+	prefixDelegatorReward
+	prefixOperatorCommission
+	prefixEscrowedRewards
 )
 
 var (
 	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixRewardPool key-value: operatorAddr+'/'+assetID->RewardPool
+	KeyPrefixRewardPool = []byte{prefixRewardPool}
+	// KeyPrefixDelegatorReward key-value: stakerID+'/'+assetID+'/'+operatorAddr->DelegatorReward
+	KeyPrefixDelegatorReward = []byte{prefixDelegatorReward}
+	// KeyPrefixOperatorCommission key-value: operatorAddr->OperatorCommission
+	KeyPrefixOperatorCommission = []byte{prefixOperatorCommission}
+	// KeyPrefixEscrowedRewards key-value: assetID->the rewards escrowed for the pools of the asset
+	KeyPrefixEscrowedRewards = []byte{prefixEscrowedRewards}
 
 	ParamsKey = []byte("Params")
 )
+
+func GetRewardPoolKey(operatorAddr, assetID string) []byte {
+	return []byte(strings.Join([]string{operatorAddr, assetID}, "/"))
+}
+
+// GetDelegatorRewardPrefix returns the prefix of the staker's rewards from all operators for the specified asset.
+func GetDelegatorRewardPrefix(stakerID, assetID string) []byte {
+	return []byte(strings.Join([]string{stakerID, assetID, ""}, "/"))
+}
+
+func GetDelegatorRewardKey(stakerID, assetID, operatorAddr string) []byte {
+	return append(GetDelegatorRewardPrefix(stakerID, assetID), []byte(operatorAddr)...)
+}
+
+// ParseDelegatorRewardKey returns the stakerID, assetID and operator address of the delegator reward key.
+func ParseDelegatorRewardKey(key []byte) (stakerID, assetID, operatorAddr string, err error) {
+	stringList := strings.Split(string(key), "/")
+	if len(stringList) != 3 {
+		return "", "", "", errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("invalid delegator reward key:%s", key))
+	}
+	return stringList[0], stringList[1], stringList[2], nil
+}
@@ -1,11 +1,19 @@
 package types
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetOperatorCommission{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+	_ sdk.Msg = &MsgClaimCommission{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -25,3 +33,84 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgSetOperatorCommission message.
+func (m *MsgSetOperatorCommission) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetOperatorCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if !ValidateCommissionRate(m.CommissionRate) {
+		return errorsmod.Wrap(ErrInvalidCommissionRate, fmt.Sprintf("the rate is:%s", m.CommissionRate))
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgSetOperatorCommission) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgFundRewardPool message.
+func (m *MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFundRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	if m.AvsAddress != "" && !common.IsHexAddress(m.AvsAddress) {
+		return errorsmod.Wrap(ErrInvalidEvmAddressFormat, fmt.Sprintf("the AVS address is:%s", m.AvsAddress))
+	}
+	// the rewards are escrowed from the deposit of the AVS owner if the AVS is specified, otherwise from the funder
+	if m.AvsAddress != "" && m.FunderAddr != "" {
+		return errorsmod.Wrap(ErrNotAllowedToFund, "the funder address should be empty if the AVS address is specified")
+	}
+	if m.AvsAddress == "" {
+		if _, err := sdk.AccAddressFromBech32(m.FunderAddr); err != nil {
+			return errorsmod.Wrap(err, "invalid funder address")
+		}
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidRewardAmount, fmt.Sprintf("the amount is:%s", m.Amount))
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgFundRewardPool) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgClaimCommission message.
+func (m *MsgClaimCommission) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.FromAddress)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgClaimCommission) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.FromAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidRewardAmount, fmt.Sprintf("the amount is:%s", m.Amount))
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgClaimCommission) GetSignBytes() []byte {
+	return nil
+}
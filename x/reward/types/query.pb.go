@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -113,34 +115,350 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryRewardPoolRequest is request type for the Query/RewardPool RPC method.
+type QueryRewardPoolRequest struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+}
+
+func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{} }
+func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolRequest) ProtoMessage()    {}
+func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{2}
+}
+func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
+
+func (m *QueryRewardPoolRequest) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *QueryRewardPoolRequest) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// QueryRewardPoolResponse is response type for the Query/RewardPool RPC method.
+type QueryRewardPoolResponse struct {
+	Pool RewardPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool"`
+}
+
+func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
+func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolResponse) ProtoMessage()    {}
+func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{3}
+}
+func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardPoolResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolResponse) GetPool() RewardPool {
+	if m != nil {
+		return m.Pool
+	}
+	return RewardPool{}
+}
+
+// QueryStakerRewardsRequest is request type for the Query/StakerRewards RPC method.
+type QueryStakerRewardsRequest struct {
+	StakerID string `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	AssetID  string `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+}
+
+func (m *QueryStakerRewardsRequest) Reset()         { *m = QueryStakerRewardsRequest{} }
+func (m *QueryStakerRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerRewardsRequest) ProtoMessage()    {}
+func (*QueryStakerRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{4}
+}
+func (m *QueryStakerRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerRewardsRequest.Merge(m, src)
+}
+func (m *QueryStakerRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryStakerRewardsRequest) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *QueryStakerRewardsRequest) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+// QueryStakerRewardsResponse is response type for the Query/StakerRewards RPC method.
+type QueryStakerRewardsResponse struct {
+	Rewards []StakerOperatorReward                 `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	Total   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+}
+
+func (m *QueryStakerRewardsResponse) Reset()         { *m = QueryStakerRewardsResponse{} }
+func (m *QueryStakerRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerRewardsResponse) ProtoMessage()    {}
+func (*QueryStakerRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{5}
+}
+func (m *QueryStakerRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerRewardsResponse.Merge(m, src)
+}
+func (m *QueryStakerRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryStakerRewardsResponse) GetRewards() []StakerOperatorReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QueryOperatorCommissionRequest is request type for the Query/OperatorCommission RPC method.
+type QueryOperatorCommissionRequest struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+}
+
+func (m *QueryOperatorCommissionRequest) Reset()         { *m = QueryOperatorCommissionRequest{} }
+func (m *QueryOperatorCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorCommissionRequest) ProtoMessage()    {}
+func (*QueryOperatorCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{6}
+}
+func (m *QueryOperatorCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorCommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorCommissionRequest.Merge(m, src)
+}
+func (m *QueryOperatorCommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorCommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorCommissionRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorCommissionRequest) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+// QueryOperatorCommissionResponse is response type for the Query/OperatorCommission RPC method.
+type QueryOperatorCommissionResponse struct {
+	Commission OperatorCommission `protobuf:"bytes,1,opt,name=commission,proto3" json:"commission"`
+}
+
+func (m *QueryOperatorCommissionResponse) Reset()         { *m = QueryOperatorCommissionResponse{} }
+func (m *QueryOperatorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorCommissionResponse) ProtoMessage()    {}
+func (*QueryOperatorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03321eafc9126bed, []int{7}
+}
+func (m *QueryOperatorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorCommissionResponse.Merge(m, src)
+}
+func (m *QueryOperatorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorCommissionResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorCommissionResponse) GetCommission() OperatorCommission {
+	if m != nil {
+		return m.Commission
+	}
+	return OperatorCommission{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.reward.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.reward.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "exocore.reward.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "exocore.reward.QueryRewardPoolResponse")
+	proto.RegisterType((*QueryStakerRewardsRequest)(nil), "exocore.reward.QueryStakerRewardsRequest")
+	proto.RegisterType((*QueryStakerRewardsResponse)(nil), "exocore.reward.QueryStakerRewardsResponse")
+	proto.RegisterType((*QueryOperatorCommissionRequest)(nil), "exocore.reward.QueryOperatorCommissionRequest")
+	proto.RegisterType((*QueryOperatorCommissionResponse)(nil), "exocore.reward.QueryOperatorCommissionResponse")
 }
 
 func init() { proto.RegisterFile("exocore/reward/query.proto", fileDescriptor_03321eafc9126bed) }
 
 var fileDescriptor_03321eafc9126bed = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0xc7, 0x57, 0xc1, 0x1d, 0x22, 0x78, 0x88, 0x63, 0x8c, 0x2a, 0x41, 0xea, 0x45, 0x3c, 0x24,
-	0x6e, 0x7e, 0x03, 0x61, 0x17, 0x0f, 0xa2, 0x1e, 0xbd, 0xa5, 0xf3, 0x11, 0x8b, 0xb6, 0x2f, 0x4d,
-	0x52, 0xb7, 0x79, 0xf4, 0x13, 0x08, 0x7e, 0x29, 0x8f, 0x03, 0x2f, 0x1e, 0xa5, 0xf5, 0x83, 0xc8,
-	0x9a, 0x28, 0x6c, 0x8a, 0xb7, 0xf2, 0xfe, 0xbf, 0xff, 0xaf, 0xef, 0x85, 0xc4, 0x30, 0xc3, 0x09,
-	0x1a, 0x10, 0x06, 0xa6, 0xd2, 0xdc, 0x88, 0xb2, 0x02, 0x33, 0xe7, 0xda, 0xa0, 0x43, 0xba, 0x1d,
-	0x32, 0xee, 0xb3, 0xb8, 0xa7, 0x50, 0x61, 0x1b, 0x89, 0xe5, 0x97, 0xa7, 0xe2, 0x3d, 0x85, 0xa8,
-	0xee, 0x41, 0x48, 0x9d, 0x09, 0x59, 0x14, 0xe8, 0xa4, 0xcb, 0xb0, 0xb0, 0x21, 0x3d, 0x9a, 0xa0,
-	0xcd, 0xd1, 0x8a, 0x54, 0x5a, 0xf0, 0x72, 0xf1, 0x30, 0x4c, 0xc1, 0xc9, 0xa1, 0xd0, 0x52, 0x65,
-	0x45, 0x0b, 0x07, 0x76, 0x77, 0x6d, 0x17, 0x2d, 0x8d, 0xcc, 0x83, 0x28, 0xe9, 0x11, 0x7a, 0xb9,
-	0xac, 0x5f, 0xb4, 0xc3, 0x2b, 0x28, 0x2b, 0xb0, 0x2e, 0x19, 0x93, 0x9d, 0x95, 0xa9, 0xd5, 0x58,
-	0x58, 0xa0, 0x9c, 0x74, 0x7d, 0x79, 0x10, 0xed, 0x47, 0x87, 0x5b, 0xa3, 0x3e, 0x5f, 0x3d, 0x85,
-	0x07, 0x3e, 0x50, 0xa3, 0x47, 0xb2, 0xd9, 0x6a, 0x68, 0x49, 0xba, 0x3e, 0xa2, 0xc9, 0x7a, 0xe5,
-	0xf7, 0xdf, 0xe3, 0x83, 0x7f, 0x19, 0xbf, 0x4b, 0xc2, 0x9e, 0xde, 0x3e, 0x5f, 0x36, 0x06, 0xb4,
-	0x2f, 0xfe, 0x3c, 0xef, 0xf4, 0xec, 0xb5, 0x66, 0xd1, 0xa2, 0x66, 0xd1, 0x47, 0xcd, 0xa2, 0xe7,
-	0x86, 0x75, 0x16, 0x0d, 0xeb, 0xbc, 0x37, 0xac, 0x73, 0x7d, 0xac, 0x32, 0x77, 0x5b, 0xa5, 0x7c,
-	0x82, 0xb9, 0x18, 0xfb, 0xee, 0x39, 0xb8, 0x29, 0x9a, 0xbb, 0x1f, 0xd5, 0xec, 0x5b, 0xe6, 0xe6,
-	0x1a, 0x6c, 0xda, 0x6d, 0xdf, 0xea, 0xe4, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x6d, 0xb6, 0xc4,
-	0xd6, 0x01, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0x14, 0x4b,
+	0x14, 0x9e, 0xe6, 0xf2, 0x73, 0xef, 0xe1, 0xde, 0xbb, 0x28, 0x09, 0x0e, 0xad, 0x69, 0x4c, 0x6b,
+	0x10, 0x49, 0xa6, 0x4b, 0x06, 0xd8, 0x28, 0x1b, 0x47, 0x48, 0x1c, 0x17, 0x02, 0xcd, 0xce, 0x85,
+	0xa4, 0x66, 0xa6, 0xd2, 0x76, 0x66, 0xa6, 0x4f, 0xd3, 0x55, 0x08, 0x84, 0xb0, 0xf1, 0x09, 0x4c,
+	0xf4, 0x0d, 0x7c, 0x02, 0x13, 0xe2, 0x33, 0xb0, 0x24, 0xb8, 0x31, 0x2e, 0x88, 0x01, 0x77, 0xbe,
+	0x84, 0x99, 0xaa, 0x6a, 0x98, 0xe9, 0x1e, 0x90, 0x85, 0x2b, 0xa8, 0x3a, 0xdf, 0xf9, 0xbe, 0x6f,
+	0xbe, 0x3a, 0xa7, 0xc1, 0xe6, 0x3b, 0x58, 0xc7, 0x84, 0xd3, 0x84, 0x6f, 0xb3, 0xa4, 0x41, 0x37,
+	0xb7, 0x78, 0xb2, 0xeb, 0xc5, 0x09, 0x4a, 0x24, 0xff, 0x9b, 0x9a, 0xa7, 0x6b, 0xf6, 0x58, 0x80,
+	0x01, 0xaa, 0x12, 0xed, 0xfc, 0xa7, 0x51, 0xf6, 0xed, 0x00, 0x31, 0x68, 0x71, 0xca, 0xe2, 0x90,
+	0xb2, 0x28, 0x42, 0xc9, 0x64, 0x88, 0x91, 0x30, 0xd5, 0x99, 0x3a, 0x8a, 0x36, 0x0a, 0x5a, 0x63,
+	0x82, 0x6b, 0x72, 0xfa, 0x66, 0xb6, 0xc6, 0x25, 0x9b, 0xa5, 0x31, 0x0b, 0xc2, 0x48, 0x81, 0x0d,
+	0xf6, 0x56, 0xc6, 0x4b, 0xcc, 0x12, 0xd6, 0x4e, 0x89, 0xb2, 0x46, 0xe5, 0x6e, 0xcc, 0xd3, 0xda,
+	0x84, 0x16, 0xd9, 0xd0, 0xde, 0xf4, 0x41, 0x97, 0xdc, 0x31, 0x20, 0x6b, 0x1d, 0xd5, 0x55, 0xc5,
+	0xe5, 0xf3, 0xcd, 0x2d, 0x2e, 0xa4, 0xbb, 0x0c, 0x37, 0x7a, 0x6e, 0x45, 0x8c, 0x91, 0xe0, 0xc4,
+	0x83, 0x61, 0xad, 0x59, 0xb4, 0xee, 0x58, 0xd3, 0xa3, 0xe5, 0x71, 0xaf, 0x37, 0x01, 0xcf, 0xe0,
+	0x0d, 0xca, 0x8d, 0x61, 0x5c, 0xd1, 0xf8, 0xaa, 0xba, 0x8a, 0xd8, 0x32, 0x02, 0x64, 0x11, 0xfe,
+	0xc5, 0x98, 0x27, 0x4c, 0x62, 0xf2, 0xa4, 0xd1, 0x48, 0x14, 0xdf, 0x3f, 0x95, 0xe2, 0xf1, 0x41,
+	0x69, 0xcc, 0xd8, 0xeb, 0x5c, 0x73, 0x21, 0xd6, 0x65, 0x12, 0x46, 0x81, 0xdf, 0x83, 0x26, 0x45,
+	0x18, 0x61, 0x42, 0x70, 0x59, 0x5d, 0x2a, 0x0e, 0x74, 0x1a, 0xfd, 0xf4, 0xe8, 0xae, 0xc0, 0xcd,
+	0x9c, 0xa2, 0x31, 0x3f, 0x0f, 0x83, 0x31, 0x62, 0xcb, 0x58, 0xb7, 0xb3, 0xd6, 0x2f, 0x3a, 0x2a,
+	0x83, 0x87, 0x27, 0x93, 0x05, 0x5f, 0xa1, 0xdd, 0x35, 0x98, 0x50, 0x84, 0xeb, 0x92, 0x35, 0x79,
+	0xa2, 0x41, 0x69, 0x4c, 0xc4, 0x86, 0xbf, 0x85, 0xba, 0xaf, 0x2e, 0xe9, 0x5f, 0xe0, 0x9f, 0x9f,
+	0xaf, 0xf0, 0xf8, 0xd9, 0x02, 0xbb, 0x1f, 0xa7, 0xf1, 0xb9, 0x04, 0x23, 0xda, 0x52, 0x27, 0xe5,
+	0xbf, 0xa6, 0x47, 0xcb, 0xf7, 0xb2, 0x56, 0x75, 0xdf, 0x8a, 0x49, 0x44, 0xf7, 0x1b, 0xd3, 0x69,
+	0x2b, 0xf1, 0x61, 0x48, 0xa2, 0x64, 0x2d, 0x2d, 0x5e, 0x59, 0xec, 0x54, 0xbf, 0x9d, 0x4c, 0x4e,
+	0x05, 0xa1, 0x7c, 0xbd, 0x55, 0xf3, 0xea, 0xd8, 0x36, 0x73, 0x60, 0xfe, 0x94, 0x44, 0xa3, 0x69,
+	0x66, 0xa6, 0x1a, 0xc9, 0xe3, 0x83, 0x12, 0x98, 0x77, 0xa8, 0x46, 0xd2, 0xd7, 0x54, 0xee, 0x2b,
+	0x70, 0x94, 0xef, 0x54, 0xf9, 0x29, 0xb6, 0xdb, 0xa1, 0x10, 0x21, 0x46, 0x7f, 0xe4, 0x59, 0xdd,
+	0x26, 0x4c, 0x5e, 0xca, 0x6f, 0xc2, 0x79, 0x06, 0x50, 0x3f, 0xbf, 0x35, 0x4f, 0xe9, 0x66, 0xf3,
+	0xc9, 0xf7, 0x9b, 0x74, 0xba, 0x7a, 0xcb, 0x3f, 0x07, 0x61, 0x48, 0xa9, 0x91, 0x4d, 0x18, 0xd6,
+	0x73, 0x4b, 0x72, 0x4c, 0xf9, 0xd5, 0xb0, 0xef, 0x5e, 0x89, 0xd1, 0x36, 0x5d, 0xe7, 0xed, 0x97,
+	0x1f, 0xef, 0x07, 0x8a, 0x64, 0x9c, 0xf6, 0x5d, 0x59, 0xf2, 0xc1, 0x02, 0xb8, 0x18, 0x38, 0x32,
+	0xd5, 0x97, 0x33, 0xb7, 0x35, 0xf6, 0xfd, 0xdf, 0xe2, 0x8c, 0xfe, 0x82, 0xd2, 0xa7, 0xa4, 0x94,
+	0xd3, 0x47, 0x6c, 0xd1, 0xbd, 0xee, 0xd4, 0xf7, 0xe9, 0x9e, 0x19, 0xcc, 0x7d, 0xf2, 0xd1, 0x82,
+	0xff, 0x7a, 0x86, 0x92, 0x3c, 0xe8, 0xab, 0xd8, 0x6f, 0x19, 0xec, 0x99, 0xeb, 0x40, 0x8d, 0xbf,
+	0xc7, 0xca, 0xdf, 0x02, 0x99, 0xcb, 0xfa, 0xd3, 0xeb, 0xb3, 0xa1, 0x4f, 0x82, 0xee, 0xa5, 0xeb,
+	0xd4, 0xed, 0xf2, 0x93, 0x05, 0x24, 0xff, 0xc4, 0xc4, 0xeb, 0xab, 0x7f, 0xe9, 0xac, 0xda, 0xf4,
+	0xda, 0x78, 0x63, 0xfa, 0x91, 0x32, 0x3d, 0x4f, 0xca, 0x59, 0xd3, 0x69, 0x9c, 0x1b, 0x17, 0xe3,
+	0x95, 0xc9, 0xb8, 0xf2, 0xfc, 0xf0, 0xd4, 0xb1, 0x8e, 0x4e, 0x1d, 0xeb, 0xfb, 0xa9, 0x63, 0xbd,
+	0x3b, 0x73, 0x0a, 0x47, 0x67, 0x4e, 0xe1, 0xeb, 0x99, 0x53, 0x78, 0xf9, 0xb0, 0x6b, 0x23, 0x97,
+	0x35, 0xef, 0x0b, 0x2e, 0xb7, 0x31, 0x69, 0x9e, 0xcb, 0xec, 0xf4, 0x7c, 0xd3, 0x6b, 0xc3, 0xea,
+	0xcb, 0x3d, 0xf7, 0x6b, 0x00, 0xd9, 0x2c, 0xf0, 0x87, 0x9b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +475,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RewardPool queries the reward pool of the operator for the specified asset.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// StakerRewards queries the claimable rewards of the staker for the specified asset.
+	StakerRewards(ctx context.Context, in *QueryStakerRewardsRequest, opts ...grpc.CallOption) (*QueryStakerRewardsResponse, error)
+	// OperatorCommission queries the commission rate of the operator.
+	OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +500,43 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakerRewards(ctx context.Context, in *QueryStakerRewardsRequest, opts ...grpc.CallOption) (*QueryStakerRewardsResponse, error) {
+	out := new(QueryStakerRewardsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Query/StakerRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorCommission(ctx context.Context, in *QueryOperatorCommissionRequest, opts ...grpc.CallOption) (*QueryOperatorCommissionResponse, error) {
+	out := new(QueryOperatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Query/OperatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RewardPool queries the reward pool of the operator for the specified asset.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// StakerRewards queries the claimable rewards of the staker for the specified asset.
+	StakerRewards(context.Context, *QueryStakerRewardsRequest) (*QueryStakerRewardsResponse, error)
+	// OperatorCommission queries the commission rate of the operator.
+	OperatorCommission(context.Context, *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +546,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) StakerRewards(ctx context.Context, req *QueryStakerRewardsRequest) (*QueryStakerRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakerRewards not implemented")
+}
+func (*UnimplementedQueryServer) OperatorCommission(ctx context.Context, req *QueryOperatorCommissionRequest) (*QueryOperatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorCommission not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +578,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakerRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakerRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Query/StakerRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakerRewards(ctx, req.(*QueryStakerRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorCommissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Query/OperatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorCommission(ctx, req.(*QueryOperatorCommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.reward.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +640,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "StakerRewards",
+			Handler:    _Query_StakerRewards_Handler,
+		},
+		{
+			MethodName: "OperatorCommission",
+			Handler:    _Query_OperatorCommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/reward/query.proto",
@@ -283,30 +715,247 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakerRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakerRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakerRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorCommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorCommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorCommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.Params != nil {
@@ -316,6 +965,92 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakerRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOperatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +1193,600 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, StakerOperatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
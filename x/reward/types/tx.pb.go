@@ -8,7 +8,9 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -127,36 +129,303 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetOperatorCommission sets the commission rate of the operator, the signer
+// should be a registered operator.
+type MsgSetOperatorCommission struct {
+	FromAddress    string                                 `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commissionRate"`
+}
+
+func (m *MsgSetOperatorCommission) Reset()         { *m = MsgSetOperatorCommission{} }
+func (m *MsgSetOperatorCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorCommission) ProtoMessage()    {}
+func (*MsgSetOperatorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{2}
+}
+func (m *MsgSetOperatorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOperatorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorCommission.Merge(m, src)
+}
+func (m *MsgSetOperatorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOperatorCommission proto.InternalMessageInfo
+
+type MsgSetOperatorCommissionResponse struct {
+}
+
+func (m *MsgSetOperatorCommissionResponse) Reset()         { *m = MsgSetOperatorCommissionResponse{} }
+func (m *MsgSetOperatorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOperatorCommissionResponse) ProtoMessage()    {}
+func (*MsgSetOperatorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{3}
+}
+func (m *MsgSetOperatorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOperatorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOperatorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOperatorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOperatorCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetOperatorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOperatorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOperatorCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOperatorCommissionResponse proto.InternalMessageInfo
+
+// MsgFundRewardPool funds the reward pool of an operator for a specified asset.
+// The signer should be the owner of an AVS that the operator has opted into and
+// that accepts the asset, or the authority of the module if avsAddress is empty.
+// The rewards are escrowed from the deposit of the asset on the client chain, the
+// deposit of the AVS owner is used if avsAddress isn't empty, otherwise the deposit
+// of funderAddr is used.
+type MsgFundRewardPool struct {
+	FromAddress  string                                 `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	AvsAddress   string                                 `protobuf:"bytes,2,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+	OperatorAddr string                                 `protobuf:"bytes,3,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string                                 `protobuf:"bytes,4,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// funderAddr is the address whose deposit escrows the rewards funded by the
+	// authority, it should be empty if avsAddress isn't empty.
+	FunderAddr string `protobuf:"bytes,6,opt,name=funderAddr,proto3" json:"funderAddr,omitempty"`
+}
+
+func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
+func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPool) ProtoMessage()    {}
+func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{4}
+}
+func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPool.Merge(m, src)
+}
+func (m *MsgFundRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPool proto.InternalMessageInfo
+
+type MsgFundRewardPoolResponse struct {
+}
+
+func (m *MsgFundRewardPoolResponse) Reset()         { *m = MsgFundRewardPoolResponse{} }
+func (m *MsgFundRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{5}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPoolResponse proto.InternalMessageInfo
+
+// MsgClaimCommission claims the commission of the operator, the claimed amount is
+// credited to the deposit of the operator's hex address on the client chain of the asset.
+type MsgClaimCommission struct {
+	FromAddress string                                 `protobuf:"bytes,1,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"`
+	AssetID     string                                 `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *MsgClaimCommission) Reset()         { *m = MsgClaimCommission{} }
+func (m *MsgClaimCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimCommission) ProtoMessage()    {}
+func (*MsgClaimCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{6}
+}
+func (m *MsgClaimCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimCommission.Merge(m, src)
+}
+func (m *MsgClaimCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimCommission proto.InternalMessageInfo
+
+type MsgClaimCommissionResponse struct {
+}
+
+func (m *MsgClaimCommissionResponse) Reset()         { *m = MsgClaimCommissionResponse{} }
+func (m *MsgClaimCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimCommissionResponse) ProtoMessage()    {}
+func (*MsgClaimCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd4863caedb1c8f, []int{7}
+}
+func (m *MsgClaimCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimCommissionResponse.Merge(m, src)
+}
+func (m *MsgClaimCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimCommissionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.reward.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.reward.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetOperatorCommission)(nil), "exocore.reward.MsgSetOperatorCommission")
+	proto.RegisterType((*MsgSetOperatorCommissionResponse)(nil), "exocore.reward.MsgSetOperatorCommissionResponse")
+	proto.RegisterType((*MsgFundRewardPool)(nil), "exocore.reward.MsgFundRewardPool")
+	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "exocore.reward.MsgFundRewardPoolResponse")
+	proto.RegisterType((*MsgClaimCommission)(nil), "exocore.reward.MsgClaimCommission")
+	proto.RegisterType((*MsgClaimCommissionResponse)(nil), "exocore.reward.MsgClaimCommissionResponse")
 }
 
 func init() { proto.RegisterFile("exocore/reward/tx.proto", fileDescriptor_9cd4863caedb1c8f) }
 
 var fileDescriptor_9cd4863caedb1c8f = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0xd1, 0x2f, 0xa9, 0xd0, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4a, 0xe8, 0x41, 0x24, 0xa4, 0x24, 0xd3, 0xf3, 0xf3, 0xd3,
-	0x73, 0x52, 0xf5, 0xc1, 0xb2, 0x49, 0xa5, 0x69, 0xfa, 0x89, 0x79, 0x95, 0x10, 0xa5, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x4c, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0x8e, 0x87, 0x48, 0x40, 0x38, 0x50, 0x29, 0x71, 0x08, 0x4f, 0x3f, 0xb7, 0x38, 0x5d, 0xbf,
-	0xcc, 0x10, 0x44, 0x41, 0x25, 0xa4, 0xd1, 0x5c, 0x53, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xd5, 0xa5,
-	0xd4, 0xcf, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96,
-	0x11, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x9d, 0x63, 0x4a, 0x4a, 0x51,
-	0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x09, 0x17, 0x1b,
-	0xc4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x31, 0x3d, 0x54, 0xef, 0xea, 0x41, 0xcc,
-	0x77, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xd6, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d,
-	0x84, 0x29, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x0e, 0x0a, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e,
-	0x35, 0x8a, 0xe7, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0xe2, 0x41, 0x71, 0xaf, 0x3c, 0xba,
-	0x3d, 0x68, 0xfa, 0xa5, 0xd4, 0x09, 0x28, 0x80, 0x59, 0xe0, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3,
-	0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9,
-	0xb9, 0xfa, 0xae, 0x10, 0xc3, 0xfc, 0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0x61, 0xc1, 0x5b,
-	0x01, 0x8f, 0xee, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x00, 0x1b, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x2a, 0x46, 0x9e, 0xfc, 0x0d, 0x02, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0xb2, 0xb8, 0x86, 0x07, 0x59, 0x42, 0x83, 0xd2, 0x2d, 0xa6, 0xbb, 0xf6, 0xa0, 0x48,
+	0xc2, 0x16, 0xd1, 0xa0, 0xd9, 0xe0, 0xc1, 0x05, 0x4d, 0x30, 0x59, 0x25, 0x45, 0x13, 0xe3, 0x41,
+	0x33, 0x6c, 0x87, 0xa1, 0x81, 0x76, 0x36, 0x33, 0xb3, 0xfc, 0xb8, 0x7a, 0x32, 0x5e, 0xf0, 0xe6,
+	0x95, 0x3f, 0x81, 0x03, 0x7f, 0x04, 0x47, 0xc2, 0xc9, 0x78, 0x20, 0x06, 0x0e, 0xf8, 0x2f, 0x18,
+	0x2f, 0x66, 0xdb, 0xe9, 0xd2, 0x2d, 0x0b, 0xd5, 0xc8, 0x65, 0xdb, 0x79, 0xef, 0x9b, 0xef, 0x7d,
+	0xf3, 0xbd, 0xb7, 0x53, 0x18, 0xc1, 0x9b, 0xb4, 0x4e, 0x19, 0xb6, 0x18, 0xde, 0x40, 0xcc, 0xb1,
+	0xc4, 0x66, 0xb9, 0xc1, 0xa8, 0xa0, 0x6a, 0x5e, 0x26, 0xca, 0x61, 0x42, 0x2f, 0x10, 0x4a, 0xc9,
+	0x1a, 0xb6, 0x82, 0xec, 0x52, 0x73, 0xd9, 0x42, 0xfe, 0x56, 0x08, 0xd5, 0x87, 0x09, 0x25, 0x34,
+	0x78, 0xb5, 0x5a, 0x6f, 0x32, 0x5a, 0xa8, 0x53, 0xee, 0x51, 0xfe, 0x21, 0x4c, 0x84, 0x0b, 0x99,
+	0x1a, 0x09, 0x57, 0x96, 0xc7, 0x89, 0xb5, 0x7e, 0xbf, 0xf5, 0x90, 0x89, 0xd1, 0x84, 0x9a, 0x06,
+	0x62, 0xc8, 0x8b, 0x76, 0x0d, 0x21, 0xcf, 0xf5, 0xa9, 0x15, 0xfc, 0x86, 0x21, 0x73, 0x5b, 0x81,
+	0xc1, 0x1a, 0x27, 0x6f, 0x1a, 0x0e, 0x12, 0x78, 0x21, 0x00, 0xab, 0xd3, 0xd0, 0x87, 0x9a, 0x62,
+	0x85, 0x32, 0x57, 0x6c, 0x69, 0x4a, 0x49, 0x19, 0xeb, 0xab, 0x6a, 0x87, 0x7b, 0x13, 0xc3, 0x52,
+	0xc1, 0x53, 0xc7, 0x61, 0x98, 0xf3, 0x45, 0xc1, 0x5c, 0x9f, 0xd8, 0x67, 0x50, 0xf5, 0x21, 0xe4,
+	0xc2, 0x72, 0x5a, 0x4f, 0x49, 0x19, 0xeb, 0x9f, 0xba, 0x59, 0xee, 0x74, 0xa0, 0x1c, 0xf2, 0x57,
+	0x7b, 0xf7, 0x8f, 0x8a, 0x19, 0x5b, 0x62, 0x2b, 0xf9, 0x8f, 0xa7, 0xbb, 0xe3, 0x67, 0x2c, 0x66,
+	0x01, 0x46, 0x12, 0x82, 0x6c, 0xcc, 0x1b, 0xd4, 0xe7, 0xd8, 0xfc, 0xad, 0x80, 0x56, 0xe3, 0x64,
+	0x11, 0x8b, 0x57, 0x0d, 0xcc, 0x90, 0xa0, 0x6c, 0x96, 0x7a, 0x9e, 0xcb, 0xb9, 0x4b, 0x7d, 0xb5,
+	0x02, 0xfd, 0xcb, 0x8c, 0x7a, 0x52, 0x5d, 0xaa, 0xee, 0x38, 0x58, 0x75, 0x20, 0x5f, 0x6f, 0x33,
+	0xd9, 0x48, 0xe0, 0xe0, 0x04, 0x7d, 0xd5, 0x99, 0x96, 0xd2, 0xef, 0x47, 0xc5, 0x3b, 0xc4, 0x15,
+	0x2b, 0xcd, 0xa5, 0x72, 0x9d, 0x7a, 0xb2, 0x0f, 0xf2, 0x31, 0xc1, 0x9d, 0x55, 0x4b, 0x6c, 0x35,
+	0x30, 0x2f, 0xcf, 0xe1, 0xfa, 0xe1, 0xde, 0x04, 0xc8, 0x62, 0x73, 0xb8, 0x6e, 0x27, 0x38, 0x2b,
+	0x4f, 0x3e, 0xed, 0x14, 0x33, 0x3f, 0x77, 0x8a, 0x99, 0xd6, 0x89, 0xe3, 0xf5, 0x3f, 0x9f, 0xee,
+	0x8e, 0x97, 0xa2, 0xc6, 0x5d, 0x74, 0x40, 0xd3, 0x84, 0xd2, 0x45, 0xb9, 0xb6, 0x43, 0xdb, 0x59,
+	0x18, 0xaa, 0x71, 0xf2, 0xbc, 0xe9, 0x3b, 0x76, 0xe0, 0xf9, 0x02, 0xa5, 0x6b, 0xff, 0x65, 0x8d,
+	0x01, 0x80, 0xd6, 0x79, 0xb4, 0x35, 0xb0, 0xc5, 0x8e, 0x45, 0xd4, 0x19, 0x18, 0xa0, 0x52, 0x4f,
+	0x2b, 0xa4, 0x65, 0x53, 0xc8, 0x3b, 0xd0, 0xaa, 0x06, 0xd7, 0x11, 0xe7, 0x58, 0xcc, 0xcf, 0x69,
+	0xbd, 0x01, 0x75, 0xb4, 0x54, 0x5f, 0x43, 0x0e, 0x79, 0xb4, 0xe9, 0x0b, 0xed, 0xda, 0x3f, 0xb7,
+	0x62, 0xde, 0x17, 0xb1, 0x56, 0xcc, 0xfb, 0xc2, 0x96, 0x5c, 0xea, 0x63, 0x80, 0xe5, 0xa6, 0xef,
+	0xe0, 0x50, 0x6b, 0x2e, 0x45, 0x6b, 0x0c, 0x5b, 0x99, 0xbe, 0xac, 0x79, 0x85, 0x58, 0xf3, 0x3a,
+	0xbd, 0x37, 0x47, 0xa1, 0x70, 0x2e, 0xd8, 0x6e, 0xd7, 0x2f, 0x05, 0xd4, 0x1a, 0x27, 0xb3, 0x6b,
+	0xc8, 0xf5, 0xae, 0x68, 0x94, 0x63, 0x8e, 0xf6, 0x5c, 0xe4, 0x68, 0xf6, 0xea, 0x1c, 0xad, 0x3c,
+	0xba, 0xcc, 0x17, 0x3d, 0xe6, 0x4b, 0xe2, 0x90, 0xe6, 0x2d, 0xd0, 0xcf, 0x47, 0x23, 0x67, 0xa6,
+	0xbe, 0x66, 0x21, 0x5b, 0xe3, 0x44, 0x7d, 0x0b, 0x03, 0x1d, 0x77, 0x53, 0x31, 0x79, 0xa7, 0x24,
+	0xee, 0x0a, 0xfd, 0x6e, 0x0a, 0x20, 0xaa, 0xa0, 0x72, 0xb8, 0xd1, 0xfd, 0x22, 0x19, 0xeb, 0xc2,
+	0xd0, 0x15, 0xa9, 0x4f, 0xfe, 0x2d, 0xb2, 0x5d, 0xf4, 0x3d, 0xe4, 0x13, 0xff, 0xcd, 0xdb, 0x5d,
+	0x38, 0x3a, 0x21, 0xfa, 0xbd, 0x54, 0x48, 0x9b, 0x1f, 0xc1, 0x60, 0x72, 0x98, 0xcc, 0x2e, 0xbb,
+	0x13, 0x18, 0x7d, 0x3c, 0x1d, 0x13, 0x95, 0xa8, 0xbe, 0xd8, 0x3f, 0x36, 0x94, 0x83, 0x63, 0x43,
+	0xf9, 0x71, 0x6c, 0x28, 0x5f, 0x4e, 0x8c, 0xcc, 0xc1, 0x89, 0x91, 0xf9, 0x76, 0x62, 0x64, 0xde,
+	0x4d, 0xc6, 0x06, 0xe9, 0x59, 0xc8, 0xf7, 0x12, 0x8b, 0x0d, 0xca, 0x56, 0xad, 0x68, 0x0e, 0x36,
+	0xdb, 0x5f, 0xc9, 0xd6, 0x58, 0x2d, 0xe5, 0x82, 0x8f, 0xd0, 0x83, 0x3f, 0x03, 0x00, 0x93, 0x91,
+	0x67, 0x36, 0x44, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +441,12 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetOperatorCommission sets the commission rate of the operator
+	SetOperatorCommission(ctx context.Context, in *MsgSetOperatorCommission, opts ...grpc.CallOption) (*MsgSetOperatorCommissionResponse, error)
+	// FundRewardPool funds the reward pool of an operator for a specified asset
+	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
+	// ClaimCommission claims the commission of the operator
+	ClaimCommission(ctx context.Context, in *MsgClaimCommission, opts ...grpc.CallOption) (*MsgClaimCommissionResponse, error)
 }
 
 type msgClient struct {
@@ -191,9 +466,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetOperatorCommission(ctx context.Context, in *MsgSetOperatorCommission, opts ...grpc.CallOption) (*MsgSetOperatorCommissionResponse, error) {
+	out := new(MsgSetOperatorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Msg/SetOperatorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
+	out := new(MsgFundRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Msg/FundRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimCommission(ctx context.Context, in *MsgClaimCommission, opts ...grpc.CallOption) (*MsgClaimCommissionResponse, error) {
+	out := new(MsgClaimCommissionResponse)
+	err := c.cc.Invoke(ctx, "/exocore.reward.Msg/ClaimCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetOperatorCommission sets the commission rate of the operator
+	SetOperatorCommission(context.Context, *MsgSetOperatorCommission) (*MsgSetOperatorCommissionResponse, error)
+	// FundRewardPool funds the reward pool of an operator for a specified asset
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	// ClaimCommission claims the commission of the operator
+	ClaimCommission(context.Context, *MsgClaimCommission) (*MsgClaimCommissionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +511,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetOperatorCommission(ctx context.Context, req *MsgSetOperatorCommission) (*MsgSetOperatorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOperatorCommission not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) ClaimCommission(ctx context.Context, req *MsgClaimCommission) (*MsgClaimCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimCommission not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +543,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetOperatorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOperatorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOperatorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Msg/SetOperatorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOperatorCommission(ctx, req.(*MsgSetOperatorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Msg/FundRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.reward.Msg/ClaimCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimCommission(ctx, req.(*MsgClaimCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.reward.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +605,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetOperatorCommission",
+			Handler:    _Msg_SetOperatorCommission_Handler,
+		},
+		{
+			MethodName: "FundRewardPool",
+			Handler:    _Msg_FundRewardPool_Handler,
+		},
+		{
+			MethodName: "ClaimCommission",
+			Handler:    _Msg_ClaimCommission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/reward/tx.proto",
@@ -302,43 +685,359 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetOperatorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetOperatorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetOperatorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOperatorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOperatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunderAddr) > 0 {
+		i -= len(m.FunderAddr)
+		copy(dAtA[i:], m.FunderAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddr)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetOperatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetOperatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FunderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -508,6 +1207,664 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetOperatorCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOperatorCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOperatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOperatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOperatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOperatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,41 +1,33 @@
 package types
 
 import (
-	"fmt"
-
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Constructor of the Pool
-func NewPool(name string) Pool {
-	return Pool{
-		Name:    name,
-		Rewards: []Pool_Reward{},
+// NewRewardPool returns an empty reward pool of the operator for the specified asset
+func NewRewardPool(operatorAddr, assetID string) RewardPool {
+	return RewardPool{
+		OperatorAddr:      operatorAddr,
+		AssetID:           assetID,
+		Balance:           sdkmath.NewInt(0),
+		TotalShares:       sdkmath.NewInt(0),
+		RewardPerShare:    sdk.ZeroDec(),
+		CommissionRewards: sdkmath.NewInt(0),
 	}
 }
 
-// ValidateBasic for the pool
-func (m Pool) ValidateBasic() error {
-	validatorDic := make(map[string]bool)
-	for _, reward := range m.Rewards {
-		validatorAddr := reward.Validator.String()
-		if validatorDic[validatorAddr] {
-			return fmt.Errorf("duplicate validator %s in pool %s", validatorAddr, m.Name)
-		}
-
-		if err := sdk.VerifyAddressFormat(reward.Validator); err != nil {
-			return fmt.Errorf("invalid validator %s in pool %s", validatorAddr, m.Name)
-		}
-
-		if reward.Coins == nil || reward.Coins.Empty() {
-			return fmt.Errorf("empty rewards found for validator %s in pool %s", validatorAddr, m.Name)
-		}
-
-		if err := reward.Coins.Validate(); err != nil {
-			return fmt.Errorf("invalid rewards for validator %s found in pool %s", validatorAddr, m.Name)
-		}
-		validatorDic[validatorAddr] = true
+// NewDelegatorReward returns the reward state of a new delegator, its reward index starts from the current
+// index of the pool.
+func NewDelegatorReward(rewardPerShare sdk.Dec) DelegatorReward {
+	return DelegatorReward{
+		Shares:         sdkmath.NewInt(0),
+		RewardPerShare: rewardPerShare,
+		AccruedRewards: sdkmath.NewInt(0),
 	}
+}
 
-	return nil
+// ValidateCommissionRate checks if the commission rate is between 0 and 1
+func ValidateCommissionRate(rate sdk.Dec) bool {
+	return !rate.IsNil() && !rate.IsNegative() && rate.LTE(sdk.OneDec())
 }
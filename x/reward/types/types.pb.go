@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,23 +25,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type Pool struct {
-	Name    string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Rewards []Pool_Reward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+// RewardPool is the reward pool of an operator for a specified asset, the rewards
+// funded to the pool are shared by the operator and its delegators.
+type RewardPool struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	// balance is the amount that has been funded to the pool and hasn't been claimed.
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// totalShares is the sum of the shares of all delegators, the share of a
+	// delegator is its delegated amount that can be undelegated.
+	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"totalShares"`
+	// rewardPerShare is the cumulative reward index of a share.
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=rewardPerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewardPerShare"`
+	// commissionRewards is the commission of the operator that hasn't been claimed.
+	CommissionRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=commissionRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"commissionRewards"`
 }
 
-func (m *Pool) Reset()         { *m = Pool{} }
-func (m *Pool) String() string { return proto.CompactTextString(m) }
-func (*Pool) ProtoMessage()    {}
-func (*Pool) Descriptor() ([]byte, []int) {
+func (m *RewardPool) Reset()         { *m = RewardPool{} }
+func (m *RewardPool) String() string { return proto.CompactTextString(m) }
+func (*RewardPool) ProtoMessage()    {}
+func (*RewardPool) Descriptor() ([]byte, []int) {
 	return fileDescriptor_620cd6dbeff3c5e2, []int{0}
 }
-func (m *Pool) XXX_Unmarshal(b []byte) error {
+func (m *RewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RewardPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -51,35 +62,39 @@ func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Pool) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Pool.Merge(m, src)
+func (m *RewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPool.Merge(m, src)
 }
-func (m *Pool) XXX_Size() int {
+func (m *RewardPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *Pool) XXX_DiscardUnknown() {
-	xxx_messageInfo_Pool.DiscardUnknown(m)
+func (m *RewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Pool proto.InternalMessageInfo
+var xxx_messageInfo_RewardPool proto.InternalMessageInfo
 
-type Pool_Reward struct {
-	Validator github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator,omitempty"`
-	Coins     github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+// DelegatorReward is the reward state of a delegator in a reward pool.
+type DelegatorReward struct {
+	Shares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
+	// rewardPerShare is the reward index of the pool when the reward is settled last time.
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rewardPerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rewardPerShare"`
+	// accruedRewards is the settled reward that hasn't been claimed.
+	AccruedRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=accruedRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"accruedRewards"`
 }
 
-func (m *Pool_Reward) Reset()         { *m = Pool_Reward{} }
-func (m *Pool_Reward) String() string { return proto.CompactTextString(m) }
-func (*Pool_Reward) ProtoMessage()    {}
-func (*Pool_Reward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_620cd6dbeff3c5e2, []int{0, 0}
+func (m *DelegatorReward) Reset()         { *m = DelegatorReward{} }
+func (m *DelegatorReward) String() string { return proto.CompactTextString(m) }
+func (*DelegatorReward) ProtoMessage()    {}
+func (*DelegatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_620cd6dbeff3c5e2, []int{1}
 }
-func (m *Pool_Reward) XXX_Unmarshal(b []byte) error {
+func (m *DelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Pool_Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Pool_Reward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegatorReward.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -89,50 +104,140 @@ func (m *Pool_Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Pool_Reward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Pool_Reward.Merge(m, src)
+func (m *DelegatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorReward.Merge(m, src)
 }
-func (m *Pool_Reward) XXX_Size() int {
+func (m *DelegatorReward) XXX_Size() int {
 	return m.Size()
 }
-func (m *Pool_Reward) XXX_DiscardUnknown() {
-	xxx_messageInfo_Pool_Reward.DiscardUnknown(m)
+func (m *DelegatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorReward.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Pool_Reward proto.InternalMessageInfo
+var xxx_messageInfo_DelegatorReward proto.InternalMessageInfo
+
+// OperatorCommission is the proportion of the funded rewards taken by the operator.
+type OperatorCommission struct {
+	Rate         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	UpdateHeight uint64                                 `protobuf:"varint,2,opt,name=updateHeight,proto3" json:"updateHeight,omitempty"`
+}
+
+func (m *OperatorCommission) Reset()         { *m = OperatorCommission{} }
+func (m *OperatorCommission) String() string { return proto.CompactTextString(m) }
+func (*OperatorCommission) ProtoMessage()    {}
+func (*OperatorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_620cd6dbeff3c5e2, []int{2}
+}
+func (m *OperatorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorCommission.Merge(m, src)
+}
+func (m *OperatorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorCommission proto.InternalMessageInfo
+
+// StakerOperatorReward is the claimable reward of a staker from an operator.
+type StakerOperatorReward struct {
+	OperatorAddr string                                 `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *StakerOperatorReward) Reset()         { *m = StakerOperatorReward{} }
+func (m *StakerOperatorReward) String() string { return proto.CompactTextString(m) }
+func (*StakerOperatorReward) ProtoMessage()    {}
+func (*StakerOperatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_620cd6dbeff3c5e2, []int{3}
+}
+func (m *StakerOperatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerOperatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerOperatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerOperatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerOperatorReward.Merge(m, src)
+}
+func (m *StakerOperatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerOperatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerOperatorReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerOperatorReward proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterType((*Pool)(nil), "exocore.reward.Pool")
-	proto.RegisterType((*Pool_Reward)(nil), "exocore.reward.Pool.Reward")
+	proto.RegisterType((*RewardPool)(nil), "exocore.reward.RewardPool")
+	proto.RegisterType((*DelegatorReward)(nil), "exocore.reward.DelegatorReward")
+	proto.RegisterType((*OperatorCommission)(nil), "exocore.reward.OperatorCommission")
+	proto.RegisterType((*StakerOperatorReward)(nil), "exocore.reward.StakerOperatorReward")
 }
 
 func init() { proto.RegisterFile("exocore/reward/types.proto", fileDescriptor_620cd6dbeff3c5e2) }
 
 var fileDescriptor_620cd6dbeff3c5e2 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x3d, 0x4e, 0xc3, 0x30,
-	0x14, 0x8e, 0x4b, 0x29, 0xaa, 0x41, 0x0c, 0x16, 0x43, 0x09, 0x92, 0x5b, 0x31, 0x75, 0xa9, 0xdd,
-	0xc2, 0xc8, 0x44, 0x10, 0x6b, 0x41, 0x19, 0x18, 0xd8, 0x9c, 0xc4, 0x2a, 0x51, 0x7f, 0x5e, 0x65,
-	0x9b, 0xb6, 0xdc, 0x82, 0x89, 0x43, 0x70, 0x06, 0x0e, 0xd0, 0xb1, 0x23, 0x53, 0x81, 0xe6, 0x16,
-	0x4c, 0xc8, 0x71, 0x22, 0xe8, 0xc6, 0xe4, 0x27, 0x7d, 0xfe, 0xfe, 0xde, 0xc3, 0xbe, 0x5c, 0x40,
-	0x0c, 0x4a, 0x72, 0x25, 0xe7, 0x42, 0x25, 0xdc, 0x3c, 0x4d, 0xa5, 0x66, 0x53, 0x05, 0x06, 0xc8,
-	0x61, 0x81, 0x31, 0x87, 0xf9, 0x47, 0x03, 0x18, 0x40, 0x0e, 0x71, 0x3b, 0xb9, 0x5f, 0x3e, 0x8d,
-	0x41, 0x8f, 0x41, 0xf3, 0x48, 0x68, 0xc9, 0x67, 0xbd, 0x48, 0x1a, 0xd1, 0xe3, 0x31, 0xa4, 0x13,
-	0x87, 0x9f, 0xbe, 0x54, 0x70, 0xf5, 0x16, 0x60, 0x44, 0x08, 0xae, 0x4e, 0xc4, 0x58, 0x36, 0x50,
-	0x0b, 0xb5, 0xeb, 0x61, 0x3e, 0x93, 0x0b, 0xbc, 0xe7, 0xc4, 0x75, 0xa3, 0xd2, 0xda, 0x69, 0xef,
-	0x9f, 0x9d, 0xb0, 0x6d, 0x53, 0x66, 0xa9, 0x2c, 0xcc, 0xe7, 0xa0, 0xba, 0x5c, 0x37, 0xbd, 0xb0,
-	0x64, 0xf8, 0x6f, 0x08, 0xd7, 0x1c, 0x42, 0x6e, 0x70, 0x7d, 0x26, 0x46, 0x69, 0x22, 0x0c, 0xa8,
-	0xdc, 0xe0, 0x20, 0xe8, 0x7d, 0xaf, 0x9b, 0x9d, 0x41, 0x6a, 0x1e, 0x1e, 0x23, 0x16, 0xc3, 0x98,
-	0x17, 0x31, 0xdd, 0xd3, 0xd1, 0xc9, 0xb0, 0xe8, 0x7a, 0x27, 0x46, 0x97, 0x49, 0xa2, 0xa4, 0xd6,
-	0xe1, 0xaf, 0x06, 0x11, 0x78, 0xd7, 0x76, 0x28, 0x63, 0x1d, 0x33, 0xc7, 0x63, 0xb6, 0x25, 0x2b,
-	0x5a, 0xb2, 0x2b, 0x48, 0x27, 0x41, 0xd7, 0x86, 0x7a, 0xfd, 0x68, 0xb6, 0xff, 0xe1, 0x65, 0x09,
-	0x3a, 0x74, 0xca, 0x41, 0x7f, 0xf9, 0x45, 0xbd, 0xe5, 0x86, 0xa2, 0xd5, 0x86, 0xa2, 0xcf, 0x0d,
-	0x45, 0xcf, 0x19, 0xf5, 0x56, 0x19, 0xf5, 0xde, 0x33, 0xea, 0xdd, 0x77, 0xff, 0xc8, 0x5d, 0xbb,
-	0x95, 0xf4, 0xa5, 0x99, 0x83, 0x1a, 0xf2, 0xf2, 0x64, 0x8b, 0xad, 0xa3, 0x45, 0xb5, 0x7c, 0xdf,
-	0xe7, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x85, 0xb2, 0x49, 0x53, 0xd3, 0x01, 0x00, 0x00,
-}
-
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0x86, 0x7d, 0x8e, 0xb9, 0x88, 0x21, 0x32, 0x62, 0xe5, 0x62, 0x71, 0x71, 0x41, 0x57, 0x20,
+	0x1a, 0xdb, 0x48, 0xb4, 0x69, 0x08, 0x46, 0xc2, 0x4d, 0xb0, 0xce, 0x11, 0x05, 0x05, 0x68, 0xbd,
+	0x37, 0x3a, 0x1f, 0xf6, 0xdd, 0x58, 0xbb, 0x6b, 0x25, 0xbc, 0x02, 0x15, 0xcf, 0x41, 0x4b, 0x1e,
+	0xc2, 0x65, 0x94, 0x0a, 0x51, 0x44, 0x60, 0xf7, 0x3c, 0x03, 0xf2, 0xed, 0x1e, 0xb2, 0x83, 0x28,
+	0x10, 0x47, 0x65, 0xef, 0xcc, 0xce, 0x37, 0x33, 0xff, 0xec, 0x0d, 0xb4, 0xf1, 0x9c, 0x24, 0x29,
+	0xec, 0x29, 0x3c, 0x13, 0x2a, 0xee, 0x99, 0xf7, 0x73, 0xd4, 0xdd, 0xb9, 0x22, 0x43, 0xac, 0xe9,
+	0x7c, 0x5d, 0xeb, 0x6b, 0xb7, 0x12, 0x4a, 0xa8, 0x70, 0xf5, 0x36, 0xff, 0xec, 0xad, 0xf6, 0x7d,
+	0x49, 0x3a, 0x23, 0xfd, 0xd6, 0x3a, 0xec, 0xc1, 0xba, 0xc2, 0x1f, 0x7b, 0x00, 0x51, 0x11, 0x3b,
+	0x24, 0x9a, 0xb1, 0x23, 0x38, 0xa0, 0x39, 0x2a, 0x61, 0x48, 0x3d, 0x8d, 0x63, 0xc5, 0xbd, 0x07,
+	0xde, 0xa3, 0xdb, 0xc7, 0xfc, 0xea, 0xa2, 0xd3, 0x72, 0x61, 0x1b, 0x33, 0x6a, 0x3d, 0x32, 0x2a,
+	0xcd, 0x93, 0x68, 0xe7, 0x36, 0xe3, 0xb0, 0x2f, 0xb4, 0x46, 0x33, 0xe8, 0xf3, 0xfa, 0x26, 0x30,
+	0x2a, 0x8f, 0xec, 0x15, 0xec, 0x8f, 0xc5, 0x4c, 0xe4, 0x12, 0xf9, 0x5e, 0x81, 0x3c, 0x5a, 0x5e,
+	0x1f, 0xd6, 0xbe, 0x5e, 0x1f, 0x3e, 0x4c, 0x52, 0x33, 0x59, 0x8c, 0xbb, 0x92, 0x32, 0x57, 0x98,
+	0xfb, 0xe9, 0xe8, 0x78, 0xea, 0x5a, 0x1d, 0xe4, 0xe6, 0xea, 0xa2, 0x03, 0xae, 0x80, 0x41, 0x6e,
+	0xa2, 0x12, 0xc6, 0xde, 0xc0, 0x1d, 0x43, 0x46, 0xcc, 0x46, 0x13, 0xa1, 0x50, 0xf3, 0x46, 0x05,
+	0xec, 0x6d, 0x20, 0x8b, 0xa1, 0x69, 0x95, 0x1d, 0xa2, 0x2a, 0x4c, 0xfc, 0xd6, 0x5f, 0xa7, 0xe8,
+	0xa3, 0xdc, 0x4a, 0xd1, 0x47, 0x19, 0xdd, 0x60, 0xb2, 0x77, 0x70, 0x4f, 0x52, 0x96, 0xa5, 0x5a,
+	0xa7, 0x94, 0xdb, 0x69, 0x68, 0xee, 0x57, 0xd0, 0xcb, 0xef, 0xd8, 0xf0, 0x73, 0x1d, 0xee, 0xf6,
+	0x71, 0x86, 0xc9, 0x66, 0x6a, 0xd6, 0xc8, 0x4e, 0xc1, 0xd7, 0x56, 0x40, 0xaf, 0x82, 0xa4, 0xbe,
+	0xfe, 0x93, 0x76, 0xf5, 0xff, 0xa0, 0x5d, 0x0c, 0x4d, 0x21, 0xa5, 0x5a, 0x60, 0x5c, 0x0a, 0x57,
+	0xc5, 0x03, 0xbb, 0xc1, 0x0c, 0x3f, 0x78, 0xc0, 0x5e, 0xba, 0xa7, 0xfe, 0xec, 0x97, 0xa6, 0x6c,
+	0x08, 0x0d, 0x25, 0x0c, 0x72, 0xaf, 0x82, 0xc6, 0x0a, 0x12, 0x0b, 0xe1, 0x60, 0x31, 0x8f, 0x85,
+	0xc1, 0x17, 0x98, 0x26, 0x13, 0x53, 0x48, 0xd6, 0x88, 0x76, 0x6c, 0xe1, 0x27, 0x0f, 0x5a, 0x23,
+	0x23, 0xa6, 0xa8, 0xca, 0x92, 0xdc, 0x1c, 0xff, 0xed, 0xeb, 0x3d, 0x05, 0x5f, 0x64, 0xb4, 0xc8,
+	0x0d, 0xaf, 0x57, 0xa0, 0xa0, 0x63, 0x1d, 0x9f, 0x2c, 0xbf, 0x07, 0xb5, 0xe5, 0x2a, 0xf0, 0x2e,
+	0x57, 0x81, 0xf7, 0x6d, 0x15, 0x78, 0x1f, 0xd7, 0x41, 0xed, 0x72, 0x1d, 0xd4, 0xbe, 0xac, 0x83,
+	0xda, 0xeb, 0xc7, 0x5b, 0xec, 0xe7, 0x76, 0x95, 0x9d, 0xa0, 0x39, 0x23, 0x35, 0xed, 0x95, 0x5b,
+	0xef, 0x7c, 0x67, 0xef, 0x8d, 0xfd, 0x62, 0x6f, 0x3d, 0xf9, 0x39, 0x00, 0x7e, 0xd1, 0x27, 0xe6,
+	0x16, 0x05, 0x00, 0x00,
+}
+
+func (m *RewardPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -142,41 +247,74 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardPool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.CommissionRewards.Size()
+		i -= size
+		if _, err := m.CommissionRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RewardPerShare.Size()
+		i -= size
+		if _, err := m.RewardPerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool_Reward) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -186,34 +324,121 @@ func (m *Pool_Reward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool_Reward) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorReward) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool_Reward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size := m.AccruedRewards.Size()
+		i -= size
+		if _, err := m.AccruedRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RewardPerShare.Size()
+		i -= size
+		if _, err := m.RewardPerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdateHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpdateHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerOperatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+	return dAtA[:n], nil
+}
+
+func (m *StakerOperatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerOperatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OperatorAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -231,41 +456,72 @@ func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *Pool) Size() (n int) {
+func (m *RewardPool) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.OperatorAddr)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RewardPerShare.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CommissionRewards.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.RewardPerShare.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AccruedRewards.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *OperatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.UpdateHeight != 0 {
+		n += 1 + sovTypes(uint64(m.UpdateHeight))
 	}
 	return n
 }
 
-func (m *Pool_Reward) Size() (n int) {
+func (m *StakerOperatorReward) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Validator)
+	l = len(m.OperatorAddr)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -275,7 +531,257 @@ func sovTypes(x uint64) (n int) {
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *RewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -298,15 +804,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -334,13 +840,49 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -350,23 +892,23 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, Pool_Reward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccruedRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -391,7 +933,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool_Reward) Unmarshal(dAtA []byte) error {
+func (m *OperatorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -414,17 +956,17 @@ func (m *Pool_Reward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -434,31 +976,132 @@ func (m *Pool_Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Validator == nil {
-				m.Validator = []byte{}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateHeight", wireType)
+			}
+			m.UpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerOperatorReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerOperatorReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerOperatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -468,23 +1111,23 @@ func (m *Pool_Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20,6 +20,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryOperatorSlashRecords())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryOperatorSlashRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records <operatorAddr>",
+		Short: "shows the slash records of the operator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OperatorSlashRecords(cmd.Context(), &types.QueryOperatorSlashRecordsRequest{
+				OperatorAddr: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slash-records")
+
+	return cmd
+}
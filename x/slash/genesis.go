@@ -0,0 +1,57 @@
+package exoslash
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ExocoreNetwork/exocore/x/slash/keeper"
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis imports the params, the frozen statuses of the operators, the slash histories and the slash records.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if !isEmptyParams(data.Params) {
+		if err := k.SetParams(ctx, &data.Params); err != nil {
+			panic(err)
+		}
+	}
+	for _, status := range data.FrozenStatuses {
+		if err := k.SetFrozenStatus(ctx, status.OperatorAddr, status.Frozen); err != nil {
+			panic(err)
+		}
+	}
+	for i := range data.SlashHistories {
+		if err := k.SetOperatorAssetSlashHistory(ctx, &data.SlashHistories[i]); err != nil {
+			panic(err)
+		}
+	}
+	for i := range data.SlashRecords {
+		k.ImportSlashRecord(ctx, &data.SlashRecords[i])
+	}
+	k.SetLastSlashRecordID(ctx, data.LastSlashRecordID)
+}
+
+// ExportGenesis exports the params and the slash states
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		if !errorsmod.IsOf(err, types.ErrNoParamsKey) {
+			panic(err)
+		}
+		params = &types.Params{}
+	}
+	histories, err := k.GetAllOperatorAssetSlashHistories(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Params:            *params,
+		FrozenStatuses:    k.GetAllFrozenStatuses(ctx),
+		SlashHistories:    histories,
+		SlashRecords:      k.GetAllSlashRecords(ctx),
+		LastSlashRecordID: k.GetLastSlashRecordID(ctx),
+	}
+}
+
+func isEmptyParams(params types.Params) bool {
+	return params.ExoCoreLzAppAddress == "" && params.ExoCoreLzAppEventTopic == ""
+}
@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"strings"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	rtypes "github.com/ExocoreNetwork/exocore/x/slash/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +23,7 @@ import (
 
 type SlashParams struct {
 	ClientChainLzID           uint64
+	LzNonce                   uint64
 	Action                    types.CrossChainOpType
 	AssetsAddress             []byte
 	OperatorAddress           sdk.AccAddress
@@ -32,14 +34,6 @@ type SlashParams struct {
 	Proof                     []byte
 }
 
-// nolint: unused // This is to be implemented.
-type OperatorFrozenStatus struct {
-	// nolint: unused // This is to be implemented.
-	operatorAddress sdk.AccAddress
-	// nolint: unused // This is to be implemented.
-	status bool
-}
-
 func (k Keeper) getParamsFromEventLog(ctx sdk.Context, log *ethtypes.Log) (*SlashParams, error) {
 	// check if action is deposit
 	var action types.CrossChainOpType
@@ -113,13 +107,6 @@ func (k Keeper) getParamsFromEventLog(ctx sdk.Context, log *ethtypes.Log) (*Slas
 	}, nil
 }
 
-func getStakeIDAndAssetID(params *SlashParams) (stakeID string, assetID string) {
-	clientChainLzIDStr := hexutil.EncodeUint64(params.ClientChainLzID)
-	stakeID = strings.Join([]string{hexutil.Encode(params.StakerAddress), clientChainLzIDStr}, "_")
-	assetID = strings.Join([]string{hexutil.Encode(params.AssetsAddress), clientChainLzIDStr}, "_")
-	return
-}
-
 func (k Keeper) FilterCrossChainEventLogs(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) ([]*ethtypes.Log, error) {
 	params, err := k.GetParams(ctx)
 	if err != nil {
@@ -175,48 +162,266 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethty
 // 	panic("implement me")
 // }
 
+// Slash slashes the operator's specified asset by the proportion on behalf of the AVS identified by the middleware
+// contract address, the operator should have opted into the AVS at the slash height. The amounts delegated to the
+// operator by all stakers and the pending undelegations from the operator are reduced pro-rata immediately, so the
+// undelegations requested before the slash, including the ones in the same block, can't escape it. The slashed
+// assets are moved to the configured receiver or burned, and a slash record is stored for every slash.
 func (k Keeper) Slash(ctx sdk.Context, event *SlashParams) error {
-	// TODO the stakes are frozen for the impacted middleware, and deposits and withdrawals are disabled as well. All pending deposits and withdrawals for the current epoch will be invalidated.
-	//	_ = k.SetFrozenStatus(ctx, string(event.OperatorAddress), true)
-
-	// check event parameter then execute slash operation
-	if event.OpAmount.IsNegative() {
-		return errorsmod.Wrap(rtypes.ErrSlashAmountIsNegative, fmt.Sprintf("the amount is:%s", event.OpAmount))
+	if event.Proportion.IsNil() || !event.Proportion.IsPositive() || event.Proportion.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(rtypes.ErrInvalidSlashProportion, fmt.Sprintf("the proportion is:%v", event.Proportion))
 	}
-	stakeID, assetID := getStakeIDAndAssetID(event)
+	_, assetID := types.GetStakeIDAndAssetID(event.ClientChainLzID, nil, event.AssetsAddress)
 	// check is asset exist
 	if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
 		return errorsmod.Wrap(rtypes.ErrSlashAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
 	}
-
-	changeAmount := types.StakerSingleAssetOrChangeInfo{
-		TotalDepositAmountOrWantChangeValue: event.OpAmount.Neg(),
-		CanWithdrawAmountOrWantChangeValue:  event.OpAmount.Neg(),
+	if !k.delegationKeeper.IsOperator(ctx, event.OperatorAddress) {
+		return errorsmod.Wrap(rtypes.ErrSlashOperatorNotExist, fmt.Sprintf("the operator is:%s", event.OperatorAddress))
 	}
-	err := k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
+	avsInfo, err := k.avsKeeper.GetAVSInfo(ctx, hexutil.Encode(event.MiddlewareContractAddress))
 	if err != nil {
+		return errorsmod.Wrap(rtypes.ErrSlashAVSNotExist, fmt.Sprintf("the AVS address is:%s", hexutil.Encode(event.MiddlewareContractAddress)))
+	}
+	if !k.avsKeeper.IsOptedIn(ctx, event.OperatorAddress.String(), avsInfo.MiddlewareContractAddress) {
+		return errorsmod.Wrap(rtypes.ErrOperatorNotOptedIn, fmt.Sprintf("operator:%s,AVS:%s,height:%d", event.OperatorAddress, avsInfo.MiddlewareContractAddress, ctx.BlockHeight()))
+	}
+
+	// slash the delegated amounts and the pending undelegations of every staker
+	operatorAddr := event.OperatorAddress.String()
+	totalSlashedAmount := sdkmath.NewInt(0)
+	err = k.delegationKeeper.IterateOperatorAssetDelegations(ctx, operatorAddr, assetID, func(stakerID string, amounts *delegationtype.DelegationAmounts) error {
+		stakerSlashedAmount := event.Proportion.MulInt(amounts.CanUndelegationAmount).TruncateInt()
+		if stakerSlashedAmount.IsPositive() {
+			if err := k.slashStakerDelegation(ctx, stakerID, assetID, operatorAddr, stakerSlashedAmount, false); err != nil {
+				return err
+			}
+		}
+		slashedWaitAmount, err := k.slashPendingUndelegations(ctx, stakerID, assetID, operatorAddr, amounts.WaitUndelegationAmount, event.Proportion)
+		if err != nil {
+			return err
+		}
+		stakerSlashedAmount = stakerSlashedAmount.Add(slashedWaitAmount)
+		if stakerSlashedAmount.IsZero() {
+			return nil
+		}
+		err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.StakerSingleAssetOrChangeInfo{
+			TotalDepositAmountOrWantChangeValue:     stakerSlashedAmount.Neg(),
+			WaitUndelegationAmountOrWantChangeValue: slashedWaitAmount.Neg(),
+		})
+		if err != nil {
+			return err
+		}
+		totalSlashedAmount = totalSlashedAmount.Add(stakerSlashedAmount)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if totalSlashedAmount.IsPositive() {
+		if err = k.TransferSlashedAssets(ctx, assetID, totalSlashedAmount); err != nil {
+			return err
+		}
+	}
+
+	// record the proportion as the slash history of the operator's asset
+	if err = k.RecordOperatorAssetSlash(ctx, event.OperatorAddress, assetID, event.Proportion); err != nil {
 		return err
 	}
-	if err = k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, event.OpAmount.Neg()); err != nil {
+	receiver, err := k.getSlashedAssetsReceiver(ctx, assetID)
+	if err != nil {
 		return err
 	}
+	k.SetSlashRecord(ctx, &rtypes.SlashRecord{
+		OperatorAddr:  operatorAddr,
+		AssetID:       assetID,
+		AvsAddress:    avsInfo.MiddlewareContractAddress,
+		Proportion:    event.Proportion,
+		Height:        uint64(ctx.BlockHeight()),
+		SlashedAmount: totalSlashedAmount,
+		Receiver:      receiver,
+	})
 	return nil
 }
 
-// func (k Keeper) FreezeOperator(ctx sdk.Context, event *SlashParams) error {
-// 	k.SetFrozenStatus(ctx, string(event.OperatorAddress), true)
-// 	return nil
-// }
+// SlashStakerAsset removes the amount from the delegations of the staker's asset, it's used when the asset has been
+// slashed on the client chain, e.g. the beacon chain validator of the native ETH is slashed. The amounts delegated to
+// the operators are reduced in the order of the operator address, then the pending undelegations are reduced if the
+// delegated amounts aren't enough. The slashed amount is removed from the staker's deposit and the total staking
+// amount of the asset because it has been burned on the client chain.
+func (k Keeper) SlashStakerAsset(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	delegationInfo, err := k.delegationKeeper.GetDelegationInfo(ctx, stakerID, assetID)
+	if err != nil {
+		return err
+	}
+	operators := make([]string, 0, len(delegationInfo.DelegationInfos))
+	for operatorAddr := range delegationInfo.DelegationInfos {
+		operators = append(operators, operatorAddr)
+	}
+	sort.Strings(operators)
 
-//	func (k Keeper) ResetFrozenStatus(ctx sdk.Context, event *SlashParams) error {
-//		k.SetFrozenStatus(ctx, string(event.OperatorAddress), true)
-//		return nil
-//	}
-// func (k Keeper) IsOperatorFrozen(ctx sdk.Context, event *SlashParams) (bool, error) {
-// 	return k.GetFrozenStatus(ctx, string(event.OperatorAddress))
+	remainingAmount := amount
+	for _, operatorAddr := range operators {
+		if !remainingAmount.IsPositive() {
+			break
+		}
+		slashedAmount := sdkmath.MinInt(remainingAmount, delegationInfo.DelegationInfos[operatorAddr].CanUndelegationAmount)
+		if !slashedAmount.IsPositive() {
+			continue
+		}
+		if err = k.slashStakerDelegation(ctx, stakerID, assetID, operatorAddr, slashedAmount, false); err != nil {
+			return err
+		}
+		remainingAmount = remainingAmount.Sub(slashedAmount)
+	}
 
-// }
-// func (k Keeper) OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec {
-// 	//TODO
-// 	return sdkmath.LegacyNewDec(3)
-// }
+	// the pending undelegations are still slashable, their amounts are reduced before they are completed
+	slashedWaitAmount := sdkmath.NewInt(0)
+	if remainingAmount.IsPositive() {
+		records, err := k.delegationKeeper.GetStakerPendingUndelegations(ctx, stakerID, assetID)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if !remainingAmount.IsPositive() {
+				break
+			}
+			slashedAmount := sdkmath.MinInt(remainingAmount, record.Amount)
+			if !slashedAmount.IsPositive() {
+				continue
+			}
+			if err = k.slashStakerDelegation(ctx, stakerID, assetID, record.OperatorAddr, slashedAmount, true); err != nil {
+				return err
+			}
+			record.Amount = record.Amount.Sub(slashedAmount)
+			if _, err = k.delegationKeeper.SetSingleUndelegationRecord(ctx, record); err != nil {
+				return err
+			}
+			remainingAmount = remainingAmount.Sub(slashedAmount)
+			slashedWaitAmount = slashedWaitAmount.Add(slashedAmount)
+		}
+	}
+	if remainingAmount.IsPositive() {
+		return errorsmod.Wrap(rtypes.ErrSlashAmountExceeded, fmt.Sprintf("stakerID:%s,assetID:%s,amount:%s,remaining:%s", stakerID, assetID, amount, remainingAmount))
+	}
+
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakerID, assetID, types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue:     amount.Neg(),
+		WaitUndelegationAmountOrWantChangeValue: slashedWaitAmount.Neg(),
+	})
+	if err != nil {
+		return err
+	}
+	return k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, amount.Neg())
+}
+
+// slashPendingUndelegations reduces the staker's pending undelegations from the operator by the proportion, it
+// returns the total slashed amount. The waitAmount is the total pending amount of the staker's delegation, it's
+// used to skip the lookup of the records if nothing is being undelegated.
+func (k Keeper) slashPendingUndelegations(ctx sdk.Context, stakerID, assetID, operatorAddr string, waitAmount sdkmath.Int, proportion sdkmath.LegacyDec) (sdkmath.Int, error) {
+	slashedAmount := sdkmath.NewInt(0)
+	if !waitAmount.IsPositive() {
+		return slashedAmount, nil
+	}
+	records, err := k.delegationKeeper.GetStakerPendingUndelegations(ctx, stakerID, assetID)
+	if err != nil {
+		return slashedAmount, err
+	}
+	for _, record := range records {
+		if record.OperatorAddr != operatorAddr {
+			continue
+		}
+		recordSlashedAmount := proportion.MulInt(record.Amount).TruncateInt()
+		if !recordSlashedAmount.IsPositive() {
+			continue
+		}
+		if err = k.slashStakerDelegation(ctx, stakerID, assetID, operatorAddr, recordSlashedAmount, true); err != nil {
+			return slashedAmount, err
+		}
+		record.Amount = record.Amount.Sub(recordSlashedAmount)
+		if _, err = k.delegationKeeper.SetSingleUndelegationRecord(ctx, record); err != nil {
+			return slashedAmount, err
+		}
+		slashedAmount = slashedAmount.Add(recordSlashedAmount)
+	}
+	return slashedAmount, nil
+}
+
+// slashStakerDelegation removes the slashed amount from the staker's delegation to the operator and the operator's
+// asset state, the amount is removed from the pending undelegation amounts if isPending is true.
+func (k Keeper) slashStakerDelegation(ctx sdk.Context, stakerID, assetID, operatorAddr string, amount sdkmath.Int, isPending bool) error {
+	opAccAddr, err := sdk.AccAddressFromBech32(operatorAddr)
+	if err != nil {
+		return err
+	}
+	delegationAmounts := &delegationtype.DelegationAmounts{}
+	operatorChange := types.OperatorSingleAssetOrChangeInfo{
+		TotalAmountOrWantChangeValue: amount.Neg(),
+	}
+	if isPending {
+		delegationAmounts.WaitUndelegationAmount = amount.Neg()
+		operatorChange.WaitUndelegationAmountOrWantChangeValue = amount.Neg()
+	} else {
+		delegationAmounts.CanUndelegationAmount = amount.Neg()
+	}
+	err = k.delegationKeeper.UpdateDelegationState(ctx, stakerID, assetID, map[string]*delegationtype.DelegationAmounts{operatorAddr: delegationAmounts})
+	if err != nil {
+		return err
+	}
+	if err = k.delegationKeeper.UpdateStakerDelegationTotalAmount(ctx, stakerID, assetID, amount.Neg()); err != nil {
+		return err
+	}
+	return k.restakingStateKeeper.UpdateOperatorAssetState(ctx, opAccAddr, assetID, operatorChange)
+}
+
+// TransferSlashedAssets moves the slashed assets to the deposit of the receiver configured in the params,
+// so the receiver can withdraw them to the client chain. The slashed assets are burned if the receiver isn't set.
+func (k Keeper) TransferSlashedAssets(ctx sdk.Context, assetID string, amount sdkmath.Int) error {
+	receiver, err := k.getSlashedAssetsReceiver(ctx, assetID)
+	if err != nil {
+		return err
+	}
+	if receiver == "" {
+		return k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, amount.Neg())
+	}
+	return k.restakingStateKeeper.UpdateStakerAssetState(ctx, receiver, assetID, types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: amount,
+		CanWithdrawAmountOrWantChangeValue:  amount,
+	})
+}
+
+// getSlashedAssetsReceiver returns the stakerID of the receiver on the client chain of the asset
+func (k Keeper) getSlashedAssetsReceiver(ctx sdk.Context, assetID string) (string, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil || params.SlashedAssetsReceiver == "" {
+		// the slashed assets are burned if the params haven't been set
+		return "", nil
+	}
+	_, clientChainLzID, err := types.ParseID(assetID)
+	if err != nil {
+		return "", err
+	}
+	receiver, _ := types.GetStakeIDAndAssetIDFromStr(clientChainLzID, params.SlashedAssetsReceiver, "")
+	return receiver, nil
+}
+
+// FreezeOperator freezes the operator, the delegations to it and the completion of its undelegations will be blocked.
+// It's called by the governance through MsgFreezeOperator.
+func (k Keeper) FreezeOperator(ctx sdk.Context, opAddr sdk.AccAddress) error {
+	if !k.delegationKeeper.IsOperator(ctx, opAddr) {
+		return errorsmod.Wrap(rtypes.ErrSlashOperatorNotExist, fmt.Sprintf("the operator is:%s", opAddr))
+	}
+	return k.SetFrozenStatus(ctx, opAddr.String(), true)
+}
+
+// ResetFrozenStatus unfreezes the operator, the postponed undelegations are completed at their reset heights.
+func (k Keeper) ResetFrozenStatus(ctx sdk.Context, opAddr sdk.AccAddress) error {
+	if !k.IsOperatorFrozen(ctx, opAddr) {
+		return errorsmod.Wrap(rtypes.ErrOperatorNotFrozen, fmt.Sprintf("the operator is:%s", opAddr))
+	}
+	return k.SetFrozenStatus(ctx, opAddr.String(), false)
+}
@@ -1,22 +1,43 @@
 package keeper_test
 
 import (
+	"strings"
+
 	sdkmath "cosmossdk.io/math"
+	avstype "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationKeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	depositKeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	"github.com/ExocoreNetwork/exocore/x/slash/keeper"
 	slashtype "github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// registerAVS registers the AVS that accepts the asset and returns its middleware contract address
+func (suite *KeeperTestSuite) registerAVS(assetID string) common.Address {
+	avsAddress := common.HexToAddress("0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea")
+	err := suite.app.AVSKeeper.SetAVSInfo(suite.ctx, &avstype.AVSInfo{
+		Name:                      "avs",
+		MiddlewareContractAddress: avsAddress.String(),
+		AssetIDs:                  []string{assetID},
+		UnbondingPeriod:           1,
+	})
+	suite.NoError(err)
+	return avsAddress
+}
+
 func (suite *KeeperTestSuite) TestSlash() {
 	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
 	usdcAddress := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
 	event := &keeper.SlashParams{
 		ClientChainLzID: 101,
 		Action:          types.Slash,
-		StakerAddress:   suite.address[:],
-		OpAmount:        sdkmath.NewInt(90),
+		OperatorAddress: opAccAddr,
+		Proportion:      sdkmath.LegacyNewDecWithPrec(1, 1),
 	}
 
 	depositEvent := &depositKeeper.DepositParams{
@@ -28,43 +49,235 @@ func (suite *KeeperTestSuite) TestSlash() {
 
 	// deposit firstly
 	depositEvent.AssetsAddress = usdtAddress[:]
-	err := suite.app.DepositKeeper.Deposit(suite.ctx, depositEvent)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, depositEvent)
 	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(depositEvent.ClientChainLzID, depositEvent.StakerAddress, depositEvent.AssetsAddress)
 
-	// test the case that the slash  hasn't registered
+	// test the case that the slash asset hasn't registered
 	event.AssetsAddress = usdcAddress[:]
 	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
-	suite.ErrorContains(err, slashtype.ErrSlashAssetNotExist.Error())
+	suite.ErrorIs(err, slashtype.ErrSlashAssetNotExist)
 
-	assets, err := suite.app.StakingAssetsManageKeeper.GetAllStakingAssetsInfo(suite.ctx)
+	// test the case that the operator hasn't registered
+	event.AssetsAddress = usdtAddress[:]
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.ErrorIs(err, slashtype.ErrSlashOperatorNotExist)
+
+	// register the operator, then delegate and undelegate part of the asset
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
 	suite.NoError(err)
-	suite.app.Logger().Info("the assets is:", "assets", assets)
+	delegationEvent := &delegationKeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: depositEvent.ClientChainLzID,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(50),
+	}
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationEvent)
+	suite.NoError(err)
+	delegationEvent.OpAmount = sdkmath.NewInt(20)
+	delegationEvent.LzNonce = 1
+	err = suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationEvent)
+	suite.NoError(err)
+	undelegateHeight := suite.ctx.BlockHeight()
+
+	// the operator can only be slashed by the registered AVS that it has opted into
+	avsAddress := common.HexToAddress("0xceb69f6342ece283b2f5c9088ff249b5d0ae66ea")
+	event.MiddlewareContractAddress = avsAddress[:]
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.ErrorIs(err, slashtype.ErrSlashAVSNotExist)
+	suite.registerAVS(assetID)
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.ErrorIs(err, slashtype.ErrOperatorNotOptedIn)
+	suite.NoError(suite.app.AVSKeeper.OptIn(suite.ctx, opAccAddr, avsAddress.String()))
+
+	// test the invalid proportion
+	event.Proportion = sdkmath.LegacyNewDec(2)
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.ErrorIs(err, slashtype.ErrInvalidSlashProportion)
+
+	// test the normal case, the slashed assets are burned if the receiver isn't set
+	suite.ctx = suite.ctx.WithBlockHeight(undelegateHeight + 1)
+	event.Proportion = sdkmath.LegacyNewDecWithPrec(1, 1)
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.NoError(err)
+
+	// check state after slash
+	delegationAmounts, err := suite.app.DelegationKeeper.GetSingleDelegationInfo(suite.ctx, stakerID, assetID, opAccAddr.String())
+	suite.NoError(err)
+	suite.Equal(delegationtype.DelegationAmounts{
+		CanUndelegationAmount:  sdkmath.NewInt(27),
+		WaitUndelegationAmount: sdkmath.NewInt(18),
+	}, *delegationAmounts)
+	totalDelegationAmount, err := suite.app.DelegationKeeper.GetStakerDelegationTotalAmount(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(45), totalDelegationAmount)
 
-	stakerID, assetID := types.GetStakeIDAndAssetID(depositEvent.ClientChainLzID, depositEvent.StakerAddress, depositEvent.AssetsAddress)
 	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
 	suite.NoError(err)
 	suite.Equal(types.StakerSingleAssetOrChangeInfo{
-		TotalDepositAmountOrWantChangeValue:     depositEvent.OpAmount,
-		CanWithdrawAmountOrWantChangeValue:      depositEvent.OpAmount,
-		WaitUndelegationAmountOrWantChangeValue: sdkmath.NewInt(0),
+		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(95),
+		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(50),
+		WaitUndelegationAmountOrWantChangeValue: sdkmath.NewInt(18),
 	}, *info)
 
-	// test the normal case
-	event.AssetsAddress = usdtAddress[:]
-	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	operatorInfo, err := suite.app.StakingAssetsManageKeeper.GetOperatorSpecifiedAssetInfo(suite.ctx, opAccAddr, assetID)
 	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(45), operatorInfo.TotalAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(18), operatorInfo.WaitUndelegationAmountOrWantChangeValue)
+
+	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(95), assetInfo.StakingTotalAmount)
+
+	res, err := suite.app.ExoSlashKeeper.OperatorSlashRecords(suite.ctx, &slashtype.QueryOperatorSlashRecordsRequest{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(1, len(res.Records))
+	suite.Equal(uint64(1), res.Records[0].Id)
+	suite.Equal(assetID, res.Records[0].AssetID)
+	suite.Equal(strings.ToLower(avsAddress.String()), res.Records[0].AvsAddress)
+	suite.Equal(sdkmath.NewInt(5), res.Records[0].SlashedAmount)
+	suite.Equal("", res.Records[0].Receiver)
+
+	// the slashed undelegation returns the remaining amount when it's completed
+	suite.ctx = suite.ctx.WithBlockHeight(undelegateHeight + int64(delegationtype.CanUndelegationDelayHeight))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
 
-	// check state after slash
-	stakerID, assetID = types.GetStakeIDAndAssetID(event.ClientChainLzID, event.StakerAddress, event.AssetsAddress)
 	info, err = suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
 	suite.NoError(err)
 	suite.Equal(types.StakerSingleAssetOrChangeInfo{
-		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(10),
-		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(10),
+		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(95),
+		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(68),
 		WaitUndelegationAmountOrWantChangeValue: sdkmath.NewInt(0),
 	}, *info)
 
-	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	totalDelegationAmount, err = suite.app.DelegationKeeper.GetStakerDelegationTotalAmount(suite.ctx, stakerID, assetID)
 	suite.NoError(err)
-	suite.Equal(sdkmath.NewInt(10), assetInfo.StakingTotalAmount)
+	suite.Equal(sdkmath.NewInt(27), totalDelegationAmount)
+
+	operatorInfo, err = suite.app.StakingAssetsManageKeeper.GetOperatorSpecifiedAssetInfo(suite.ctx, opAccAddr, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(27), operatorInfo.TotalAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(0), operatorInfo.WaitUndelegationAmountOrWantChangeValue)
+
+	assetInfo, err = suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(95), assetInfo.StakingTotalAmount)
+
+	// the slashed assets are moved to the receiver if it's set
+	receiver := "0x3e108c058e8066da635321dc3018294ca82ddedf"
+	err = suite.app.ExoSlashKeeper.SetParams(suite.ctx, &slashtype.Params{
+		ExoCoreLzAppAddress:    "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+		SlashedAssetsReceiver:  receiver,
+	})
+	suite.NoError(err)
+	event.Proportion = sdkmath.LegacyNewDecWithPrec(5, 1)
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.NoError(err)
+
+	receiverID, _ := types.GetStakeIDAndAssetIDFromStr(depositEvent.ClientChainLzID, receiver, "")
+	info, err = suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, receiverID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(13), info.TotalDepositAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(13), info.CanWithdrawAmountOrWantChangeValue)
+
+	assetInfo, err = suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(95), assetInfo.StakingTotalAmount)
+
+	res, err = suite.app.ExoSlashKeeper.OperatorSlashRecords(suite.ctx, &slashtype.QueryOperatorSlashRecordsRequest{OperatorAddr: opAccAddr.String()})
+	suite.NoError(err)
+	suite.Equal(2, len(res.Records))
+	suite.Equal(receiverID, res.Records[1].Receiver)
+
+	// the operator can't be slashed by the AVS after it has opted out
+	suite.NoError(suite.app.AVSKeeper.OptOut(suite.ctx, opAccAddr, avsAddress.String()))
+	err = suite.app.ExoSlashKeeper.Slash(suite.ctx, event)
+	suite.ErrorIs(err, slashtype.ErrOperatorNotOptedIn)
+
+	// the receiver should be a hex address
+	err = suite.app.ExoSlashKeeper.SetParams(suite.ctx, &slashtype.Params{
+		ExoCoreLzAppAddress:    "0x3fC91A3afd70395Cd496C647d5a6CC9D4B2b7FAD",
+		ExoCoreLzAppEventTopic: "0xc6a377bfc4eb120024a8ac08eef205be16b817020812c73223e81d1bdb9708ec",
+		SlashedAssetsReceiver:  opAccAddr.String(),
+	})
+	suite.ErrorIs(err, slashtype.ErrInvalidReceiverAddress)
+}
+
+func (suite *KeeperTestSuite) TestSlashUndelegationInSameBlock() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, suite.address[:], usdtAddress[:])
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositKeeper.DepositParams{
+		ClientChainLzID: 101,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	delegationEvent := &delegationKeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: 101,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(100),
+	}
+	suite.NoError(suite.app.DelegationKeeper.DelegateTo(suite.ctx, delegationEvent))
+	avsAddress := suite.registerAVS(assetID)
+	suite.NoError(suite.app.AVSKeeper.OptIn(suite.ctx, opAccAddr, avsAddress.String()))
+	event := &keeper.SlashParams{
+		ClientChainLzID:           101,
+		Action:                    types.Slash,
+		AssetsAddress:             usdtAddress[:],
+		OperatorAddress:           opAccAddr,
+		MiddlewareContractAddress: avsAddress[:],
+		Proportion:                sdkmath.LegacyNewDecWithPrec(1, 1),
+	}
+
+	// the undelegation requested before the slash in the same block is slashed
+	delegationEvent.OpAmount = sdkmath.NewInt(20)
+	delegationEvent.LzNonce = 1
+	suite.NoError(suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationEvent))
+	suite.NoError(suite.app.ExoSlashKeeper.Slash(suite.ctx, event))
+	// the undelegation requested after the slash in the same block isn't slashed again
+	delegationEvent.LzNonce = 2
+	suite.NoError(suite.app.DelegationKeeper.UndelegateFrom(suite.ctx, delegationEvent))
+
+	records, err := suite.app.DelegationKeeper.GetStakerPendingUndelegations(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Len(records, 2)
+	delegationAmounts, err := suite.app.DelegationKeeper.GetSingleDelegationInfo(suite.ctx, stakerID, assetID, opAccAddr.String())
+	suite.NoError(err)
+	suite.Equal(delegationtype.DelegationAmounts{
+		CanUndelegationAmount:  sdkmath.NewInt(52),
+		WaitUndelegationAmount: sdkmath.NewInt(38),
+	}, *delegationAmounts)
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(records[0].CompleteBlockNumber))
+	suite.NoError(suite.app.DelegationKeeper.CompleteUndelegations(suite.ctx))
+	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue:     sdkmath.NewInt(90),
+		CanWithdrawAmountOrWantChangeValue:      sdkmath.NewInt(38),
+		WaitUndelegationAmountOrWantChangeValue: sdkmath.NewInt(0),
+	}, *info)
+	suite.NoError(suite.app.DelegationKeeper.CheckAssetStatesConsistency(suite.ctx))
 }
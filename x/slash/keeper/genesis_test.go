@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	exoslash "github.com/ExocoreNetwork/exocore/x/slash"
+	slashtype "github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesisRoundTrip() {
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	assetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	slashKeeper := suite.app.ExoSlashKeeper
+
+	suite.NoError(slashKeeper.SetFrozenStatus(suite.ctx, opAccAddr.String(), true))
+	ctx := suite.ctx.WithBlockHeight(10)
+	suite.NoError(slashKeeper.RecordOperatorAssetSlash(ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(1, 1)))
+	ctx = suite.ctx.WithBlockHeight(20)
+	suite.NoError(slashKeeper.RecordOperatorAssetSlash(ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(2, 1)))
+	for i := 0; i < 2; i++ {
+		slashKeeper.SetSlashRecord(ctx, &slashtype.SlashRecord{
+			OperatorAddr:  opAccAddr.String(),
+			AssetID:       assetID,
+			Proportion:    sdkmath.LegacyNewDecWithPrec(2, 1),
+			Height:        20,
+			SlashedAmount: sdkmath.NewInt(10),
+		})
+	}
+
+	genesis := exoslash.ExportGenesis(suite.ctx, slashKeeper)
+	suite.Len(genesis.FrozenStatuses, 1)
+	suite.Len(genesis.SlashHistories, 2)
+	suite.Len(genesis.SlashRecords, 2)
+	suite.Equal(uint64(2), genesis.LastSlashRecordID)
+	suite.NoError(genesis.Validate())
+
+	suite.SetupTest()
+	slashKeeper = suite.app.ExoSlashKeeper
+	exoslash.InitGenesis(suite.ctx, slashKeeper, *genesis)
+	suite.Equal(genesis, exoslash.ExportGenesis(suite.ctx, slashKeeper))
+	suite.True(slashKeeper.IsOperatorFrozen(suite.ctx, opAccAddr))
+	proportion := slashKeeper.OperatorAssetSlashedProportion(suite.ctx, opAccAddr, assetID, 0, 20)
+	// 1-(1-0.1)*(1-0.2) = 0.28
+	suite.True(sdkmath.LegacyNewDecWithPrec(28, 2).Equal(proportion))
+
+	// the slash ID continues from the imported one
+	record := &slashtype.SlashRecord{
+		OperatorAddr:  opAccAddr.String(),
+		AssetID:       assetID,
+		Proportion:    sdkmath.LegacyNewDecWithPrec(2, 1),
+		SlashedAmount: sdkmath.NewInt(10),
+	}
+	slashKeeper.SetSlashRecord(suite.ctx, record)
+	suite.Equal(uint64(3), record.Id)
+
+	// the slash records can't reference an ID beyond the last one
+	genesis.LastSlashRecordID = 1
+	suite.ErrorIs(genesis.Validate(), slashtype.ErrInvalidGenesisData)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/keeper"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,34 +19,56 @@ import (
 type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
+	// the address capable of executing the governance messages, it's the gov module account
+	authority string
 
 	// other keepers
 	restakingStateKeeper keeper.Keeper
+	delegationKeeper     types.DelegationKeeper
+	avsKeeper            types.AVSKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	restakingStateKeeper keeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+	avsKeeper types.AVSKeeper,
+	authority string,
 ) Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
 	return Keeper{
 		cdc:                  cdc,
 		storeKey:             storeKey,
+		authority:            authority,
 		restakingStateKeeper: restakingStateKeeper,
+		delegationKeeper:     delegationKeeper,
+		avsKeeper:            avsKeeper,
 	}
 }
 
+// GetAuthority returns the authority address of the module
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+var _ delegationtype.ISlashKeeper = Keeper{}
+
 type IEXOSlash interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 	OptIntoSlashing(ctx sdk.Context, event *SlashParams) error
 	Slash(ctx sdk.Context, event *SlashParams) error
-	FreezeOperator(ctx sdk.Context, event *SlashParams) error
-	ResetFrozenStatus(ctx sdk.Context, event *SlashParams) error
-	IsOperatorFrozen(ctx sdk.Context, event *SlashParams) (bool, error)
+	SlashStakerAsset(ctx sdk.Context, stakerID, assetID string, amount sdkmath.Int) error
+	FreezeOperator(ctx sdk.Context, opAddr sdk.AccAddress) error
+	ResetFrozenStatus(ctx sdk.Context, opAddr sdk.AccAddress) error
+	IsOperatorFrozen(ctx sdk.Context, opAddr sdk.AccAddress) bool
 	SetParams(ctx sdk.Context, params *types.Params) error
 	GetParams(ctx sdk.Context) (*types.Params, error)
 	OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec
@@ -2,21 +2,62 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// nolint: unused // Implementation of the msgServer (via proto) to be done.
 type msgServer struct {
 	Keeper
 }
 
+// NewMsgServerImpl returns an implementation of the MsgServer interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
 func (k Keeper) UpdateParams(ctx context.Context, params *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	c := sdk.UnwrapSDKContext(ctx)
+	if params.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, params.Authority))
+	}
 	err := k.SetParams(c, &params.Params)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// FreezeOperator the signer should be the authority of the module
+func (k msgServer) FreezeOperator(goCtx context.Context, req *types.MsgFreezeOperator) (*types.MsgFreezeOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, req.Authority))
+	}
+	opAccAddr, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", req.OperatorAddr))
+	}
+	if err := k.Keeper.FreezeOperator(ctx, opAccAddr); err != nil {
+		return nil, err
+	}
+	return &types.MsgFreezeOperatorResponse{}, nil
+}
+
+// UnfreezeOperator the signer should be the authority of the module
+func (k msgServer) UnfreezeOperator(goCtx context.Context, req *types.MsgUnfreezeOperator) (*types.MsgUnfreezeOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Authority != k.authority {
+		return nil, errorsmod.Wrap(types.ErrInvalidAuthority, fmt.Sprintf("expected:%s,got:%s", k.authority, req.Authority))
+	}
+	opAccAddr, err := sdk.AccAddressFromBech32(req.OperatorAddr)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, fmt.Sprintf("error occurred when parse acc address from Bech32,the addr is:%s", req.OperatorAddr))
+	}
+	if err := k.ResetFrozenStatus(ctx, opAccAddr); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnfreezeOperatorResponse{}, nil
 }
@@ -17,7 +17,11 @@ func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
 	if len(common.FromHex(params.ExoCoreLzAppEventTopic)) != common.HashLength {
 		return types.ErrInvalidLzUaTopicIDLength
 	}
+	if params.SlashedAssetsReceiver != "" && !common.IsHexAddress(params.SlashedAssetsReceiver) {
+		return types.ErrInvalidReceiverAddress
+	}
 	params.ExoCoreLzAppAddress = strings.ToLower(params.ExoCoreLzAppAddress)
+	params.SlashedAssetsReceiver = strings.ToLower(params.SlashedAssetsReceiver)
 	params.ExoCoreLzAppEventTopic = strings.ToLower(params.ExoCoreLzAppEventTopic)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
 	// key := common.HexToAddress(incentive.Contract)
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/slash/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OperatorSlashRecords(goCtx context.Context, req *types.QueryOperatorSlashRecordsRequest) (*types.QueryOperatorSlashRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixSlashRecord, []byte(req.OperatorAddr+"/")...))
+	records := make([]*types.SlashRecord, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryOperatorSlashRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/ExocoreNetwork/exocore/x/slash/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,15 +22,169 @@ func (k Keeper) SetFrozenStatus(ctx sdk.Context, operatorAddr string, status boo
 
 func (k Keeper) GetFrozenStatus(ctx sdk.Context, operatorAddr string) (bool, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
-	ifExist := store.Has(types.ParamsKey)
+	value := store.Get([]byte(operatorAddr))
+	if value == nil {
+		return false, errorsmod.Wrap(types.ErrNoOperatorStatusKey, fmt.Sprintf("the operator is:%s", operatorAddr))
+	}
+	return string(value) == "1", nil
+}
 
-	if !ifExist {
-		return false, types.ErrNoOperatorStatusKey
+// IsOperatorFrozen the operator isn't frozen if its status has never been set.
+func (k Keeper) IsOperatorFrozen(ctx sdk.Context, opAddr sdk.AccAddress) bool {
+	frozen, err := k.GetFrozenStatus(ctx, opAddr.String())
+	if err != nil {
+		return false
 	}
-	value := store.Get([]byte(operatorAddr))
-	if string(value) == "1" {
-		return true, nil
+	return frozen
+}
+
+// RecordOperatorAssetSlash records the proportion of the operator's asset slashed at the current height.
+// The slashes in the same block are merged, so the remaining proportion is the product of the remaining proportion of every slash.
+func (k Keeper) RecordOperatorAssetSlash(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, proportion sdkmath.LegacyDec) error {
+	if proportion.IsNil() || !proportion.IsPositive() || proportion.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(types.ErrInvalidSlashProportion, fmt.Sprintf("the proportion is:%v", proportion))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorSlashHistory)
+	key := types.GetSlashHistoryKey(opAddr.String(), assetID, uint64(ctx.BlockHeight()))
+	value := store.Get(key)
+	if value != nil {
+		var slashed sdkmath.LegacyDec
+		if err := slashed.Unmarshal(value); err != nil {
+			return err
+		}
+		remaining := sdkmath.LegacyOneDec().Sub(slashed).Mul(sdkmath.LegacyOneDec().Sub(proportion))
+		proportion = sdkmath.LegacyOneDec().Sub(remaining)
+	}
+	bz, err := proportion.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// OperatorAssetSlashedProportion returns the total proportion of the operator's asset slashed in the heights (startHeight,endHeight].
+func (k Keeper) OperatorAssetSlashedProportion(ctx sdk.Context, opAddr sdk.AccAddress, assetID string, startHeight, endHeight uint64) sdkmath.LegacyDec {
+	if endHeight <= startHeight {
+		return sdkmath.LegacyZeroDec()
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixOperatorSlashHistory, types.GetSlashHistoryPrefix(opAddr.String(), assetID)...))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(startHeight+1), sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(endHeight)))
+	defer iterator.Close()
+
+	remaining := sdkmath.LegacyOneDec()
+	for ; iterator.Valid(); iterator.Next() {
+		var slashed sdkmath.LegacyDec
+		if err := slashed.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		remaining = remaining.Mul(sdkmath.LegacyOneDec().Sub(slashed))
 	}
+	return sdkmath.LegacyOneDec().Sub(remaining)
+}
+
+// SetSlashRecord stores the slash record with a self-increasing slashID
+func (k Keeper) SetSlashRecord(ctx sdk.Context, record *types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	slashID := uint64(1)
+	if value := store.Get(types.KeySlashRecordID); value != nil {
+		slashID = sdk.BigEndianToUint64(value) + 1
+	}
+	store.Set(types.KeySlashRecordID, sdk.Uint64ToBigEndian(slashID))
+
+	record.Id = slashID
+	recordStore := prefix.NewStore(store, types.KeyPrefixSlashRecord)
+	recordStore.Set(types.GetSlashRecordKey(record.OperatorAddr, slashID), k.cdc.MustMarshal(record))
+}
+
+// GetAllFrozenStatuses returns the frozen statuses of all operators whose status has been set.
+func (k Keeper) GetAllFrozenStatuses(ctx sdk.Context) []types.OperatorFrozenStatus {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorInfo)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
 
-	return false, nil
+	ret := make([]types.OperatorFrozenStatus, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ret = append(ret, types.OperatorFrozenStatus{
+			OperatorAddr: string(iterator.Key()),
+			Frozen:       string(iterator.Value()) == "1",
+		})
+	}
+	return ret
+}
+
+// SetOperatorAssetSlashHistory sets the merged slashed proportion of the operator's asset at the height,
+// it's used to import the genesis state.
+func (k Keeper) SetOperatorAssetSlashHistory(ctx sdk.Context, history *types.OperatorAssetSlashHistory) error {
+	if history.Proportion.IsNil() || !history.Proportion.IsPositive() || history.Proportion.GT(sdkmath.LegacyOneDec()) {
+		return errorsmod.Wrap(types.ErrInvalidSlashProportion, fmt.Sprintf("the proportion is:%v", history.Proportion))
+	}
+	bz, err := history.Proportion.Marshal()
+	if err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorSlashHistory)
+	store.Set(types.GetSlashHistoryKey(history.OperatorAddr, history.AssetID, history.Height), bz)
+	return nil
+}
+
+// GetAllOperatorAssetSlashHistories returns the slash histories of all operators' assets.
+func (k Keeper) GetAllOperatorAssetSlashHistories(ctx sdk.Context) ([]types.OperatorAssetSlashHistory, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOperatorSlashHistory)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.OperatorAssetSlashHistory, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		operatorAddr, assetID, height, err := types.ParseSlashHistoryKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		var proportion sdkmath.LegacyDec
+		if err := proportion.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		ret = append(ret, types.OperatorAssetSlashHistory{
+			OperatorAddr: operatorAddr,
+			AssetID:      assetID,
+			Height:       height,
+			Proportion:   proportion,
+		})
+	}
+	return ret, nil
+}
+
+// GetLastSlashRecordID returns the last ID assigned to the slash records, it's zero if there isn't any record.
+func (k Keeper) GetLastSlashRecordID(ctx sdk.Context) uint64 {
+	value := ctx.KVStore(k.storeKey).Get(types.KeySlashRecordID)
+	if value == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(value)
+}
+
+// SetLastSlashRecordID sets the last ID assigned to the slash records, it's used to import the genesis state.
+func (k Keeper) SetLastSlashRecordID(ctx sdk.Context, slashID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeySlashRecordID, sdk.Uint64ToBigEndian(slashID))
+}
+
+// ImportSlashRecord stores the slash record with its own ID, it's used to import the genesis state.
+func (k Keeper) ImportSlashRecord(ctx sdk.Context, record *types.SlashRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSlashRecord)
+	store.Set(types.GetSlashRecordKey(record.OperatorAddr, record.Id), k.cdc.MustMarshal(record))
+}
+
+// GetAllSlashRecords returns the slash records of all operators.
+func (k Keeper) GetAllSlashRecords(ctx sdk.Context) []types.SlashRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSlashRecord)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	ret := make([]types.SlashRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		ret = append(ret, record)
+	}
+	return ret
 }
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	slashtype "github.com/ExocoreNetwork/exocore/x/slash/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestFrozenStatus() {
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+
+	_, err = suite.app.ExoSlashKeeper.GetFrozenStatus(suite.ctx, opAccAddr.String())
+	suite.ErrorIs(err, slashtype.ErrNoOperatorStatusKey)
+	suite.False(suite.app.ExoSlashKeeper.IsOperatorFrozen(suite.ctx, opAccAddr))
+
+	err = suite.app.ExoSlashKeeper.SetFrozenStatus(suite.ctx, opAccAddr.String(), true)
+	suite.NoError(err)
+	suite.True(suite.app.ExoSlashKeeper.IsOperatorFrozen(suite.ctx, opAccAddr))
+
+	err = suite.app.ExoSlashKeeper.SetFrozenStatus(suite.ctx, opAccAddr.String(), false)
+	suite.NoError(err)
+	suite.False(suite.app.ExoSlashKeeper.IsOperatorFrozen(suite.ctx, opAccAddr))
+}
+
+func (suite *KeeperTestSuite) TestOperatorAssetSlashedProportion() {
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	assetID := "0xdac17f958d2ee523a2206206994597c13d831ec7_0x65"
+	slashKeeper := suite.app.ExoSlashKeeper
+
+	err = slashKeeper.RecordOperatorAssetSlash(suite.ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(11, 1))
+	suite.ErrorIs(err, slashtype.ErrInvalidSlashProportion)
+
+	// two slashes in the same block are merged: 1-(1-0.1)*(1-0.5) = 0.55
+	ctx := suite.ctx.WithBlockHeight(10)
+	suite.NoError(slashKeeper.RecordOperatorAssetSlash(ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(1, 1)))
+	suite.NoError(slashKeeper.RecordOperatorAssetSlash(ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(5, 1)))
+	ctx = suite.ctx.WithBlockHeight(20)
+	suite.NoError(slashKeeper.RecordOperatorAssetSlash(ctx, opAccAddr, assetID, sdkmath.LegacyNewDecWithPrec(2, 1)))
+
+	testCases := []struct {
+		startHeight uint64
+		endHeight   uint64
+		expected    sdkmath.LegacyDec
+	}{
+		{0, 9, sdkmath.LegacyZeroDec()},
+		{9, 10, sdkmath.LegacyNewDecWithPrec(55, 2)},
+		{10, 19, sdkmath.LegacyZeroDec()},
+		{10, 20, sdkmath.LegacyNewDecWithPrec(2, 1)},
+		// 1-(1-0.55)*(1-0.2) = 0.64
+		{1, 100, sdkmath.LegacyNewDecWithPrec(64, 2)},
+		{20, 20, sdkmath.LegacyZeroDec()},
+	}
+	for _, tc := range testCases {
+		proportion := slashKeeper.OperatorAssetSlashedProportion(ctx, opAccAddr, assetID, tc.startHeight, tc.endHeight)
+		suite.True(tc.expected.Equal(proportion), "start:%d,end:%d,expected:%s,actual:%s", tc.startHeight, tc.endHeight, tc.expected, proportion)
+	}
+
+	// the slash history of other assets isn't affected
+	proportion := slashKeeper.OperatorAssetSlashedProportion(ctx, opAccAddr, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48_0x65", 1, 100)
+	suite.True(proportion.IsZero())
+}
@@ -23,7 +23,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "exocore/MsgUpdateParamsForSlash"
+	updateParamsName     = "exocore/MsgUpdateParamsForSlash"
+	freezeOperatorName   = "exocore/MsgFreezeOperator"
+	unfreezeOperatorName = "exocore/MsgUnfreezeOperator"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +39,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgFreezeOperator{},
+		&MsgUnfreezeOperator{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -47,4 +51,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // Amino JSON serialization and EIP-712 compatibility.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgFreezeOperator{}, freezeOperatorName, nil)
+	cdc.RegisterConcrete(&MsgUnfreezeOperator{}, unfreezeOperatorName, nil)
 }
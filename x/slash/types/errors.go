@@ -14,4 +14,13 @@ var (
 	ErrSlashAmountIsNegative    = errorsmod.Register(ModuleName, 3, "the slash amount is negative")
 	ErrSlashAssetNotExist       = errorsmod.Register(ModuleName, 4, "the slash asset doesn't exist")
 	ErrNoOperatorStatusKey      = errorsmod.Register(ModuleName, 5, "there is no stored key for slash OpratorStatus")
+	ErrInvalidSlashProportion   = errorsmod.Register(ModuleName, 6, "the slash proportion should be in (0,1]")
+	ErrSlashOperatorNotExist    = errorsmod.Register(ModuleName, 7, "the slashed operator has not been registered")
+	ErrInvalidReceiverAddress   = errorsmod.Register(ModuleName, 8, "the slashed assets receiver isn't a valid hex address")
+	ErrInvalidAuthority         = errorsmod.Register(ModuleName, 9, "the signer isn't the authority of the module")
+	ErrOperatorNotFrozen        = errorsmod.Register(ModuleName, 10, "the operator isn't frozen")
+	ErrSlashAmountExceeded      = errorsmod.Register(ModuleName, 11, "the slash amount exceeds the delegated amount of the staker")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 12, "the genesis data supplied is invalid")
+	ErrSlashAVSNotExist         = errorsmod.Register(ModuleName, 13, "the AVS that slashes the operator has not been registered")
+	ErrOperatorNotOptedIn       = errorsmod.Register(ModuleName, 14, "the slashed operator hasn't opted into the AVS at the slash height")
 )
@@ -1,6 +1,9 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
+	avstypes "github.com/ExocoreNetwork/exocore/x/avs/types"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -16,3 +19,20 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// DelegationKeeper defines the expected delegation keeper used to slash the delegations of the operator or the staker
+type DelegationKeeper interface {
+	IsOperator(ctx sdk.Context, addr sdk.AccAddress) bool
+	IterateOperatorAssetDelegations(ctx sdk.Context, operatorAddr, assetID string, fn func(stakerID string, amounts *delegationtype.DelegationAmounts) error) error
+	GetDelegationInfo(ctx sdk.Context, stakerID, assetID string) (*delegationtype.QueryDelegationInfoResponse, error)
+	GetStakerPendingUndelegations(ctx sdk.Context, stakerID, assetID string) ([]*delegationtype.UndelegationRecord, error)
+	SetSingleUndelegationRecord(ctx sdk.Context, record *delegationtype.UndelegationRecord) ([]byte, error)
+	UpdateDelegationState(ctx sdk.Context, stakerID string, assetID string, delegationAmounts map[string]*delegationtype.DelegationAmounts) error
+	UpdateStakerDelegationTotalAmount(ctx sdk.Context, stakerID string, assetID string, opAmount sdkmath.Int) error
+}
+
+// AVSKeeper defines the expected avs keeper used to check the AVS that slashes the operator
+type AVSKeeper interface {
+	GetAVSInfo(ctx sdk.Context, avsAddress string) (*avstypes.AVSInfo, error)
+	IsOptedIn(ctx sdk.Context, operatorAddr, avsAddress string) bool
+}
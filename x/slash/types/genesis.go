@@ -1,7 +1,12 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	restakingtype "github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default global index
@@ -10,7 +15,6 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
 }
@@ -18,7 +22,51 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	frozenStatuses := make(map[string]struct{}, len(gs.FrozenStatuses))
+	for _, status := range gs.FrozenStatuses {
+		if _, err := sdk.AccAddressFromBech32(status.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the frozen status is invalid:%s", status.OperatorAddr))
+		}
+		if _, ok := frozenStatuses[status.OperatorAddr]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated frozen status of the operator:%s", status.OperatorAddr))
+		}
+		frozenStatuses[status.OperatorAddr] = struct{}{}
+	}
 
-	return gs.Params.Validate()
+	histories := make(map[string]struct{}, len(gs.SlashHistories))
+	for _, history := range gs.SlashHistories {
+		if _, err := sdk.AccAddressFromBech32(history.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the slash history is invalid:%s", history.OperatorAddr))
+		}
+		if _, _, err := restakingtype.ParseID(history.AssetID); err != nil {
+			return err
+		}
+		if history.Proportion.IsNil() || !history.Proportion.IsPositive() || history.Proportion.GT(sdkmath.LegacyOneDec()) {
+			return errorsmod.Wrap(ErrInvalidSlashProportion, fmt.Sprintf("the proportion is:%v", history.Proportion))
+		}
+		key := string(GetSlashHistoryKey(history.OperatorAddr, history.AssetID, history.Height))
+		if _, ok := histories[key]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated slash history, operator:%s assetID:%s height:%d", history.OperatorAddr, history.AssetID, history.Height))
+		}
+		histories[key] = struct{}{}
+	}
+
+	recordIDs := make(map[uint64]struct{}, len(gs.SlashRecords))
+	for _, record := range gs.SlashRecords {
+		if _, err := sdk.AccAddressFromBech32(record.OperatorAddr); err != nil {
+			return errorsmod.Wrap(err, fmt.Sprintf("the operator address of the slash record is invalid:%s", record.OperatorAddr))
+		}
+		if record.Id == 0 || record.Id > gs.LastSlashRecordID {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the slash record ID should be in [1,%d], ID:%d", gs.LastSlashRecordID, record.Id))
+		}
+		if _, ok := recordIDs[record.Id]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicated slash record ID:%d", record.Id))
+		}
+		recordIDs[record.Id] = struct{}{}
+	}
+	return nil
 }
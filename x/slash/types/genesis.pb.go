@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,16 +25,137 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OperatorFrozenStatus is the frozen status of an operator.
+type OperatorFrozenStatus struct {
+	OperatorAddr string `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Frozen       bool   `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *OperatorFrozenStatus) Reset()         { *m = OperatorFrozenStatus{} }
+func (m *OperatorFrozenStatus) String() string { return proto.CompactTextString(m) }
+func (*OperatorFrozenStatus) ProtoMessage()    {}
+func (*OperatorFrozenStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0800c20695e285d5, []int{0}
+}
+func (m *OperatorFrozenStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorFrozenStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorFrozenStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorFrozenStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorFrozenStatus.Merge(m, src)
+}
+func (m *OperatorFrozenStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorFrozenStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorFrozenStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorFrozenStatus proto.InternalMessageInfo
+
+func (m *OperatorFrozenStatus) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *OperatorFrozenStatus) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// OperatorAssetSlashHistory is the merged proportion of the operator's asset
+// slashed at the height.
+type OperatorAssetSlashHistory struct {
+	OperatorAddr string                                 `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string                                 `protobuf:"bytes,2,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Height       uint64                                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Proportion   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+}
+
+func (m *OperatorAssetSlashHistory) Reset()         { *m = OperatorAssetSlashHistory{} }
+func (m *OperatorAssetSlashHistory) String() string { return proto.CompactTextString(m) }
+func (*OperatorAssetSlashHistory) ProtoMessage()    {}
+func (*OperatorAssetSlashHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0800c20695e285d5, []int{1}
+}
+func (m *OperatorAssetSlashHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAssetSlashHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAssetSlashHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAssetSlashHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAssetSlashHistory.Merge(m, src)
+}
+func (m *OperatorAssetSlashHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAssetSlashHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAssetSlashHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAssetSlashHistory proto.InternalMessageInfo
+
+func (m *OperatorAssetSlashHistory) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *OperatorAssetSlashHistory) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *OperatorAssetSlashHistory) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // GenesisState defines the exoslash module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params         Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FrozenStatuses []OperatorFrozenStatus      `protobuf:"bytes,2,rep,name=frozenStatuses,proto3" json:"frozenStatuses"`
+	SlashHistories []OperatorAssetSlashHistory `protobuf:"bytes,3,rep,name=slashHistories,proto3" json:"slashHistories"`
+	SlashRecords   []SlashRecord               `protobuf:"bytes,4,rep,name=slashRecords,proto3" json:"slashRecords"`
+	// lastSlashRecordID is the last ID assigned to the slash records.
+	LastSlashRecordID uint64 `protobuf:"varint,5,opt,name=lastSlashRecordID,proto3" json:"lastSlashRecordID,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0800c20695e285d5, []int{0}
+	return fileDescriptor_0800c20695e285d5, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -68,27 +191,166 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetFrozenStatuses() []OperatorFrozenStatus {
+	if m != nil {
+		return m.FrozenStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashHistories() []OperatorAssetSlashHistory {
+	if m != nil {
+		return m.SlashHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastSlashRecordID() uint64 {
+	if m != nil {
+		return m.LastSlashRecordID
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterType((*OperatorFrozenStatus)(nil), "exocore.slash.OperatorFrozenStatus")
+	proto.RegisterType((*OperatorAssetSlashHistory)(nil), "exocore.slash.OperatorAssetSlashHistory")
 	proto.RegisterType((*GenesisState)(nil), "exocore.slash.GenesisState")
 }
 
 func init() { proto.RegisterFile("exocore/slash/genesis.proto", fileDescriptor_0800c20695e285d5) }
 
 var fileDescriptor_0800c20695e285d5 = []byte{
-	// 194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4a, 0xea, 0x81, 0x25, 0xa5, 0x44,
-	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x14, 0xaa, 0x09, 0x05,
-	0x89, 0x45, 0x89, 0xb9, 0x50, 0x03, 0x94, 0x9c, 0xb9, 0x78, 0xdc, 0x21, 0x26, 0x06, 0x97, 0x24,
-	0x96, 0xa4, 0x0a, 0x19, 0x73, 0xb1, 0x41, 0xe4, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44,
-	0xf5, 0x50, 0x6c, 0xd0, 0x0b, 0x00, 0x4b, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55,
-	0xea, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xae, 0x10, 0x83, 0xfc, 0x52, 0x4b, 0xca,
-	0xf3, 0x8b, 0xb2, 0xf5, 0x61, 0x8e, 0xaa, 0x80, 0x3a, 0xab, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x2c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xab, 0xe9, 0x4d, 0xf6, 0x00,
-	0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0x2d, 0xdb, 0x75, 0x9b, 0x8d, 0x1b, 0xe8, 0xe2, 0x96, 0xb5, 0x0b, 0x8a, 0x71, 0xa1,
+	0xe8, 0x50, 0x4b, 0x90, 0x5c, 0x73, 0x89, 0x51, 0x3f, 0x7c, 0xe9, 0x87, 0x0c, 0x3d, 0x94, 0x42,
+	0x51, 0xe4, 0x89, 0x2c, 0x62, 0x7b, 0xc4, 0xce, 0x86, 0x26, 0x7d, 0x8a, 0x3e, 0x4c, 0x1e, 0x22,
+	0xc7, 0x90, 0x53, 0xe9, 0x21, 0x14, 0xfb, 0xd4, 0xb7, 0x28, 0x5a, 0xad, 0x88, 0xe4, 0xb4, 0xa7,
+	0x5e, 0x24, 0xcd, 0xfc, 0x67, 0x7f, 0x33, 0xda, 0x3f, 0xc3, 0x9e, 0xc2, 0x19, 0x46, 0x28, 0xc1,
+	0xa3, 0x79, 0x48, 0x33, 0x2f, 0x86, 0x25, 0x50, 0x42, 0x6e, 0x2a, 0x51, 0x21, 0x7f, 0x68, 0x44,
+	0x57, 0x8b, 0xbd, 0x6e, 0x84, 0xb4, 0x40, 0xfa, 0xa2, 0x45, 0x2f, 0x0f, 0xf2, 0xca, 0x5e, 0x27,
+	0xc6, 0x18, 0xf3, 0x7c, 0xf6, 0x65, 0xb2, 0xbd, 0x2a, 0x3c, 0x0d, 0x65, 0xb8, 0x28, 0x4e, 0x74,
+	0xab, 0x9a, 0x7e, 0xe6, 0xd2, 0x60, 0xce, 0x3a, 0xef, 0x52, 0x90, 0xa1, 0x42, 0xf9, 0x4a, 0xe2,
+	0x37, 0x58, 0x4e, 0x54, 0xa8, 0x4e, 0x89, 0x1f, 0xb0, 0x36, 0x9a, 0xfc, 0xe1, 0x74, 0x2a, 0x85,
+	0xd5, 0xb7, 0x9c, 0xad, 0x91, 0xb8, 0xbe, 0x18, 0x76, 0xcc, 0x30, 0x59, 0x1a, 0x88, 0x26, 0x4a,
+	0x26, 0xcb, 0x38, 0xa8, 0x54, 0xf3, 0x27, 0xac, 0x75, 0xac, 0x69, 0xa2, 0xde, 0xb7, 0x9c, 0x07,
+	0x81, 0x89, 0x06, 0xbf, 0x2d, 0xd6, 0x2d, 0xda, 0x1d, 0x12, 0x81, 0x9a, 0x64, 0xa3, 0xbc, 0x49,
+	0x48, 0xa1, 0x3c, 0xff, 0xcf, 0x9e, 0x82, 0xdd, 0x0f, 0x33, 0xe4, 0xd8, 0xd7, 0x4d, 0xb7, 0x82,
+	0x22, 0xcc, 0xa6, 0x99, 0x41, 0x12, 0xcf, 0x94, 0x68, 0xf4, 0x2d, 0xa7, 0x19, 0x98, 0x88, 0x7f,
+	0x66, 0x2c, 0x95, 0x98, 0xa2, 0x54, 0x09, 0x2e, 0x45, 0x53, 0x77, 0x3b, 0xb8, 0xbc, 0xd9, 0xad,
+	0xfd, 0xbc, 0xd9, 0x7d, 0x1e, 0x27, 0x6a, 0x76, 0x7a, 0xe4, 0x46, 0xb8, 0x30, 0xb7, 0x6f, 0x5e,
+	0x43, 0x9a, 0x9e, 0x78, 0xea, 0x3c, 0x05, 0x72, 0x7d, 0x88, 0xae, 0x2f, 0x86, 0xcc, 0xcc, 0xe6,
+	0x43, 0x14, 0x94, 0x78, 0x83, 0x55, 0x9d, 0xb5, 0x5f, 0xe7, 0x16, 0x67, 0x77, 0x0a, 0x7c, 0x9f,
+	0xb5, 0x72, 0x57, 0xf4, 0x8f, 0x6d, 0xef, 0x3d, 0x76, 0x2b, 0x96, 0xbb, 0xef, 0xb5, 0x38, 0x6a,
+	0x66, 0x13, 0x04, 0xa6, 0x94, 0x7f, 0x60, 0x3b, 0xc7, 0x25, 0x5f, 0x80, 0x44, 0xbd, 0xdf, 0x70,
+	0xb6, 0xf7, 0x9e, 0x6d, 0x1c, 0xfe, 0x9b, 0x89, 0x06, 0xb5, 0x01, 0xe0, 0x1f, 0xd9, 0x0e, 0xdd,
+	0x5e, 0x7b, 0x02, 0x24, 0x1a, 0x1a, 0xe9, 0xfc, 0x03, 0x79, 0xc7, 0xa8, 0x82, 0x5b, 0xa5, 0x70,
+	0x9f, 0xb5, 0x75, 0x26, 0x80, 0x08, 0xe5, 0x94, 0x44, 0x53, 0x53, 0x7b, 0x1b, 0xd4, 0xc9, 0x6d,
+	0x89, 0xe1, 0x54, 0x4e, 0xf1, 0x17, 0xec, 0xd1, 0x3c, 0x24, 0x55, 0x2a, 0x1b, 0xfb, 0xe2, 0x9e,
+	0xf6, 0xed, 0xae, 0x30, 0x1a, 0x5f, 0xae, 0x6c, 0xeb, 0x6a, 0x65, 0x5b, 0xbf, 0x56, 0xb6, 0xf5,
+	0x7d, 0x6d, 0xd7, 0xae, 0xd6, 0x76, 0xed, 0xc7, 0xda, 0xae, 0x7d, 0xf2, 0x4a, 0x06, 0xbe, 0xcc,
+	0x27, 0x78, 0x0b, 0xea, 0x2b, 0xca, 0x13, 0xaf, 0xd8, 0x86, 0x33, 0xb3, 0x0f, 0xda, 0xcd, 0xa3,
+	0x96, 0x5e, 0x88, 0xfd, 0x3f, 0x03, 0x00, 0x46, 0xf7, 0x86, 0xfb, 0xa6, 0x03, 0x00, 0x00,
+}
+
+func (m *OperatorFrozenStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorFrozenStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorFrozenStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAssetSlashHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAssetSlashHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAssetSlashHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +373,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastSlashRecordID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashRecordID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SlashHistories) > 0 {
+		for iNdEx := len(m.SlashHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FrozenStatuses) > 0 {
+		for iNdEx := len(m.FrozenStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -135,6 +444,44 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *OperatorFrozenStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *OperatorAssetSlashHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -143,6 +490,27 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenStatuses) > 0 {
+		for _, e := range m.FrozenStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashHistories) > 0 {
+		for _, e := range m.SlashHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastSlashRecordID != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashRecordID))
+	}
 	return n
 }
 
@@ -152,6 +520,275 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *OperatorFrozenStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorFrozenStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorFrozenStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAssetSlashHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAssetSlashHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAssetSlashHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -214,6 +851,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenStatuses = append(m.FrozenStatuses, OperatorFrozenStatus{})
+			if err := m.FrozenStatuses[len(m.FrozenStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashHistories = append(m.SlashHistories, OperatorAssetSlashHistory{})
+			if err := m.SlashHistories[len(m.SlashHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashRecordID", wireType)
+			}
+			m.LastSlashRecordID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSlashRecordID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
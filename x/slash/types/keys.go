@@ -1,5 +1,14 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "exoslash"
@@ -19,13 +28,49 @@ func KeyPrefix(p string) []byte {
 }
 
 const (
-	prefixParams       = 1
-	prefixOperatorInfo = 2
+	prefixParams               = 1
+	prefixOperatorInfo         = 2
+	prefixOperatorSlashHistory = 3
+	prefixSlashRecord          = 4
+	prefixSlashRecordID        = 5
 )
 
 var (
 	KeyPrefixParams = []byte{prefixParams}
-	// KeyPrefixOperatorInfo key-value: operatorAddr->operatorInfo
+	// KeyPrefixOperatorInfo key-value: operatorAddr->frozenStatus
 	KeyPrefixOperatorInfo = []byte{prefixOperatorInfo}
-	ParamsKey             = []byte("Params")
+	// KeyPrefixOperatorSlashHistory key-value: operatorAddr+'/'+assetID+'/'+bigEndian(height)->slashedProportion
+	KeyPrefixOperatorSlashHistory = []byte{prefixOperatorSlashHistory}
+	// KeyPrefixSlashRecord key-value: operatorAddr+'/'+bigEndian(slashID)->SlashRecord
+	KeyPrefixSlashRecord = []byte{prefixSlashRecord}
+	// KeySlashRecordID key-value: key->the last slashID
+	KeySlashRecordID = []byte{prefixSlashRecordID}
+	ParamsKey        = []byte("Params")
 )
+
+// GetSlashRecordKey The slashID is encoded in big endian, so the slash records of an operator can be iterated in order.
+func GetSlashRecordKey(operatorAddr string, slashID uint64) []byte {
+	return append([]byte(operatorAddr+"/"), sdk.Uint64ToBigEndian(slashID)...)
+}
+
+// GetSlashHistoryPrefix returns the prefix of the slash history of the operator's specified asset.
+func GetSlashHistoryPrefix(operatorAddr, assetID string) []byte {
+	return []byte(strings.Join([]string{operatorAddr, assetID, ""}, "/"))
+}
+
+// GetSlashHistoryKey The height is encoded in big endian, so the slash history can be iterated by height.
+func GetSlashHistoryKey(operatorAddr, assetID string, height uint64) []byte {
+	return append(GetSlashHistoryPrefix(operatorAddr, assetID), sdk.Uint64ToBigEndian(height)...)
+}
+
+// ParseSlashHistoryKey parses the operator address, assetID and height from the slash history key.
+func ParseSlashHistoryKey(key []byte) (operatorAddr, assetID string, height uint64, err error) {
+	if len(key) < 8 {
+		return "", "", 0, errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("invalid slash history key:%v", key))
+	}
+	keys := strings.Split(string(key[:len(key)-8]), "/")
+	if len(keys) != 3 || keys[2] != "" {
+		return "", "", 0, errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("invalid slash history key:%v", key))
+	}
+	return keys[0], keys[1], sdk.BigEndianToUint64(key[len(key)-8:]), nil
+}
@@ -5,7 +5,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgFreezeOperator{}
+	_ sdk.Msg = &MsgUnfreezeOperator{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -25,3 +29,47 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return nil
 }
+
+// GetSigners returns the expected signers for a MsgFreezeOperator message.
+func (m *MsgFreezeOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgFreezeOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgFreezeOperator) GetSignBytes() []byte {
+	return nil
+}
+
+// GetSigners returns the expected signers for a MsgUnfreezeOperator message.
+func (m *MsgUnfreezeOperator) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUnfreezeOperator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid from address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.OperatorAddr); err != nil {
+		return errorsmod.Wrap(err, "invalid operator address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m *MsgUnfreezeOperator) GetSignBytes() []byte {
+	return nil
+}
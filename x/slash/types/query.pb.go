@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -113,33 +113,152 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryOperatorSlashRecordsRequest is request type for the Query/OperatorSlashRecords RPC method.
+type QueryOperatorSlashRecordsRequest struct {
+	OperatorAddr string             `protobuf:"bytes,1,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorSlashRecordsRequest) Reset()         { *m = QueryOperatorSlashRecordsRequest{} }
+func (m *QueryOperatorSlashRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorSlashRecordsRequest) ProtoMessage()    {}
+func (*QueryOperatorSlashRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cd6399098c1a574, []int{2}
+}
+func (m *QueryOperatorSlashRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorSlashRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorSlashRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorSlashRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorSlashRecordsRequest.Merge(m, src)
+}
+func (m *QueryOperatorSlashRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorSlashRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorSlashRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorSlashRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorSlashRecordsRequest) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *QueryOperatorSlashRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOperatorSlashRecordsResponse is response type for the Query/OperatorSlashRecords RPC method.
+type QueryOperatorSlashRecordsResponse struct {
+	Records    []*SlashRecord      `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOperatorSlashRecordsResponse) Reset()         { *m = QueryOperatorSlashRecordsResponse{} }
+func (m *QueryOperatorSlashRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorSlashRecordsResponse) ProtoMessage()    {}
+func (*QueryOperatorSlashRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8cd6399098c1a574, []int{3}
+}
+func (m *QueryOperatorSlashRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorSlashRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorSlashRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorSlashRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorSlashRecordsResponse.Merge(m, src)
+}
+func (m *QueryOperatorSlashRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorSlashRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorSlashRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorSlashRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorSlashRecordsResponse) GetRecords() []*SlashRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryOperatorSlashRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.slash.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.slash.QueryParamsResponse")
+	proto.RegisterType((*QueryOperatorSlashRecordsRequest)(nil), "exocore.slash.QueryOperatorSlashRecordsRequest")
+	proto.RegisterType((*QueryOperatorSlashRecordsResponse)(nil), "exocore.slash.QueryOperatorSlashRecordsResponse")
 }
 
 func init() { proto.RegisterFile("exocore/slash/query.proto", fileDescriptor_8cd6399098c1a574) }
 
 var fileDescriptor_8cd6399098c1a574 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4a, 0xe9, 0x81, 0xa5, 0xa4, 0x44, 0xd2, 0xf3,
-	0xd3, 0xf3, 0xc1, 0x32, 0xfa, 0x20, 0x16, 0x44, 0x91, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e,
-	0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e,
-	0x31, 0x54, 0x56, 0x3a, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x18, 0x62, 0xac, 0x7e, 0x99, 0x21, 0xb2,
-	0xf9, 0x52, 0x52, 0xa8, 0x56, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x35, 0x2a, 0x89, 0x70, 0x09,
-	0x05, 0x82, 0x94, 0x06, 0x80, 0x05, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94, 0x5c, 0xb8,
-	0x84, 0x51, 0x44, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x74, 0xb9, 0xd8, 0x20, 0x9a, 0x25,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf5, 0x50, 0x5c, 0xae, 0x07, 0x55, 0x0e, 0x55, 0x64,
-	0x54, 0xce, 0xc5, 0x0a, 0x36, 0x45, 0x28, 0x8f, 0x8b, 0x0d, 0x22, 0x25, 0xa4, 0x88, 0xa6, 0x03,
-	0xd3, 0x6e, 0x29, 0x25, 0x7c, 0x4a, 0x20, 0x0e, 0x51, 0x92, 0x6d, 0xba, 0xfc, 0x64, 0x32, 0x93,
-	0xb8, 0x90, 0xa8, 0x3e, 0x36, 0xaf, 0x39, 0x79, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x7e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x2b,
-	0x44, 0xab, 0x5f, 0x6a, 0x49, 0x79, 0x7e, 0x51, 0x36, 0xdc, 0xa4, 0x0a, 0xa8, 0x59, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x60, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0xd5,
-	0xbc, 0x52, 0xbf, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x8e, 0xd3, 0x30,
+	0x14, 0xc6, 0xeb, 0x22, 0x8a, 0xf0, 0xc0, 0xc6, 0x74, 0x44, 0x09, 0x10, 0x75, 0x22, 0xc1, 0x8c,
+	0x90, 0xc6, 0x66, 0x0a, 0x17, 0x00, 0xf1, 0x47, 0x6c, 0x60, 0x08, 0x3b, 0x76, 0x4e, 0x6a, 0x65,
+	0x22, 0xda, 0xbc, 0xd4, 0x76, 0xa1, 0x15, 0x62, 0xc3, 0x01, 0x10, 0x12, 0x37, 0xe8, 0x8a, 0xa3,
+	0xb0, 0xac, 0xc4, 0x86, 0x25, 0x6a, 0x39, 0x08, 0x8a, 0xed, 0x96, 0x24, 0x44, 0x85, 0x4d, 0x55,
+	0xf9, 0xfb, 0xde, 0xf7, 0x7e, 0xef, 0xd9, 0xc1, 0xd7, 0xc4, 0x0c, 0x62, 0x90, 0x82, 0xa9, 0x11,
+	0x57, 0x67, 0x6c, 0x32, 0x15, 0x72, 0x4e, 0x73, 0x09, 0x1a, 0xc8, 0x65, 0x27, 0x51, 0x23, 0x79,
+	0xdd, 0x04, 0x12, 0x30, 0x0a, 0x2b, 0xfe, 0x59, 0x93, 0x77, 0x23, 0x01, 0x48, 0x46, 0x82, 0xf1,
+	0x3c, 0x65, 0x3c, 0xcb, 0x40, 0x73, 0x9d, 0x42, 0xa6, 0x9c, 0x7a, 0x3d, 0x06, 0x35, 0x06, 0x65,
+	0x63, 0xd9, 0xdb, 0x93, 0x72, 0xbe, 0xe7, 0x55, 0x5b, 0xe7, 0x5c, 0xf2, 0xf1, 0xa6, 0xb0, 0x86,
+	0x65, 0x7e, 0x9d, 0x74, 0xc7, 0x65, 0x46, 0x5c, 0x89, 0x6d, 0x70, 0x24, 0x34, 0x3f, 0x61, 0x39,
+	0x4f, 0xd2, 0xcc, 0x00, 0x58, 0x6f, 0xd0, 0xc5, 0xe4, 0x65, 0xe1, 0x38, 0x35, 0xd9, 0xa1, 0x98,
+	0x4c, 0x85, 0xd2, 0xc1, 0x23, 0x7c, 0xa5, 0x72, 0xaa, 0x72, 0xc8, 0x94, 0x20, 0xc7, 0xb8, 0x63,
+	0x19, 0x7a, 0xa8, 0x8f, 0x8e, 0xf6, 0x06, 0xfb, 0xb4, 0xb2, 0x00, 0xea, 0xec, 0xce, 0x14, 0x7c,
+	0x42, 0xb8, 0x6f, 0x62, 0x5e, 0xe4, 0x42, 0x72, 0x0d, 0xf2, 0x55, 0xe1, 0x0a, 0x45, 0x0c, 0x72,
+	0xb8, 0x69, 0x45, 0x02, 0x7c, 0x09, 0x9c, 0xfc, 0x60, 0x38, 0x94, 0x26, 0xf9, 0x62, 0x58, 0x39,
+	0x23, 0x4f, 0x30, 0xfe, 0x03, 0xde, 0x6b, 0x9b, 0xde, 0xb7, 0xa9, 0x9d, 0x92, 0x16, 0x53, 0x52,
+	0xbb, 0x35, 0x37, 0x25, 0x3d, 0xe5, 0x89, 0x70, 0xf9, 0x61, 0xa9, 0x32, 0x58, 0x20, 0x7c, 0xb0,
+	0x03, 0xc8, 0x4d, 0x79, 0x1f, 0x5f, 0x90, 0xf6, 0xa8, 0x87, 0xfa, 0xe7, 0x8e, 0xf6, 0x06, 0x5e,
+	0x6d, 0xcc, 0x52, 0x55, 0xb8, 0xb1, 0x92, 0xa7, 0x0d, 0x8c, 0x87, 0xff, 0x64, 0xb4, 0x2d, 0xcb,
+	0x90, 0x83, 0x45, 0x1b, 0x9f, 0x37, 0x90, 0x24, 0xc3, 0x1d, 0xbb, 0x51, 0x72, 0x50, 0x23, 0xf8,
+	0xfb, 0xca, 0xbc, 0x60, 0x97, 0xc5, 0xb6, 0x09, 0x6e, 0x7e, 0xfc, 0xfe, 0xeb, 0x4b, 0xfb, 0x2a,
+	0xd9, 0x67, 0x4d, 0x0f, 0x8b, 0x7c, 0x45, 0xb8, 0xdb, 0xb4, 0x19, 0xc2, 0x9a, 0xb2, 0x77, 0x5c,
+	0xaa, 0x77, 0xf7, 0xff, 0x0b, 0x1c, 0xda, 0xb1, 0x41, 0x3b, 0x24, 0xb7, 0x6a, 0x68, 0x6e, 0xbd,
+	0xec, 0x7d, 0xf9, 0x41, 0x7c, 0x78, 0xf8, 0xec, 0xdb, 0xca, 0x47, 0xcb, 0x95, 0x8f, 0x7e, 0xae,
+	0x7c, 0xf4, 0x79, 0xed, 0xb7, 0x96, 0x6b, 0xbf, 0xf5, 0x63, 0xed, 0xb7, 0x5e, 0xb3, 0x24, 0xd5,
+	0x67, 0xd3, 0x88, 0xc6, 0x30, 0x66, 0x8f, 0x6d, 0xd4, 0x73, 0xa1, 0xdf, 0x81, 0x7c, 0xb3, 0x4d,
+	0x9e, 0xb9, 0x6c, 0x3d, 0xcf, 0x85, 0x8a, 0x3a, 0xe6, 0x43, 0xb8, 0xf7, 0x7b, 0x00, 0x7a, 0x2e,
+	0x47, 0xed, 0xe8, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -156,6 +275,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// OperatorSlashRecords queries the slash records of the operator.
+	OperatorSlashRecords(ctx context.Context, in *QueryOperatorSlashRecordsRequest, opts ...grpc.CallOption) (*QueryOperatorSlashRecordsResponse, error)
 }
 
 type queryClient struct {
@@ -175,10 +296,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) OperatorSlashRecords(ctx context.Context, in *QueryOperatorSlashRecordsRequest, opts ...grpc.CallOption) (*QueryOperatorSlashRecordsResponse, error) {
+	out := new(QueryOperatorSlashRecordsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.Query/OperatorSlashRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// OperatorSlashRecords queries the slash records of the operator.
+	OperatorSlashRecords(context.Context, *QueryOperatorSlashRecordsRequest) (*QueryOperatorSlashRecordsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -188,6 +320,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) OperatorSlashRecords(ctx context.Context, req *QueryOperatorSlashRecordsRequest) (*QueryOperatorSlashRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorSlashRecords not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -211,6 +346,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OperatorSlashRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorSlashRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorSlashRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.Query/OperatorSlashRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorSlashRecords(ctx, req.(*QueryOperatorSlashRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.slash.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -219,6 +372,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "OperatorSlashRecords",
+			Handler:    _Query_OperatorSlashRecords_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/slash/query.proto",
@@ -282,6 +439,97 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOperatorSlashRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorSlashRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSlashRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorSlashRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorSlashRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorSlashRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -315,6 +563,42 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOperatorSlashRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorSlashRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -457,6 +741,244 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOperatorSlashRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorSlashRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorSlashRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorSlashRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorSlashRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorSlashRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &SlashRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/slash/slash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashRecord is the record stored for every operator slash.
+type SlashRecord struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorAddr string `protobuf:"bytes,2,opt,name=operatorAddr,proto3" json:"operatorAddr,omitempty"`
+	AssetID      string `protobuf:"bytes,3,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	// avsAddress is the middleware contract address of the AVS that slashes the operator.
+	AvsAddress string                                 `protobuf:"bytes,4,opt,name=avsAddress,proto3" json:"avsAddress,omitempty"`
+	Proportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+	Height     uint64                                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// slashedAmount is the amount slashed from the delegated assets, the pending
+	// undelegations are slashed by the proportion when they are completed.
+	SlashedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=slashedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashedAmount"`
+	// receiver is the stakerID that receives the slashed assets, it's empty if the assets are burned.
+	Receiver string `protobuf:"bytes,8,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_027dfd7f8c599b2f, []int{0}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetAvsAddress() string {
+	if m != nil {
+		return m.AvsAddress
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SlashRecord)(nil), "exocore.slash.SlashRecord")
+}
+
+func init() { proto.RegisterFile("exocore/slash/slash.proto", fileDescriptor_027dfd7f8c599b2f) }
+
+var fileDescriptor_027dfd7f8c599b2f = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbd, 0x6e, 0xe2, 0x40,
+	0x10, 0xb6, 0x81, 0x03, 0x6e, 0xef, 0xb8, 0x62, 0x85, 0x4e, 0x0b, 0x85, 0x41, 0x57, 0x9c, 0x68,
+	0xb0, 0x8b, 0x6b, 0x69, 0x40, 0x5c, 0xe1, 0x26, 0x85, 0xe9, 0xa2, 0x48, 0x91, 0xb1, 0x47, 0xb6,
+	0x45, 0xf0, 0x58, 0xbb, 0x0b, 0x21, 0xcf, 0x90, 0x26, 0x0f, 0xc3, 0x43, 0x50, 0x22, 0xaa, 0x28,
+	0x05, 0x8a, 0xe0, 0x45, 0x22, 0xaf, 0x97, 0xc8, 0x94, 0x69, 0x76, 0xf7, 0xfb, 0xe6, 0xe7, 0x9b,
+	0xd9, 0x19, 0xd2, 0x81, 0x0d, 0x06, 0xc8, 0xc1, 0x11, 0x0f, 0xbe, 0x88, 0x8b, 0xd3, 0xce, 0x38,
+	0x4a, 0xa4, 0x2d, 0x6d, 0xb2, 0x15, 0xd9, 0xed, 0x04, 0x28, 0x96, 0x28, 0xee, 0x95, 0xd1, 0x29,
+	0x40, 0xe1, 0xd9, 0x6d, 0x47, 0x18, 0x61, 0xc1, 0xe7, 0xaf, 0x82, 0xfd, 0xf3, 0x5c, 0x25, 0x3f,
+	0x66, 0x79, 0xa8, 0x07, 0x01, 0xf2, 0x90, 0xfe, 0x22, 0x95, 0x24, 0x64, 0x66, 0xdf, 0x1c, 0xd4,
+	0xbc, 0x4a, 0x12, 0xd2, 0x11, 0xf9, 0x89, 0x19, 0x70, 0x5f, 0x22, 0x1f, 0x87, 0x21, 0x67, 0x95,
+	0xbe, 0x39, 0xf8, 0x3e, 0x61, 0x87, 0xed, 0xb0, 0xad, 0xb3, 0xe7, 0x34, 0x08, 0x31, 0x93, 0x3c,
+	0x49, 0x23, 0xef, 0xca, 0x9b, 0x32, 0xd2, 0xf0, 0x85, 0x00, 0xe9, 0x4e, 0x59, 0x35, 0x0f, 0xf4,
+	0x2e, 0x90, 0x5a, 0x84, 0xf8, 0x6b, 0xa1, 0x63, 0x59, 0x4d, 0x19, 0x4b, 0x0c, 0xbd, 0x23, 0x24,
+	0xe3, 0x98, 0x21, 0x97, 0x09, 0xa6, 0xec, 0x9b, 0x52, 0x1d, 0xed, 0x8e, 0x3d, 0xe3, 0xed, 0xd8,
+	0xfb, 0x1b, 0x25, 0x32, 0x5e, 0xcd, 0xed, 0x00, 0x97, 0xba, 0x45, 0x7d, 0x0d, 0x45, 0xb8, 0x70,
+	0xe4, 0x53, 0x06, 0xc2, 0x9e, 0x42, 0x70, 0xd8, 0x0e, 0x89, 0xae, 0x71, 0x0a, 0x81, 0x57, 0xca,
+	0x47, 0x7f, 0x93, 0x7a, 0x0c, 0x49, 0x14, 0x4b, 0x56, 0x57, 0x9d, 0x6a, 0x44, 0xe7, 0xa4, 0xa5,
+	0xfe, 0x11, 0xc2, 0xf1, 0x12, 0x57, 0xa9, 0x64, 0x8d, 0x2f, 0x0b, 0xbb, 0xa9, 0x2c, 0x09, 0xbb,
+	0xa9, 0xf4, 0xae, 0x53, 0xd2, 0x2e, 0x69, 0x72, 0x08, 0x20, 0x59, 0x03, 0x67, 0x4d, 0xd5, 0xf7,
+	0x27, 0x9e, 0xb8, 0xbb, 0x93, 0x65, 0xee, 0x4f, 0x96, 0xf9, 0x7e, 0xb2, 0xcc, 0x97, 0xb3, 0x65,
+	0xec, 0xcf, 0x96, 0xf1, 0x7a, 0xb6, 0x8c, 0x5b, 0xa7, 0x24, 0xfd, 0xbf, 0x18, 0xf9, 0x0d, 0xc8,
+	0x47, 0xe4, 0x0b, 0xe7, 0xb2, 0x1c, 0x1b, 0xbd, 0x1e, 0xaa, 0x8e, 0x79, 0x5d, 0xcd, 0xf7, 0xdf,
+	0xc7, 0x00, 0x00, 0xbf, 0xd8, 0xac, 0x3c, 0x02, 0x00, 0x00,
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.SlashedAmount.Size()
+		i -= size
+		if _, err := m.SlashedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AvsAddress) > 0 {
+		i -= len(m.AvsAddress)
+		copy(dAtA[i:], m.AvsAddress)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.AvsAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSlash(uint64(m.Id))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.AvsAddress)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSlash(uint64(m.Height))
+	}
+	l = m.SlashedAmount.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	return n
+}
+
+func sovSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlash(x uint64) (n int) {
+	return sovSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlash = fmt.Errorf("proto: unexpected end of group")
+)
@@ -126,35 +126,231 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgFreezeOperator is the Msg/FreezeOperator request type.
+type MsgFreezeOperator struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// operator_addr is the address of the operator to be frozen.
+	OperatorAddr string `protobuf:"bytes,2,opt,name=operator_addr,json=operatorAddr,proto3" json:"operator_addr,omitempty"`
+}
+
+func (m *MsgFreezeOperator) Reset()         { *m = MsgFreezeOperator{} }
+func (m *MsgFreezeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOperator) ProtoMessage()    {}
+func (*MsgFreezeOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ec062c35f00efd9, []int{2}
+}
+func (m *MsgFreezeOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOperator.Merge(m, src)
+}
+func (m *MsgFreezeOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeOperator proto.InternalMessageInfo
+
+func (m *MsgFreezeOperator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgFreezeOperator) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+// MsgFreezeOperatorResponse is the response type of Msg/FreezeOperator.
+type MsgFreezeOperatorResponse struct {
+}
+
+func (m *MsgFreezeOperatorResponse) Reset()         { *m = MsgFreezeOperatorResponse{} }
+func (m *MsgFreezeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOperatorResponse) ProtoMessage()    {}
+func (*MsgFreezeOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ec062c35f00efd9, []int{3}
+}
+func (m *MsgFreezeOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOperatorResponse.Merge(m, src)
+}
+func (m *MsgFreezeOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeOperatorResponse proto.InternalMessageInfo
+
+// MsgUnfreezeOperator is the Msg/UnfreezeOperator request type.
+type MsgUnfreezeOperator struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// operator_addr is the address of the operator to be unfrozen.
+	OperatorAddr string `protobuf:"bytes,2,opt,name=operator_addr,json=operatorAddr,proto3" json:"operator_addr,omitempty"`
+}
+
+func (m *MsgUnfreezeOperator) Reset()         { *m = MsgUnfreezeOperator{} }
+func (m *MsgUnfreezeOperator) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeOperator) ProtoMessage()    {}
+func (*MsgUnfreezeOperator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ec062c35f00efd9, []int{4}
+}
+func (m *MsgUnfreezeOperator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeOperator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeOperator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeOperator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeOperator.Merge(m, src)
+}
+func (m *MsgUnfreezeOperator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeOperator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeOperator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeOperator proto.InternalMessageInfo
+
+func (m *MsgUnfreezeOperator) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeOperator) GetOperatorAddr() string {
+	if m != nil {
+		return m.OperatorAddr
+	}
+	return ""
+}
+
+// MsgUnfreezeOperatorResponse is the response type of Msg/UnfreezeOperator.
+type MsgUnfreezeOperatorResponse struct {
+}
+
+func (m *MsgUnfreezeOperatorResponse) Reset()         { *m = MsgUnfreezeOperatorResponse{} }
+func (m *MsgUnfreezeOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeOperatorResponse) ProtoMessage()    {}
+func (*MsgUnfreezeOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ec062c35f00efd9, []int{5}
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeOperatorResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeOperatorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "exocore.slash.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "exocore.slash.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFreezeOperator)(nil), "exocore.slash.MsgFreezeOperator")
+	proto.RegisterType((*MsgFreezeOperatorResponse)(nil), "exocore.slash.MsgFreezeOperatorResponse")
+	proto.RegisterType((*MsgUnfreezeOperator)(nil), "exocore.slash.MsgUnfreezeOperator")
+	proto.RegisterType((*MsgUnfreezeOperatorResponse)(nil), "exocore.slash.MsgUnfreezeOperatorResponse")
 }
 
 func init() { proto.RegisterFile("exocore/slash/tx.proto", fileDescriptor_6ec062c35f00efd9) }
 
 var fileDescriptor_6ec062c35f00efd9 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xad, 0xc8, 0x4f,
-	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xd0, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x8a, 0xeb, 0x81, 0xc5, 0xa5, 0xa4, 0x50, 0x95, 0x15, 0x24, 0x16,
-	0x25, 0xe6, 0x16, 0x43, 0x94, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x54, 0x54, 0x32, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x38, 0x1e, 0x22, 0x01, 0xe1, 0x40, 0xa5, 0xc4,
-	0x21, 0x3c, 0xfd, 0xdc, 0xe2, 0x74, 0xfd, 0x32, 0x43, 0x10, 0x05, 0x91, 0x50, 0xea, 0x63, 0xe4,
-	0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x00, 0xdb, 0x21, 0x64, 0xc6,
-	0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x44, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2,
-	0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x63, 0x2e, 0x36, 0x88, 0x2b, 0x25,
-	0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf5, 0x50, 0x7c, 0xa4, 0x07, 0x31, 0xde, 0x89, 0xe5,
-	0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x52, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x86, 0x28,
-	0x49, 0x72, 0x89, 0xa3, 0xb9, 0x27, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x28, 0x96,
-	0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28, 0x8c, 0x8b, 0x07, 0xc5, 0xb9, 0x72, 0x68, 0xd6, 0xa0, 0x69,
-	0x97, 0x52, 0xc3, 0x2f, 0x0f, 0x33, 0xde, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
-	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
-	0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x5d,
-	0x21, 0x66, 0xf9, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xeb, 0xc3, 0x22, 0xa9, 0x02, 0x16, 0x9b,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xc0, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x0e,
-	0x3b, 0x4b, 0x60, 0xeb, 0x01, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc6, 0x33, 0x55, 0x0a, 0x1d, 0xdb, 0xaa, 0xb1, 0xda, 0x34, 0xc5, 0x58, 0x72, 0x90, 0x52,
+	0x30, 0x83, 0x2d, 0x78, 0x10, 0x3c, 0x58, 0x50, 0xf0, 0x50, 0x95, 0x8a, 0x1e, 0x44, 0x28, 0x69,
+	0x33, 0x4e, 0x8b, 0xa6, 0x13, 0x66, 0xa6, 0xda, 0x7a, 0xf4, 0x01, 0x96, 0x3d, 0x2f, 0x0b, 0xfb,
+	0x0a, 0x7b, 0xd8, 0x87, 0xe8, 0xb1, 0xec, 0x69, 0x4f, 0xcb, 0xd2, 0x1e, 0xf6, 0x35, 0x96, 0x64,
+	0x92, 0x96, 0x24, 0x65, 0x0b, 0x7b, 0xda, 0x53, 0x66, 0xfe, 0xdf, 0x37, 0xdf, 0xfc, 0x26, 0xff,
+	0x4c, 0xe0, 0x13, 0x3c, 0xa5, 0x03, 0xca, 0x30, 0xe2, 0xbf, 0x6d, 0x3e, 0x44, 0x62, 0x6a, 0x79,
+	0x8c, 0x0a, 0xaa, 0x16, 0xc2, 0xba, 0x15, 0xd4, 0x75, 0x3d, 0x6e, 0xf3, 0x6c, 0x66, 0xbb, 0x5c,
+	0x5a, 0xf5, 0x12, 0xa1, 0x84, 0x06, 0x43, 0xe4, 0x8f, 0xc2, 0x6a, 0x65, 0x40, 0xb9, 0x4b, 0x79,
+	0x4f, 0x0a, 0x72, 0x12, 0x4a, 0x65, 0x39, 0x43, 0x2e, 0x27, 0xe8, 0xcf, 0x4b, 0xff, 0x21, 0x05,
+	0x73, 0x0f, 0xc0, 0xfb, 0x1d, 0x4e, 0xbe, 0x7a, 0x8e, 0x2d, 0xf0, 0xe7, 0x60, 0x0f, 0xf5, 0x15,
+	0xcc, 0xd9, 0x13, 0x31, 0xa4, 0x6c, 0x24, 0x66, 0x1a, 0xa8, 0x81, 0x7a, 0xae, 0xad, 0x9d, 0x9e,
+	0xbc, 0x28, 0x85, 0x89, 0x6f, 0x1d, 0x87, 0x61, 0xce, 0xbf, 0x08, 0x36, 0x1a, 0x93, 0xee, 0xc6,
+	0xaa, 0xb6, 0x60, 0x56, 0x52, 0x6a, 0x99, 0x1a, 0xa8, 0xdf, 0x6b, 0x3e, 0xb6, 0x62, 0x27, 0xb2,
+	0x64, 0x7c, 0xfb, 0xee, 0xfc, 0xfc, 0x99, 0xd2, 0x0d, 0xad, 0xaf, 0x8b, 0xff, 0x2f, 0x8f, 0x1b,
+	0x9b, 0x10, 0xb3, 0x02, 0xcb, 0x09, 0x9e, 0x2e, 0xe6, 0x1e, 0x1d, 0x73, 0x6c, 0x1e, 0x00, 0xf8,
+	0xb0, 0xc3, 0xc9, 0x7b, 0x86, 0xf1, 0x3f, 0xfc, 0xc9, 0xc3, 0xcc, 0x16, 0x94, 0xdd, 0x98, 0xf6,
+	0x0d, 0x2c, 0xd0, 0x30, 0xa3, 0x67, 0x3b, 0x0e, 0xd3, 0x32, 0x3b, 0xd6, 0xe6, 0x23, 0xbb, 0x5f,
+	0x4e, 0x71, 0x57, 0x61, 0x25, 0xc5, 0xb6, 0x26, 0x3f, 0x04, 0xf0, 0x91, 0x7f, 0xaa, 0xf1, 0xcf,
+	0x5b, 0xc9, 0xfe, 0x14, 0x56, 0xb7, 0xd0, 0x45, 0xf4, 0xcd, 0xa3, 0x0c, 0xbc, 0xd3, 0xe1, 0x44,
+	0xfd, 0x06, 0xf3, 0xb1, 0xef, 0xc4, 0x48, 0xf4, 0x37, 0xd1, 0x37, 0xfd, 0xf9, 0xf5, 0x7a, 0x94,
+	0xaf, 0xfe, 0x80, 0xc5, 0x44, 0x4f, 0x6b, 0xe9, 0x95, 0x71, 0x87, 0x5e, 0xdf, 0xe5, 0x58, 0xa7,
+	0xf7, 0xe1, 0x83, 0xd4, 0x7b, 0x37, 0xb7, 0x90, 0x25, 0x3c, 0x7a, 0x63, 0xb7, 0x27, 0xda, 0xa3,
+	0xfd, 0x61, 0xbe, 0x34, 0xc0, 0x62, 0x69, 0x80, 0x8b, 0xa5, 0x01, 0xf6, 0x57, 0x86, 0xb2, 0x58,
+	0x19, 0xca, 0xd9, 0xca, 0x50, 0xbe, 0x23, 0x32, 0x12, 0xc3, 0x49, 0xdf, 0x1a, 0x50, 0x17, 0xbd,
+	0x93, 0x79, 0x1f, 0xb1, 0xf8, 0x4b, 0xd9, 0x2f, 0x14, 0xdd, 0xef, 0x69, 0xf4, 0x23, 0x98, 0x79,
+	0x98, 0xf7, 0xb3, 0xc1, 0xbd, 0x6c, 0x5d, 0x0d, 0x00, 0xbe, 0x9e, 0xaf, 0xd8, 0x26, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +366,11 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// FreezeOperator freezes the operator through the governance, the completion of its undelegations is
+	// postponed until it's unfrozen.
+	FreezeOperator(ctx context.Context, in *MsgFreezeOperator, opts ...grpc.CallOption) (*MsgFreezeOperatorResponse, error)
+	// UnfreezeOperator resets the frozen status of the operator through the governance.
+	UnfreezeOperator(ctx context.Context, in *MsgUnfreezeOperator, opts ...grpc.CallOption) (*MsgUnfreezeOperatorResponse, error)
 }
 
 type msgClient struct {
@@ -189,9 +390,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) FreezeOperator(ctx context.Context, in *MsgFreezeOperator, opts ...grpc.CallOption) (*MsgFreezeOperatorResponse, error) {
+	out := new(MsgFreezeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.Msg/FreezeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeOperator(ctx context.Context, in *MsgUnfreezeOperator, opts ...grpc.CallOption) (*MsgUnfreezeOperatorResponse, error) {
+	out := new(MsgUnfreezeOperatorResponse)
+	err := c.cc.Invoke(ctx, "/exocore.slash.Msg/UnfreezeOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// FreezeOperator freezes the operator through the governance, the completion of its undelegations is
+	// postponed until it's unfrozen.
+	FreezeOperator(context.Context, *MsgFreezeOperator) (*MsgFreezeOperatorResponse, error)
+	// UnfreezeOperator resets the frozen status of the operator through the governance.
+	UnfreezeOperator(context.Context, *MsgUnfreezeOperator) (*MsgUnfreezeOperatorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -201,6 +425,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) FreezeOperator(ctx context.Context, req *MsgFreezeOperator) (*MsgFreezeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOperator not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeOperator(ctx context.Context, req *MsgUnfreezeOperator) (*MsgUnfreezeOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeOperator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -224,6 +454,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FreezeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.Msg/FreezeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeOperator(ctx, req.(*MsgFreezeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeOperator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.slash.Msg/UnfreezeOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeOperator(ctx, req.(*MsgUnfreezeOperator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.slash.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -232,6 +498,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "FreezeOperator",
+			Handler:    _Msg_FreezeOperator_Handler,
+		},
+		{
+			MethodName: "UnfreezeOperator",
+			Handler:    _Msg_UnfreezeOperator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/slash/tx.proto",
@@ -300,6 +574,126 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFreezeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeOperator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeOperator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeOperator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddr) > 0 {
+		i -= len(m.OperatorAddr)
+		copy(dAtA[i:], m.OperatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -335,10 +729,62 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
+func (m *MsgFreezeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeOperator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
@@ -506,6 +952,334 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFreezeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeOperator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeOperator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeOperator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,6 +25,8 @@ func GetQueryCmd(string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryStakerWithdrawals())
+	cmd.AddCommand(CmdQueryWithdrawal())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryStakerWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-withdrawals <stakerID>",
+		Short: "shows the withdrawal records of the staker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakerWithdrawals(cmd.Context(), &types.QueryStakerWithdrawalsRequest{
+				StakerID:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "staker-withdrawals")
+
+	return cmd
+}
+
+func CmdQueryWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawal <id>",
+		Short: "shows the withdrawal record of the id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Withdrawal(cmd.Context(), &types.QueryWithdrawalRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
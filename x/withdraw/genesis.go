@@ -0,0 +1,36 @@
+package withdraw
+
+import (
+	"github.com/ExocoreNetwork/exocore/x/withdraw/keeper"
+	"github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the withdraw module's state from a provided genesis state.
+// The withdrawal records are indexed again, and the next withdrawal id continues from the largest imported id.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := k.SetWithdrawalParams(ctx, &genState.Params); err != nil {
+		panic(err)
+	}
+	lastID := uint64(0)
+	for i := range genState.Withdrawals {
+		record := genState.Withdrawals[i]
+		k.SetWithdrawalRecord(ctx, &record)
+		if record.Id > lastID {
+			lastID = record.Id
+		}
+	}
+	k.SetLastWithdrawalID(ctx, lastID)
+}
+
+// ExportGenesis returns the withdraw module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.GetWithdrawalParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{
+		Params:      *params,
+		Withdrawals: k.GetAllWithdrawalRecords(ctx),
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	withdrawtype "github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock marks the pending withdrawals that reach the maturity height as claimable,
+// then the assets can be released on the client chain.
+func (k Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	ids := k.GetMaturedWithdrawalIDs(ctx, uint64(ctx.BlockHeight()))
+	for _, id := range ids {
+		record, err := k.GetWithdrawalRecord(ctx, id)
+		if err != nil {
+			panic(err)
+		}
+		record.Status = withdrawtype.WithdrawalClaimable
+		k.SetWithdrawalRecord(ctx, record)
+	}
+	return []abci.ValidatorUpdate{}
+}
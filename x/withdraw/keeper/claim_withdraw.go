@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
 	withdrawtype "github.com/ExocoreNetwork/exocore/x/withdraw/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +16,7 @@ import (
 
 type WithdrawParams struct {
 	ClientChainLzID uint64
+	LzNonce         uint64
 	Action          types.CrossChainOpType
 	AssetsAddress   []byte
 	WithdrawAddress []byte
@@ -117,27 +120,132 @@ func getStakeIDAndAssetID(params *WithdrawParams) (stakeID string, assetID strin
 // 	return nil
 // }
 
-func (k Keeper) Withdraw(ctx sdk.Context, params *WithdrawParams) error {
-	// check event parameter then execute deposit operation
+// Withdraw records a withdrawal request of the staker. The amount is removed from the staker's asset state
+// immediately, so it can't be delegated or withdrawn again, but the withdrawal can only be claimed on the client
+// chain after the maturity height. The request is rejected if the staker has delegated the asset to a frozen operator.
+func (k Keeper) Withdraw(ctx sdk.Context, params *WithdrawParams) (*withdrawtype.WithdrawalRecord, error) {
+	// check event parameter then execute withdraw operation
 	if params.OpAmount.IsNegative() {
-		return errorsmod.Wrap(withdrawtype.ErrWithdrawAmountIsNegative, fmt.Sprintf("the amount is:%s", params.OpAmount))
+		return nil, errorsmod.Wrap(withdrawtype.ErrWithdrawAmountIsNegative, fmt.Sprintf("the amount is:%s", params.OpAmount))
 	}
 	stakeID, assetID := getStakeIDAndAssetID(params)
 
 	// check if asset exist
 	if !k.restakingStateKeeper.IsStakingAsset(ctx, assetID) {
-		return errorsmod.Wrap(withdrawtype.ErrWithdrawAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
+		return nil, errorsmod.Wrap(withdrawtype.ErrWithdrawAssetNotExist, fmt.Sprintf("the assetID is:%s", assetID))
 	}
+	if err := k.restakingStateKeeper.CheckAssetNotPaused(ctx, assetID); err != nil {
+		return nil, err
+	}
+	if err := k.checkDelegatedOperators(ctx, stakeID, assetID); err != nil {
+		return nil, err
+	}
+	// the outflow is recorded before the asset state is updated, the whole withdrawal is reverted by the caller
+	// if any of the following steps fails.
+	if err := k.restakingStateKeeper.CheckAndRecordWithdrawalOutflow(ctx, assetID, params.OpAmount); err != nil {
+		return nil, err
+	}
+	withdrawalParams, err := k.GetWithdrawalParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	changeAmount := types.StakerSingleAssetOrChangeInfo{
 		TotalDepositAmountOrWantChangeValue: params.OpAmount.Neg(),
 		CanWithdrawAmountOrWantChangeValue:  params.OpAmount.Neg(),
 	}
-	err := k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, stakeID, assetID, changeAmount)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err = k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, assetID, params.OpAmount.Neg()); err != nil {
+		return nil, err
+	}
+
+	height := uint64(ctx.BlockHeight())
+	record := &withdrawtype.WithdrawalRecord{
+		Id:             k.GetNextWithdrawalID(ctx),
+		StakerID:       stakeID,
+		AssetID:        assetID,
+		Amount:         params.OpAmount,
+		RequestHeight:  height,
+		MaturityHeight: height + withdrawalParams.WithdrawalDelayBlocks,
+		Status:         withdrawtype.WithdrawalPending,
+	}
+	k.SetWithdrawalRecord(ctx, record)
+	return record, nil
+}
+
+// CancelWithdrawal cancels the pending withdrawal of the staker, the amount is returned to the staker's asset state
+// and released from the withdrawal outflow of the asset.
+func (k Keeper) CancelWithdrawal(ctx sdk.Context, stakerID string, withdrawalID uint64) (*withdrawtype.WithdrawalRecord, error) {
+	record, err := k.GetWithdrawalRecord(ctx, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if record.StakerID != stakerID {
+		return nil, errorsmod.Wrap(withdrawtype.ErrWithdrawalStakerMismatch, fmt.Sprintf("withdrawalID:%d,staker:%s,input staker:%s", withdrawalID, record.StakerID, stakerID))
+	}
+	if record.Status != withdrawtype.WithdrawalPending {
+		return nil, errorsmod.Wrap(withdrawtype.ErrInvalidWithdrawalStatus, fmt.Sprintf("only the pending withdrawal can be cancelled, withdrawalID:%d,status:%s", withdrawalID, record.Status))
+	}
+
+	err = k.restakingStateKeeper.UpdateStakerAssetState(ctx, record.StakerID, record.AssetID, types.StakerSingleAssetOrChangeInfo{
+		TotalDepositAmountOrWantChangeValue: record.Amount,
+		CanWithdrawAmountOrWantChangeValue:  record.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = k.restakingStateKeeper.UpdateStakingAssetTotalAmount(ctx, record.AssetID, record.Amount); err != nil {
+		return nil, err
+	}
+	k.restakingStateKeeper.ReleaseWithdrawalOutflow(ctx, record.AssetID, record.Amount, int64(record.RequestHeight))
+
+	record.Status = withdrawtype.WithdrawalCancelled
+	k.SetWithdrawalRecord(ctx, record)
+	return record, nil
+}
+
+// CompleteWithdrawal marks the claimable withdrawal as completed, it's called when the release of the assets
+// on the client chain is confirmed.
+func (k Keeper) CompleteWithdrawal(ctx sdk.Context, withdrawalID uint64) (*withdrawtype.WithdrawalRecord, error) {
+	record, err := k.GetWithdrawalRecord(ctx, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != withdrawtype.WithdrawalClaimable {
+		return nil, errorsmod.Wrap(withdrawtype.ErrInvalidWithdrawalStatus, fmt.Sprintf("only the claimable withdrawal can be completed, withdrawalID:%d,status:%s", withdrawalID, record.Status))
+	}
+	record.Status = withdrawtype.WithdrawalCompleted
+	k.SetWithdrawalRecord(ctx, record)
+	return record, nil
+}
+
+// checkDelegatedOperators returns an error if any operator that the staker has delegated the asset to is frozen.
+func (k Keeper) checkDelegatedOperators(ctx sdk.Context, stakerID, assetID string) error {
+	delegationInfo, err := k.delegationKeeper.GetDelegationInfo(ctx, stakerID, assetID)
+	if err != nil {
+		if errorsmod.IsOf(err, delegationtype.ErrNoKeyInTheStore) {
+			// the staker hasn't delegated the asset
+			return nil
+		}
 		return err
 	}
+	// the operators are sorted to make the returned error deterministic
+	operators := make([]string, 0, len(delegationInfo.DelegationInfos))
+	for operator := range delegationInfo.DelegationInfos {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+	for _, operator := range operators {
+		opAccAddr, err := sdk.AccAddressFromBech32(operator)
+		if err != nil {
+			return err
+		}
+		if k.slashKeeper.IsOperatorFrozen(ctx, opAccAddr) {
+			return errorsmod.Wrap(withdrawtype.ErrOperatorFrozen, fmt.Sprintf("staker:%s,assetID:%s,operator:%s", stakerID, assetID, operator))
+		}
+	}
 	return nil
 }
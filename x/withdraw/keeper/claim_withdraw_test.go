@@ -33,7 +33,7 @@ func (suite *KeeperTestSuite) TestClaimWithdrawRequest() {
 
 	// test the case that the withdraw asset hasn't registered
 	event.AssetsAddress = usdcAddress[:]
-	err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
 	suite.ErrorContains(err, withdrawtype.ErrWithdrawAssetNotExist.Error())
 
 	assets, err := suite.app.StakingAssetsManageKeeper.GetAllStakingAssetsInfo(suite.ctx)
@@ -50,7 +50,7 @@ func (suite *KeeperTestSuite) TestClaimWithdrawRequest() {
 	}, *info)
 	// test the normal case
 	event.AssetsAddress = usdtAddress[:]
-	err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
 	suite.NoError(err)
 
 	// check state after withdraw
@@ -67,3 +67,53 @@ func (suite *KeeperTestSuite) TestClaimWithdrawRequest() {
 	suite.NoError(err)
 	suite.Equal(sdkmath.NewInt(10), assetInfo.StakingTotalAmount)
 }
+
+func (suite *KeeperTestSuite) TestWithdrawPausedAndCapped() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	err := suite.app.DepositKeeper.Deposit(suite.ctx, &depositKeeper.DepositParams{
+		ClientChainLzID: 101,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, suite.address[:], usdtAddress[:])
+	event := &keeper.WithdrawParams{
+		ClientChainLzID: 101,
+		Action:          types.WithdrawPrinciple,
+		WithdrawAddress: suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(30),
+	}
+
+	// the paused asset can't be withdrawn
+	err = suite.app.StakingAssetsManageKeeper.SetAssetPaused(suite.ctx, assetID, true)
+	suite.NoError(err)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.ErrorIs(err, types.ErrAssetPaused)
+	err = suite.app.StakingAssetsManageKeeper.SetAssetPaused(suite.ctx, assetID, false)
+	suite.NoError(err)
+
+	// the withdrawal exceeding the per-block cap is rejected without changing the asset state
+	err = suite.app.StakingAssetsManageKeeper.SetAssetWithdrawalOutflowCap(suite.ctx, &types.WithdrawalOutflowCap{
+		AssetID:     assetID,
+		PerBlockCap: sdkmath.NewInt(50),
+		PerEpochCap: sdkmath.ZeroInt(),
+	})
+	suite.NoError(err)
+	record, err := suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.NoError(err)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.ErrorIs(err, types.ErrWithdrawalOutflowCapExceeded)
+	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(70), info.TotalDepositAmountOrWantChangeValue)
+
+	// the cancelled withdrawal is released from the outflow
+	_, err = suite.app.WithdrawKeeper.CancelWithdrawal(suite.ctx, stakerID, record.Id)
+	suite.NoError(err)
+	suite.Equal(sdkmath.ZeroInt(), suite.app.StakingAssetsManageKeeper.GetWithdrawalOutflow(suite.ctx, assetID).BlockOutflow)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.NoError(err)
+}
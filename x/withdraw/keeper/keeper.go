@@ -20,6 +20,8 @@ type (
 		// restaking keepers for asset status update
 		restakingStateKeeper restakingkeeper.Keeper
 		depositKeeper        depositkeeper.Keeper
+		delegationKeeper     types.DelegationKeeper
+		slashKeeper          types.SlashKeeper
 	}
 )
 
@@ -28,12 +30,16 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	restakingStateKeeper restakingkeeper.Keeper,
 	depositKeeper depositkeeper.Keeper,
+	delegationKeeper types.DelegationKeeper,
+	slashKeeper types.SlashKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:                  cdc,
 		storeKey:             storeKey,
 		restakingStateKeeper: restakingStateKeeper,
 		depositKeeper:        depositKeeper,
+		delegationKeeper:     delegationKeeper,
+		slashKeeper:          slashKeeper,
 	}
 }
 
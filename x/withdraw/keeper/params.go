@@ -2,6 +2,8 @@ package keeper
 
 import (
 	paramstypes "github.com/ExocoreNetwork/exocore/x/deposit/types"
+	"github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -39,3 +41,28 @@ func (k Keeper) SetParams(ctx sdk.Context, params *paramstypes.Params) error {
 	// return nil
 	return k.depositKeeper.SetParams(ctx, params)
 }
+
+// SetWithdrawalParams sets the parameters of the withdrawal delay, they are stored in the withdraw module
+// while the exoCore LayerZero app parameters are still read from the deposit module.
+func (k Keeper) SetWithdrawalParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	bz := k.cdc.MustMarshal(params)
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetWithdrawalParams returns the parameters of the withdrawal delay
+func (k Keeper) GetWithdrawalParams(ctx sdk.Context) (*types.Params, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParams)
+	value := store.Get(types.ParamsKey)
+	if value == nil {
+		return nil, types.ErrNoParamsKey
+	}
+
+	ret := &types.Params{}
+	k.cdc.MustUnmarshal(value, ret)
+	return ret, nil
+}
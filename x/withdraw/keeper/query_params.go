@@ -13,7 +13,12 @@ func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*typ
 	if err != nil {
 		return nil, err
 	}
+	withdrawalParams, err := k.GetWithdrawalParams(c)
+	if err != nil {
+		return nil, err
+	}
 	return &types.QueryParamsResponse{
-		Params: params,
+		Params:           params,
+		WithdrawalParams: withdrawalParams,
 	}, nil
 }
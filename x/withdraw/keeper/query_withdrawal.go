@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StakerWithdrawals(goCtx context.Context, req *types.QueryStakerWithdrawalsRequest) (*types.QueryStakerWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixStakerWithdrawal, types.GetStakerWithdrawalPrefix(req.StakerID)...))
+	records := make([]types.WithdrawalRecord, 0)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		record, err := k.GetWithdrawalRecord(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		records = append(records, *record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryStakerWithdrawalsResponse{Withdrawals: records, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Withdrawal(goCtx context.Context, req *types.QueryWithdrawalRequest) (*types.QueryWithdrawalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.GetWithdrawalRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryWithdrawalResponse{Withdrawal: record}, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	withdrawtype "github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// This file provides all functions about the withdrawal records state management.
+
+// GetNextWithdrawalID increases and returns the withdrawal id, the first id is 1.
+func (k Keeper) GetNextWithdrawalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	withdrawalID := uint64(1)
+	if value := store.Get(withdrawtype.KeyWithdrawalID); value != nil {
+		withdrawalID = sdk.BigEndianToUint64(value) + 1
+	}
+	store.Set(withdrawtype.KeyWithdrawalID, sdk.Uint64ToBigEndian(withdrawalID))
+	return withdrawalID
+}
+
+// SetWithdrawalRecord stores the withdrawal record and updates its indexes. The record is indexed by the
+// maturity height only when it's pending.
+func (k Keeper) SetWithdrawalRecord(ctx sdk.Context, record *withdrawtype.WithdrawalRecord) {
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, withdrawtype.KeyPrefixWithdrawalRecord)
+	recordStore.Set(sdk.Uint64ToBigEndian(record.Id), k.cdc.MustMarshal(record))
+
+	stakerStore := prefix.NewStore(store, withdrawtype.KeyPrefixStakerWithdrawal)
+	stakerStore.Set(withdrawtype.GetStakerWithdrawalKey(record.StakerID, record.Id), []byte{})
+
+	waitMatureStore := prefix.NewStore(store, withdrawtype.KeyPrefixWaitMatureWithdrawal)
+	waitMatureKey := withdrawtype.GetWaitMatureWithdrawalKey(record.MaturityHeight, record.Id)
+	if record.Status == withdrawtype.WithdrawalPending {
+		waitMatureStore.Set(waitMatureKey, []byte{})
+	} else {
+		waitMatureStore.Delete(waitMatureKey)
+	}
+}
+
+func (k Keeper) GetWithdrawalRecord(ctx sdk.Context, withdrawalID uint64) (*withdrawtype.WithdrawalRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), withdrawtype.KeyPrefixWithdrawalRecord)
+	value := store.Get(sdk.Uint64ToBigEndian(withdrawalID))
+	if value == nil {
+		return nil, errorsmod.Wrap(withdrawtype.ErrWithdrawalNotExist, fmt.Sprintf("the withdrawalID is:%d", withdrawalID))
+	}
+	var record withdrawtype.WithdrawalRecord
+	k.cdc.MustUnmarshal(value, &record)
+	return &record, nil
+}
+
+// GetMaturedWithdrawalIDs returns the ids of the pending withdrawals whose maturity height isn't greater than the input height.
+func (k Keeper) GetMaturedWithdrawalIDs(ctx sdk.Context, height uint64) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), withdrawtype.KeyPrefixWaitMatureWithdrawal)
+	// the end key is exclusive, so the height is increased by one
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iterator.Close()
+
+	ids := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()[8:]))
+	}
+	return ids
+}
+
+// GetAllWithdrawalRecords returns all withdrawal records ordered by id, it's used to export the genesis state.
+func (k Keeper) GetAllWithdrawalRecords(ctx sdk.Context) []withdrawtype.WithdrawalRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), withdrawtype.KeyPrefixWithdrawalRecord)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ret := make([]withdrawtype.WithdrawalRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record withdrawtype.WithdrawalRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		ret = append(ret, record)
+	}
+	return ret
+}
+
+// SetLastWithdrawalID sets the last used withdrawal id, it's used to import the genesis state.
+func (k Keeper) SetLastWithdrawalID(ctx sdk.Context, withdrawalID uint64) {
+	ctx.KVStore(k.storeKey).Set(withdrawtype.KeyWithdrawalID, sdk.Uint64ToBigEndian(withdrawalID))
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	delegationkeeper "github.com/ExocoreNetwork/exocore/x/delegation/keeper"
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
+	depositkeeper "github.com/ExocoreNetwork/exocore/x/deposit/keeper"
+	"github.com/ExocoreNetwork/exocore/x/restaking_assets_manage/types"
+	"github.com/ExocoreNetwork/exocore/x/withdraw/keeper"
+	withdrawtype "github.com/ExocoreNetwork/exocore/x/withdraw/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawalLifecycle() {
+	usdtAddress := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	err := suite.app.WithdrawKeeper.SetWithdrawalParams(suite.ctx, &withdrawtype.Params{WithdrawalDelayBlocks: 10})
+	suite.NoError(err)
+	err = suite.app.DepositKeeper.Deposit(suite.ctx, &depositkeeper.DepositParams{
+		ClientChainLzID: 101,
+		Action:          types.Deposit,
+		StakerAddress:   suite.address[:],
+		AssetsAddress:   usdtAddress[:],
+		OpAmount:        sdkmath.NewInt(100),
+	})
+	suite.NoError(err)
+	stakerID, assetID := types.GetStakeIDAndAssetID(101, suite.address[:], usdtAddress[:])
+
+	event := &keeper.WithdrawParams{
+		ClientChainLzID: 101,
+		Action:          types.WithdrawPrinciple,
+		AssetsAddress:   usdtAddress[:],
+		WithdrawAddress: suite.address[:],
+		OpAmount:        sdkmath.NewInt(30),
+	}
+	record, err := suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.NoError(err)
+	startHeight := uint64(suite.ctx.BlockHeight())
+	suite.Equal(uint64(1), record.Id)
+	suite.Equal(startHeight+10, record.MaturityHeight)
+	suite.Equal(withdrawtype.WithdrawalPending, record.Status)
+
+	// the pending withdrawal can be cancelled by its staker
+	_, err = suite.app.WithdrawKeeper.CancelWithdrawal(suite.ctx, "0x01_0x65", record.Id)
+	suite.ErrorIs(err, withdrawtype.ErrWithdrawalStakerMismatch)
+	record, err = suite.app.WithdrawKeeper.CancelWithdrawal(suite.ctx, stakerID, record.Id)
+	suite.NoError(err)
+	suite.Equal(withdrawtype.WithdrawalCancelled, record.Status)
+	info, err := suite.app.StakingAssetsManageKeeper.GetStakerSpecifiedAssetInfo(suite.ctx, stakerID, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(100), info.TotalDepositAmountOrWantChangeValue)
+	suite.Equal(sdkmath.NewInt(100), info.CanWithdrawAmountOrWantChangeValue)
+	_, err = suite.app.WithdrawKeeper.CancelWithdrawal(suite.ctx, stakerID, record.Id)
+	suite.ErrorIs(err, withdrawtype.ErrInvalidWithdrawalStatus)
+
+	record, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.NoError(err)
+	suite.Equal(uint64(2), record.Id)
+	assetInfo, err := suite.app.StakingAssetsManageKeeper.GetStakingAssetInfo(suite.ctx, assetID)
+	suite.NoError(err)
+	suite.Equal(sdkmath.NewInt(70), assetInfo.StakingTotalAmount)
+
+	// the withdrawal can't be completed before it's claimable
+	_, err = suite.app.WithdrawKeeper.CompleteWithdrawal(suite.ctx, record.Id)
+	suite.ErrorIs(err, withdrawtype.ErrInvalidWithdrawalStatus)
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(record.MaturityHeight) - 1)
+	suite.app.WithdrawKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	record, err = suite.app.WithdrawKeeper.GetWithdrawalRecord(suite.ctx, record.Id)
+	suite.NoError(err)
+	suite.Equal(withdrawtype.WithdrawalPending, record.Status)
+
+	suite.ctx = suite.ctx.WithBlockHeight(int64(record.MaturityHeight))
+	suite.app.WithdrawKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{})
+	record, err = suite.app.WithdrawKeeper.GetWithdrawalRecord(suite.ctx, record.Id)
+	suite.NoError(err)
+	suite.Equal(withdrawtype.WithdrawalClaimable, record.Status)
+
+	// the matured withdrawal can't be cancelled
+	_, err = suite.app.WithdrawKeeper.CancelWithdrawal(suite.ctx, stakerID, record.Id)
+	suite.ErrorIs(err, withdrawtype.ErrInvalidWithdrawalStatus)
+	record, err = suite.app.WithdrawKeeper.CompleteWithdrawal(suite.ctx, record.Id)
+	suite.NoError(err)
+	suite.Equal(withdrawtype.WithdrawalCompleted, record.Status)
+	_, err = suite.app.WithdrawKeeper.CompleteWithdrawal(suite.ctx, record.Id)
+	suite.ErrorIs(err, withdrawtype.ErrInvalidWithdrawalStatus)
+	_, err = suite.app.WithdrawKeeper.CompleteWithdrawal(suite.ctx, 100)
+	suite.ErrorIs(err, withdrawtype.ErrWithdrawalNotExist)
+
+	res, err := suite.app.WithdrawKeeper.StakerWithdrawals(suite.ctx, &withdrawtype.QueryStakerWithdrawalsRequest{StakerID: stakerID})
+	suite.NoError(err)
+	suite.Equal(2, len(res.Withdrawals))
+	suite.Equal(withdrawtype.WithdrawalCancelled, res.Withdrawals[0].Status)
+	suite.Equal(withdrawtype.WithdrawalCompleted, res.Withdrawals[1].Status)
+
+	// the delegators of a frozen operator can't withdraw
+	opAccAddr, err := sdk.AccAddressFromBech32("evmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3h6cprl")
+	suite.NoError(err)
+	_, err = suite.app.DelegationKeeper.RegisterOperator(suite.ctx, &delegationtype.RegisterOperatorReq{
+		FromAddress: opAccAddr.String(),
+		Info: &delegationtype.OperatorInfo{
+			EarningsAddr: opAccAddr.String(),
+		},
+	})
+	suite.NoError(err)
+	err = suite.app.DelegationKeeper.DelegateTo(suite.ctx, &delegationkeeper.DelegationOrUndelegationParams{
+		ClientChainLzID: 101,
+		Action:          types.DelegateTo,
+		AssetsAddress:   usdtAddress[:],
+		OperatorAddress: opAccAddr,
+		StakerAddress:   suite.address[:],
+		OpAmount:        sdkmath.NewInt(10),
+	})
+	suite.NoError(err)
+	err = suite.app.ExoSlashKeeper.SetFrozenStatus(suite.ctx, opAccAddr.String(), true)
+	suite.NoError(err)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.ErrorIs(err, withdrawtype.ErrOperatorFrozen)
+
+	err = suite.app.ExoSlashKeeper.SetFrozenStatus(suite.ctx, opAccAddr.String(), false)
+	suite.NoError(err)
+	_, err = suite.app.WithdrawKeeper.Withdraw(suite.ctx, event)
+	suite.NoError(err)
+}
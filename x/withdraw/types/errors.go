@@ -14,4 +14,9 @@ var (
 	ErrNoParamsKey              = errorsmod.Register(ModuleName, 2, "there is no stored key for params")
 	ErrWithdrawAmountIsNegative = errorsmod.Register(ModuleName, 3, "the withdraw amount is negative")
 	ErrWithdrawAssetNotExist    = errorsmod.Register(ModuleName, 4, "the withdraw asset doesn't exist")
+	ErrWithdrawalNotExist       = errorsmod.Register(ModuleName, 5, "the withdrawal record doesn't exist")
+	ErrInvalidWithdrawalStatus  = errorsmod.Register(ModuleName, 6, "the status of the withdrawal record doesn't allow the operation")
+	ErrOperatorFrozen           = errorsmod.Register(ModuleName, 7, "the staker has delegated the asset to a frozen operator")
+	ErrWithdrawalStakerMismatch = errorsmod.Register(ModuleName, 8, "the withdrawal record doesn't belong to the staker")
+	ErrInvalidGenesisData       = errorsmod.Register(ModuleName, 9, "the genesis data supplied is invalid")
 )
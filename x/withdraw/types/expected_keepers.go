@@ -1,6 +1,7 @@
 package types
 
 import (
+	delegationtype "github.com/ExocoreNetwork/exocore/x/delegation/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -16,3 +17,13 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
+
+// DelegationKeeper defines the expected delegation keeper used to check the operators delegated to by the staker.
+type DelegationKeeper interface {
+	GetDelegationInfo(ctx sdk.Context, stakerID, assetID string) (*delegationtype.QueryDelegationInfoResponse, error)
+}
+
+// SlashKeeper defines the expected slash keeper used to check the frozen status of the operators.
+type SlashKeeper interface {
+	IsOperatorFrozen(ctx sdk.Context, opAddr sdk.AccAddress) bool
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
 )
 
 // DefaultIndex is the default global index
@@ -10,15 +12,26 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:      DefaultParams(),
+		Withdrawals: []WithdrawalRecord{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// this line is used by starport scaffolding # genesis/types/validate
-
+	ids := make(map[uint64]struct{}, len(gs.Withdrawals))
+	for _, record := range gs.Withdrawals {
+		if _, ok := ids[record.Id]; ok {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("duplicate withdrawal id:%d", record.Id))
+		}
+		ids[record.Id] = struct{}{}
+		if record.Id == 0 || record.StakerID == "" || record.AssetID == "" {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("invalid withdrawal record:%v", record))
+		}
+		if record.Amount.IsNil() || !record.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidGenesisData, fmt.Sprintf("the withdrawal amount isn't positive, id:%d", record.Id))
+		}
+	}
 	return gs.Params.Validate()
 }
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "withdraw"
@@ -17,9 +21,37 @@ func KeyPrefix(p string) []byte {
 
 const (
 	prefixParams = iota + 1
+	prefixWithdrawalRecord
+	prefixStakerWithdrawal
+	prefixWaitMatureWithdrawal
+	prefixWithdrawalID
 )
 
 var (
 	ParamsKey       = []byte("WithdrawParams")
 	KeyPrefixParams = []byte{prefixParams}
+	// KeyPrefixWithdrawalRecord key-value: bigEndian(withdrawalID)->WithdrawalRecord
+	KeyPrefixWithdrawalRecord = []byte{prefixWithdrawalRecord}
+	// KeyPrefixStakerWithdrawal key-value: stakerID+'/'+bigEndian(withdrawalID)->struct{}
+	KeyPrefixStakerWithdrawal = []byte{prefixStakerWithdrawal}
+	// KeyPrefixWaitMatureWithdrawal key-value: bigEndian(maturityHeight)+bigEndian(withdrawalID)->struct{}
+	// only the pending withdrawals are indexed, so they can be marked as claimable in the EndBlock.
+	KeyPrefixWaitMatureWithdrawal = []byte{prefixWaitMatureWithdrawal}
+	// KeyWithdrawalID key-value: key->the last withdrawalID
+	KeyWithdrawalID = []byte{prefixWithdrawalID}
 )
+
+// GetStakerWithdrawalPrefix returns the prefix used to iterate the withdrawals of the staker.
+func GetStakerWithdrawalPrefix(stakerID string) []byte {
+	return []byte(stakerID + "/")
+}
+
+// GetStakerWithdrawalKey The withdrawalID is encoded in big endian, so the withdrawals of a staker can be iterated in order.
+func GetStakerWithdrawalKey(stakerID string, withdrawalID uint64) []byte {
+	return append(GetStakerWithdrawalPrefix(stakerID), sdk.Uint64ToBigEndian(withdrawalID)...)
+}
+
+// GetWaitMatureWithdrawalKey The height is encoded in big endian, so the withdrawals can be iterated by the maturity height.
+func GetWaitMatureWithdrawalKey(maturityHeight, withdrawalID uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(maturityHeight), sdk.Uint64ToBigEndian(withdrawalID)...)
+}
@@ -4,6 +4,9 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// DefaultWithdrawalDelayBlocks is the default number of blocks that a withdrawal request waits before it's claimable
+const DefaultWithdrawalDelayBlocks = uint64(100)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // ParamKeyTable the param key table for launch module
@@ -12,13 +15,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(withdrawalDelayBlocks uint64) Params {
+	return Params{
+		WithdrawalDelayBlocks: withdrawalDelayBlocks,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultWithdrawalDelayBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -26,7 +31,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{}
 }
 
-// Validate validates the set of params
+// Validate validates the set of params, a zero delay means the withdrawal is claimable at the end of
+// the block in which it's requested.
 func (p Params) Validate() error {
 	return nil
 }
@@ -27,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	ExoCoreLzAppAddress    string `protobuf:"bytes,1,opt,name=exoCoreLzAppAddress,proto3" json:"exoCoreLzAppAddress,omitempty"`
 	ExoCoreLzAppEventTopic string `protobuf:"bytes,2,opt,name=exoCoreLzAppEventTopic,proto3" json:"exoCoreLzAppEventTopic,omitempty"`
+	// withdrawalDelayBlocks is the number of blocks that a withdrawal request needs to wait
+	// before it becomes claimable.
+	WithdrawalDelayBlocks uint64 `protobuf:"varint,3,opt,name=withdrawalDelayBlocks,proto3" json:"withdrawalDelayBlocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -76,6 +79,13 @@ func (m *Params) GetExoCoreLzAppEventTopic() string {
 	return ""
 }
 
+func (m *Params) GetWithdrawalDelayBlocks() uint64 {
+	if m != nil {
+		return m.WithdrawalDelayBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "exocore.withdraw.Params")
 }
@@ -83,19 +93,22 @@ func init() {
 func init() { proto.RegisterFile("exocore/withdraw/params.proto", fileDescriptor_35a6d42797266547) }
 
 var fileDescriptor_35a6d42797266547 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
+	// 230 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xad, 0xc8, 0x4f,
 	0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0xd7, 0x2f, 0x48, 0x2c,
 	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x52, 0x11, 0x17,
-	0x5b, 0x00, 0x58, 0x9f, 0x90, 0x01, 0x97, 0x70, 0x6a, 0x45, 0xbe, 0x73, 0x7e, 0x51, 0xaa, 0x4f,
-	0x95, 0x63, 0x41, 0x81, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0x67, 0x10, 0x36, 0x29, 0x21, 0x33, 0x2e, 0x31, 0x64, 0x61, 0xd7, 0xb2, 0xd4, 0xbc, 0x92, 0x90,
-	0xfc, 0x82, 0xcc, 0x64, 0x09, 0x26, 0xb0, 0x26, 0x1c, 0xb2, 0x4e, 0xb6, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9c, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0x0f, 0xf3, 0x5f, 0x05, 0xc2, 0x87, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x97, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x56, 0x86, 0xd8, 0xa9, 0x02, 0x01, 0x00, 0x00,
+	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0xd2, 0x0a, 0x46,
+	0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x03, 0x2e, 0xe1, 0xd4, 0x8a, 0x7c, 0xe7, 0xfc, 0xa2, 0x54,
+	0x9f, 0x2a, 0xc7, 0x82, 0x02, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46,
+	0x0d, 0xce, 0x20, 0x6c, 0x52, 0x42, 0x66, 0x5c, 0x62, 0xc8, 0xc2, 0xae, 0x65, 0xa9, 0x79, 0x25,
+	0x21, 0xf9, 0x05, 0x99, 0xc9, 0x12, 0x4c, 0x60, 0x4d, 0x38, 0x64, 0x85, 0x4c, 0xb8, 0x44, 0x61,
+	0xce, 0x4a, 0xcc, 0x71, 0x49, 0xcd, 0x49, 0xac, 0x74, 0xca, 0xc9, 0x4f, 0xce, 0x2e, 0x96, 0x60,
+	0x56, 0x60, 0xd4, 0x60, 0x09, 0xc2, 0x2e, 0xe9, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
+	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
+	0xc7, 0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
+	0xae, 0x10, 0x7f, 0xfb, 0xa5, 0x96, 0x94, 0xe7, 0x17, 0x65, 0xeb, 0xc3, 0x42, 0xa9, 0x02, 0x11,
+	0x4e, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xff, 0x1b, 0x03, 0x06, 0x00, 0x94, 0xb2,
+	0x1d, 0x53, 0x48, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -118,6 +131,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithdrawalDelayBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WithdrawalDelayBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ExoCoreLzAppEventTopic) > 0 {
 		i -= len(m.ExoCoreLzAppEventTopic)
 		copy(dAtA[i:], m.ExoCoreLzAppEventTopic)
@@ -160,6 +178,9 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.WithdrawalDelayBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WithdrawalDelayBlocks))
+	}
 	return n
 }
 
@@ -262,6 +283,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ExoCoreLzAppEventTopic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelayBlocks", wireType)
+			}
+			m.WithdrawalDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7,7 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/ExocoreNetwork/exocore/x/deposit/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -72,6 +72,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params *types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// withdrawalParams holds the parameters of the withdrawal delay.
+	WithdrawalParams *Params `protobuf:"bytes,2,opt,name=withdrawalParams,proto3" json:"withdrawalParams,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -114,35 +116,258 @@ func (m *QueryParamsResponse) GetParams() *types.Params {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetWithdrawalParams() *Params {
+	if m != nil {
+		return m.WithdrawalParams
+	}
+	return nil
+}
+
+// QueryStakerWithdrawalsRequest is request type for the Query/StakerWithdrawals RPC method.
+type QueryStakerWithdrawalsRequest struct {
+	StakerID   string             `protobuf:"bytes,1,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakerWithdrawalsRequest) Reset()         { *m = QueryStakerWithdrawalsRequest{} }
+func (m *QueryStakerWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryStakerWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59bca5e59812c328, []int{2}
+}
+func (m *QueryStakerWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryStakerWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerWithdrawalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryStakerWithdrawalsRequest) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *QueryStakerWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStakerWithdrawalsResponse is response type for the Query/StakerWithdrawals RPC method.
+type QueryStakerWithdrawalsResponse struct {
+	Withdrawals []WithdrawalRecord  `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStakerWithdrawalsResponse) Reset()         { *m = QueryStakerWithdrawalsResponse{} }
+func (m *QueryStakerWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakerWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryStakerWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59bca5e59812c328, []int{3}
+}
+func (m *QueryStakerWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakerWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakerWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakerWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakerWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryStakerWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakerWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakerWithdrawalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakerWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryStakerWithdrawalsResponse) GetWithdrawals() []WithdrawalRecord {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+func (m *QueryStakerWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWithdrawalRequest is request type for the Query/Withdrawal RPC method.
+type QueryWithdrawalRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryWithdrawalRequest) Reset()         { *m = QueryWithdrawalRequest{} }
+func (m *QueryWithdrawalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalRequest) ProtoMessage()    {}
+func (*QueryWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59bca5e59812c328, []int{4}
+}
+func (m *QueryWithdrawalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalRequest.Merge(m, src)
+}
+func (m *QueryWithdrawalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawalRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryWithdrawalResponse is response type for the Query/Withdrawal RPC method.
+type QueryWithdrawalResponse struct {
+	Withdrawal *WithdrawalRecord `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+}
+
+func (m *QueryWithdrawalResponse) Reset()         { *m = QueryWithdrawalResponse{} }
+func (m *QueryWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawalResponse) ProtoMessage()    {}
+func (*QueryWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59bca5e59812c328, []int{5}
+}
+func (m *QueryWithdrawalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawalResponse.Merge(m, src)
+}
+func (m *QueryWithdrawalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawalResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawalResponse) GetWithdrawal() *WithdrawalRecord {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "exocore.withdraw.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "exocore.withdraw.QueryParamsResponse")
+	proto.RegisterType((*QueryStakerWithdrawalsRequest)(nil), "exocore.withdraw.QueryStakerWithdrawalsRequest")
+	proto.RegisterType((*QueryStakerWithdrawalsResponse)(nil), "exocore.withdraw.QueryStakerWithdrawalsResponse")
+	proto.RegisterType((*QueryWithdrawalRequest)(nil), "exocore.withdraw.QueryWithdrawalRequest")
+	proto.RegisterType((*QueryWithdrawalResponse)(nil), "exocore.withdraw.QueryWithdrawalResponse")
 }
 
 func init() { proto.RegisterFile("exocore/withdraw/query.proto", fileDescriptor_59bca5e59812c328) }
 
 var fileDescriptor_59bca5e59812c328 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x7b, 0x82, 0x1d, 0xe2, 0x22, 0xb1, 0x43, 0x39, 0x4a, 0x28, 0x45, 0x41, 0x1c, 0x12,
-	0xee, 0xfc, 0x06, 0x82, 0x83, 0x20, 0xa2, 0x8e, 0x6e, 0xb9, 0xf6, 0x91, 0x06, 0xed, 0xbd, 0x34,
-	0x49, 0x7b, 0xed, 0x26, 0x7e, 0x02, 0xc1, 0x2f, 0xe5, 0x58, 0x70, 0x71, 0x94, 0x9e, 0x1f, 0x44,
-	0x7a, 0xb9, 0x53, 0xb4, 0x83, 0x5b, 0x78, 0xff, 0xdf, 0xfb, 0xf1, 0xcf, 0x23, 0x3d, 0x58, 0xe0,
-	0x10, 0x2d, 0x88, 0x42, 0xfb, 0xf1, 0xc8, 0xca, 0x42, 0x4c, 0x67, 0x60, 0x97, 0xdc, 0x58, 0xf4,
-	0x48, 0xf7, 0xeb, 0x94, 0x37, 0x69, 0xdc, 0x51, 0xa8, 0xb0, 0x0a, 0xc5, 0xe6, 0x15, 0xb8, 0xb8,
-	0xa7, 0x10, 0xd5, 0x03, 0x08, 0x69, 0xb4, 0x90, 0x79, 0x8e, 0x5e, 0x7a, 0x8d, 0xb9, 0xab, 0xd3,
-	0x93, 0x21, 0xba, 0x09, 0x3a, 0x91, 0x49, 0x07, 0x41, 0x2f, 0xe6, 0x49, 0x06, 0x5e, 0x26, 0xc2,
-	0x48, 0xa5, 0xf3, 0x0a, 0xae, 0xd9, 0x7e, 0xd3, 0x67, 0x04, 0x06, 0x9d, 0xf6, 0x62, 0x9e, 0x34,
-	0xcf, 0x40, 0x0c, 0x3a, 0x84, 0xde, 0x6c, 0x1c, 0xd7, 0xd2, 0xca, 0x89, 0xbb, 0x85, 0xe9, 0x0c,
-	0x9c, 0x1f, 0x5c, 0x90, 0x83, 0x5f, 0x53, 0x67, 0x30, 0x77, 0x40, 0x53, 0xd2, 0x36, 0xd5, 0xa4,
-	0x1b, 0xf5, 0xa3, 0xe3, 0xbd, 0x34, 0xe6, 0xcd, 0x8f, 0x1a, 0xe9, 0x3c, 0xe1, 0xf5, 0x4e, 0x4d,
-	0xa6, 0x8f, 0x11, 0xd9, 0xad, 0x5c, 0xb4, 0x20, 0xed, 0x90, 0xd1, 0x43, 0xfe, 0xf7, 0x12, 0x7c,
-	0xbb, 0x44, 0x7c, 0xf4, 0x0f, 0x15, 0x4a, 0x0d, 0xfa, 0x4f, 0x6f, 0x9f, 0x2f, 0x3b, 0x31, 0xed,
-	0x8a, 0xad, 0xe3, 0x87, 0x0a, 0x67, 0x97, 0xaf, 0x6b, 0x16, 0xad, 0xd6, 0x2c, 0xfa, 0x58, 0xb3,
-	0xe8, 0xb9, 0x64, 0xad, 0x55, 0xc9, 0x5a, 0xef, 0x25, 0x6b, 0xdd, 0xa5, 0x4a, 0xfb, 0xf1, 0x2c,
-	0xe3, 0x43, 0x9c, 0x88, 0xf3, 0xb0, 0x7d, 0x05, 0xbe, 0x40, 0x7b, 0xff, 0x2d, 0x5b, 0xfc, 0xe8,
-	0xfc, 0xd2, 0x80, 0xcb, 0xda, 0xd5, 0xe1, 0x4e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xea, 0xd2,
-	0x25, 0x41, 0xec, 0x01, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xb4, 0x4f, 0xf4, 0x30, 0x91, 0x50, 0x59, 0x2a, 0x88, 0xac, 0xd6, 0xa4, 0xe6,
+	0x2d, 0xe5, 0xe0, 0x25, 0xe1, 0xc0, 0x3d, 0x2a, 0x20, 0x10, 0x42, 0xc5, 0x1c, 0x90, 0x90, 0x10,
+	0xda, 0xc4, 0x2b, 0xd7, 0x6a, 0x93, 0x75, 0xbd, 0x9b, 0xa4, 0x55, 0xd5, 0x0b, 0x5c, 0x91, 0x40,
+	0xe2, 0x83, 0x70, 0xe2, 0x03, 0x70, 0xeb, 0xb1, 0x12, 0x17, 0x4e, 0x08, 0x25, 0x7c, 0x10, 0x94,
+	0x7d, 0x89, 0x53, 0x16, 0xd3, 0xdc, 0x36, 0x99, 0xf9, 0xff, 0xe7, 0xb7, 0x33, 0xe3, 0x85, 0x35,
+	0x7a, 0xc0, 0xba, 0x2c, 0xa3, 0x78, 0x94, 0x88, 0x9d, 0x28, 0x23, 0x23, 0xbc, 0x3f, 0xa0, 0xd9,
+	0x61, 0x90, 0x66, 0x4c, 0x30, 0xb4, 0xa2, 0xa3, 0x81, 0x89, 0xba, 0xab, 0x31, 0x8b, 0x99, 0x0c,
+	0xe2, 0xe9, 0x49, 0xe5, 0xb9, 0x6b, 0x31, 0x63, 0xf1, 0x1e, 0xc5, 0x24, 0x4d, 0x30, 0xe9, 0xf7,
+	0x99, 0x20, 0x22, 0x61, 0x7d, 0xae, 0xa3, 0x77, 0xba, 0x8c, 0xf7, 0x18, 0xc7, 0x1d, 0xc2, 0xa9,
+	0xb2, 0xc7, 0xc3, 0x66, 0x87, 0x0a, 0xd2, 0xc4, 0x29, 0x89, 0x93, 0xbe, 0x4c, 0xd6, 0xb9, 0x75,
+	0xc3, 0x13, 0xd1, 0x94, 0xf1, 0x44, 0xe0, 0x61, 0xd3, 0x1c, 0x75, 0xc6, 0xba, 0x45, 0x9c, 0x92,
+	0x8c, 0xf4, 0x4c, 0xb1, 0x0d, 0x2b, 0x6c, 0x0e, 0x64, 0x4f, 0xa5, 0xf8, 0xab, 0x80, 0x9e, 0x4f,
+	0x29, 0xb6, 0xa5, 0x2e, 0xa4, 0xfb, 0x03, 0xca, 0x85, 0xff, 0xc1, 0x81, 0xcb, 0x67, 0xfe, 0xe6,
+	0x29, 0xeb, 0x73, 0x8a, 0x5a, 0x50, 0x51, 0x05, 0x6a, 0x4e, 0xdd, 0x69, 0x54, 0x5b, 0x6e, 0x60,
+	0x9a, 0x62, 0xb8, 0x86, 0xcd, 0x40, 0x6b, 0x74, 0x26, 0xda, 0x82, 0x95, 0xbc, 0xaa, 0x8a, 0xd5,
+	0xca, 0x52, 0x5d, 0x0b, 0xfe, 0x6c, 0xa9, 0xd1, 0x5a, 0x0a, 0xff, 0x9d, 0x03, 0xeb, 0x92, 0xe8,
+	0x85, 0x20, 0xbb, 0x34, 0x7b, 0x39, 0x8b, 0x1b, 0x66, 0xe4, 0xc2, 0xff, 0x5c, 0xc6, 0x1e, 0x6f,
+	0x49, 0xba, 0x0b, 0xe1, 0xec, 0x37, 0x7a, 0x08, 0x90, 0x77, 0x57, 0x57, 0xbf, 0x15, 0xa8, 0x51,
+	0x04, 0xd3, 0x51, 0x04, 0x6a, 0xd2, 0x7a, 0x14, 0xc1, 0x36, 0x89, 0xa9, 0xf6, 0x0d, 0xe7, 0x94,
+	0xfe, 0x17, 0x07, 0xbc, 0x22, 0x0a, 0xdd, 0xa2, 0x27, 0x50, 0xcd, 0xe1, 0xa7, 0x7d, 0x5a, 0x6a,
+	0x54, 0x5b, 0xbe, 0x7d, 0xd3, 0x5c, 0x1b, 0xd2, 0x2e, 0xcb, 0xa2, 0xf6, 0xf2, 0xc9, 0x8f, 0x6b,
+	0xa5, 0x70, 0x5e, 0x8c, 0x1e, 0xfd, 0x05, 0xfb, 0xf6, 0xb9, 0xd8, 0x0a, 0xe4, 0x0c, 0x77, 0x03,
+	0xae, 0x48, 0xec, 0xf9, 0xa2, 0xaa, 0x6b, 0x17, 0xa1, 0x9c, 0x44, 0xb2, 0x5f, 0xcb, 0x61, 0x39,
+	0x89, 0xfc, 0xd7, 0x70, 0xd5, 0xca, 0xd4, 0x37, 0x6b, 0x03, 0xe4, 0x70, 0x7a, 0x01, 0x16, 0xb8,
+	0x58, 0x38, 0xa7, 0x6a, 0x7d, 0x5d, 0x82, 0xff, 0xa4, 0x3f, 0x1a, 0x41, 0x45, 0x8d, 0x16, 0xdd,
+	0xb0, 0x3d, 0xec, 0x95, 0x74, 0x6f, 0x9e, 0x93, 0xa5, 0x20, 0xfd, 0xfa, 0xdb, 0x6f, 0xbf, 0x3e,
+	0x95, 0x5d, 0x54, 0xc3, 0x05, 0x9f, 0x06, 0xfa, 0xec, 0xc0, 0x25, 0x6b, 0x7c, 0x08, 0x17, 0xd8,
+	0x17, 0xad, 0x9b, 0x7b, 0x77, 0x71, 0x81, 0x46, 0xbb, 0x2f, 0xd1, 0x9a, 0x08, 0xdb, 0x68, 0x6a,
+	0x51, 0xdf, 0xcc, 0xcd, 0x1e, 0x1f, 0x99, 0xe5, 0x3d, 0x46, 0xef, 0x1d, 0x80, 0xdc, 0x10, 0x35,
+	0x0a, 0x2a, 0x5b, 0xc3, 0x75, 0x37, 0x17, 0xc8, 0xd4, 0x70, 0x9b, 0x12, 0xee, 0x3a, 0xda, 0xc0,
+	0xff, 0x78, 0x33, 0xf0, 0x51, 0x12, 0x1d, 0xb7, 0x9f, 0x9e, 0x8c, 0x3d, 0xe7, 0x74, 0xec, 0x39,
+	0x3f, 0xc7, 0x9e, 0xf3, 0x71, 0xe2, 0x95, 0x4e, 0x27, 0x5e, 0xe9, 0xfb, 0xc4, 0x2b, 0xbd, 0x6a,
+	0xc5, 0x89, 0xd8, 0x19, 0x74, 0x82, 0x2e, 0xeb, 0xe1, 0x07, 0xca, 0xe6, 0x19, 0x15, 0x23, 0x96,
+	0xed, 0xce, 0x5c, 0x0f, 0x72, 0x5f, 0x71, 0x98, 0x52, 0xde, 0xa9, 0xc8, 0x77, 0xe8, 0xde, 0xef,
+	0x01, 0x00, 0x62, 0x61, 0xd4, 0xf7, 0x7d, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -159,6 +384,10 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// StakerWithdrawals queries the withdrawal records of the staker, they are ordered by the record id.
+	StakerWithdrawals(ctx context.Context, in *QueryStakerWithdrawalsRequest, opts ...grpc.CallOption) (*QueryStakerWithdrawalsResponse, error)
+	// Withdrawal queries the withdrawal record by its id.
+	Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error)
 }
 
 type queryClient struct {
@@ -178,10 +407,32 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) StakerWithdrawals(ctx context.Context, in *QueryStakerWithdrawalsRequest, opts ...grpc.CallOption) (*QueryStakerWithdrawalsResponse, error) {
+	out := new(QueryStakerWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/exocore.withdraw.Query/StakerWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Withdrawal(ctx context.Context, in *QueryWithdrawalRequest, opts ...grpc.CallOption) (*QueryWithdrawalResponse, error) {
+	out := new(QueryWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/exocore.withdraw.Query/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// StakerWithdrawals queries the withdrawal records of the staker, they are ordered by the record id.
+	StakerWithdrawals(context.Context, *QueryStakerWithdrawalsRequest) (*QueryStakerWithdrawalsResponse, error)
+	// Withdrawal queries the withdrawal record by its id.
+	Withdrawal(context.Context, *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -191,6 +442,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) StakerWithdrawals(ctx context.Context, req *QueryStakerWithdrawalsRequest) (*QueryStakerWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakerWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) Withdrawal(ctx context.Context, req *QueryWithdrawalRequest) (*QueryWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -214,6 +471,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StakerWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakerWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakerWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.withdraw.Query/StakerWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakerWithdrawals(ctx, req.(*QueryStakerWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/exocore.withdraw.Query/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Withdrawal(ctx, req.(*QueryWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "exocore.withdraw.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -222,6 +515,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "StakerWithdrawals",
+			Handler:    _Query_StakerWithdrawals_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Query_Withdrawal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "exocore/withdraw/query.proto",
@@ -270,6 +571,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithdrawalParams != nil {
+		{
+			size, err := m.WithdrawalParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -285,57 +598,276 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryStakerWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryStakerWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStakerWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryStakerWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryStakerWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryStakerWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Withdrawal != nil {
+		{
+			size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithdrawalParams != nil {
+		l = m.WithdrawalParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakerWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Withdrawal != nil {
+		l = m.Withdrawal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -439,6 +971,435 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalParams == nil {
+				m.WithdrawalParams = &Params{}
+			}
+			if err := m.WithdrawalParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerWithdrawalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerWithdrawalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakerWithdrawalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakerWithdrawalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakerWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, WithdrawalRecord{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Withdrawal == nil {
+				m.Withdrawal = &WithdrawalRecord{}
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,617 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: exocore/withdraw/withdrawal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WithdrawalStatus is the status of a withdrawal record.
+type WithdrawalStatus int32
+
+const (
+	// WITHDRAWAL_STATUS_PENDING means the withdrawal is waiting for the maturity height,
+	// it can be cancelled in this status.
+	WithdrawalPending WithdrawalStatus = 0
+	// WITHDRAWAL_STATUS_CLAIMABLE means the withdrawal has matured, and the assets can be
+	// released on the client chain.
+	WithdrawalClaimable WithdrawalStatus = 1
+	// WITHDRAWAL_STATUS_COMPLETED means the release on the client chain has been confirmed.
+	WithdrawalCompleted WithdrawalStatus = 2
+	// WITHDRAWAL_STATUS_CANCELLED means the withdrawal has been cancelled before it matured,
+	// and the amount has been returned to the staker.
+	WithdrawalCancelled WithdrawalStatus = 3
+)
+
+var WithdrawalStatus_name = map[int32]string{
+	0: "WITHDRAWAL_STATUS_PENDING",
+	1: "WITHDRAWAL_STATUS_CLAIMABLE",
+	2: "WITHDRAWAL_STATUS_COMPLETED",
+	3: "WITHDRAWAL_STATUS_CANCELLED",
+}
+
+var WithdrawalStatus_value = map[string]int32{
+	"WITHDRAWAL_STATUS_PENDING":   0,
+	"WITHDRAWAL_STATUS_CLAIMABLE": 1,
+	"WITHDRAWAL_STATUS_COMPLETED": 2,
+	"WITHDRAWAL_STATUS_CANCELLED": 3,
+}
+
+func (x WithdrawalStatus) String() string {
+	return proto.EnumName(WithdrawalStatus_name, int32(x))
+}
+
+func (WithdrawalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_06edef7592b1c353, []int{0}
+}
+
+// WithdrawalRecord is the record stored for every withdrawal request.
+// The withdrawn amount has been removed from the staker's asset state when the record is created.
+type WithdrawalRecord struct {
+	Id             uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StakerID       string                                 `protobuf:"bytes,2,opt,name=stakerID,proto3" json:"stakerID,omitempty"`
+	AssetID        string                                 `protobuf:"bytes,3,opt,name=assetID,proto3" json:"assetID,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	RequestHeight  uint64                                 `protobuf:"varint,5,opt,name=requestHeight,proto3" json:"requestHeight,omitempty"`
+	MaturityHeight uint64                                 `protobuf:"varint,6,opt,name=maturityHeight,proto3" json:"maturityHeight,omitempty"`
+	Status         WithdrawalStatus                       `protobuf:"varint,7,opt,name=status,proto3,enum=exocore.withdraw.WithdrawalStatus" json:"status,omitempty"`
+}
+
+func (m *WithdrawalRecord) Reset()         { *m = WithdrawalRecord{} }
+func (m *WithdrawalRecord) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalRecord) ProtoMessage()    {}
+func (*WithdrawalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06edef7592b1c353, []int{0}
+}
+func (m *WithdrawalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalRecord.Merge(m, src)
+}
+func (m *WithdrawalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalRecord proto.InternalMessageInfo
+
+func (m *WithdrawalRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WithdrawalRecord) GetStakerID() string {
+	if m != nil {
+		return m.StakerID
+	}
+	return ""
+}
+
+func (m *WithdrawalRecord) GetAssetID() string {
+	if m != nil {
+		return m.AssetID
+	}
+	return ""
+}
+
+func (m *WithdrawalRecord) GetRequestHeight() uint64 {
+	if m != nil {
+		return m.RequestHeight
+	}
+	return 0
+}
+
+func (m *WithdrawalRecord) GetMaturityHeight() uint64 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *WithdrawalRecord) GetStatus() WithdrawalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return WithdrawalPending
+}
+
+func init() {
+	proto.RegisterEnum("exocore.withdraw.WithdrawalStatus", WithdrawalStatus_name, WithdrawalStatus_value)
+	proto.RegisterType((*WithdrawalRecord)(nil), "exocore.withdraw.WithdrawalRecord")
+}
+
+func init() { proto.RegisterFile("exocore/withdraw/withdrawal.proto", fileDescriptor_06edef7592b1c353) }
+
+var fileDescriptor_06edef7592b1c353 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xd9, 0xb5, 0xab, 0x03, 0x96, 0x38, 0x2a, 0x66, 0x23, 0x64, 0xe3, 0x22, 0x4b,
+	0x11, 0x36, 0x85, 0xd5, 0x83, 0x88, 0x97, 0xb4, 0x09, 0x6e, 0x20, 0x5b, 0x4b, 0x1a, 0x29, 0x78,
+	0x29, 0xd3, 0x64, 0x48, 0x43, 0x93, 0x4c, 0xcd, 0x4c, 0xe8, 0xee, 0x1b, 0xc8, 0x9e, 0x7c, 0x81,
+	0x3d, 0xf9, 0x0a, 0x1e, 0x7c, 0x84, 0x3d, 0x2e, 0x9e, 0xc4, 0xc3, 0x22, 0xed, 0x6b, 0x78, 0x90,
+	0x4d, 0xd2, 0x56, 0x5b, 0xf6, 0x94, 0xf9, 0xfe, 0xff, 0xff, 0x6f, 0x98, 0x7c, 0xdf, 0x07, 0x9f,
+	0x91, 0x53, 0xea, 0xd3, 0x8c, 0x34, 0xa7, 0x11, 0x1f, 0x05, 0x19, 0x9e, 0x2e, 0x0f, 0x38, 0xd6,
+	0x27, 0x19, 0xe5, 0x14, 0x49, 0x55, 0x44, 0x5f, 0x38, 0xca, 0xae, 0x4f, 0x59, 0x42, 0xd9, 0xa0,
+	0xf0, 0x9b, 0x65, 0x51, 0x86, 0x95, 0x47, 0x21, 0x0d, 0x69, 0xa9, 0xdf, 0x9c, 0x4a, 0x75, 0xff,
+	0xbb, 0x08, 0xa5, 0xfe, 0xf2, 0x5e, 0x97, 0xf8, 0x34, 0x0b, 0x50, 0x1d, 0x8a, 0x51, 0x20, 0x03,
+	0x0d, 0x34, 0xb6, 0x5d, 0x31, 0x0a, 0x90, 0x02, 0xef, 0x32, 0x8e, 0xc7, 0x24, 0xb3, 0x4d, 0x59,
+	0xd4, 0x40, 0xe3, 0x9e, 0xbb, 0xac, 0x91, 0x0c, 0x77, 0x30, 0x63, 0x84, 0xdb, 0xa6, 0xbc, 0x55,
+	0x58, 0x8b, 0x12, 0x79, 0xb0, 0x86, 0x13, 0x9a, 0xa7, 0x5c, 0xde, 0xbe, 0x31, 0x5a, 0x6f, 0x2f,
+	0xaf, 0xf7, 0x84, 0x5f, 0xd7, 0x7b, 0x07, 0x61, 0xc4, 0x47, 0xf9, 0x50, 0xf7, 0x69, 0x52, 0xbd,
+	0xb0, 0xfa, 0x1c, 0xb2, 0x60, 0xdc, 0xe4, 0x67, 0x13, 0xc2, 0x74, 0x3b, 0xe5, 0x3f, 0xbe, 0x1d,
+	0xc2, 0xea, 0x07, 0xec, 0x94, 0xbb, 0xd5, 0x5d, 0xe8, 0x39, 0xbc, 0x9f, 0x91, 0x4f, 0x39, 0x61,
+	0xfc, 0x98, 0x44, 0xe1, 0x88, 0xcb, 0x77, 0x8a, 0x67, 0xfe, 0x2f, 0xa2, 0x03, 0x58, 0x4f, 0x30,
+	0xcf, 0xb3, 0x88, 0x9f, 0x55, 0xb1, 0x5a, 0x11, 0x5b, 0x53, 0xd1, 0x1b, 0x58, 0x63, 0x1c, 0xf3,
+	0x9c, 0xc9, 0x3b, 0x1a, 0x68, 0xd4, 0x8f, 0xf6, 0xf5, 0xf5, 0x96, 0xea, 0xab, 0xee, 0xf4, 0x8a,
+	0xa4, 0x5b, 0x11, 0x2f, 0xfe, 0x00, 0x28, 0xad, 0x9b, 0xe8, 0x15, 0xdc, 0xed, 0xdb, 0xde, 0xb1,
+	0xe9, 0x1a, 0x7d, 0xc3, 0x19, 0xf4, 0x3c, 0xc3, 0xfb, 0xd0, 0x1b, 0x74, 0xad, 0x8e, 0x69, 0x77,
+	0xde, 0x49, 0x82, 0xf2, 0xf8, 0xfc, 0x42, 0x7b, 0xb0, 0x82, 0xba, 0x24, 0x0d, 0xa2, 0x34, 0x44,
+	0xaf, 0xe1, 0xd3, 0x4d, 0xaa, 0xed, 0x18, 0xf6, 0x89, 0xd1, 0x72, 0x2c, 0x09, 0x28, 0x4f, 0xce,
+	0x2f, 0xb4, 0x87, 0x2b, 0xae, 0x1d, 0xe3, 0x28, 0xc1, 0xc3, 0x98, 0xdc, 0x42, 0xbe, 0x3f, 0xe9,
+	0x3a, 0x96, 0x67, 0x99, 0x92, 0xb8, 0x41, 0xd2, 0x64, 0x12, 0x13, 0x4e, 0x82, 0x5b, 0x48, 0xa3,
+	0xd3, 0xb6, 0x1c, 0xc7, 0x32, 0xa5, 0xad, 0x0d, 0x12, 0xa7, 0x3e, 0x89, 0x63, 0x12, 0x28, 0xdb,
+	0x9f, 0xbf, 0xaa, 0x42, 0xcb, 0xb9, 0x9c, 0xa9, 0xe0, 0x6a, 0xa6, 0x82, 0xdf, 0x33, 0x15, 0x7c,
+	0x99, 0xab, 0xc2, 0xd5, 0x5c, 0x15, 0x7e, 0xce, 0x55, 0xe1, 0xe3, 0xd1, 0x3f, 0x03, 0xb6, 0xca,
+	0x76, 0x76, 0x08, 0x9f, 0xd2, 0x6c, 0xdc, 0x5c, 0xec, 0xf4, 0xe9, 0x6a, 0xab, 0x8b, 0x81, 0x0f,
+	0x6b, 0xc5, 0x3a, 0xbe, 0xfc, 0x3b, 0x00, 0x61, 0x13, 0x62, 0x8d, 0xf6, 0x02, 0x00, 0x00,
+}
+
+func (m *WithdrawalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaturityHeight != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.MaturityHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RequestHeight != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.RequestHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintWithdrawal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.AssetID) > 0 {
+		i -= len(m.AssetID)
+		copy(dAtA[i:], m.AssetID)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.AssetID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakerID) > 0 {
+		i -= len(m.StakerID)
+		copy(dAtA[i:], m.StakerID)
+		i = encodeVarintWithdrawal(dAtA, i, uint64(len(m.StakerID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintWithdrawal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintWithdrawal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovWithdrawal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WithdrawalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Id))
+	}
+	l = len(m.StakerID)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = len(m.AssetID)
+	if l > 0 {
+		n += 1 + l + sovWithdrawal(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovWithdrawal(uint64(l))
+	if m.RequestHeight != 0 {
+		n += 1 + sovWithdrawal(uint64(m.RequestHeight))
+	}
+	if m.MaturityHeight != 0 {
+		n += 1 + sovWithdrawal(uint64(m.MaturityHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovWithdrawal(uint64(m.Status))
+	}
+	return n
+}
+
+func sovWithdrawal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWithdrawal(x uint64) (n int) {
+	return sovWithdrawal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WithdrawalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeight", wireType)
+			}
+			m.RequestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityHeight", wireType)
+			}
+			m.MaturityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= WithdrawalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWithdrawal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWithdrawal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipWithdrawal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowWithdrawal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowWithdrawal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthWithdrawal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupWithdrawal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthWithdrawal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthWithdrawal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowWithdrawal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupWithdrawal = fmt.Errorf("proto: unexpected end of group")
+)